@@ -2,26 +2,56 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// addr2line simulation - only enough to make pprof work on Macs
-
+// Addr2line is a minimal simulation of the GNU addr2line tool,
+// just enough to support pprof.
+//
+// Usage:
+//	go tool addr2line [-i] [-json] binary [address...]
+//
+// Addr2line reads hexadecimal addresses, one per line and with optional 0x prefix,
+// from standard input. For each input address, addr2line prints two output lines,
+// first the name of the function containing the address and second the file:line
+// of the source code corresponding to that address.
+//
+// If addresses are given on the command line, addr2line translates them
+// as one batch instead of reading standard input, which is faster for
+// large numbers of addresses.
+//
+// The -i flag causes addr2line to print the function and file:line for each
+// call inlined at the address as well, innermost first, followed by the
+// function and file:line of the call site in the enclosing function.
+//
+// The -json flag causes addr2line to print, for each address, a JSON object
+// with fields Address and Frames, a list of the frames at the address,
+// innermost first, with fields Func, Entry, File, Line and Inline.
+//
+// Addresses in C code, such as that linked using cgo, are translated using
+// the DWARF debugging information in the binary, which also records inlined calls.
+//
+// This tool is intended for use only by pprof; its interface may change or
+// it may be deleted entirely in future releases.
 package main
 
 import (
 	"bufio"
-	"debug/elf"
-	"debug/gosym"
-	"debug/macho"
-	"debug/pe"
+	"debug/symbolize"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strconv"
 	"strings"
 )
 
+var (
+	inlines  = flag.Bool("i", false, "print inlined calls")
+	jsonFlag = flag.Bool("json", false, "print JSON output")
+)
+
 func printUsage(w *os.File) {
-	fmt.Fprintf(w, "usage: addr2line binary\n")
+	fmt.Fprintf(w, "usage: addr2line [-i] [-json] binary [address...]\n")
 	fmt.Fprintf(w, "reads addresses from standard input and writes two lines for each:\n")
 	fmt.Fprintf(w, "\tfunction name\n")
 	fmt.Fprintf(w, "\tfile:line\n")
@@ -44,29 +74,41 @@ func main() {
 
 	flag.Usage = usage
 	flag.Parse()
-	if flag.NArg() != 1 {
+	if flag.NArg() < 1 {
 		usage()
 	}
 
-	f, err := os.Open(flag.Arg(0))
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	textStart, symtab, pclntab, err := loadTables(f)
+	s, err := symbolize.Open(flag.Arg(0))
 	if err != nil {
 		log.Fatalf("reading %s: %v", flag.Arg(0), err)
 	}
+	defer s.Close()
 
-	pcln := gosym.NewLineTable(pclntab, textStart)
-	tab, err := gosym.NewTable(symtab, pcln)
-	if err != nil {
-		log.Fatalf("reading %s: %v", flag.Arg(0), err)
+	stdout := bufio.NewWriter(os.Stdout)
+	defer stdout.Flush()
+
+	if flag.NArg() > 1 {
+		// Batch mode.
+		args := flag.Args()[1:]
+		pcs := make([]uint64, len(args))
+		for i, arg := range args {
+			pc, err := parseAddr(arg)
+			if err != nil {
+				log.Fatalf("invalid address %q", arg)
+			}
+			pcs[i] = pc
+		}
+		frames, err := s.Symbolize(pcs)
+		if err != nil {
+			log.Fatalf("reading %s: %v", flag.Arg(0), err)
+		}
+		for i, pc := range pcs {
+			printFrames(stdout, pc, frames[i])
+		}
+		return
 	}
 
 	stdin := bufio.NewScanner(os.Stdin)
-	stdout := bufio.NewWriter(os.Stdout)
-
 	for stdin.Scan() {
 		p := stdin.Text()
 		if strings.Contains(p, ":") {
@@ -77,71 +119,54 @@ func main() {
 			fmt.Fprintf(stdout, "!reverse translation not implemented\n")
 			continue
 		}
-		pc, _ := strconv.ParseUint(p, 16, 64)
-		file, line, fn := tab.PCToLine(pc)
-		name := "?"
-		if fn != nil {
-			name = fn.Name
-		} else {
-			file = "?"
-			line = 0
+		pc, _ := parseAddr(p)
+		frames, err := s.Frames(pc)
+		if err != nil {
+			log.Fatalf("reading %s: %v", flag.Arg(0), err)
 		}
-		fmt.Fprintf(stdout, "%s\n%s:%d\n", name, file, line)
+		printFrames(stdout, pc, frames)
 	}
-	stdout.Flush()
 }
 
-func loadTables(f *os.File) (textStart uint64, symtab, pclntab []byte, err error) {
-	if obj, err := elf.NewFile(f); err == nil {
-		if sect := obj.Section(".text"); sect != nil {
-			textStart = sect.Addr
-		}
-		if sect := obj.Section(".gosymtab"); sect != nil {
-			if symtab, err = sect.Data(); err != nil {
-				return 0, nil, nil, err
-			}
-		}
-		if sect := obj.Section(".gopclntab"); sect != nil {
-			if pclntab, err = sect.Data(); err != nil {
-				return 0, nil, nil, err
-			}
-		}
-		return textStart, symtab, pclntab, nil
-	}
+func parseAddr(s string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(s), "0x"), 16, 64)
+}
 
-	if obj, err := macho.NewFile(f); err == nil {
-		if sect := obj.Section("__text"); sect != nil {
-			textStart = sect.Addr
+// jsonAddr is the JSON output for an address.
+type jsonAddr struct {
+	Address string
+	Frames  []symbolize.Frame
+}
+
+// printFrames prints the frames for pc to w.
+func printFrames(w io.Writer, pc uint64, frames []symbolize.Frame) {
+	if *jsonFlag {
+		if frames == nil {
+			frames = []symbolize.Frame{}
 		}
-		if sect := obj.Section("__gosymtab"); sect != nil {
-			if symtab, err = sect.Data(); err != nil {
-				return 0, nil, nil, err
-			}
+		b, err := json.Marshal(&jsonAddr{fmt.Sprintf("%#x", pc), frames})
+		if err != nil {
+			log.Fatal(err)
 		}
-		if sect := obj.Section("__gopclntab"); sect != nil {
-			if pclntab, err = sect.Data(); err != nil {
-				return 0, nil, nil, err
-			}
-		}
-		return textStart, symtab, pclntab, nil
+		fmt.Fprintf(w, "%s\n", b)
+		return
 	}
 
-	if obj, err := pe.NewFile(f); err == nil {
-		if sect := obj.Section(".text"); sect != nil {
-			textStart = uint64(sect.VirtualAddress)
-		}
-		if sect := obj.Section(".gosymtab"); sect != nil {
-			if symtab, err = sect.Data(); err != nil {
-				return 0, nil, nil, err
-			}
+	if len(frames) == 0 {
+		fmt.Fprintf(w, "?\n?:0\n")
+		return
+	}
+	if !*inlines {
+		frames = frames[:1]
+	}
+	for _, f := range frames {
+		name, file := f.Func, f.File
+		if name == "" {
+			name = "?"
 		}
-		if sect := obj.Section(".gopclntab"); sect != nil {
-			if pclntab, err = sect.Data(); err != nil {
-				return 0, nil, nil, err
-			}
+		if file == "" {
+			file = "?"
 		}
-		return textStart, symtab, pclntab, nil
+		fmt.Fprintf(w, "%s\n%s:%d\n", name, file, f.Line)
 	}
-
-	return 0, nil, nil, fmt.Errorf("unrecognized binary format")
 }
@@ -0,0 +1,320 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// A change is a difference between two versions of an API,
+// made of the features removed from the old version and those
+// added in the new one.
+type change struct {
+	removed      string // feature in the old API, or ""
+	added        string // feature in the new API, or ""
+	incompatible bool   // whether existing clients may break
+	reason       string // short description of the change
+}
+
+// feature returns the feature used to order changes.
+func (c *change) feature() string {
+	if c.removed != "" {
+		return c.removed
+	}
+	return c.added
+}
+
+// compareCompat is like compareAPI, but classifies the differences
+// between features and required as compatible or incompatible changes,
+// and reports whether all changes are compatible.
+func compareCompat(w io.Writer, features, required, optional, exception []string) (ok bool) {
+	ok = true
+	removed, added := diffAPI(features, required, optional, exception)
+	changes := classify(removed, added)
+	for _, incompatible := range []bool{true, false} {
+		for _, c := range changes {
+			if c.incompatible != incompatible {
+				continue
+			}
+			if incompatible {
+				fmt.Fprintf(w, "incompatible: %s\n", c.reason)
+				ok = false
+			} else {
+				fmt.Fprintf(w, "compatible: %s\n", c.reason)
+				if !*allowNew {
+					ok = false // we're in lock-down mode for next release
+				}
+			}
+			if c.removed != "" {
+				fmt.Fprintf(w, "\t-%s\n", c.removed)
+			}
+			if c.added != "" {
+				fmt.Fprintf(w, "\t+%s\n", c.added)
+			}
+		}
+	}
+	return
+}
+
+// diffAPI returns the required features missing from features and
+// the features not in required. As with compareAPI, features listed
+// in optional are not reported as added, nor those in exception as
+// removed.
+func diffAPI(features, required, optional, exception []string) (removed, added []string) {
+	optionalSet := set(optional)
+	exceptionSet := set(exception)
+	featureSet := set(features)
+	requiredSet := set(required)
+
+	for _, f := range required {
+		if !featureSet[f] && !exceptionSet[f] && !featureSet[featureWithoutContext(f)] {
+			removed = append(removed, f)
+		}
+	}
+	for _, f := range features {
+		if !requiredSet[f] && !optionalSet[f] {
+			added = append(added, f)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+	return
+}
+
+// classify pairs up the removed and added features that describe
+// the same API element and classifies the resulting changes.
+// The changes are returned in feature order.
+func classify(removed, added []string) []*change {
+	var changes []*change
+
+	addedByKey := make(map[string]string)
+	for _, f := range added {
+		addedByKey[featureKey(f)] = f
+	}
+	paired := make(map[string]bool)
+	grown := make(map[string]bool) // interfaces with added methods
+
+	for _, r := range removed {
+		key := featureKey(r)
+		a, ok := addedByKey[key]
+		if !ok || paired[a] {
+			if featureKind(r) == "method set" {
+				// The interface was removed, or gained unexported
+				// methods; either is reported by other features.
+				continue
+			}
+			changes = append(changes, &change{
+				removed:      r,
+				incompatible: !strings.HasSuffix(r, ", unexported methods"),
+				reason:       "removed " + featureKind(r),
+			})
+			continue
+		}
+		paired[a] = true
+		c := &change{removed: r, added: a, incompatible: true}
+		switch kind := featureKind(r); kind {
+		case "method set":
+			names := methodNames(a)
+			for name := range methodNames(r) {
+				delete(names, name)
+			}
+			if len(names) == 0 {
+				// Only methods were removed; each removal
+				// is reported by its own feature.
+				continue
+			}
+			var list []string
+			for name := range names {
+				list = append(list, name)
+			}
+			sort.Strings(list)
+			c.reason = "added interface method " + strings.Join(list, ", ")
+			grown[typeName(a)] = true
+		case "method":
+			if pointerToValueReceiver(r, a) {
+				// The method set of T grows, and that of *T
+				// is unchanged.
+				c.incompatible = false
+				c.reason = "changed receiver from pointer to value"
+			} else if pointerToValueReceiver(a, r) {
+				c.reason = "changed receiver from value to pointer"
+			} else {
+				c.reason = "changed method signature"
+			}
+		case "func", "interface method":
+			c.reason = "changed " + kind + " signature"
+		case "const value":
+			c.reason = "changed const value"
+		case "type":
+			c.reason = "changed type"
+		default:
+			c.reason = "changed " + kind + " type"
+		}
+		changes = append(changes, c)
+	}
+
+	for _, a := range added {
+		if paired[a] {
+			continue
+		}
+		if featureKind(a) == "interface method" && grown[typeName(a)] {
+			// Reported as part of the interface's method set.
+			continue
+		}
+		c := &change{added: a, reason: "added " + featureKind(a)}
+		if strings.HasSuffix(a, ", unexported methods") {
+			// Other packages can no longer implement the interface.
+			c.incompatible = true
+			c.reason = "added unexported interface method"
+		}
+		changes = append(changes, c)
+	}
+
+	sort.Sort(byFeature(changes))
+	return changes
+}
+
+type byFeature []*change
+
+func (s byFeature) Len() int           { return len(s) }
+func (s byFeature) Less(i, j int) bool { return s[i].feature() < s[j].feature() }
+func (s byFeature) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// splitFeature splits a feature into its package scope,
+// such as "pkg p" or "pkg syscall (linux-386)", and the rest.
+func splitFeature(f string) (pkg, rest string) {
+	i := strings.Index(f, ", ")
+	if i < 0 {
+		return "", f
+	}
+	return f[:i], f[i+len(", "):]
+}
+
+// featureKey returns the part of a feature that names the API element
+// it describes. Features with the same key describe the same element,
+// so a removed and an added feature with the same key are a change
+// of that element.
+func featureKey(f string) string {
+	pkg, rest := splitFeature(f)
+	switch {
+	case strings.HasPrefix(rest, "func "):
+		return pkg + ", " + upTo(rest, "(")
+	case strings.HasPrefix(rest, "method ("):
+		// The receiver may change between T and *T.
+		i := strings.Index(rest, ") ")
+		if i < 0 {
+			return f
+		}
+		recv := strings.TrimPrefix(rest[len("method ("):i], "*")
+		return pkg + ", method (" + recv + ") " + upTo(rest[i+len(") "):], "(")
+	case strings.HasPrefix(rest, "const "):
+		if i := strings.Index(rest, " = "); i >= 0 {
+			return pkg + ", " + rest[:i+len(" =")]
+		}
+		return pkg + ", const " + upTo(rest[len("const "):], " ")
+	case strings.HasPrefix(rest, "var "):
+		return pkg + ", var " + upTo(rest[len("var "):], " ")
+	case strings.HasPrefix(rest, "type "):
+		name, rest := upTo(rest[len("type "):], " "), afterFirst(rest[len("type "):], " ")
+		switch {
+		case strings.HasPrefix(rest, "struct, embedded "), strings.HasSuffix(rest, ", unexported methods"):
+			return f
+		case strings.HasPrefix(rest, "struct, "):
+			return pkg + ", type " + name + " struct, " + upTo(rest[len("struct, "):], " ")
+		case strings.HasPrefix(rest, "interface, "):
+			return pkg + ", type " + name + " interface, " + upTo(rest[len("interface, "):], "(")
+		case strings.HasPrefix(rest, "interface {"):
+			return pkg + ", type " + name + " interface {"
+		}
+		return pkg + ", type " + name
+	}
+	return f
+}
+
+// typeName returns the package scope and type name of a feature
+// describing a type, such as "pkg p, type T".
+func typeName(f string) string {
+	pkg, rest := splitFeature(f)
+	return pkg + ", type " + upTo(strings.TrimPrefix(rest, "type "), " ")
+}
+
+// featureKind returns a description of the kind of API element
+// described by a feature.
+func featureKind(f string) string {
+	_, rest := splitFeature(f)
+	switch {
+	case strings.HasPrefix(rest, "func "):
+		return "func"
+	case strings.HasPrefix(rest, "method "):
+		return "method"
+	case strings.HasPrefix(rest, "const "):
+		if strings.Contains(rest, " = ") {
+			return "const value"
+		}
+		return "const"
+	case strings.HasPrefix(rest, "var "):
+		return "var"
+	case strings.HasPrefix(rest, "type "):
+		rest = afterFirst(rest[len("type "):], " ")
+		switch {
+		case strings.HasPrefix(rest, "struct, embedded "):
+			return "embedded field"
+		case strings.HasPrefix(rest, "struct, "):
+			return "struct field"
+		case strings.HasSuffix(rest, ", unexported methods"):
+			return "unexported interface method"
+		case strings.HasPrefix(rest, "interface, "):
+			return "interface method"
+		case strings.HasPrefix(rest, "interface {"):
+			return "method set"
+		}
+		return "type"
+	}
+	return "feature"
+}
+
+// methodNames returns the set of method names listed by an
+// interface feature of the form "type T interface { M1, M2 }".
+func methodNames(f string) map[string]bool {
+	names := make(map[string]bool)
+	i := strings.Index(f, "{")
+	j := strings.LastIndex(f, "}")
+	if i < 0 || j < i {
+		return names
+	}
+	for _, name := range strings.Split(f[i+1:j], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// pointerToValueReceiver reports whether the method features old and
+// new differ only in that the receiver changed from *T to T.
+func pointerToValueReceiver(old, new string) bool {
+	return strings.Replace(old, "method (*", "method (", 1) == new && old != new
+}
+
+// upTo returns the prefix of s before the first instance of sep,
+// or s if sep does not occur in s.
+func upTo(s, sep string) string {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// afterFirst returns the suffix of s after the first instance of sep,
+// or "" if sep does not occur in s.
+func afterFirst(s, sep string) string {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[i+len(sep):]
+	}
+	return ""
+}
@@ -0,0 +1,165 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCompareCompat(t *testing.T) {
+	tests := []struct {
+		name                                    string
+		features, required, optional, exception []string
+		ok                                      bool   // want
+		out                                     string // want
+	}{
+		{
+			name:     "no change",
+			features: []string{"pkg p, func F()"},
+			required: []string{"pkg p, func F()"},
+			ok:       true,
+			out:      "",
+		},
+		{
+			name:     "func added",
+			features: []string{"pkg p, func F()", "pkg p, func G()"},
+			required: []string{"pkg p, func F()"},
+			ok:       true,
+			out:      "compatible: added func\n\t+pkg p, func G()\n",
+		},
+		{
+			name:     "method removed",
+			features: []string{"pkg p, type T struct"},
+			required: []string{"pkg p, type T struct", "pkg p, method (*T) M()"},
+			ok:       false,
+			out:      "incompatible: removed method\n\t-pkg p, method (*T) M()\n",
+		},
+		{
+			name:     "signature changed",
+			features: []string{"pkg p, func F(int, string) error"},
+			required: []string{"pkg p, func F(int) error"},
+			ok:       false,
+			out:      "incompatible: changed func signature\n\t-pkg p, func F(int) error\n\t+pkg p, func F(int, string) error\n",
+		},
+		{
+			name:     "receiver changed to value",
+			features: []string{"pkg p, method (T) M()"},
+			required: []string{"pkg p, method (*T) M()"},
+			ok:       true,
+			out:      "compatible: changed receiver from pointer to value\n\t-pkg p, method (*T) M()\n\t+pkg p, method (T) M()\n",
+		},
+		{
+			name:     "receiver changed to pointer",
+			features: []string{"pkg p, method (*T) M()"},
+			required: []string{"pkg p, method (T) M()"},
+			ok:       false,
+			out:      "incompatible: changed receiver from value to pointer\n\t-pkg p, method (T) M()\n\t+pkg p, method (*T) M()\n",
+		},
+		{
+			name: "interface method added",
+			features: []string{
+				"pkg p, type I interface { M, N }",
+				"pkg p, type I interface, M()",
+				"pkg p, type I interface, N()",
+			},
+			required: []string{
+				"pkg p, type I interface { M }",
+				"pkg p, type I interface, M()",
+			},
+			ok:  false,
+			out: "incompatible: added interface method N\n\t-pkg p, type I interface { M }\n\t+pkg p, type I interface { M, N }\n",
+		},
+		{
+			name: "interface method removed",
+			features: []string{
+				"pkg p, type I interface { M }",
+				"pkg p, type I interface, M()",
+			},
+			required: []string{
+				"pkg p, type I interface { M, N }",
+				"pkg p, type I interface, M()",
+				"pkg p, type I interface, N()",
+			},
+			ok:  false,
+			out: "incompatible: removed interface method\n\t-pkg p, type I interface, N()\n",
+		},
+		{
+			name: "method added to interface with unexported methods",
+			features: []string{
+				"pkg p, type I interface, M()",
+				"pkg p, type I interface, N()",
+				"pkg p, type I interface, unexported methods",
+			},
+			required: []string{
+				"pkg p, type I interface, M()",
+				"pkg p, type I interface, unexported methods",
+			},
+			ok:  true,
+			out: "compatible: added interface method\n\t+pkg p, type I interface, N()\n",
+		},
+		{
+			name: "unexported method added to interface",
+			features: []string{
+				"pkg p, type I interface, M()",
+				"pkg p, type I interface, unexported methods",
+			},
+			required: []string{
+				"pkg p, type I interface { M }",
+				"pkg p, type I interface, M()",
+			},
+			ok:  false,
+			out: "incompatible: added unexported interface method\n\t+pkg p, type I interface, unexported methods\n",
+		},
+		{
+			name:     "struct field removed",
+			features: []string{"pkg p, type S struct", "pkg p, type S struct, A int"},
+			required: []string{"pkg p, type S struct", "pkg p, type S struct, A int", "pkg p, type S struct, B string"},
+			ok:       false,
+			out:      "incompatible: removed struct field\n\t-pkg p, type S struct, B string\n",
+		},
+		{
+			name:     "struct field type changed",
+			features: []string{"pkg p, type S struct, A int64"},
+			required: []string{"pkg p, type S struct, A int"},
+			ok:       false,
+			out:      "incompatible: changed struct field type\n\t-pkg p, type S struct, A int\n\t+pkg p, type S struct, A int64\n",
+		},
+		{
+			name:     "const value changed",
+			features: []string{"pkg p, const C ideal-int", "pkg p, const C = 2"},
+			required: []string{"pkg p, const C ideal-int", "pkg p, const C = 1"},
+			ok:       false,
+			out:      "incompatible: changed const value\n\t-pkg p, const C = 1\n\t+pkg p, const C = 2\n",
+		},
+		{
+			name:      "exception removal",
+			features:  []string{"pkg p, func F()"},
+			required:  []string{"pkg p, func F()", "pkg p, func G()"},
+			exception: []string{"pkg p, func G()"},
+			ok:        true,
+			out:       "",
+		},
+		{
+			name:     "incompatible changes first",
+			features: []string{"pkg p, func A()", "pkg p, var V string"},
+			required: []string{"pkg p, var V int", "pkg p, func Z()"},
+			ok:       false,
+			out: "incompatible: removed func\n\t-pkg p, func Z()\n" +
+				"incompatible: changed var type\n\t-pkg p, var V int\n\t+pkg p, var V string\n" +
+				"compatible: added func\n\t+pkg p, func A()\n",
+		},
+	}
+	for _, tt := range tests {
+		buf := new(bytes.Buffer)
+		gotok := compareCompat(buf, tt.features, tt.required, tt.optional, tt.exception)
+		if gotok != tt.ok {
+			t.Errorf("%s: ok = %v; want %v", tt.name, gotok, tt.ok)
+		}
+		if got := buf.String(); got != tt.out {
+			t.Errorf("%s: output differs\nGOT:\n%s\nWANT:\n%s", tt.name, got, tt.out)
+		}
+	}
+}
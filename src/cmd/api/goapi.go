@@ -2,9 +2,17 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// +build api_tool
-
 // Binary api computes the exported API of a set of Go packages.
+//
+// With no arguments, api prints the API of the standard library.
+// Otherwise the arguments are import paths or patterns, as accepted
+// by "go list", naming packages in GOROOT or GOPATH; the API of those
+// packages is printed instead. The output, one feature per line, may
+// be saved to a file (see -w) and later used with -c to check a new
+// revision of the same packages against it. With -compat, each
+// difference found by -c is classified as a compatible or an
+// incompatible change, and api exits with a non-zero status if any
+// change is incompatible.
 package main
 
 import (
@@ -14,8 +22,10 @@ import (
 	"fmt"
 	"go/ast"
 	"go/build"
+	"go/constant"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io"
 	"io/ioutil"
 	"log"
@@ -26,8 +36,6 @@ import (
 	"runtime"
 	"sort"
 	"strings"
-
-	"code.google.com/p/go.tools/go/types"
 )
 
 // Flags
@@ -38,6 +46,8 @@ var (
 	nextFile   = flag.String("next", "", "optional filename of tentative upcoming API features for the next release. This file can be lazily maintained. It only affects the delta warnings from the -c file printed on success.")
 	verbose    = flag.Bool("v", false, "verbose debugging")
 	forceCtx   = flag.String("contexts", "", "optional comma-separated list of <goos>-<goarch>[-cgo] to override default contexts.")
+	writeFile  = flag.String("w", "", "optional filename to write the API to, instead of standard output")
+	compat     = flag.Bool("compat", false, "with -c, classify the API differences as compatible or incompatible changes")
 )
 
 // contexts are the default contexts which are scanned, unless
@@ -122,29 +132,32 @@ func main() {
 	}
 	for _, c := range contexts {
 		c.Compiler = build.Default.Compiler
+		c.GOROOT = build.Default.GOROOT
+		c.GOPATH = build.Default.GOPATH
 	}
 
-	var pkgNames []string
-	if flag.NArg() > 0 {
-		pkgNames = flag.Args()
-	} else {
-		stds, err := exec.Command("go", "list", "std").Output()
-		if err != nil {
-			log.Fatal(err)
-		}
-		pkgNames = strings.Fields(string(stds))
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"std"}
+	}
+	list, err := exec.Command("go", append([]string{"list"}, patterns...)...).Output()
+	if err != nil {
+		log.Fatalf("go list %s: %v", strings.Join(patterns, " "), err)
 	}
+	pkgNames := strings.Fields(string(list))
 
 	var featureCtx = make(map[string]map[string]bool) // feature -> context name -> true
 	for _, context := range contexts {
-		w := NewWalker(context, filepath.Join(build.Default.GOROOT, "src/pkg"))
+		w := NewWalker(context, "")
 
 		for _, name := range pkgNames {
 			// - Package "unsafe" contains special signatures requiring
 			//   extra care when printing them - ignore since it is not
 			//   going to change w/o a language change.
 			// - We don't care about the API of commands.
-			if name != "unsafe" && !strings.HasPrefix(name, "cmd/") {
+			// - Internal packages cannot be imported by other
+			//   trees, so they have no API.
+			if name != "unsafe" && !strings.HasPrefix(name, "cmd/") && !isInternal(name) {
 				if name == "runtime/cgo" && !context.CgoEnabled {
 					// w.Import(name) will return nil
 					continue
@@ -182,7 +195,20 @@ func main() {
 		}
 	}()
 
-	bw := bufio.NewWriter(os.Stdout)
+	out := os.Stdout
+	if *writeFile != "" {
+		f, err := os.Create(*writeFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Fatal(err)
+			}
+		}()
+		out = f
+	}
+	bw := bufio.NewWriter(out)
 	defer bw.Flush()
 
 	if *checkFile == "" {
@@ -199,9 +225,24 @@ func main() {
 	}
 	optional := fileFeatures(*nextFile)
 	exception := fileFeatures(*exceptFile)
+	if *compat {
+		fail = !compareCompat(bw, features, required, optional, exception)
+		return
+	}
 	fail = !compareAPI(bw, features, required, optional, exception)
 }
 
+// isInternal reports whether the import path contains an
+// "internal" element.
+func isInternal(path string) bool {
+	for _, elem := range strings.Split(path, "/") {
+		if elem == "internal" {
+			return true
+		}
+	}
+	return false
+}
+
 // export emits the exported package features.
 func (w *Walker) export(pkg *types.Package) {
 	if *verbose {
@@ -437,17 +478,27 @@ func (w *Walker) Import(name string) (pkg *types.Package) {
 	}
 	w.imported[name] = &importing
 
-	// Determine package files.
-	dir := filepath.Join(w.root, filepath.FromSlash(name))
-	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
-		log.Fatalf("no source in tree for package %q", pkg)
-	}
-
 	context := w.context
 	if context == nil {
 		context = &build.Default
 	}
 
+	// Determine package files.
+	// Without a root, the package is found in GOROOT or GOPATH.
+	var dir string
+	if w.root != "" {
+		dir = filepath.Join(w.root, filepath.FromSlash(name))
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			log.Fatalf("no source in tree for package %q", name)
+		}
+	} else {
+		bp, err := context.Import(name, "", build.FindOnly)
+		if err != nil {
+			log.Fatalf("no source for package %q: %v", name, err)
+		}
+		dir = bp.Dir
+	}
+
 	// Look in cache.
 	// If we've already done an import with the same set
 	// of relevant tags, reuse the result.
@@ -504,11 +555,7 @@ func (w *Walker) Import(name string) (pkg *types.Package) {
 	conf := types.Config{
 		IgnoreFuncBodies: true,
 		FakeImportC:      true,
-		Import: func(imports map[string]*types.Package, name string) (*types.Package, error) {
-			pkg := w.Import(name)
-			imports[name] = pkg
-			return pkg, nil
-		},
+		Importer:         importerFunc(func(name string) (*types.Package, error) { return w.Import(name), nil }),
 	}
 	pkg, err = conf.Check(name, fset, files, nil)
 	if err != nil {
@@ -525,6 +572,11 @@ func (w *Walker) Import(name string) (pkg *types.Package) {
 	return
 }
 
+// importerFunc adapts a function to the types.Importer interface.
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
 // pushScope enters a new scope (walking a package, type, node, etc)
 // and returns a function that will leave the scope (with sanity checking
 // for mismatched pushes & pops)
@@ -624,9 +676,9 @@ func (w *Walker) writeType(buf *bytes.Buffer, typ types.Type) {
 	case *types.Chan:
 		var s string
 		switch typ.Dir() {
-		case ast.SEND:
+		case types.SendOnly:
 			s = "chan<- "
-		case ast.RECV:
+		case types.RecvOnly:
 			s = "<-chan "
 		default:
 			s = "chan "
@@ -649,7 +701,7 @@ func (w *Walker) writeType(buf *bytes.Buffer, typ types.Type) {
 }
 
 func (w *Walker) writeSignature(buf *bytes.Buffer, sig *types.Signature) {
-	w.writeParams(buf, sig.Params(), sig.IsVariadic())
+	w.writeParams(buf, sig.Params(), sig.Variadic())
 	switch res := sig.Results(); res.Len() {
 	case 0:
 		// nothing to do
@@ -694,7 +746,7 @@ func (w *Walker) emitObj(obj types.Object) {
 	switch obj := obj.(type) {
 	case *types.Const:
 		w.emitf("const %s %s", obj.Name(), w.typeString(obj.Type()))
-		w.emitf("const %s = %s", obj.Name(), obj.Val())
+		w.emitf("const %s = %s", obj.Name(), constString(obj.Val()))
 	case *types.Var:
 		w.emitf("var %s %s", obj.Name(), w.typeString(obj.Type()))
 	case *types.TypeName:
@@ -706,6 +758,19 @@ func (w *Walker) emitObj(obj types.Object) {
 	}
 }
 
+// constString returns the exact value of a constant.
+// Floating-point values are written as fractions.
+func constString(v constant.Value) string {
+	if v.Kind() == constant.Float {
+		num, denom := constant.Num(v).String(), constant.Denom(v).String()
+		if denom == "1" {
+			return num
+		}
+		return num + "/" + denom
+	}
+	return v.String()
+}
+
 func (w *Walker) emitType(obj *types.TypeName) {
 	name := obj.Name()
 	typ := obj.Type()
@@ -721,10 +786,10 @@ func (w *Walker) emitType(obj *types.TypeName) {
 
 	// emit methods with value receiver
 	var methodNames map[string]bool
-	vset := typ.MethodSet()
+	vset := types.NewMethodSet(typ)
 	for i, n := 0, vset.Len(); i < n; i++ {
 		m := vset.At(i)
-		if m.Obj().IsExported() {
+		if m.Obj().Exported() {
 			w.emitMethod(m)
 			if methodNames == nil {
 				methodNames = make(map[string]bool)
@@ -736,10 +801,10 @@ func (w *Walker) emitType(obj *types.TypeName) {
 	// emit methods with pointer receiver; exclude
 	// methods that we have emitted already
 	// (the method set of *T includes the methods of T)
-	pset := types.NewPointer(typ).MethodSet()
+	pset := types.NewMethodSet(types.NewPointer(typ))
 	for i, n := 0, pset.Len(); i < n; i++ {
 		m := pset.At(i)
-		if m.Obj().IsExported() && !methodNames[m.Obj().Name()] {
+		if m.Obj().Exported() && !methodNames[m.Obj().Name()] {
 			w.emitMethod(m)
 		}
 	}
@@ -752,7 +817,7 @@ func (w *Walker) emitStructType(name string, typ *types.Struct) {
 
 	for i := 0; i < typ.NumFields(); i++ {
 		f := typ.Field(i)
-		if !f.IsExported() {
+		if !f.Exported() {
 			continue
 		}
 		typ := f.Type()
@@ -769,10 +834,10 @@ func (w *Walker) emitIfaceType(name string, typ *types.Interface) {
 
 	var methodNames []string
 	complete := true
-	mset := typ.MethodSet()
+	mset := types.NewMethodSet(typ)
 	for i, n := 0, mset.Len(); i < n; i++ {
 		m := mset.At(i).Obj().(*types.Func)
-		if !m.IsExported() {
+		if !m.Exported() {
 			complete = false
 			continue
 		}
@@ -823,7 +888,7 @@ func (w *Walker) emitMethod(m *types.Selection) {
 		if p, _ := recv.(*types.Pointer); p != nil {
 			base = p.Elem()
 		}
-		if obj := base.(*types.Named).Obj(); !obj.IsExported() {
+		if obj := base.(*types.Named).Obj(); !obj.Exported() {
 			log.Fatalf("exported method with unexported receiver base type: %s", m)
 		}
 	}
@@ -373,7 +373,6 @@ static char *oldtool[] = {
 // Unreleased directories (relative to $GOROOT) that should
 // not be in release branches.
 static char *unreleased[] = {
-	"src/cmd/prof",
 	"src/pkg/old",
 };
 
@@ -689,20 +688,6 @@ install(char *dir)
 	bpathf(&path, "%s/src/%s", goroot, dir);
 	name = lastelem(dir);
 
-	// For misc/prof, copy into the tool directory and we're done.
-	if(hasprefix(dir, "misc/")) {
-		copy(bpathf(&b, "%s/%s", tooldir, name),
-			bpathf(&b1, "%s/misc/%s", goroot, name), 1);
-		goto out;
-	}
-
-	// For release, cmd/prof is not included.
-	if((streq(dir, "cmd/prof")) && !isdir(bstr(&path))) {
-		if(vflag > 1)
-			errprintf("skipping %s - does not exist\n", dir);
-		goto out;
-	}
-
 	// set up gcc command line on first run.
 	if(gccargs.len == 0) {
 		bprintf(&b, "%s %s", defaultcc, defaultcflags);
@@ -1330,11 +1315,6 @@ static char *buildorder[] = {
 	"libmach",
 	"liblink",
 
-	"misc/pprof",
-
-	"cmd/objdump",
-	"cmd/prof",
-
 	"cmd/cc",  // must be before c
 	"cmd/gc",  // must be before g
 	"cmd/%sl",  // must be before a, c, g
@@ -1368,6 +1348,7 @@ static char *buildorder[] = {
 	"pkg/reflect",
 	"pkg/fmt",
 	"pkg/encoding",
+	"pkg/encoding/hex",
 	"pkg/encoding/json",
 	"pkg/flag",
 	"pkg/path/filepath",
@@ -1409,8 +1390,6 @@ static char *cleantab[] = {
 	"cmd/cc",
 	"cmd/gc",
 	"cmd/go",	
-	"cmd/objdump",
-	"cmd/prof",
 	"lib9",
 	"libbio",
 	"libmach",
@@ -1420,6 +1399,7 @@ static char *cleantab[] = {
 	"pkg/container/heap",
 	"pkg/encoding",
 	"pkg/encoding/base64",
+	"pkg/encoding/hex",
 	"pkg/encoding/json",
 	"pkg/errors",
 	"pkg/flag",
@@ -1469,8 +1449,6 @@ clean(void)
 	vinit(&dir);
 
 	for(i=0; i<nelem(cleantab); i++) {
-		if((streq(cleantab[i], "cmd/prof")) && !isdir(cleantab[i]))
-			continue;
 		bpathf(&path, "%s/src/%s", goroot, cleantab[i]);
 		xreaddir(&dir, bstr(&path));
 		// Remove generated files.
@@ -0,0 +1,286 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/build"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// The test package lives in the Go path testdata, as example.com/docpkg.
+func init() {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		panic(err)
+	}
+	build.Default.GOPATH = gopath
+}
+
+type test struct {
+	name string
+	args []string // Arguments to "[go] doc".
+	yes  []string // Regular expressions that should match.
+	no   []string // Regular expressions that should not match.
+}
+
+var tests = []test{
+	// Package dump includes import, package statement.
+	{
+		"package clause",
+		[]string{"example.com/docpkg"},
+		[]string{`package docpkg // import "example.com/docpkg"`},
+		nil,
+	},
+
+	// Package dump, found by the last element of its path.
+	{
+		"full package",
+		[]string{"docpkg"},
+		[]string{
+			`package docpkg // import "example.com/docpkg"`,
+			`Package docpkg is a package for testing go doc.`,
+			`It has a second paragraph.`,
+			`const ExportedConstant = 1`,
+			`var ExportedVariable = 1`,
+			`func ExportedFunc\(a int\) bool`,
+			`type ExportedType struct{ ... }`,
+			`    func NewExportedType\(\) \*ExportedType`,
+			`BUG: There is a bug.`,
+		},
+		[]string{
+			`unexported`,
+			`ExportedConstant is a constant`,
+			`ExportedMethod`,
+			`Comment in the function body`,
+		},
+	},
+
+	// Package dump -u.
+	{
+		"full package with u",
+		[]string{"-u", "docpkg"},
+		[]string{
+			`const ExportedConstant = 1`,
+			`const unexportedConstant = 2`,
+			`var unexportedVariable = 2`,
+			`func unexportedFunc\(\)`,
+			`type unexportedType int`,
+		},
+		[]string{
+			`Comment on unexported constant`,
+			`unexportedMethod`,
+		},
+	},
+
+	// Package dump -all.
+	{
+		"full package with all",
+		[]string{"-all", "docpkg"},
+		[]string{
+			`Package docpkg is a package for testing go doc.`,
+			`CONSTANTS`,
+			`const ExportedConstant = 1\n    ExportedConstant is a constant.`,
+			`VARIABLES`,
+			`var ExportedVariable = 1\n    ExportedVariable is a variable.`,
+			`FUNCTIONS`,
+			`func ExportedFunc\(a int\) bool\n    ExportedFunc is an exported function.`,
+			`TYPES`,
+			`type ExportedType struct {`,
+			`func NewExportedType\(\) \*ExportedType\n    NewExportedType returns a new ExportedType.`,
+			`func \(t \*ExportedType\) ExportedMethod\(a int\) bool\n    ExportedMethod is a method of ExportedType.`,
+		},
+		[]string{
+			`unexportedConstant`,
+			`unexportedFunc`,
+			`unexportedMethod`,
+			`unexportedType`,
+			`Comment in the function body`,
+		},
+	},
+
+	// Function, named after the package.
+	{
+		"function",
+		[]string{"docpkg", "ExportedFunc"},
+		[]string{
+			`package docpkg // import "example.com/docpkg"`,
+			`func ExportedFunc\(a int\) bool`,
+			`ExportedFunc is an exported function.`,
+		},
+		[]string{
+			`Comment in the function body`,
+			`ExportedType`,
+		},
+	},
+
+	// Function, matched without regard to case, in pkg.sym form.
+	{
+		"function lower case",
+		[]string{"example.com/docpkg.exportedfunc"},
+		[]string{`func ExportedFunc\(a int\) bool`},
+		[]string{`package docpkg`},
+	},
+
+	// Function -src.
+	{
+		"function with src",
+		[]string{"-src", "docpkg", "ExportedFunc"},
+		[]string{
+			`// ExportedFunc is an exported function.\nfunc ExportedFunc\(a int\) bool {`,
+			`return a > 0 // Comment in the function body.`,
+		},
+		nil,
+	},
+
+	// Unexported function -u.
+	{
+		"unexported function with u",
+		[]string{"-u", "docpkg", "unexportedFunc"},
+		[]string{
+			`func unexportedFunc\(\)`,
+			`unexportedFunc is an unexported function.`,
+		},
+		nil,
+	},
+
+	// Type, with its constructor and methods.
+	{
+		"type",
+		[]string{"example.com/docpkg.ExportedType"},
+		[]string{
+			`ExportedType is a struct type.`,
+			`type ExportedType struct {`,
+			`ExportedField int`,
+			`// contains filtered or unexported fields`,
+			`func NewExportedType\(\) \*ExportedType`,
+			`func \(t \*ExportedType\) ExportedMethod\(a int\) bool`,
+		},
+		[]string{
+			`unexportedField`,
+			`unexportedMethod`,
+			`package docpkg`,
+		},
+	},
+
+	// Type -u.
+	{
+		"type with u",
+		[]string{"-u", "docpkg.ExportedType"},
+		[]string{
+			`ExportedField   int`,
+			`unexportedField int // Comment on unexported field.`,
+			`func \(t \*ExportedType\) unexportedMethod\(\)`,
+		},
+		[]string{`Has unexported fields`},
+	},
+
+	// Method, in pkg.Type.Method form.
+	{
+		"method",
+		[]string{"example.com/docpkg.ExportedType.ExportedMethod"},
+		[]string{
+			`func \(t \*ExportedType\) ExportedMethod\(a int\) bool`,
+			`ExportedMethod is a method of ExportedType.`,
+		},
+		[]string{
+			`Comment in the method body`,
+			`NewExportedType`,
+		},
+	},
+
+	// Method -src.
+	{
+		"method with src",
+		[]string{"-src", "docpkg.ExportedType.ExportedMethod"},
+		[]string{
+			`// ExportedMethod is a method of ExportedType.\nfunc \(t \*ExportedType\) ExportedMethod\(a int\) bool {`,
+			`return true // Comment in the method body.`,
+		},
+		nil,
+	},
+
+	// Field.
+	{
+		"field",
+		[]string{"docpkg", "ExportedType.ExportedField"},
+		[]string{
+			`type ExportedType struct {`,
+			`// ExportedField is an exported field.`,
+			`ExportedField int`,
+		},
+		[]string{`unexportedField`},
+	},
+}
+
+func TestDoc(t *testing.T) {
+	for _, test := range tests {
+		var b bytes.Buffer
+		var flagSet flag.FlagSet
+		err := do(&b, &flagSet, test.args)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		output := b.Bytes()
+		failed := false
+		for j, yes := range test.yes {
+			re, err := regexp.Compile(yes)
+			if err != nil {
+				t.Fatalf("%s.%d: compiling %#q: %s", test.name, j, yes, err)
+			}
+			if !re.Match(output) {
+				t.Errorf("%s.%d: no match for %s %#q", test.name, j, test.args, yes)
+				failed = true
+			}
+		}
+		for j, no := range test.no {
+			re, err := regexp.Compile(no)
+			if err != nil {
+				t.Fatalf("%s.%d: compiling %#q: %s", test.name, j, no, err)
+			}
+			if re.Match(output) {
+				t.Errorf("%s.%d: incorrect match for %s %#q", test.name, j, test.args, no)
+				failed = true
+			}
+		}
+		if failed {
+			t.Logf("\n%s", output)
+		}
+	}
+}
+
+var errorTests = []struct {
+	args []string
+	err  string // Substring of the expected error.
+}{
+	{[]string{"docpkg", "NoSuchSymbol"}, "no symbol NoSuchSymbol in package docpkg"},
+	{[]string{"docpkg", "unexportedFunc"}, "no symbol unexportedFunc in package docpkg"},
+	{[]string{"docpkg", "ExportedType.NoSuchMethod"}, "no method or field ExportedType.NoSuchMethod in package docpkg"},
+	{[]string{"docpkg", "ExportedType.unexportedMethod"}, "no method or field ExportedType.unexportedMethod in package docpkg"},
+	{[]string{"docpkg", "ExportedFunc.Method"}, "symbol ExportedFunc is not a type in package docpkg"},
+	{[]string{"nosuchpackage", "ExportedFunc"}, "no such package nosuchpackage"},
+	{[]string{"example.com/nosuchpackage.ExportedFunc"}, "no such package example.com"},
+	{[]string{"-all", "docpkg", "ExportedFunc"}, "-all applies only to package documentation"},
+}
+
+func TestDocErrors(t *testing.T) {
+	for _, test := range errorTests {
+		var b bytes.Buffer
+		var flagSet flag.FlagSet
+		err := do(&b, &flagSet, test.args)
+		if err == nil {
+			t.Errorf("%s: succeeded, want error %q\n%s", test.args, test.err, b.Bytes())
+			continue
+		}
+		if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error %q, want %q", test.args, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Doc (usually run as go doc) accepts zero, one or two arguments.
+//
+// Zero arguments:
+//	go doc
+// Show the documentation for the package in the current directory.
+//
+// One argument:
+//	go doc <pkg>
+//	go doc <sym>[.<method>]
+//	go doc [<pkg>.]<sym>[.<method>]
+// The first item in this list that succeeds is the one whose documentation
+// is printed. If there is a symbol but no package, the package in the current
+// directory is chosen.
+//
+// Two arguments:
+//	go doc <pkg> <sym>[.<method>]
+//
+// Show the documentation for the package, symbol, and method. The
+// first argument must be a full package path. This is similar to the
+// command-line usage for the godoc command.
+//
+// For complete documentation, run "go help doc".
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/build"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	unexported bool // -u flag
+	matchCase  bool // -c flag
+	showAll    bool // -all flag
+	showSrc    bool // -src flag
+)
+
+// usage is a replacement usage function for the flags package.
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of [go] doc:\n")
+	fmt.Fprintf(os.Stderr, "\tgo doc\n")
+	fmt.Fprintf(os.Stderr, "\tgo doc <pkg>\n")
+	fmt.Fprintf(os.Stderr, "\tgo doc <sym>[.<method>]\n")
+	fmt.Fprintf(os.Stderr, "\tgo doc [<pkg>].<sym>[.<method>]\n")
+	fmt.Fprintf(os.Stderr, "\tgo doc <pkg> <sym>[.<method>]\n")
+	fmt.Fprintf(os.Stderr, "For more information run\n")
+	fmt.Fprintf(os.Stderr, "\tgo help doc\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("doc: ")
+	err := do(os.Stdout, flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// do is the workhorse, broken out of main to make testing easier.
+func do(writer io.Writer, flagSet *flag.FlagSet, args []string) (err error) {
+	flagSet.Usage = usage
+	unexported = false
+	matchCase = false
+	showAll = false
+	showSrc = false
+	flagSet.BoolVar(&unexported, "u", false, "show unexported symbols as well as exported")
+	flagSet.BoolVar(&matchCase, "c", false, "symbol matching honors case (paths not affected)")
+	flagSet.BoolVar(&showAll, "all", false, "show all documentation for package")
+	flagSet.BoolVar(&showSrc, "src", false, "show source code for symbol")
+	flagSet.Parse(args)
+
+	buildPackage, userPath, symbol, err := parseArgs(flagSet.Args())
+	if err != nil {
+		return err
+	}
+	if showAll && symbol != "" {
+		return errors.New("-all applies only to package documentation")
+	}
+	symbol, method := parseSymbol(symbol)
+	pkg := parsePackage(writer, buildPackage, userPath)
+	defer func() {
+		pkg.flush()
+		e := recover()
+		if e == nil {
+			return
+		}
+		pkgError, ok := e.(PackageError)
+		if ok {
+			err = pkgError
+			return
+		}
+		panic(e)
+	}()
+
+	switch {
+	case symbol == "":
+		pkg.packageDoc()
+	case method == "":
+		if !pkg.symbolDoc(symbol) {
+			return fmt.Errorf("no symbol %s in package %s", symbol, userPath)
+		}
+	default:
+		if !pkg.methodDoc(symbol, method) && !pkg.fieldDoc(symbol, method) {
+			return fmt.Errorf("no method or field %s.%s in package %s", symbol, method, userPath)
+		}
+	}
+	return nil
+}
+
+// parseArgs analyzes the arguments (if any) and returns the package
+// it represents, the part of the argument the user used to identify
+// the path (or "" if it's the current package) and the symbol
+// (possibly with a .method) within that package.
+func parseArgs(args []string) (pkg *build.Package, path, symbol string, err error) {
+	switch len(args) {
+	default:
+		usage()
+	case 0:
+		// Easy: current directory.
+		pkg, err := importDir(pwd())
+		return pkg, "", "", err
+	case 1:
+		// Done below.
+	case 2:
+		// Package must be findable and importable.
+		pkg, err := findPackage(args[0])
+		if err != nil {
+			return nil, "", "", err
+		}
+		return pkg, args[0], args[1], nil
+	}
+	// Usual case: one argument.
+	arg := args[0]
+	// If it contains slashes, it begins with a package path.
+	// First, is it a complete package path as it is? If so, we are done.
+	// This avoids confusion over package paths that have other
+	// package paths as their prefix.
+	if pkg, err := build.Import(arg, pwd(), 0); err == nil {
+		return pkg, arg, "", nil
+	}
+	// Another disambiguator: If the symbol starts with an upper
+	// case letter, it can only be a symbol in the current directory.
+	// Kills the problem caused by case-insensitive file systems
+	// matching an upper case name as a package name.
+	if isUpper(arg) {
+		pkg, err := importDir(pwd())
+		if err == nil {
+			return pkg, "", arg, nil
+		}
+	}
+	// If it has a slash, it must be a package path but there is a symbol.
+	// It's the last package path we care about.
+	slash := strings.LastIndex(arg, "/")
+	// There may be periods in the package path before or after the slash
+	// and between a symbol and method.
+	// Split the string at various periods to see what we find.
+	// In general there may be ambiguities but this should almost always
+	// work.
+	var period int
+	// slash+1: if there's no slash, the value is -1 and start is 0; otherwise
+	// start is the byte after the slash.
+	for start := slash + 1; start < len(arg); start = period + 1 {
+		period = strings.Index(arg[start:], ".")
+		if period < 0 {
+			break
+		}
+		period += start
+		symbol := arg[period+1:]
+		if pkg, err := findPackage(arg[:period]); err == nil {
+			return pkg, arg[:period], symbol, nil
+		}
+	}
+	// If it has a slash, we've failed.
+	if slash >= 0 {
+		return nil, "", "", fmt.Errorf("no such package %s", arg[:slash])
+	}
+	// Guess it's a package name unless it contains a period.
+	if !strings.Contains(arg, ".") {
+		if pkg, err := findPackage(arg); err == nil {
+			return pkg, arg, "", nil
+		}
+	}
+	// Guess it's a symbol in the current directory.
+	pkg, err = importDir(pwd())
+	if err != nil {
+		return nil, "", "", err
+	}
+	return pkg, "", arg, nil
+}
+
+// isDotSlash reports whether the path begins with a reference
+// to the local . or .. directory.
+func isDotSlash(arg string) bool {
+	if arg == "." || arg == ".." {
+		return true
+	}
+	return strings.HasPrefix(arg, "./") || strings.HasPrefix(arg, "../")
+}
+
+// importDir is just an error-catching wrapper for build.ImportDir.
+func importDir(dir string) (*build.Package, error) {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	return pkg, nil
+}
+
+// parseSymbol breaks str apart into a symbol and method.
+// Both may be missing or the method may be missing.
+// If present, each must be a valid Go identifier.
+func parseSymbol(str string) (symbol, method string) {
+	if str == "" {
+		return
+	}
+	elem := strings.Split(str, ".")
+	switch len(elem) {
+	case 1:
+	case 2:
+		method = elem[1]
+		isIdentifier(method)
+	default:
+		log.Printf("too many periods in symbol specification")
+		usage()
+	}
+	symbol = elem[0]
+	isIdentifier(symbol)
+	return
+}
+
+// isIdentifier checks that the name is valid Go identifier, and
+// logs and exits if it is not.
+func isIdentifier(name string) {
+	if len(name) == 0 {
+		log.Fatal("empty symbol")
+	}
+	for i, ch := range name {
+		if unicode.IsLetter(ch) || ch == '_' || i > 0 && unicode.IsDigit(ch) {
+			continue
+		}
+		log.Fatalf("invalid identifier %q", name)
+	}
+}
+
+// isExported reports whether the name is an exported identifier.
+// If the unexported flag (-u) is true, isExported returns true because
+// it means that we treat the name as if it is exported.
+func isExported(name string) bool {
+	return unexported || isUpper(name)
+}
+
+// isUpper reports whether the name starts with an upper case letter.
+func isUpper(name string) bool {
+	ch, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(ch)
+}
+
+// findPackage returns the package whose import path matches pkg,
+// which may be a complete import path or a suffix of one made of
+// whole path elements, as in "json" for "encoding/json".
+// The Go root is searched before the Go path, and within each
+// tree shorter import paths are preferred, with ties broken
+// alphabetically.
+func findPackage(pkg string) (*build.Package, error) {
+	if pkg == "" || isUpper(pkg) || isDotSlash(pkg) {
+		return nil, fmt.Errorf("no such package %s", pkg)
+	}
+	if p, err := build.Import(pkg, pwd(), 0); err == nil {
+		return p, nil
+	}
+	for _, root := range build.Default.SrcDirs() {
+		var matches []string
+		filepath.Walk(root, func(dir string, fi os.FileInfo, err error) error {
+			if err != nil || !fi.IsDir() {
+				return nil
+			}
+			if dir != root {
+				name := fi.Name()
+				if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" {
+					return filepath.SkipDir
+				}
+			}
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return nil
+			}
+			importPath := filepath.ToSlash(rel)
+			if importPath == pkg || strings.HasSuffix(importPath, "/"+pkg) {
+				matches = append(matches, importPath)
+			}
+			return nil
+		})
+		sort.Sort(byDepth(matches))
+		for _, importPath := range matches {
+			if p, err := build.ImportDir(filepath.Join(root, filepath.FromSlash(importPath)), 0); err == nil {
+				return p, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no such package %s", pkg)
+}
+
+// byDepth sorts import paths by number of elements, then by name.
+type byDepth []string
+
+func (x byDepth) Len() int      { return len(x) }
+func (x byDepth) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x byDepth) Less(i, j int) bool {
+	if di, dj := strings.Count(x[i], "/"), strings.Count(x[j], "/"); di != dj {
+		return di < dj
+	}
+	return x[i] < x[j]
+}
+
+var pwdCache string
+
+// pwd returns the current directory.
+func pwd() string {
+	if pwdCache != "" {
+		return pwdCache
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	pwdCache = wd
+	return wd
+}
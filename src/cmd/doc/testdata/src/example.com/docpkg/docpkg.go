@@ -0,0 +1,51 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package docpkg is a package for testing go doc.
+//
+// It has a second paragraph.
+package docpkg
+
+// ExportedConstant is a constant.
+const ExportedConstant = 1
+
+const unexportedConstant = 2 // Comment on unexported constant.
+
+// ExportedVariable is a variable.
+var ExportedVariable = 1
+
+var unexportedVariable = 2 // Comment on unexported variable.
+
+// ExportedFunc is an exported function.
+func ExportedFunc(a int) bool {
+	return a > 0 // Comment in the function body.
+}
+
+// unexportedFunc is an unexported function.
+func unexportedFunc() {}
+
+// ExportedType is a struct type.
+type ExportedType struct {
+	// ExportedField is an exported field.
+	ExportedField   int
+	unexportedField int // Comment on unexported field.
+}
+
+// NewExportedType returns a new ExportedType.
+func NewExportedType() *ExportedType {
+	return &ExportedType{}
+}
+
+// ExportedMethod is a method of ExportedType.
+func (t *ExportedType) ExportedMethod(a int) bool {
+	return true // Comment in the method body.
+}
+
+// unexportedMethod is an unexported method of ExportedType.
+func (t *ExportedType) unexportedMethod() {}
+
+// unexportedType is an unexported type.
+type unexportedType int
+
+// BUG(me): There is a bug.
@@ -8,7 +8,7 @@ newer ones.  After you update to a new Go release, fix helps make
 the necessary changes to your programs.
 
 Usage:
-	go tool fix [-r name,...] [path ...]
+	go tool fix [-r name,...] [-t template,...] [path ...]
 
 Without an explicit path, fix reads standard input and writes the
 result to standard output.
@@ -26,6 +26,28 @@ named list.  By default fix considers all known rewrites.  Fix's
 rewrites are idempotent, so that it is safe to apply fix to updated
 or partially updated code even without using the -r flag.
 
+The -t flag names Go source files holding rewrite templates to apply
+in addition to the known rewrites. A template declares two functions
+before and after with identical signatures, each consisting of a single
+return or expression statement; fix replaces the expressions matching
+the body of before with the body of after. The parameters serve as
+wildcards matching only sub-expressions of the parameter's type, so
+unlike the known rewrites, templates use the complete type information
+of the package being fixed, computed from its source files and the
+installed packages it imports. For example, this template replaces
+calls of Close on *os.File values with calls of Sync:
+
+	package template
+
+	import "os"
+
+	func before(f *os.File) error { return f.Close() }
+	func after(f *os.File) error  { return f.Sync() }
+
+Imports needed by the replacements are added and imports that are no
+longer used are removed. Overlapping rewrites are reported as errors
+and not applied.
+
 Fix prints the full list of fixes it can apply in its help output;
 to see them, run go tool fix -?.
 
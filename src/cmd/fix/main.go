@@ -32,6 +32,9 @@ var allowedRewrites = flag.String("r", "",
 var forceRewrites = flag.String("force", "",
 	"force these fixes to run even if the code looks updated")
 
+var rewriteTemplates = flag.String("t", "",
+	"apply the rewrite templates in this comma-separated list of files")
+
 var allowed, force map[string]bool
 
 var doDiff = flag.Bool("diff", false, "display diffs instead of rewriting files")
@@ -40,7 +43,7 @@ var doDiff = flag.Bool("diff", false, "display diffs instead of rewriting files"
 const debug = false // display incorrectly reformatted source and exit
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "usage: go tool fix [-diff] [-r fixname,...] [-force fixname,...] [path ...]\n")
+	fmt.Fprintf(os.Stderr, "usage: go tool fix [-diff] [-r fixname,...] [-force fixname,...] [-t template,...] [path ...]\n")
 	flag.PrintDefaults()
 	fmt.Fprintf(os.Stderr, "\nAvailable rewrites are:\n")
 	sort.Sort(byName(fixes))
@@ -73,6 +76,17 @@ func main() {
 		}
 	}
 
+	if *rewriteTemplates != "" {
+		for _, name := range strings.Split(*rewriteTemplates, ",") {
+			t, err := parseTemplate(name, nil)
+			if err != nil {
+				report(err)
+				os.Exit(exitCode)
+			}
+			templates = append(templates, t)
+		}
+	}
+
 	if flag.NArg() == 0 {
 		if err := processFile("standard input", true); err != nil {
 			report(err)
@@ -161,6 +175,10 @@ func processFile(filename string, useStdin bool) error {
 			}
 		}
 	}
+	if templates != nil && templateFix(newFile) {
+		fixed = true
+		fmt.Fprintf(&fixlog, " templates")
+	}
 	if !fixed {
 		return nil
 	}
@@ -0,0 +1,87 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/rewrite"
+	"go/types"
+	"os"
+	"path/filepath"
+)
+
+// Unlike the fixes above, which rely on the partial type information
+// described by a TypeConfig, rewrite templates are applied using the
+// types computed by go/types for the file's whole package.
+
+var (
+	templates     []*rewrite.Template
+	typesImporter types.Importer
+)
+
+// parseTemplate parses and type-checks the rewrite template in the
+// named file. If src != nil, it is used as the file's source.
+func parseTemplate(filename string, src interface{}) (*rewrite.Template, error) {
+	if typesImporter == nil {
+		typesImporter = importer.Default()
+	}
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	return rewrite.NewTemplate(fset, f, typesImporter)
+}
+
+// templateFix applies the rewrite templates to f.
+// Conflicting rewrites are reported but not applied.
+func templateFix(f *ast.File) bool {
+	n, err := rewrite.Apply(fset, f, typeCheckFile(f), templates...)
+	if err != nil {
+		report(err)
+	}
+	return n > 0
+}
+
+// typeCheckFile type-checks f together with the other files of its
+// package in the same directory. Type errors are ignored: expressions
+// whose types are unknown don't match any typed wildcards.
+func typeCheckFile(f *ast.File) *types.Info {
+	files := []*ast.File{f}
+	filename := fset.Position(f.Package).Filename
+	if _, err := os.Stat(filename); err == nil {
+		dir, base := filepath.Dir(filename), filepath.Base(filename)
+		if p, err := build.ImportDir(dir, 0); err == nil {
+			var names []string
+			names = append(names, p.GoFiles...)
+			names = append(names, p.CgoFiles...)
+			names = append(names, p.TestGoFiles...)
+			names = append(names, p.XTestGoFiles...)
+			for _, name := range names {
+				if name == base {
+					continue
+				}
+				g, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+				if err == nil && g.Name.Name == f.Name.Name {
+					files = append(files, g)
+				}
+			}
+		}
+	}
+
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{
+		Importer:    typesImporter,
+		FakeImportC: true,
+		Error:       func(error) {},
+	}
+	conf.Check(f.Name.Name, fset, files, info)
+	return info
+}
@@ -0,0 +1,49 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/rewrite"
+)
+
+func init() {
+	addTestCases(templateTests, templateTestFix(`package template
+
+func before(s string) bool { return len(s) == 0 }
+func after(s string) bool  { return s == "" }
+`))
+}
+
+// templateTestFix returns a fix applying the template src.
+func templateTestFix(src string) func(*ast.File) bool {
+	return func(f *ast.File) bool {
+		t, err := parseTemplate("template.go", src)
+		if err != nil {
+			panic(err)
+		}
+		templates = []*rewrite.Template{t}
+		defer func() { templates = nil }()
+		return templateFix(f)
+	}
+}
+
+var templateTests = []testCase{
+	{
+		Name: "template.0",
+		In: `package main
+
+func f(s string, b []byte) bool {
+	return len(s) == 0 || len(b) == 0 || len(s+"x") == 0
+}
+`,
+		Out: `package main
+
+func f(s string, b []byte) bool {
+	return s == "" || len(b) == 0 || s+"x" == ""
+}
+`,
+	},
+}
@@ -28,3 +28,52 @@ func httpsOrHTTP(importPath string) (string, io.ReadCloser, error) {
 func parseMetaGoImports(r io.Reader) ([]metaImport, error) {
 	panic("unreachable")
 }
+
+var errModZip = errors.New("no module support in bootstrap go command")
+
+func hashZip(zipfile, prefix string) (string, error) {
+	return "", errModZip
+}
+
+func hashDir(dir, prefix string) (string, error) {
+	return "", errModZip
+}
+
+func hashGoMod(data []byte) string {
+	return ""
+}
+
+func unzipModule(zipfile, prefix, dir string) error {
+	return errModZip
+}
+
+// The bootstrap go command runs without a build cache.
+const cacheAvailable = false
+
+var errNoCache = errors.New("no build cache in bootstrap go command")
+
+type cacheHash struct{}
+
+func newCacheHash() *cacheHash {
+	return &cacheHash{}
+}
+
+func (h *cacheHash) add(format string, args ...interface{}) {}
+
+func (h *cacheHash) sum() cacheID {
+	return cacheID{}
+}
+
+func hashFile(file string) (cacheID, error) {
+	return cacheID{}, errNoCache
+}
+
+func hashReader(r io.Reader) (cacheID, error) {
+	return cacheID{}, errNoCache
+}
+
+var errNotGoExe = errors.New("cannot read executables in bootstrap go command")
+
+func readBuildInfo(file string) (string, []string, error) {
+	return "", nil, errNotGoExe
+}
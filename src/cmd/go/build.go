@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -315,6 +316,10 @@ func runInstall(cmd *Command, args []string) {
 	pkgs := packagesForBuild(args)
 
 	for _, p := range pkgs {
+		if p.Module != "" && p.Name != "main" {
+			// Packages in modules are built but not installed.
+			continue
+		}
 		if p.Target == "" && (!p.Standard || p.ImportPath != "unsafe") {
 			if p.cmdline {
 				errorf("go install: no install location for .go files listed on command line (GOBIN not set)")
@@ -402,6 +407,10 @@ type action struct {
 	pending  int  // number of deps yet to complete
 	priority int  // relative execution priority
 	failed   bool // whether the action failed
+
+	// Build cache state.
+	outputID     cacheID // hash of the content of target
+	haveOutputID bool    // whether outputID is valid
 }
 
 // cacheKey is the key for the action cache.
@@ -550,16 +559,14 @@ func (b *builder) action(mode buildMode, depMode buildMode, p *Package) *action
 	// using cgo, to make sure we do not overwrite the binary while
 	// a package is using it.  If this is a cross-build, then the cgo we
 	// are writing is not the cgo we need to use.
-	if goos == runtime.GOOS && goarch == runtime.GOARCH && !buildRace {
-		if len(p.CgoFiles) > 0 || p.Standard && p.ImportPath == "runtime/cgo" {
-			var stk importStack
-			p1 := loadPackage("cmd/cgo", &stk)
-			if p1.Error != nil {
-				fatalf("load cmd/cgo: %v", p1.Error)
-			}
-			a.cgo = b.action(depMode, depMode, p1)
-			a.deps = append(a.deps, a.cgo)
+	if needsCgoTool(p) {
+		var stk importStack
+		p1 := loadPackage("cmd/cgo", &stk)
+		if p1.Error != nil {
+			fatalf("load cmd/cgo: %v", p1.Error)
 		}
+		a.cgo = b.action(depMode, depMode, p1)
+		a.deps = append(a.deps, a.cgo)
 	}
 
 	if p.Standard {
@@ -583,8 +590,8 @@ func (b *builder) action(mode buildMode, depMode buildMode, p *Package) *action
 		return a
 	}
 
-	if p.local && p.target == "" {
-		// Imported via local path.  No permanent target.
+	if (p.local || p.Module != "") && p.target == "" {
+		// Imported via local path or from a module.  No permanent target.
 		mode = modeBuild
 	}
 	work := p.pkgdir
@@ -622,6 +629,15 @@ func (b *builder) action(mode buildMode, depMode buildMode, p *Package) *action
 	return a
 }
 
+// needsCgoTool reports whether building p depends on the cgo binary:
+// it uses cgo and we are not cross-compiling.
+func needsCgoTool(p *Package) bool {
+	if goos != runtime.GOOS || goarch != runtime.GOARCH || buildRace {
+		return false
+	}
+	return len(p.CgoFiles) > 0 || p.Standard && p.ImportPath == "runtime/cgo"
+}
+
 // actionList returns the list of actions in the dag rooted at root
 // as visited in a depth-first post-order traversal.
 func actionList(root *action) []*action {
@@ -681,6 +697,9 @@ func (b *builder) do(root *action) {
 		if a.f != nil && (!a.failed || a.ignoreFail) {
 			err = a.f(b, a)
 		}
+		if err == nil && !a.failed {
+			b.setOutputID(a)
+		}
 
 		// The actions run in parallel but all the updates to the
 		// shared work state are serialized through b.exec.
@@ -811,6 +830,18 @@ func (b *builder) build(a *action) (err error) {
 		}
 	}
 
+	// Reuse the package archive from the build cache if possible.
+	// The -a flag forces a rebuild but still updates the cache.
+	actionID, cacheable := b.buildActionID(a)
+	if cacheable && !buildA {
+		if file, out, err := b.cache().getFile(actionID); err == nil {
+			if err := b.copyFile(a, a.objpkg, file, 0666); err == nil {
+				a.outputID, a.haveOutputID = out, true
+				return nil
+			}
+		}
+	}
+
 	var gofiles, cfiles, sfiles, objects, cgoObjects []string
 
 	gofiles = append(gofiles, a.p.GoFiles...)
@@ -990,6 +1021,13 @@ func (b *builder) build(a *action) (err error) {
 		}
 	}
 
+	if cacheable {
+		// Failing to update the cache is not a build failure.
+		if out, err := b.cache().put(actionID, a.objpkg); err == nil {
+			a.outputID, a.haveOutputID = out, true
+		}
+	}
+
 	// Link if needed.
 	if a.link {
 		// The compiler only cares about direct imports, but the
@@ -1004,6 +1042,301 @@ func (b *builder) build(a *action) (err error) {
 	return nil
 }
 
+// cache returns the build cache to use, or nil if the build
+// should not use one.
+func (b *builder) cache() *buildCache {
+	if buildN {
+		return nil
+	}
+	return defaultCache()
+}
+
+// buildActionID returns the build cache action ID for compiling the
+// package built by a, and whether the result can be cached at all.
+func (b *builder) buildActionID(a *action) (cacheID, bool) {
+	if b.cache() == nil || a.link {
+		return cacheID{}, false
+	}
+	var deps []string
+	for _, a1 := range a.deps {
+		switch {
+		case a1.p == nil:
+			continue
+		case a1 == a.cgo:
+			id, err := toolID(a1.target)
+			if err != nil {
+				return cacheID{}, false
+			}
+			deps = append(deps, "cgo "+id.String())
+		case a1.haveOutputID:
+			deps = append(deps, "import "+a1.p.ImportPath+" "+a1.outputID.String())
+		case a1.target == "":
+			// Fake package, like unsafe.
+			deps = append(deps, "import "+a1.p.ImportPath)
+		default:
+			return cacheID{}, false
+		}
+	}
+	return compileID(a.p, deps)
+}
+
+// compileID returns the action ID for compiling p, given a line
+// identifying each of its compiled dependencies, and whether the
+// result can be cached at all.  The ID covers the content of the
+// source files, the compiled dependencies, the tools and the flags and
+// settings that affect the package archive.  It does not cover the
+// package directory, so that the GOPATH entries and checkouts holding
+// the same sources share the compiled package; the source positions
+// recorded in it name the directory it was first compiled in.
+// Linking is not cached, nor are packages using SWIG or gccgo.
+func compileID(p *Package, deps []string) (cacheID, bool) {
+	if p.usesSwig() || buildContext.Compiler != "gc" {
+		return cacheID{}, false
+	}
+	h := newCacheHash()
+	h.add("go build v1")
+	h.add("goos %s goarch %s goroot %s", goos, goarch, goroot)
+	h.add("import %q name %q", p.ImportPath, p.Name)
+	h.add("standard %v local %v", p.Standard, p.local)
+	if p.local {
+		// Only local packages may have local imports.
+		h.add("prefix %q", p.localPrefix)
+	}
+	h.add("gcflags %q", buildGcflags)
+	h.add("installsuffix %q race %v tags %q", buildContext.InstallSuffix, buildRace, buildContext.BuildTags)
+
+	tools := []string{buildToolchain.compiler()}
+	if len(p.CFiles) > 0 || len(p.SFiles) > 0 {
+		tools = append(tools, tool(archChar+"c"), tool(archChar+"a"), tool("pack"))
+		// C and assembly files include headers from $GOROOT/pkg/$GOOS_$GOARCH.
+		id, err := headerID(filepath.Join(goroot, "pkg", goos+"_"+goarch))
+		if err != nil {
+			return cacheID{}, false
+		}
+		h.add("headers %s", id)
+	}
+	if p.usesCgo() {
+		tools = append(tools, tool("pack"))
+		for _, env := range []string{"CC", "CXX", "CGO_CFLAGS", "CGO_CPPFLAGS", "CGO_CXXFLAGS", "CGO_LDFLAGS", "CGO_ENABLED"} {
+			h.add("env %s=%q", env, os.Getenv(env))
+		}
+		h.add("cc %q", envList("CC", defaultCC))
+	}
+	for _, t := range tools {
+		id, err := toolID(t)
+		if err != nil {
+			return cacheID{}, false
+		}
+		h.add("tool %s %s", filepath.Base(t), id)
+	}
+
+	if p.coverMode != "" {
+		h.add("cover %s", p.coverMode)
+		var keys []string
+		for key := range p.coverVars {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			h.add("covervar %s %s", key, p.coverVars[key].Var)
+		}
+	}
+
+	for _, file := range stringList(p.GoFiles, p.CgoFiles, p.CFiles, p.CXXFiles, p.MFiles, p.HFiles, p.SFiles, p.SysoFiles) {
+		id, err := hashFile(filepath.Join(p.Dir, file))
+		if err != nil {
+			return cacheID{}, false
+		}
+		h.add("file %s %s", file, id)
+	}
+
+	for _, dep := range deps {
+		h.add("%s", dep)
+	}
+	return h.sum(), true
+}
+
+// installID returns an ID for the inputs of the installed target of p,
+// computed from the installed targets of its dependencies, and whether
+// it can be computed.  For a package it is the action ID for compiling
+// the package; for a command it also covers linking.
+func installID(p *Package) (cacheID, bool) {
+	var deps []string
+	for _, p1 := range p.imports {
+		line, ok := installedDep("import", p1)
+		if !ok {
+			return cacheID{}, false
+		}
+		deps = append(deps, line)
+	}
+	if needsCgoTool(p) {
+		id, err := toolID(filepath.Join(toolDir, "cgo"+exeSuffix))
+		if err != nil {
+			return cacheID{}, false
+		}
+		deps = append(deps, "cgo "+id.String())
+	}
+	id, ok := compileID(p, deps)
+	if !ok || p.Name != "main" {
+		return id, ok
+	}
+
+	linker := buildToolchain.linker()
+	linkerID, err := toolID(linker)
+	if err != nil {
+		return cacheID{}, false
+	}
+	h := newCacheHash()
+	h.add("go link v1")
+	h.add("main %s", id)
+	h.add("tool %s %s", filepath.Base(linker), linkerID)
+	h.add("ldflags %q omitdwarf %v", buildLdflags, p.omitDWARF)
+	h.add("env CC=%q CXX=%q", os.Getenv("CC"), os.Getenv("CXX"))
+	h.add("buildinfo %q", buildInfo(p))
+	for _, p1 := range p.deps {
+		line, ok := installedDep("dep", p1)
+		if !ok {
+			return cacheID{}, false
+		}
+		h.add("%s", line)
+	}
+	return h.sum(), true
+}
+
+// installedDep returns the line identifying the installed dependency p
+// in an install ID, and whether p is installed.
+func installedDep(kind string, p *Package) (string, bool) {
+	if p.Standard && (p.ImportPath == "unsafe" || p.ImportPath == "builtin") {
+		// Fake package.
+		return kind + " " + p.ImportPath, true
+	}
+	if p.target == "" {
+		return "", false
+	}
+	id, err := hashFile(p.target)
+	if err != nil {
+		return "", false
+	}
+	return kind + " " + p.ImportPath + " " + id.String(), true
+}
+
+// targetKey returns the build cache key under which the install ID
+// of the target file is recorded.
+func targetKey(target string) cacheID {
+	h := newCacheHash()
+	h.add("go install v1")
+	h.add("target %s", target)
+	return h.sum()
+}
+
+// recordInstall records in the build cache the install ID of the
+// target just installed by a, along with the hash of its content.
+func (b *builder) recordInstall(a *action) {
+	c := b.cache()
+	if c == nil {
+		return
+	}
+	id, ok := installID(a.p)
+	if !ok {
+		return
+	}
+	out, err := hashFile(a.target)
+	if err != nil {
+		return
+	}
+	// Failing to update the cache is not an install failure.
+	c.putBytes(targetKey(a.target), []byte(id.String()+" "+out.String()+"\n"))
+}
+
+// upToDate reports whether the build cache records that the installed
+// target of p was built from the current inputs of p and has not been
+// modified since.  The second result reports whether the cache holds
+// a record for the target at all.
+func upToDate(p *Package) (ok, recorded bool) {
+	c := defaultCache()
+	if c == nil {
+		return false, false
+	}
+	data, err := c.getBytes(targetKey(p.target))
+	if err != nil {
+		return false, false
+	}
+	f := strings.Fields(string(data))
+	if len(f) != 2 {
+		return false, false
+	}
+	id, ok := installID(p)
+	if !ok || f[0] != id.String() {
+		return false, true
+	}
+	out, err := hashFile(p.target)
+	return err == nil && f[1] == out.String(), true
+}
+
+// setOutputID records the hash of the target of a, which has just
+// completed, for use in the action IDs of the actions depending on it.
+func (b *builder) setOutputID(a *action) {
+	if b.cache() == nil || a.haveOutputID || a.target == "" || a.link {
+		return
+	}
+	if id, err := hashFile(a.target); err == nil {
+		a.outputID, a.haveOutputID = id, true
+	}
+}
+
+var (
+	idMu    sync.Mutex         // guards toolIDs
+	toolIDs map[string]cacheID // cache of tool and header hashes
+)
+
+// toolID returns the hash of the named tool binary.
+func toolID(file string) (cacheID, error) {
+	idMu.Lock()
+	defer idMu.Unlock()
+	if id, ok := toolIDs[file]; ok {
+		return id, nil
+	}
+	id, err := hashFile(file)
+	if err != nil {
+		return cacheID{}, err
+	}
+	if toolIDs == nil {
+		toolIDs = make(map[string]cacheID)
+	}
+	toolIDs[file] = id
+	return id, nil
+}
+
+// headerID returns a hash of the .h files in dir.
+func headerID(dir string) (cacheID, error) {
+	idMu.Lock()
+	defer idMu.Unlock()
+	if id, ok := toolIDs[dir]; ok {
+		return id, nil
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return cacheID{}, err
+	}
+	h := newCacheHash()
+	for _, fi := range files {
+		if !strings.HasSuffix(fi.Name(), ".h") {
+			continue
+		}
+		id, err := hashFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return cacheID{}, err
+		}
+		h.add("%s %s", fi.Name(), id)
+	}
+	id := h.sum()
+	if toolIDs == nil {
+		toolIDs = make(map[string]cacheID)
+	}
+	toolIDs[dir] = id
+	return id, nil
+}
+
 // install is the action for installing a single package or executable.
 func (b *builder) install(a *action) (err error) {
 	defer func() {
@@ -1049,7 +1382,11 @@ func (b *builder) install(a *action) (err error) {
 		}
 	}
 
-	return b.moveOrCopyFile(a, a.target, a1.target, perm)
+	if err = b.moveOrCopyFile(a, a.target, a1.target, perm); err != nil {
+		return err
+	}
+	b.recordInstall(a)
+	return nil
 }
 
 // includeArgs returns the -I or -L directory list for access
@@ -1739,6 +2076,11 @@ func (gcToolchain) ld(b *builder, p *Package, out string, allactions []*action,
 	if p.omitDWARF {
 		ldflags = append(ldflags, "-w")
 	}
+	buildinfo, err := b.writeBuildInfo(p, mainpkg)
+	if err != nil {
+		return err
+	}
+	ldflags = append(ldflags, "-buildinfo", buildinfo)
 
 	// If the user has not specified the -extld option, then specify the
 	// appropriate linker. In case of C++ code, use the compiler named
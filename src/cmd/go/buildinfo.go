@@ -0,0 +1,191 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Build information.
+//
+// The go command passes the linker a description of each binary
+// it links, which the linker embeds in the binary for 'go version -m'
+// and package debug/buildinfo to read back.  The description is a
+// sequence of tab-separated lines:
+//
+//	path	import path of the main package
+//	mod	main module path	(devel)
+//	dep	module path	version
+//	=>	replacement path	replacement version
+//	dep	repository root	revision	vcs
+//	pkg	import path of a linked package
+//	build	key=value
+//
+// A dep line lists a module providing linked packages in module mode,
+// followed by a => line if the module is replaced, or a version
+// control repository providing linked packages in GOPATH mode.
+// Packages in the standard library have no dep line.
+
+// buildInfo returns the build information for the main package p.
+func buildInfo(p *Package) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "path\t%s\n", p.ImportPath)
+
+	pkgs := append([]*Package{p}, p.deps...)
+	if modEnabled() {
+		fmt.Fprintf(&buf, "mod\t%s\t(devel)\n", modMain.Module)
+		for _, m := range buildInfoModules(pkgs) {
+			fmt.Fprintf(&buf, "dep\t%s\t%s\n", m.Path, m.Version)
+			if r, ok := modReplacement(m); ok {
+				fmt.Fprintf(&buf, "=>\t%s\t%s\n", r.Path, r.Version)
+			}
+		}
+	} else {
+		for _, r := range buildInfoRepos(pkgs) {
+			fmt.Fprintf(&buf, "dep\t%s\t%s\t%s\n", r.root, vcsRevision(r.vcs, r.dir), r.vcs.cmd)
+		}
+	}
+
+	var paths []string
+	for _, p1 := range pkgs {
+		paths = append(paths, p1.ImportPath)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&buf, "pkg\t%s\n", path)
+	}
+
+	setting := func(key, value string) {
+		fmt.Fprintf(&buf, "build\t%s=%s\n", key, value)
+	}
+	setting("-compiler", buildContext.Compiler)
+	if len(buildGcflags) > 0 {
+		setting("-gcflags", strings.Join(buildGcflags, " "))
+	}
+	if len(buildLdflags) > 0 {
+		setting("-ldflags", strings.Join(buildLdflags, " "))
+	}
+	if buildRace {
+		setting("-race", "true")
+	}
+	if len(buildContext.BuildTags) > 0 {
+		setting("-tags", strings.Join(buildContext.BuildTags, ","))
+	}
+	if buildContext.InstallSuffix != "" {
+		setting("-installsuffix", buildContext.InstallSuffix)
+	}
+	cgo := "0"
+	if buildContext.CgoEnabled {
+		cgo = "1"
+	}
+	setting("CGO_ENABLED", cgo)
+	setting("GOARCH", goarch)
+	setting("GOOS", goos)
+	if goarch == "arm" && os.Getenv("GOARM") != "" {
+		setting("GOARM", os.Getenv("GOARM"))
+	}
+	return buf.String()
+}
+
+// buildInfoModules returns the modules other than the main module
+// providing the packages, sorted by path.
+func buildInfoModules(pkgs []*Package) []modVersion {
+	seen := make(map[string]bool)
+	var mods []modVersion
+	for _, p := range pkgs {
+		if p.Module == "" || seen[p.Module] {
+			continue
+		}
+		seen[p.Module] = true
+		m := modVersion{Path: p.Module}
+		if i := strings.Index(p.Module, "@"); i >= 0 {
+			m = modVersion{Path: p.Module[:i], Version: p.Module[i+1:]}
+		}
+		if m.Path == modMain.Module && m.Version == "" {
+			continue
+		}
+		mods = append(mods, m)
+	}
+	sort.Sort(byModPath(mods))
+	return mods
+}
+
+// A buildInfoRepo is a version control repository in $GOPATH/src.
+type buildInfoRepo struct {
+	vcs  *vcsCmd
+	root string // import path of the repository root
+	dir  string // directory of the repository root
+}
+
+// buildInfoRepos returns the repositories holding the packages
+// outside the standard library, sorted by root import path.
+// Packages not in a known repository are left out.
+func buildInfoRepos(pkgs []*Package) []buildInfoRepo {
+	seen := make(map[string]bool)
+	var repos []buildInfoRepo
+	for _, p := range pkgs {
+		if p.Goroot || p.local || p.build == nil || p.build.SrcRoot == "" {
+			continue
+		}
+		vcs, root, err := vcsForDir(p)
+		if err != nil || seen[root] {
+			continue
+		}
+		seen[root] = true
+		repos = append(repos, buildInfoRepo{vcs, filepath.ToSlash(root), filepath.Join(p.build.SrcRoot, root)})
+	}
+	sort.Sort(byRepoRoot(repos))
+	return repos
+}
+
+type byRepoRoot []buildInfoRepo
+
+func (x byRepoRoot) Len() int           { return len(x) }
+func (x byRepoRoot) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+func (x byRepoRoot) Less(i, j int) bool { return x[i].root < x[j].root }
+
+var vcsRevisionCache struct {
+	sync.Mutex
+	m map[string]string
+}
+
+// vcsRevision returns the revision checked out in the repository at dir.
+// Packages are linked in parallel, so the results are cached.
+func vcsRevision(vcs *vcsCmd, dir string) string {
+	c := &vcsRevisionCache
+	c.Lock()
+	defer c.Unlock()
+	rev, ok := c.m[dir]
+	if !ok {
+		rev = vcs.revision(dir)
+		if c.m == nil {
+			c.m = make(map[string]string)
+		}
+		c.m[dir] = rev
+	}
+	return rev
+}
+
+// writeBuildInfo writes the build information for linking the
+// main package p, archived in mainpkg, to a file next to mainpkg
+// and returns the name of the file.
+func (b *builder) writeBuildInfo(p *Package, mainpkg string) (string, error) {
+	file := strings.TrimSuffix(mainpkg, ".a") + ".buildinfo"
+	info := buildInfo(p)
+	if buildN || buildX {
+		b.showcmd("", "cat >%s << 'EOF'\n%sEOF", file, info)
+		if buildN {
+			return file, nil
+		}
+	}
+	return file, ioutil.WriteFile(file, []byte(info), 0666)
+}
@@ -0,0 +1,320 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Build cache.
+//
+// The build cache holds the results of compiling packages and of
+// running tests, so that they can be reused instead of recomputed.
+// Each result is indexed by an action ID, the SHA-256 hash of every
+// input that can affect it: source file contents, the results of
+// compiling the dependencies, the tools that run, and the flags and
+// environment settings passed to them.  The result itself is stored
+// under its own hash, its output ID, so that identical outputs
+// of different actions are stored once.
+//
+// In the cache directory, the file xx/ACTION-a records the output ID
+// and size for an action ID beginning with xx, and xx/OUTPUT-d holds
+// the output with that ID.  Files are written to a temporary name and
+// renamed into place, so that concurrent go commands sharing a cache
+// never observe a partially written file.
+
+// A cacheID is an action ID or an output ID: a SHA-256 hash.
+type cacheID [32]byte
+
+func (id cacheID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// A buildCache is a build cache stored in a directory.
+type buildCache struct {
+	dir string
+}
+
+var errCacheMiss = errors.New("cache entry not found")
+
+var (
+	theCacheOnce sync.Once
+	theCache     *buildCache
+)
+
+// defaultCache returns the build cache to use, or nil if the cache
+// is disabled.  Problems opening the cache are reported once and
+// then treated as disabling the cache.
+func defaultCache() *buildCache {
+	theCacheOnce.Do(func() {
+		if !cacheAvailable {
+			return
+		}
+		dir := cacheDir()
+		if dir == "" {
+			return
+		}
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			fmt.Fprintf(os.Stderr, "go: disabling build cache: %v\n", err)
+			return
+		}
+		readme := filepath.Join(dir, "README")
+		if _, err := os.Stat(readme); err != nil {
+			ioutil.WriteFile(readme, []byte(cacheREADME), 0666)
+		}
+		theCache = &buildCache{dir: dir}
+		theCache.trim()
+	})
+	return theCache
+}
+
+const cacheREADME = `This directory holds cached build artifacts from the Go build system.
+Run "go clean -cache" if the directory is getting too large.
+See 'go help cache' for details.
+`
+
+// cacheDir returns the directory holding the build cache:
+// $GOCACHE if set, and otherwise the go-build subdirectory of
+// the user's standard cache directory.
+// It returns "" if the cache is disabled with GOCACHE=off or
+// no suitable directory can be found.
+func cacheDir() string {
+	dir := os.Getenv("GOCACHE")
+	if dir == "off" {
+		return ""
+	}
+	if dir != "" {
+		return dir
+	}
+	switch runtime.GOOS {
+	case "windows":
+		dir = os.Getenv("LocalAppData")
+	case "darwin":
+		if home := os.Getenv("HOME"); home != "" {
+			dir = filepath.Join(home, "Library", "Caches")
+		}
+	case "plan9":
+		if home := os.Getenv("home"); home != "" {
+			dir = filepath.Join(home, "lib", "cache")
+		}
+	default:
+		dir = os.Getenv("XDG_CACHE_HOME")
+		if dir == "" {
+			if home := os.Getenv("HOME"); home != "" {
+				dir = filepath.Join(home, ".cache")
+			}
+		}
+	}
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "go-build")
+}
+
+// fileName returns the name of the cache file for id with the given
+// suffix: "a" for action entries, "d" for output data.
+func (c *buildCache) fileName(id cacheID, suffix string) string {
+	s := id.String()
+	return filepath.Join(c.dir, s[:2], s+"-"+suffix)
+}
+
+// get returns the output ID and size recorded for the action ID.
+func (c *buildCache) get(id cacheID) (out cacheID, size int64, err error) {
+	file := c.fileName(id, "a")
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return cacheID{}, 0, errCacheMiss
+	}
+	// v1 <action id> <output id> <size>
+	f := strings.Fields(string(data))
+	if len(f) != 4 || f[0] != "v1" || f[1] != id.String() {
+		return cacheID{}, 0, errCacheMiss
+	}
+	b, err := hex.DecodeString(f[2])
+	if err != nil || len(b) != len(out) {
+		return cacheID{}, 0, errCacheMiss
+	}
+	copy(out[:], b)
+	size, err = strconv.ParseInt(f[3], 10, 64)
+	if err != nil || size < 0 {
+		return cacheID{}, 0, errCacheMiss
+	}
+	c.used(file)
+	return out, size, nil
+}
+
+// getFile returns the name of the file holding the output
+// recorded for the action ID, along with the output ID.
+func (c *buildCache) getFile(id cacheID) (file string, out cacheID, err error) {
+	out, size, err := c.get(id)
+	if err != nil {
+		return "", cacheID{}, err
+	}
+	file = c.fileName(out, "d")
+	fi, err := os.Stat(file)
+	if err != nil || fi.Size() != size {
+		return "", cacheID{}, errCacheMiss
+	}
+	c.used(file)
+	return file, out, nil
+}
+
+// getBytes returns the output recorded for the action ID.
+func (c *buildCache) getBytes(id cacheID) ([]byte, error) {
+	file, out, err := c.getFile(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errCacheMiss
+	}
+	if h, err := hashReader(bytes.NewReader(data)); err != nil || h != out {
+		return nil, errCacheMiss
+	}
+	return data, nil
+}
+
+// put stores the content of file as the output of the action ID
+// and returns its output ID.
+func (c *buildCache) put(id cacheID, file string) (cacheID, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return cacheID{}, err
+	}
+	defer f.Close()
+	out, err := hashReader(f)
+	if err != nil {
+		return cacheID{}, err
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		return cacheID{}, err
+	}
+	return out, c.putReader(id, out, f)
+}
+
+// putBytes stores data as the output of the action ID.
+func (c *buildCache) putBytes(id cacheID, data []byte) error {
+	out, err := hashReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return c.putReader(id, out, bytes.NewReader(data))
+}
+
+func (c *buildCache) putReader(id, out cacheID, r io.Reader) error {
+	// Write the output data, unless an identical copy
+	// is already present.
+	dfile := c.fileName(out, "d")
+	if _, err := os.Stat(dfile); err != nil {
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, r); err != nil {
+			return err
+		}
+		if err := c.writeFile(dfile, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	fi, err := os.Stat(dfile)
+	if err != nil {
+		return err
+	}
+	entry := fmt.Sprintf("v1 %s %s %d\n", id, out, fi.Size())
+	return c.writeFile(c.fileName(id, "a"), []byte(entry))
+}
+
+// writeFile writes data to file by way of a temporary file,
+// so that readers see either the old or the new content.
+func (c *buildCache) writeFile(file string, data []byte) error {
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, filepath.Base(file)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), file)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// Cache files that have not been used for cacheTrimLimit are
+// removed by trim, which runs at most once per cacheTrimInterval.
+// To keep that bookkeeping cheap, file modification times are
+// updated only when they are more than cacheMtimeInterval old.
+const (
+	cacheMtimeInterval = 1 * time.Hour
+	cacheTrimInterval  = 24 * time.Hour
+	cacheTrimLimit     = 5 * 24 * time.Hour
+)
+
+// used records that file was used, for the benefit of trim.
+func (c *buildCache) used(file string) {
+	fi, err := os.Stat(file)
+	if err == nil && time.Since(fi.ModTime()) < cacheMtimeInterval {
+		return
+	}
+	now := time.Now()
+	os.Chtimes(file, now, now)
+}
+
+// trim removes cache files that have not been used recently.
+func (c *buildCache) trim() {
+	now := time.Now()
+	stamp := filepath.Join(c.dir, "trim.txt")
+	if data, err := ioutil.ReadFile(stamp); err == nil {
+		if t, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err == nil && now.Sub(time.Unix(t, 0)) < cacheTrimInterval {
+			return
+		}
+	}
+	cutoff := now.Add(-cacheTrimLimit)
+	for i := 0; i < 256; i++ {
+		subdir := filepath.Join(c.dir, fmt.Sprintf("%02x", i))
+		files, err := ioutil.ReadDir(subdir)
+		if err != nil {
+			continue
+		}
+		for _, fi := range files {
+			if fi.ModTime().Before(cutoff) {
+				os.Remove(filepath.Join(subdir, fi.Name()))
+			}
+		}
+	}
+	ioutil.WriteFile(stamp, []byte(fmt.Sprintf("%d\n", now.Unix())), 0666)
+}
+
+// clean removes all entries from the cache.
+func (c *buildCache) clean() error {
+	var firstErr error
+	for i := 0; i < 256; i++ {
+		subdir := filepath.Join(c.dir, fmt.Sprintf("%02x", i))
+		if err := os.RemoveAll(subdir); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	os.Remove(filepath.Join(c.dir, "trim.txt"))
+	return firstErr
+}
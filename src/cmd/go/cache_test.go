@@ -0,0 +1,114 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c := &buildCache{dir: dir}
+
+	h := newCacheHash()
+	h.add("action %d", 1)
+	id1 := h.sum()
+	h.add("action %d", 2)
+	id2 := h.sum()
+	if id1 == id2 {
+		t.Fatalf("hash did not change after add")
+	}
+
+	if _, _, err := c.getFile(id1); err != errCacheMiss {
+		t.Fatalf("getFile on empty cache: %v, want errCacheMiss", err)
+	}
+
+	file := filepath.Join(dir, "input")
+	if err := ioutil.WriteFile(file, []byte("hello"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	out1, err := c.put(id1, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.putBytes(id2, []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	f1, o1, err := c.getFile(id1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o1 != out1 {
+		t.Errorf("getFile output ID = %s, want %s", o1, out1)
+	}
+	f2, _, err := c.getFile(id2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f1 != f2 {
+		t.Errorf("identical outputs stored twice: %s and %s", f1, f2)
+	}
+	data, err := c.getBytes(id2)
+	if err != nil || string(data) != "hello" {
+		t.Errorf("getBytes = %q, %v, want %q, nil", data, err, "hello")
+	}
+
+	// A corrupted output must not be returned.
+	if err := ioutil.WriteFile(f1, []byte("HELLO"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.getBytes(id1); err != errCacheMiss {
+		t.Errorf("getBytes of corrupted entry: %v, want errCacheMiss", err)
+	}
+
+	if err := c.clean(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.get(id1); err != errCacheMiss {
+		t.Errorf("get after clean: %v, want errCacheMiss", err)
+	}
+}
+
+func TestCompileIDContent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The same sources in two directories compile to the same
+	// package; different sources do not.
+	var ids []cacheID
+	for i, src := range []string{"package p\n", "package p\n", "package p\n\nvar X int\n"} {
+		pdir := filepath.Join(dir, fmt.Sprint(i), "src", "p")
+		if err := os.MkdirAll(pdir, 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(pdir, "p.go"), []byte(src), 0666); err != nil {
+			t.Fatal(err)
+		}
+		p := &Package{ImportPath: "p", Name: "p", Dir: pdir, GoFiles: []string{"p.go"}}
+		id, ok := compileID(p, []string{"import unsafe"})
+		if !ok {
+			t.Fatalf("compileID(%s) not cacheable", pdir)
+		}
+		ids = append(ids, id)
+	}
+	if ids[0] != ids[1] {
+		t.Errorf("compileID differs for the same sources in different directories")
+	}
+	if ids[0] == ids[2] {
+		t.Errorf("compileID unchanged after editing the sources")
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !cmd_go_bootstrap
+
+// This code is compiled into the real 'go' binary, but it is not
+// compiled into the binary that is built during all.bash, so as
+// to avoid needing to build crypto/sha256 during the bootstrap
+// process.  The bootstrap binary runs without a build cache.
+
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// cacheAvailable reports whether this binary can use the build cache.
+const cacheAvailable = true
+
+// A cacheHash computes a cacheID from a sequence of inputs.
+type cacheHash struct {
+	h hash.Hash
+}
+
+func newCacheHash() *cacheHash {
+	return &cacheHash{sha256.New()}
+}
+
+// add adds a formatted line of text to the hash.
+func (h *cacheHash) add(format string, args ...interface{}) {
+	fmt.Fprintf(h.h, format, args...)
+	h.h.Write([]byte{'\n'})
+}
+
+// sum returns the hash of the inputs added so far.
+func (h *cacheHash) sum() cacheID {
+	var id cacheID
+	h.h.Sum(id[:0])
+	return id
+}
+
+// hashFile returns the hash of the named file's content.
+func hashFile(file string) (cacheID, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return cacheID{}, err
+	}
+	defer f.Close()
+	return hashReader(f)
+}
+
+// hashReader returns the hash of the data read from r.
+func hashReader(r io.Reader) (cacheID, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return cacheID{}, err
+	}
+	var id cacheID
+	h.Sum(id[:0])
+	return id, nil
+}
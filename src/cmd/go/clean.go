@@ -13,7 +13,7 @@ import (
 )
 
 var cmdClean = &Command{
-	UsageLine: "clean [-i] [-r] [-n] [-x] [packages]",
+	UsageLine: "clean [-i] [-r] [-n] [-x] [-cache] [-modcache] [packages]",
 	Short:     "remove object files",
 	Long: `
 Clean removes object files from package source directories.
@@ -52,6 +52,12 @@ dependencies of the packages named by the import paths.
 
 The -x flag causes clean to print remove commands as it executes them.
 
+The -cache flag causes clean to remove the entire build cache,
+discarding compiled packages and test results; see 'go help cache'.
+
+The -modcache flag causes clean to remove the entire module download
+cache, including unpacked source code of versioned dependencies.
+
 For more about specifying packages, see 'go help packages'.
 	`,
 }
@@ -61,6 +67,9 @@ var cleanN bool // clean -n flag
 var cleanR bool // clean -r flag
 var cleanX bool // clean -x flag
 
+var cleanCache bool    // clean -cache flag
+var cleanModcache bool // clean -modcache flag
+
 func init() {
 	// break init cycle
 	cmdClean.Run = runClean
@@ -69,9 +78,48 @@ func init() {
 	cmdClean.Flag.BoolVar(&cleanN, "n", false, "")
 	cmdClean.Flag.BoolVar(&cleanR, "r", false, "")
 	cmdClean.Flag.BoolVar(&cleanX, "x", false, "")
+	cmdClean.Flag.BoolVar(&cleanCache, "cache", false, "")
+	cmdClean.Flag.BoolVar(&cleanModcache, "modcache", false, "")
 }
 
 func runClean(cmd *Command, args []string) {
+	if cleanCache {
+		if dir := cacheDir(); dir != "" {
+			if cleanN || cleanX {
+				var b builder
+				b.print = fmt.Print
+				b.showcmd("", "rm -r %s", filepath.Join(dir, "??"))
+			}
+			if !cleanN {
+				c := &buildCache{dir: dir}
+				if err := c.clean(); err != nil {
+					errorf("go clean -cache: %v", err)
+				}
+			}
+		}
+		if len(args) == 0 && !cleanModcache {
+			return
+		}
+	}
+	if cleanModcache {
+		dir, err := modCacheRoot()
+		if err != nil {
+			fatalf("go clean -modcache: %v", err)
+		}
+		if cleanN || cleanX {
+			var b builder
+			b.print = fmt.Print
+			b.showcmd("", "rm -rf %s", dir)
+		}
+		if !cleanN {
+			if err := os.RemoveAll(dir); err != nil {
+				errorf("go clean -modcache: %v", err)
+			}
+		}
+		if len(args) == 0 {
+			return
+		}
+	}
 	for _, pkg := range packagesAndErrors(args) {
 		clean(pkg)
 	}
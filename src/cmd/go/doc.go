@@ -16,12 +16,15 @@ The commands are:
 
     build       compile packages and dependencies
     clean       remove object files
+    doc         show documentation for package or symbol
     env         print Go environment information
     fix         run go tool fix on packages
     fmt         run gofmt on package sources
+    generate    generate Go files by processing source
     get         download and install packages and dependencies
     install     compile and install packages and dependencies
     list        list packages
+    mod         module maintenance
     run         compile and run Go program
     test        test packages
     tool        run specified go tool
@@ -35,6 +38,8 @@ Additional help topics:
     c           calling between Go and C
     gopath      GOPATH environment variable
     importpath  import path syntax
+    modules     modules, module versions, and go.mod files
+    cache       build and test caching
     packages    description of package lists
     testflag    description of testing flags
     testfunc    description of testing functions
@@ -122,7 +127,7 @@ Remove object files
 
 Usage:
 
-	go clean [-i] [-r] [-n] [-x] [packages]
+	go clean [-i] [-r] [-n] [-x] [-cache] [-modcache] [packages]
 
 Clean removes object files from package source directories.
 The go command builds most objects in a temporary directory,
@@ -160,9 +165,119 @@ dependencies of the packages named by the import paths.
 
 The -x flag causes clean to print remove commands as it executes them.
 
+The -cache flag causes clean to remove the entire build cache,
+discarding compiled packages and test results; see 'go help cache'.
+
+The -modcache flag causes clean to remove the entire module download
+cache, including unpacked source code of versioned dependencies.
+
 For more about specifying packages, see 'go help packages'.
 
 
+Show documentation for package or symbol
+
+Usage:
+
+	go doc [-u] [-c] [-all] [-src] [package|[package.]symbol[.method]]
+
+Doc prints the documentation comments associated with the item identified by its
+arguments (a package, const, func, type, var, method, or struct field)
+followed by a one-line summary of each of the first-level items "under"
+that item (package-level declarations for a package, methods for a type,
+etc.).
+
+Doc accepts zero, one, or two arguments.
+
+Given no arguments, that is, when run as
+
+	go doc
+
+it prints the package documentation for the package in the current directory.
+If the package is a command (package main), the exported symbols of the package
+are elided from the presentation unless the -all flag is provided.
+
+When run with one argument, the argument is treated as a Go-syntax-like
+representation of the item to be documented. What the argument selects depends
+on what is installed in GOROOT and GOPATH, as well as the form of the argument,
+which is schematically one of these:
+
+	go doc <pkg>
+	go doc <sym>[.<method>]
+	go doc [<pkg>.]<sym>[.<method>]
+	go doc [<pkg>.][<sym>.]<method>
+
+The first item in this list matched by the argument is the one whose documentation
+is printed. (See the examples below.)
+
+For packages, the order of scanning is determined lexically in breadth-first order.
+That is, the package presented is the one that matches the search and is nearest
+the root and lexically first at its level of the hierarchy. The GOROOT tree is
+always scanned in its entirety before GOPATH.
+
+If there is no package specified or matched, the package in the current
+directory is selected, so "go doc Foo" shows the documentation for symbol Foo in
+the current package.
+
+The package path must be either a qualified path or a proper suffix of a
+path. The go tool's usual package mechanism does not apply: package path
+elements like . and ... are not implemented by go doc.
+
+When run with two arguments, the first must be a full package path (not just a
+suffix), and the second is a symbol, or symbol with method or struct field.
+This is similar to the syntax accepted by godoc:
+
+	go doc <pkg> <sym>[.<methodOrField>]
+
+In all forms, when matching symbols, lower-case letters in the argument match
+either case but upper-case letters match exactly. This means that there may be
+multiple matches of a lower-case argument in a package if different symbols have
+different cases. If this occurs, documentation for all matches is printed.
+
+Examples:
+	go doc
+		Show documentation for current package.
+	go doc Foo
+		Show documentation for Foo in the current package.
+		(Foo starts with a capital letter so it cannot match
+		a package path.)
+	go doc encoding/json
+		Show documentation for the encoding/json package.
+	go doc json
+		Shorthand for encoding/json.
+	go doc json.Number (or go doc json.number)
+		Show documentation and method summary for json.Number.
+	go doc json.Number.Int64 (or go doc json.number.int64)
+		Show documentation for json.Number's Int64 method.
+	go doc text/template.new # One argument
+		Show documentation for text/template's New function.
+	go doc text/template new # Two arguments
+		Show documentation for text/template's New function.
+
+	These invocations all print the documentation for
+	json.Decoder's Decode method:
+
+	go doc json.Decoder.Decode
+	go doc json.decoder.decode
+	go doc json.decode
+	cd $GOROOT/src/pkg/encoding/json; go doc decode
+
+Flags:
+	-all
+		Show all the documentation for the package.
+	-c
+		Respect case when matching symbols.
+	-src
+		Show the full source code for the symbol. This will
+		display the full Go source of its declaration and
+		definition, such as a function definition (including
+		the body), type declaration or enclosing const
+		block. The output may therefore include unexported
+		details.
+	-u
+		Show documentation for unexported as well as exported
+		symbols, methods, and fields.
+
+
 Print Go environment information
 
 Usage:
@@ -213,6 +328,107 @@ To run gofmt with specific options, run gofmt itself.
 See also: go fix, go vet.
 
 
+Generate Go files by processing source
+
+Usage:
+
+	go generate [-run regexp] [-n] [-v] [-x] [file.go... | packages]
+
+Generate runs commands described by directives within existing
+files. Those commands can run any process but the intent is to
+create or update Go source files, for instance by running yacc.
+
+Go generate is never run automatically by go build, go get, go test,
+and so on. It must be run explicitly.
+
+Go generate scans the file for directives, which are lines of
+the form,
+
+	//go:generate command argument...
+
+(note: no leading spaces and no space in "//go") where command
+is the generator to be run, corresponding to an executable file
+that can be run locally. It must either be in the shell path
+(gofmt), a fully qualified path (/usr/you/bin/mytool), or a
+command alias, described below.
+
+Note that go generate does not parse the file, so lines that look
+like directives in comments or multiline strings will be treated
+as directives.
+
+The arguments to the directive are space-separated tokens or
+double-quoted strings passed to the generator as individual
+arguments when it is run.
+
+Quoted strings use Go syntax and are evaluated before execution; a
+quoted string appears as a single argument to the generator.
+
+Go generate sets several variables when it runs the generator:
+
+	$GOARCH
+		The execution architecture (arm, amd64, etc.)
+	$GOOS
+		The execution operating system (linux, windows, etc.)
+	$GOFILE
+		The base name of the file.
+	$GOPACKAGE
+		The name of the package of the file containing the directive.
+
+Other than variable substitution and quoted-string evaluation, no
+special processing such as "globbing" is performed on the command
+line.
+
+As a last step before running the command, any invocations of any
+environment variables with alphanumeric names, such as $GOFILE or
+$HOME, are expanded throughout the command line. The syntax for
+variable expansion is $NAME on all operating systems.  Due to the
+order of evaluation, variables are expanded even inside quoted
+strings. If the variable NAME is not set, $NAME expands to the
+empty string.
+
+A directive of the form,
+
+	//go:generate -command xxx args...
+
+specifies, for the remainder of this source file only, that the
+string xxx represents the command identified by the arguments. This
+can be used to create aliases or to handle multiword generators.
+For example,
+
+	//go:generate -command yacc go tool yacc
+
+specifies that the command "yacc" represents the generator
+"go tool yacc".
+
+Go generate processes packages in the order given on the command line,
+one at a time. If the command line lists .go files, they are treated
+as a single package. Within a package, generate processes the
+source files in a package in file name order, one at a time. Within
+a source file, generate runs generators in the order they appear
+in the file, one at a time.
+
+If any generator returns an error exit status, "go generate" skips
+all further processing for that package.
+
+The generator is run in the package's source directory.
+
+Go generate accepts one specific flag:
+
+	-run=""
+		if non-empty, specifies a regular expression to
+		select directives whose full original source text (excluding
+		any trailing spaces and final newline) matches the
+		expression.
+
+It also accepts the standard build flags -v, -n, and -x.
+The -v flag prints the names of packages and files as they are
+processed.
+The -n flag prints commands that would be executed.
+The -x flag prints commands as they are executed.
+
+For more about specifying packages, see 'go help packages'.
+
+
 Download and install packages and dependencies
 
 Usage:
@@ -249,6 +465,15 @@ For more about specifying packages, see 'go help packages'.
 For more about how 'go get' finds source code to
 download, see 'go help importpath'.
 
+In module mode, get instead adjusts the requirements in the main
+module's go.mod file.  Each argument may carry a version suffix:
+path@v1.2.3 requires that version, path@v1 or path@v1.2 the latest
+matching release, path@latest (the default) the latest release, and
+path@none removes the requirement.  With no suffix, get requires the
+latest version only if the module is not already required, unless -u
+is given.  The -u flag with no arguments updates every requirement
+to its latest version.  For more about modules, see 'go help modules'.
+
 See also: go build, go install, go clean.
 
 
@@ -295,6 +520,7 @@ syntax of package template.  The default output is equivalent to -f
         Standard   bool   // is this package part of the standard Go library?
         Stale      bool   // would 'go install' do anything for this package?
         Root       string // Go root or Go path dir containing this package
+        Module     string // module containing this package, as path@version (module mode)
 
         // Source files
         GoFiles  []string       // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
@@ -317,8 +543,9 @@ syntax of package template.  The default output is equivalent to -f
         CgoPkgConfig []string // cgo: pkg-config names
 
         // Dependency information
-        Imports []string // import paths used by this package
-        Deps    []string // all (recursively) imported dependencies
+        Imports   []string          // import paths used by this package
+        ImportMap map[string]string // map from import in source to vendored ImportPath
+        Deps      []string          // all (recursively) imported dependencies
 
         // Error information
         Incomplete bool            // this package or a dependency has an error
@@ -331,6 +558,13 @@ syntax of package template.  The default output is equivalent to -f
         XTestImports []string // imports from XTestGoFiles
     }
 
+Imports lists the full import paths of the packages used, so an import
+satisfied by a vendor directory appears with its vendor prefix, as in
+"x/vendor/y"; ImportMap records the path written in the source for
+each such import.  An import of an internal or vendored package from
+outside the tree that may use it is reported as an error in Error or
+DepsErrors.  See 'go help importpath' for the visibility rules.
+
 The template function "join" calls strings.Join.
 
 The template function "context" returns the build context, defined as:
@@ -373,6 +607,51 @@ required by the race detector.
 For more about specifying packages, see 'go help packages'.
 
 
+Module maintenance
+
+Usage:
+
+	go mod command [arguments]
+
+Mod provides access to operations on modules.  It must be run
+in module mode, inside the main module (see 'go help modules').
+
+The commands are:
+
+	go mod init [path]
+
+Init creates a go.mod file in the current directory, declaring
+a new module with the given import path.  If the path is omitted,
+init uses the import path of the current directory within $GOPATH/src.
+
+	go mod download [-x] [modules]
+
+Download copies the named modules, or by default every module in the
+build list, into the module cache.  Each argument is a module path,
+denoting the version in the build list, or path@version.
+
+	go mod tidy [-v]
+
+Tidy makes go.mod match the source code of the main module: it adds
+a requirement, at the latest version, for any imported package not
+provided by the build list, and removes requirements on modules that
+provide no packages imported by the main module (including its tests),
+directly or indirectly.  It also removes unneeded entries from go.sum.
+The -v flag prints the modules it adds and removes.
+
+	go mod verify
+
+Verify checks that the copies of the modules in the build list held in
+the module cache have not been modified since they were downloaded.
+
+	go mod graph
+
+Graph prints the module requirement graph, one edge per line:
+a module and one of its requirements, separated by a space.
+
+See also: go get, go help modules.
+
+
 Compile and run Go program
 
 Usage:
@@ -428,6 +707,16 @@ with source in the current directory, including tests, and runs the tests.
 The package is built in a temporary directory so it does not interfere with the
 non-test installation.
 
+When the packages to test are listed explicitly, as in 'go test math'
+or 'go test ./...', go test records the results of successful test
+runs in the build cache.  If the test binary, the flags passed to it,
+and the files in the package directory and its testdata subdirectory
+are unchanged, go test prints the recorded result, with "(cached)"
+in place of the elapsed time, instead of running the test again.
+Only runs using the flags -cpu, -parallel, -run, -short and -v are
+cached; any other test flag disables caching.  The idiomatic way to
+force a test to run is to use -count=1.  See 'go help cache'.
+
 In addition to the build flags, the flags handled by 'go test' itself are:
 
 	-c  Compile the test binary to pkg.test but do not run it.
@@ -474,20 +763,40 @@ Print Go version
 
 Usage:
 
-	go version
+	go version [-m] [file ...]
+
+Version prints the build information for Go executables.
+
+Go version reports the Go version used to build each of the named
+executable files.
+
+If no files are named on the command line, go version prints its own
+version information, as reported by runtime.Version.
+
+If a directory is named, go version walks that directory, recursively,
+looking for recognized Go binaries and reporting their versions.
+By default, go version does not report unrecognized files found
+during a directory scan.
+
+The -m flag causes go version to print each executable's embedded
+build information, when available: the path of the main package,
+the main module, the modules or, in GOPATH mode, the repositories
+and revisions providing the linked packages, the packages themselves,
+and the build settings.  Each line is indented by a tab.
 
-Version prints the Go version, as reported by runtime.Version.
+The build information is recorded by the linker; see 'go doc debug/buildinfo'
+for a way to read it from Go programs.
 
 
 Run go tool vet on packages
 
 Usage:
 
-	go vet [-n] [-x] [packages]
+	go vet [-n] [-x] [-json] [packages]
 
 Vet runs the Go vet command on the packages named by the import paths.
 
-For more about vet, see 'godoc code.google.com/p/go.tools/cmd/vet'.
+For more about vet, see 'godoc cmd/vet'.
 For more about specifying packages, see 'go help packages'.
 
 To run the vet tool with specific options, run 'go tool vet'.
@@ -495,6 +804,9 @@ To run the vet tool with specific options, run 'go tool vet'.
 The -n flag prints commands that would be executed.
 The -x flag prints commands as they are executed.
 
+The -json flag prints the diagnostics of each package, including
+suggested fixes, to standard output as a JSON object.
+
 See also: go fmt, go fix.
 
 
@@ -608,6 +920,34 @@ so they are rebuilt from scratch each time they are built.
 To avoid ambiguity, Go programs cannot use relative import paths
 within a work space.
 
+Internal directories
+
+Code in or below a directory named "internal" is importable only
+by code in the directory tree rooted at the parent of "internal".
+For example, a package with import path a/b/internal/c can be
+imported by code in a/b and a/b/d but not by code in a/e.
+The go command reports an import that breaks this rule as the
+error "use of internal package not allowed".
+
+Vendor directories
+
+Code below a directory named "vendor" is importable only by code
+in the directory tree rooted at the parent of "vendor", and only
+using an import path that omits the prefix up to and including the
+vendor element.  When resolving an import in code in a work space
+or the Go tree, the go command first searches the vendor directory
+in the importing directory, then in each of its parents in turn,
+before searching $GOROOT and $GOPATH.  For example, when code in
+a/b/c imports "x/y", the go command tries a/b/c/vendor/x/y,
+a/b/vendor/x/y, a/vendor/x/y and vendor/x/y, in that order.
+
+A package found in a vendor directory is identified by its full
+import path, such as a/vendor/x/y, which is the path that
+'go list' reports in Imports and Deps.  Importing such a package
+using its full path is an error.  Vendor directories are not
+consulted for imports resolved by the module system
+(see 'go help modules').
+
 Remote import paths
 
 Certain import paths also
@@ -721,6 +1061,111 @@ package appropriate for the Go release being used.
 Run 'go help install' for more.
 
 
+Modules, module versions, and go.mod files
+
+A module is a tree of Go packages that is versioned as a unit.
+The go.mod file in the root directory of the tree declares the
+module's path, which is the import path prefix for its packages,
+and the minimum versions of the other modules it requires:
+
+	module example.com/hello
+
+	require (
+		example.com/greeting v1.2.0
+		example.com/util v0.4.1
+	)
+
+	replace example.com/util => ../util
+
+Versions are semantic versions with a leading v, such as v1.2.3
+or v2.0.0-beta.1.  A replace directive substitutes a different module
+version, or a directory on the local file system, for all versions
+(or, if Old is followed by a version, for just that version) of a module.
+Lines may carry // comments; the go command does not preserve them
+when it rewrites the file.
+
+When the current directory or one of its parents contains a go.mod
+file, the go command runs in module mode: that directory is the root
+of the main module.  Setting the environment variable GOMODULES=off
+disables module mode.
+
+In module mode, import paths are resolved using the build list
+rather than $GOPATH/src.  The build list is the main module together
+with, for each module reachable by following requirements from it,
+the highest version required anywhere along the way.  This is the
+oldest version of each module that satisfies every requirement, so
+the build list changes only when a go.mod file changes: it does not
+silently move to newly published versions.  Packages in modules
+are built from source in the temporary work directory rather than
+installed; commands in the main module are installed to $GOBIN or the
+bin directory of the first $GOPATH entry as usual.  Packages with no
+dot in the first path element are taken from the standard library.
+
+Modules are downloaded from the module proxy named by the GOPROXY
+environment variable, which may be an http:// or https:// URL or a
+file:/// URL naming a local directory.  For each module path and
+version a proxy serves the files
+
+	<path>/@v/list           list of known versions, one per line
+	<path>/@v/<version>.info JSON {"Version": ..., "Time": ...}
+	<path>/@v/<version>.mod  the module's go.mod file
+	<path>/@v/<version>.zip  the module's files, all prefixed by
+	                         <path>@<version>/
+
+in which each upper-case letter of the path is written as ! followed
+by the corresponding lower-case letter.  Downloaded files are kept in
+$GOPATH/pkg/mod/cache/download, laid out the same way, so that
+directory can itself be used as a proxy; the source tree of each
+module is unpacked into $GOPATH/pkg/mod/<path>@<version>.
+
+The go.sum file beside go.mod records a cryptographic hash of each
+module version, and of each go.mod file, used in the build.  The go
+command adds missing hashes as it downloads modules, and it refuses
+to use a download whose hash does not match the one in go.sum.
+Both go.mod and go.sum should be checked in to version control.
+
+See 'go help mod' for maintenance commands and 'go help get' for
+changing the required versions.
+
+
+Build and test caching
+
+The go command caches build outputs for reuse in future builds.
+The default location for cache data is a subdirectory named go-build
+in the standard user cache directory for the current operating system:
+$XDG_CACHE_HOME or $HOME/.cache on Unix systems, $HOME/Library/Caches
+on OS X, and %LocalAppData% on Windows.  Setting the GOCACHE
+environment variable overrides this default, and setting GOCACHE=off
+disables the cache.  Running 'go env GOCACHE' prints the current
+cache directory.  The cache is safe to share between concurrent
+go commands and between work spaces.
+
+Each compiled package is recorded under a hash of its inputs: the
+contents of its source files, the compiled form of its dependencies,
+the compiler and other tools used, and the build flags and
+environment settings that affect compilation.  When all of these are
+unchanged, go build, go install and go test copy the package from
+the cache instead of compiling it again, even in a different work
+directory or GOPATH entry; the file names recorded in such a package
+for debugging name the directory it was first compiled in.  The -a
+build flag forces packages to be compiled, but the results are still
+recorded in the cache.  Linking is not cached.
+
+The go command also uses the cache to decide whether installed
+packages and commands are up to date.  Go install records the inputs
+of each file it installs, and the file is rebuilt when those inputs
+change, whatever the modification times of the files.  With the cache
+disabled, installed packages and commands in the trees of the packages
+named on the command line are always rebuilt.
+
+The go command also caches successful test results, as described
+in 'go help test'.  Use -count=1 to run a test without consulting
+the cache.
+
+The go command periodically deletes cached data that has not been
+used recently.  Running 'go clean -cache' deletes all cached data.
+
+
 Description of package lists
 
 Many commands apply to a set of packages:
@@ -783,9 +1228,9 @@ The 'go test' command takes both flags that apply to 'go test' itself
 and flags that apply to the resulting test binary.
 
 Several of the flags control profiling and write an execution profile
-suitable for "go tool pprof"; run "go tool pprof help" for more
-information.  The --alloc_space, --alloc_objects, and --show_bytes
-options of pprof control how the information is presented.
+suitable for "go tool pprof"; run "go tool pprof -h" for more
+information.  The -alloc_space, -alloc_objects, -inuse_space and
+-inuse_objects options of pprof control how the information is presented.
 
 The following flags are recognized by the 'go test' command and
 control the execution of any test:
@@ -841,6 +1286,11 @@ control the execution of any test:
 	    have passed.
 	    Sets -cover.
 
+	-count n
+	    Run each test and benchmark n times (default 1).
+	    Setting -count explicitly, as in -count=1, also disables
+	    the reuse of cached test results.
+
 	-cpu 1,2,4
 	    Specify a list of GOMAXPROCS values for which the tests or
 	    benchmarks should be executed.  The default is the current value
@@ -857,7 +1307,17 @@ control the execution of any test:
 	    Enable more precise (and expensive) memory profiles by setting
 	    runtime.MemProfileRate.  See 'godoc runtime MemProfileRate'.
 	    To profile all memory allocations, use -test.memprofilerate=1
-	    and pass --alloc_space flag to the pprof tool.
+	    and pass the -alloc_space flag to the pprof tool.
+
+	-mutexprofile mutex.out
+	    Write a mutex contention profile to the specified file
+	    when all tests are complete.
+
+	-mutexprofilefraction n
+	    Sample 1 in n stack traces of goroutines holding a
+	    contended mutex.  By default, if -test.mutexprofile is set
+	    without this flag, every contended unlock is recorded,
+	    equivalent to -test.mutexprofilefraction=1.
 
 	-outputdir directory
 	    Place output files from profiling in the specified directory,
@@ -881,6 +1341,10 @@ control the execution of any test:
 	-timeout t
 	    If a test runs longer than t, panic.
 
+	-trace trace.out
+	    Write an execution trace to the specified file before exiting.
+	    View it with 'go tool trace trace.out'.
+
 	-v
 	    Verbose output: log all tests as they are run. Also print all
 	    text from Log and Logf calls even if the test succeeds.
@@ -7,6 +7,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 )
@@ -36,12 +37,15 @@ func mkEnv() []envVar {
 	env := []envVar{
 		{"GOARCH", goarch},
 		{"GOBIN", gobin},
+		{"GOCACHE", cacheDir()},
 		{"GOCHAR", archChar},
 		{"GOEXE", exeSuffix},
 		{"GOHOSTARCH", runtime.GOARCH},
 		{"GOHOSTOS", runtime.GOOS},
+		{"GOMOD", goMod()},
 		{"GOOS", goos},
 		{"GOPATH", os.Getenv("GOPATH")},
+		{"GOPROXY", os.Getenv("GOPROXY")},
 		{"GORACE", os.Getenv("GORACE")},
 		{"GOROOT", goroot},
 		{"GOTOOLDIR", toolDir},
@@ -67,6 +71,14 @@ func mkEnv() []envVar {
 	return env
 }
 
+// goMod returns the main module's go.mod file, or "" outside module mode.
+func goMod() string {
+	if !modEnabled() {
+		return ""
+	}
+	return filepath.Join(modRoot, "go.mod")
+}
+
 func findEnv(env []envVar, name string) string {
 	for _, e := range env {
 		if e.name == name {
@@ -0,0 +1,119 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+type splitTest struct {
+	in  string
+	out []string
+}
+
+// splitTests is a function rather than a variable because goarch
+// and goos are not set until the init functions have run.
+func splitTests() []splitTest {
+	return []splitTest{
+		{"", nil},
+		{"x", []string{"x"}},
+		{" a b\tc ", []string{"a", "b", "c"}},
+		{` " a " `, []string{" a "}},
+		{"$GOARCH", []string{goarch}},
+		{"$GOOS", []string{goos}},
+		{"$GOFILE", []string{"proc.go"}},
+		{"$GOPACKAGE", []string{"sys"}},
+		{"a $XXNOTDEFINEDXX b", []string{"a", "", "b"}},
+		{"/$XXNOTDEFINED/", []string{"//"}},
+		{"yacc -o $GOARCH/yacc_$GOFILE", []string{"go", "tool", "yacc", "-o", goarch + "/yacc_proc.go"}},
+	}
+}
+
+func TestGenerateCommandParse(t *testing.T) {
+	g := &Generator{
+		r:        nil, // Unused here.
+		path:     "/usr/ken/sys/proc.go",
+		dir:      "/usr/ken/sys",
+		file:     "proc.go",
+		pkg:      "sys",
+		commands: make(map[string][]string),
+	}
+	g.setShorthand([]string{"-command", "yacc", "go", "tool", "yacc"})
+	for _, test := range splitTests() {
+		got := g.split("//go:generate " + test.in + "\n")
+		if !reflect.DeepEqual(got, test.out) {
+			t.Errorf("split(%q): got %q expected %q", test.in, got, test.out)
+		}
+	}
+}
+
+const generateRunSrc = "package sys\n\n" +
+	"//go:generate -command yacc go tool yacc\n" +
+	"//go:generate yacc -o gram.go gram.y\n" +
+	"//go:generate stringer -type=Op\n" +
+	"//go:generate echo stringer \t\n" + // trailing space is not matched
+	"// go:generate stringer -type=NotADirective\n"
+
+var generateRunTests = []struct {
+	run string
+	out string // commands printed with -n
+}{
+	{"", "go tool yacc -o gram.go gram.y\nstringer -type=Op\necho stringer\n"},
+	{"stringer", "stringer -type=Op\necho stringer\n"},
+	{"^//go:generate yacc", "go tool yacc -o gram.go gram.y\n"},
+	{"stringer$", "echo stringer\n"},
+	{"NotADirective", ""},
+	{"nomatch", ""},
+}
+
+func TestGenerateRun(t *testing.T) {
+	// With -n, the commands are printed to standard error
+	// instead of being run.
+	f, err := ioutil.TempFile("", "go-generate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	saveStderr, saveN := os.Stderr, buildN
+	os.Stderr, buildN = f, true
+	defer func() {
+		os.Stderr, buildN = saveStderr, saveN
+	}()
+
+	for _, test := range generateRunTests {
+		if err := f.Truncate(0); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Seek(0, 0); err != nil {
+			t.Fatal(err)
+		}
+		g := &Generator{
+			r:        strings.NewReader(generateRunSrc),
+			path:     "/usr/ken/sys/proc.go",
+			pkg:      "sys",
+			commands: make(map[string][]string),
+		}
+		if test.run != "" {
+			g.runRE = regexp.MustCompile(test.run)
+		}
+		if !g.run() {
+			t.Errorf("-run=%q: generate failed", test.run)
+			continue
+		}
+		out, err := ioutil.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != test.out {
+			t.Errorf("-run=%q: ran\n%swant\n%s", test.run, out, test.out)
+		}
+	}
+}
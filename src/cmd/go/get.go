@@ -51,6 +51,15 @@ For more about specifying packages, see 'go help packages'.
 For more about how 'go get' finds source code to
 download, see 'go help importpath'.
 
+In module mode, get instead adjusts the requirements in the main
+module's go.mod file.  Each argument may carry a version suffix:
+path@v1.2.3 requires that version, path@v1 or path@v1.2 the latest
+matching release, path@latest (the default) the latest release, and
+path@none removes the requirement.  With no suffix, get requires the
+latest version only if the module is not already required, unless -u
+is given.  The -u flag with no arguments updates every requirement
+to its latest version.  For more about modules, see 'go help modules'.
+
 See also: go build, go install, go clean.
 	`,
 }
@@ -66,6 +75,11 @@ func init() {
 }
 
 func runGet(cmd *Command, args []string) {
+	if modEnabled() {
+		runModGet(cmd, args)
+		return
+	}
+
 	// Phase 1.  Download/update.
 	var stk importStack
 	for _, arg := range downloadPaths(args) {
@@ -426,3 +440,106 @@ func cmpGoVersion(x, y string) int {
 	}
 	return 0
 }
+
+// runModGet implements 'go get' in module mode.
+func runModGet(cmd *Command, args []string) {
+	modLoad()
+	if len(args) == 0 && *getU {
+		for _, m := range modMain.Require {
+			v, err := modLatest(m.Path)
+			if err != nil {
+				errorf("go get: %v", err)
+				continue
+			}
+			if semverCompare(v, m.Version) > 0 {
+				modMain.setRequire(m.Path, v)
+			}
+		}
+		exitIfErrors()
+	}
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	var install []string
+	for _, arg := range args {
+		path, query := arg, "latest"
+		if i := strings.Index(arg, "@"); i >= 0 {
+			path, query = arg[:i], arg[i+1:]
+		}
+		if build.IsLocalImport(path) || strings.Contains(path, "...") {
+			if strings.Contains(arg, "@") {
+				errorf("go get %s: cannot use version with local path or pattern", arg)
+				continue
+			}
+			install = append(install, path)
+			continue
+		}
+		if !isModImportPath(path) {
+			// Standard library package.
+			install = append(install, path)
+			continue
+		}
+		if hasPathPrefix(path, modMain.Module) {
+			if strings.Contains(arg, "@") {
+				errorf("go get %s: cannot change version of main module", arg)
+				continue
+			}
+			install = append(install, path)
+			continue
+		}
+		if query == "none" {
+			found := false
+			for i, m := range modMain.Require {
+				if m.Path == path {
+					modMain.Require = append(modMain.Require[:i], modMain.Require[i+1:]...)
+					found = true
+					break
+				}
+			}
+			if !found {
+				errorf("go get %s: module %s is not required", arg, path)
+			}
+			continue
+		}
+		m, err := modFindModule(path, query)
+		if err != nil {
+			errorf("go get %s: %v", arg, err)
+			continue
+		}
+		if old := modMain.require(m.Path); old == "" || *getU || strings.Contains(arg, "@") {
+			if buildV && old != m.Version {
+				fmt.Fprintf(os.Stderr, "go: requiring %s\n", m)
+			}
+			modMain.setRequire(m.Path, m.Version)
+		}
+		install = append(install, path)
+	}
+	exitIfErrors()
+
+	modReloadBuildList()
+	writeModFile()
+
+	// Download everything the named packages need,
+	// even if they are not to be installed.
+	for name := range packageCache {
+		delete(packageCache, name)
+	}
+	var stk importStack
+	for _, arg := range importPaths(install) {
+		p := loadPackage(arg, &stk)
+		if p.Error != nil {
+			errorf("%s", p.Error)
+			continue
+		}
+		for _, err := range p.DepsErrors {
+			errorf("%s", err)
+		}
+	}
+	exitIfErrors()
+
+	if *getD || len(install) == 0 {
+		return
+	}
+	runInstall(cmd, install)
+}
@@ -119,6 +119,34 @@ so they are rebuilt from scratch each time they are built.
 To avoid ambiguity, Go programs cannot use relative import paths
 within a work space.
 
+Internal directories
+
+Code in or below a directory named "internal" is importable only
+by code in the directory tree rooted at the parent of "internal".
+For example, a package with import path a/b/internal/c can be
+imported by code in a/b and a/b/d but not by code in a/e.
+The go command reports an import that breaks this rule as the
+error "use of internal package not allowed".
+
+Vendor directories
+
+Code below a directory named "vendor" is importable only by code
+in the directory tree rooted at the parent of "vendor", and only
+using an import path that omits the prefix up to and including the
+vendor element.  When resolving an import in code in a work space
+or the Go tree, the go command first searches the vendor directory
+in the importing directory, then in each of its parents in turn,
+before searching $GOROOT and $GOPATH.  For example, when code in
+a/b/c imports "x/y", the go command tries a/b/c/vendor/x/y,
+a/b/vendor/x/y, a/vendor/x/y and vendor/x/y, in that order.
+
+A package found in a vendor directory is identified by its full
+import path, such as a/vendor/x/y, which is the path that
+'go list' reports in Imports and Deps.  Importing such a package
+using its full path is an error.  Vendor directories are not
+consulted for imports resolved by the module system
+(see 'go help modules').
+
 Remote import paths
 
 Certain import paths also
@@ -295,3 +323,114 @@ but new packages are always downloaded into the first directory
 in the list.
 	`,
 }
+
+var helpModules = &Command{
+	UsageLine: "modules",
+	Short:     "modules, module versions, and go.mod files",
+	Long: `
+A module is a tree of Go packages that is versioned as a unit.
+The go.mod file in the root directory of the tree declares the
+module's path, which is the import path prefix for its packages,
+and the minimum versions of the other modules it requires:
+
+	module example.com/hello
+
+	require (
+		example.com/greeting v1.2.0
+		example.com/util v0.4.1
+	)
+
+	replace example.com/util => ../util
+
+Versions are semantic versions with a leading v, such as v1.2.3
+or v2.0.0-beta.1.  A replace directive substitutes a different module
+version, or a directory on the local file system, for all versions
+(or, if Old is followed by a version, for just that version) of a module.
+Lines may carry // comments; the go command does not preserve them
+when it rewrites the file.
+
+When the current directory or one of its parents contains a go.mod
+file, the go command runs in module mode: that directory is the root
+of the main module.  Setting the environment variable GOMODULES=off
+disables module mode.
+
+In module mode, import paths are resolved using the build list
+rather than $GOPATH/src.  The build list is the main module together
+with, for each module reachable by following requirements from it,
+the highest version required anywhere along the way.  This is the
+oldest version of each module that satisfies every requirement, so
+the build list changes only when a go.mod file changes: it does not
+silently move to newly published versions.  Packages in modules
+are built from source in the temporary work directory rather than
+installed; commands in the main module are installed to $GOBIN or the
+bin directory of the first $GOPATH entry as usual.  Packages with no
+dot in the first path element are taken from the standard library.
+
+Modules are downloaded from the module proxy named by the GOPROXY
+environment variable, which may be an http:// or https:// URL or a
+file:/// URL naming a local directory.  For each module path and
+version a proxy serves the files
+
+	<path>/@v/list           list of known versions, one per line
+	<path>/@v/<version>.info JSON {"Version": ..., "Time": ...}
+	<path>/@v/<version>.mod  the module's go.mod file
+	<path>/@v/<version>.zip  the module's files, all prefixed by
+	                         <path>@<version>/
+
+in which each upper-case letter of the path is written as ! followed
+by the corresponding lower-case letter.  Downloaded files are kept in
+$GOPATH/pkg/mod/cache/download, laid out the same way, so that
+directory can itself be used as a proxy; the source tree of each
+module is unpacked into $GOPATH/pkg/mod/<path>@<version>.
+
+The go.sum file beside go.mod records a cryptographic hash of each
+module version, and of each go.mod file, used in the build.  The go
+command adds missing hashes as it downloads modules, and it refuses
+to use a download whose hash does not match the one in go.sum.
+Both go.mod and go.sum should be checked in to version control.
+
+See 'go help mod' for maintenance commands and 'go help get' for
+changing the required versions.
+	`,
+}
+
+var helpCache = &Command{
+	UsageLine: "cache",
+	Short:     "build and test caching",
+	Long: `
+The go command caches build outputs for reuse in future builds.
+The default location for cache data is a subdirectory named go-build
+in the standard user cache directory for the current operating system:
+$XDG_CACHE_HOME or $HOME/.cache on Unix systems, $HOME/Library/Caches
+on OS X, and %LocalAppData% on Windows.  Setting the GOCACHE
+environment variable overrides this default, and setting GOCACHE=off
+disables the cache.  Running 'go env GOCACHE' prints the current
+cache directory.  The cache is safe to share between concurrent
+go commands and between work spaces.
+
+Each compiled package is recorded under a hash of its inputs: the
+contents of its source files, the compiled form of its dependencies,
+the compiler and other tools used, and the build flags and
+environment settings that affect compilation.  When all of these are
+unchanged, go build, go install and go test copy the package from
+the cache instead of compiling it again, even in a different work
+directory or GOPATH entry; the file names recorded in such a package
+for debugging name the directory it was first compiled in.  The -a
+build flag forces packages to be compiled, but the results are still
+recorded in the cache.  Linking is not cached.
+
+The go command also uses the cache to decide whether installed
+packages and commands are up to date.  Go install records the inputs
+of each file it installs, and the file is rebuilt when those inputs
+change, whatever the modification times of the files.  With the cache
+disabled, installed packages and commands in the trees of the packages
+named on the command line are always rebuilt.
+
+The go command also caches successful test results, as described
+in 'go help test'.  Use -count=1 to run a test without consulting
+the cache.
+
+The go command periodically deletes cached data that has not been
+used recently.  Running 'go clean -cache' deletes all cached data.
+	`,
+}
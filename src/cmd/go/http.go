@@ -32,7 +32,7 @@ func httpGET(url string) ([]byte, error) {
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+		return nil, &httpError{url: url, status: resp.Status, statusCode: resp.StatusCode}
 	}
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
@@ -41,6 +41,23 @@ func httpGET(url string) ([]byte, error) {
 	return b, nil
 }
 
+// An httpError is returned by httpGET for a response
+// with a status other than 200 OK.
+type httpError struct {
+	url        string
+	status     string
+	statusCode int
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("%s: %s", e.url, e.status)
+}
+
+// notFound reports whether the server said the resource does not exist.
+func (e *httpError) notFound() bool {
+	return e.statusCode == 404 || e.statusCode == 410
+}
+
 // httpsOrHTTP returns the body of either the importPath's
 // https resource or, if unavailable, the http resource.
 func httpsOrHTTP(importPath string) (urlStr string, body io.ReadCloser, err error) {
@@ -39,6 +39,7 @@ syntax of package template.  The default output is equivalent to -f
         Standard   bool   // is this package part of the standard Go library?
         Stale      bool   // would 'go install' do anything for this package?
         Root       string // Go root or Go path dir containing this package
+        Module     string // module containing this package, as path@version (module mode)
 
         // Source files
         GoFiles  []string       // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
@@ -61,8 +62,9 @@ syntax of package template.  The default output is equivalent to -f
         CgoPkgConfig []string // cgo: pkg-config names
 
         // Dependency information
-        Imports []string // import paths used by this package
-        Deps    []string // all (recursively) imported dependencies
+        Imports   []string          // import paths used by this package
+        ImportMap map[string]string // map from import in source to vendored ImportPath
+        Deps      []string          // all (recursively) imported dependencies
 
         // Error information
         Incomplete bool            // this package or a dependency has an error
@@ -75,6 +77,13 @@ syntax of package template.  The default output is equivalent to -f
         XTestImports []string // imports from XTestGoFiles
     }
 
+Imports lists the full import paths of the packages used, so an import
+satisfied by a vendor directory appears with its vendor prefix, as in
+"x/vendor/y"; ImportMap records the path written in the source for
+each such import.  An import of an internal or vendored package from
+outside the tree that may use it is reported as an error in Error or
+DepsErrors.  See 'go help importpath' for the visibility rules.
+
 The template function "join" calls strings.Join.
 
 The template function "context" returns the build context, defined as:
@@ -76,12 +76,15 @@ func (c *Command) Runnable() bool {
 var commands = []*Command{
 	cmdBuild,
 	cmdClean,
+	cmdDoc,
 	cmdEnv,
 	cmdFix,
 	cmdFmt,
+	cmdGenerate,
 	cmdGet,
 	cmdInstall,
 	cmdList,
+	cmdMod,
 	cmdRun,
 	cmdTest,
 	cmdTool,
@@ -91,6 +94,8 @@ var commands = []*Command{
 	helpC,
 	helpGopath,
 	helpImportPath,
+	helpModules,
+	helpCache,
 	helpPackages,
 	helpTestflag,
 	helpTestfunc,
@@ -505,17 +510,19 @@ func matchPackages(pattern string) []string {
 		if err != nil || !fi.IsDir() || path == cmd {
 			return nil
 		}
-		name := path[len(cmd):]
-		if !treeCanMatch(name) {
+		// Commands are in cmd/, and the packages they use
+		// in subdirectories of the commands.
+		elem := fi.Name()
+		if elem == "testdata" || strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") {
 			return filepath.SkipDir
 		}
-		// Commands are all in cmd/, not in subdirectories.
-		if strings.Contains(name, string(filepath.Separator)) {
+
+		// We use, e.g., cmd/gofmt as the pseudo import path for gofmt
+		// and cmd/pprof/internal/report for a package used by pprof.
+		name := "cmd/" + filepath.ToSlash(path[len(cmd):])
+		if !treeCanMatch(name) {
 			return filepath.SkipDir
 		}
-
-		// We use, e.g., cmd/gofmt as the pseudo import path for gofmt.
-		name = "cmd/" + name
 		if have[name] {
 			return nil
 		}
@@ -534,8 +541,12 @@ func matchPackages(pattern string) []string {
 		return nil
 	})
 
+	// In module mode, $GOPATH/src plays no part in the build:
+	// only standard packages come from the source directories,
+	// and the rest come from the main module and its build list.
+	stdOnly := pattern == "std" || modEnabled()
 	for _, src := range buildContext.SrcDirs() {
-		if pattern == "std" && src != gorootSrcPkg {
+		if stdOnly && src != gorootSrcPkg {
 			continue
 		}
 		src = filepath.Clean(src) + string(filepath.Separator)
@@ -551,7 +562,7 @@ func matchPackages(pattern string) []string {
 			}
 
 			name := filepath.ToSlash(path[len(src):])
-			if pattern == "std" && strings.Contains(name, ".") {
+			if stdOnly && strings.Contains(name, ".") {
 				return filepath.SkipDir
 			}
 			if !treeCanMatch(name) {
@@ -574,6 +585,9 @@ func matchPackages(pattern string) []string {
 			return nil
 		})
 	}
+	if modEnabled() && pattern != "std" {
+		pkgs = append(pkgs, modMatchPackages(match, treeCanMatch, have)...)
+	}
 	return pkgs
 }
 
@@ -0,0 +1,499 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var cmdMod = &Command{
+	UsageLine:   "mod command [arguments]",
+	Short:       "module maintenance",
+	CustomFlags: true,
+	Long: `
+Mod provides access to operations on modules.  It must be run
+in module mode, inside the main module (see 'go help modules').
+
+The commands are:
+
+	go mod init [path]
+
+Init creates a go.mod file in the current directory, declaring
+a new module with the given import path.  If the path is omitted,
+init uses the import path of the current directory within $GOPATH/src.
+
+	go mod download [-x] [modules]
+
+Download copies the named modules, or by default every module in the
+build list, into the module cache.  Each argument is a module path,
+denoting the version in the build list, or path@version.
+
+	go mod tidy [-v]
+
+Tidy makes go.mod match the source code of the main module: it adds
+a requirement, at the latest version, for any imported package not
+provided by the build list, and removes requirements on modules that
+provide no packages imported by the main module (including its tests),
+directly or indirectly.  It also removes unneeded entries from go.sum.
+The -v flag prints the modules it adds and removes.
+
+	go mod verify
+
+Verify checks that the copies of the modules in the build list held in
+the module cache have not been modified since they were downloaded.
+
+	go mod graph
+
+Graph prints the module requirement graph, one edge per line:
+a module and one of its requirements, separated by a space.
+
+See also: go get, go help modules.
+	`,
+}
+
+func init() {
+	cmdMod.Run = runMod // break init loop
+}
+
+var (
+	modTidyFlag = flag.NewFlagSet("tidy", flag.ExitOnError)
+	modTidyV    = modTidyFlag.Bool("v", false, "")
+
+	modDownloadFlag = flag.NewFlagSet("download", flag.ExitOnError)
+)
+
+func init() {
+	modDownloadFlag.BoolVar(&buildX, "x", false, "")
+}
+
+func runMod(cmd *Command, args []string) {
+	if len(args) == 0 {
+		cmd.Usage()
+	}
+	name, args := args[0], args[1:]
+	parse := func(f *flag.FlagSet) []string {
+		f.Usage = func() { cmd.Usage() }
+		f.Parse(args)
+		return f.Args()
+	}
+	switch name {
+	case "init":
+		modInit(args)
+	case "download":
+		modDownloadCmd(parse(modDownloadFlag))
+	case "tidy":
+		if len(parse(modTidyFlag)) > 0 {
+			fatalf("go mod tidy: no arguments allowed")
+		}
+		modTidy()
+	case "verify":
+		if len(args) > 0 {
+			fatalf("go mod verify: no arguments allowed")
+		}
+		modVerify()
+	case "graph":
+		if len(args) > 0 {
+			fatalf("go mod graph: no arguments allowed")
+		}
+		modGraph()
+	default:
+		fatalf("go mod: unknown command %q\nRun 'go help mod' for usage.", name)
+	}
+}
+
+// modMustLoad loads the main module, failing if there is none.
+func modMustLoad(cmd string) {
+	if !modEnabled() {
+		fatalf("go %s: cannot find main module (go.mod file) in %s or any parent directory; see 'go help modules'", cmd, cwd)
+	}
+	modLoad()
+}
+
+func modInit(args []string) {
+	if len(args) > 1 {
+		fatalf("go mod init: too many arguments")
+	}
+	file := filepath.Join(cwd, "go.mod")
+	if _, err := os.Stat(file); err == nil {
+		fatalf("go mod init: go.mod already exists")
+	}
+	var path string
+	if len(args) == 1 {
+		path = args[0]
+	} else {
+		for _, src := range buildContext.SrcDirs() {
+			if rel, ok := hasSubdir(src, cwd); ok {
+				path = rel
+				break
+			}
+		}
+		if path == "" {
+			fatalf("go mod init: cannot determine module path for source directory %s (outside GOPATH, no import comments)", cwd)
+		}
+	}
+	if err := checkModPath(path); err != nil {
+		fatalf("go mod init: %v", err)
+	}
+	f := &modFile{Module: path}
+	if err := ioutil.WriteFile(file, f.format(), 0666); err != nil {
+		fatalf("go mod init: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "go: creating new go.mod: module %s\n", path)
+}
+
+func modDownloadCmd(args []string) {
+	modMustLoad("mod download")
+	list := modBuildList[1:]
+	if len(args) > 0 {
+		list = nil
+		for _, arg := range args {
+			m, err := modResolveArg(arg)
+			if err != nil {
+				errorf("go mod download: %v", err)
+				continue
+			}
+			list = append(list, m)
+		}
+		exitIfErrors()
+	}
+	for _, m := range list {
+		if r, replaced := modReplacement(m); replaced {
+			if r.Version == "" {
+				continue
+			}
+			m = r
+		}
+		if _, err := modFetchFile(m, "info"); err != nil {
+			errorf("go mod download: %v", err)
+			continue
+		}
+		if _, err := modGoMod(m); err != nil {
+			errorf("go mod download: %v", err)
+			continue
+		}
+		if _, err := modDownload(m); err != nil {
+			errorf("go mod download: %v", err)
+		}
+	}
+}
+
+// modResolveArg resolves a path or path@version argument
+// naming a module.  A plain path denotes the version in
+// the build list.
+func modResolveArg(arg string) (modVersion, error) {
+	if i := strings.Index(arg, "@"); i >= 0 {
+		m := modVersion{arg[:i], arg[i+1:]}
+		if err := checkModPath(m.Path); err != nil {
+			return modVersion{}, err
+		}
+		v, err := modQuery(m.Path, m.Version)
+		if err != nil {
+			return modVersion{}, err
+		}
+		m.Version = v
+		return m, nil
+	}
+	for _, m := range modBuildList[1:] {
+		if m.Path == arg {
+			return m, nil
+		}
+	}
+	return modVersion{}, fmt.Errorf("module %s is not in the build list", arg)
+}
+
+func modTidy() {
+	modMustLoad("mod tidy")
+	var used map[string]bool
+	for {
+		var missing []string
+		used, missing = modScanImports()
+		if len(missing) == 0 {
+			break
+		}
+		for _, path := range missing {
+			m, err := modFindModule(path, "latest")
+			if err != nil {
+				fatalf("go mod tidy: %v", err)
+			}
+			if modMain.require(m.Path) == m.Version {
+				// Added already for another package.
+				continue
+			}
+			if *modTidyV {
+				fmt.Fprintf(os.Stderr, "go: adding %s for package %s\n", m, path)
+			}
+			modMain.setRequire(m.Path, m.Version)
+		}
+		modReloadBuildList()
+	}
+
+	// Require exactly the modules that provide packages,
+	// at the versions already selected.
+	var req []modVersion
+	for _, m := range modBuildList[1:] {
+		if used[m.Path] {
+			req = append(req, m)
+		} else if *modTidyV && modMain.require(m.Path) != "" {
+			fmt.Fprintf(os.Stderr, "go: removing %s\n", m)
+		}
+	}
+	modMain.Require = req
+	writeModFile()
+
+	// Recompute the build list from the new requirements,
+	// noting the go.sum entries it needs.
+	modSum.Lock()
+	modSum.used = make(map[modVersion]bool)
+	modSum.Unlock()
+	modReloadBuildList()
+	for _, m := range modBuildList[1:] {
+		if used[m.Path] {
+			if _, err := modDir(m); err != nil {
+				fatalf("go mod tidy: %v", err)
+			}
+		}
+	}
+	if err := writeModSum(true); err != nil {
+		fatalf("go mod tidy: %v", err)
+	}
+}
+
+// A modMissingError reports that no module in the
+// build list provides an imported package.
+type modMissingError struct {
+	path string
+}
+
+func (e *modMissingError) Error() string {
+	return fmt.Sprintf("no required module provides package %s; to add it:\n\tgo get %s", e.path, e.path)
+}
+
+// modScanImports scans the packages in the main module, including
+// their tests, and the packages they import, directly or indirectly.
+// Every build constraint except "ignore" is assumed to be satisfied,
+// so that the result does not depend on the current GOOS and GOARCH.
+// It returns the set of module paths providing those packages
+// and the list of imported paths that no module provides.
+func modScanImports() (used map[string]bool, missing []string) {
+	used = make(map[string]bool)
+	seen := make(map[string]bool)
+	var work []string
+	add := func(paths []string) {
+		for _, path := range paths {
+			if !seen[path] {
+				seen[path] = true
+				work = append(work, path)
+			}
+		}
+	}
+
+	filepath.Walk(modRoot, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || !fi.IsDir() {
+			return nil
+		}
+		if path != modRoot {
+			_, elem := filepath.Split(path)
+			if strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") || elem == "testdata" {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		rel, _ := filepath.Rel(modRoot, path)
+		seen[pathpkg.Join(modMain.Module, filepath.ToSlash(rel))] = true
+		add(scanDirImports(path, true))
+		return nil
+	})
+
+	for len(work) > 0 {
+		path := work[0]
+		work = work[1:]
+		if !isModImportPath(path) {
+			continue
+		}
+		dir, m, err := modImportDir(path)
+		if err != nil {
+			if _, ok := err.(*modMissingError); ok {
+				missing = append(missing, path)
+				continue
+			}
+			fatalf("go: %v", err)
+		}
+		if m.Path == modMain.Module {
+			continue
+		}
+		used[m.Path] = true
+		add(scanDirImports(dir, false))
+	}
+	sort.Strings(missing)
+	return used, missing
+}
+
+// scanDirImports returns the paths imported by the Go files in dir,
+// including test files if tests is set.  Files marked with a
+// "+build ignore" constraint are skipped.
+func scanDirImports(dir string, tests bool) []string {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		fatalf("go: %v", err)
+	}
+	var imports []string
+	fset := token.NewFileSet()
+	for _, fi := range infos {
+		name := fi.Name()
+		if fi.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if !tests && strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ImportsOnly|parser.ParseComments)
+		if err != nil {
+			fatalf("go: %v", expandScanner(err))
+		}
+		if hasIgnoreConstraint(f) {
+			continue
+		}
+		for _, spec := range f.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err == nil && path != "C" && !build.IsLocalImport(path) {
+				imports = append(imports, path)
+			}
+		}
+	}
+	return imports
+}
+
+// hasIgnoreConstraint reports whether f has a "+build ignore"
+// constraint in the comments preceding its package clause.
+func hasIgnoreConstraint(f *ast.File) bool {
+	for _, g := range f.Comments {
+		if g.Pos() >= f.Package {
+			break
+		}
+		for _, c := range g.List {
+			line := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if !strings.HasPrefix(line, "+build") {
+				continue
+			}
+			for _, tag := range strings.Fields(line)[1:] {
+				if tag == "ignore" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// modFindModule returns the module providing the package with
+// the given import path, at the version selected by query.
+// A module already in the build list is preferred; otherwise
+// the proxy is asked about the path and then each of its parents.
+func modFindModule(path, query string) (modVersion, error) {
+	for _, m := range modBuildList[1:] {
+		if hasPathPrefix(path, m.Path) {
+			v, err := modQuery(m.Path, query)
+			if err != nil {
+				return modVersion{}, err
+			}
+			return modVersion{m.Path, v}, nil
+		}
+	}
+	for p := path; p != "." && p != "/"; p = pathpkg.Dir(p) {
+		v, err := modQuery(p, query)
+		if err == nil {
+			return modVersion{p, v}, nil
+		}
+		if !isNotFound(err) {
+			return modVersion{}, err
+		}
+	}
+	return modVersion{}, fmt.Errorf("cannot find module providing package %s", path)
+}
+
+func modVerify() {
+	modMustLoad("mod verify")
+	ok := true
+	for _, m := range modBuildList[1:] {
+		if r, replaced := modReplacement(m); replaced {
+			if r.Version == "" {
+				continue
+			}
+			m = r
+		}
+		dir, err := modDownloadDir(m.Path)
+		if err != nil {
+			fatalf("go mod verify: %v", err)
+		}
+		zipfile := filepath.Join(dir, m.Version+".zip")
+		data, err := ioutil.ReadFile(filepath.Join(dir, m.Version+".ziphash"))
+		if err != nil {
+			// Not downloaded; nothing to verify.
+			continue
+		}
+		h := strings.TrimSpace(string(data))
+		if _, err := os.Stat(zipfile); err == nil {
+			if zh, err := hashZip(zipfile, m.String()+"/"); err != nil || zh != h {
+				fmt.Fprintf(os.Stderr, "%s: zip has been modified (%s)\n", m, zipfile)
+				ok = false
+			}
+		}
+		if src, err := modSourceDir(m); err == nil {
+			if _, err := os.Stat(src); err == nil {
+				if dh, err := hashDir(src, m.String()+"/"); err != nil || dh != h {
+					fmt.Fprintf(os.Stderr, "%s: dir has been modified (%s)\n", m, src)
+					ok = false
+				}
+			}
+		}
+		if err := checkModSum(m, h); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			ok = false
+		}
+	}
+	if !ok {
+		setExitStatus(1)
+		return
+	}
+	fmt.Println("all modules verified")
+}
+
+func modGraph() {
+	modMustLoad("mod graph")
+	target := modBuildList[0]
+	seen := map[modVersion]bool{target: true}
+	work := []modVersion{target}
+	for len(work) > 0 {
+		m := work[0]
+		work = work[1:]
+		list, err := modReqs(m)
+		if err != nil {
+			fatalf("go mod graph: %v", err)
+		}
+		list = append([]modVersion(nil), list...)
+		sort.Sort(byModPath(list))
+		for _, r := range list {
+			fmt.Printf("%s %s\n", m, r)
+			if !seen[r] {
+				seen[r] = true
+				work = append(work, r)
+			}
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var semverCompareTests = []string{
+	"bad",
+	"v1.0.0-alpha",
+	"v1.0.0-alpha.1",
+	"v1.0.0-alpha.beta",
+	"v1.0.0-beta",
+	"v1.0.0-beta.2",
+	"v1.0.0-beta.11",
+	"v1.0.0-rc.1",
+	"v1.0.0",
+	"v1.2.0",
+	"v1.2.3",
+	"v1.10.0",
+	"v2.0.0",
+	"v10.0.0",
+}
+
+func TestSemverCompare(t *testing.T) {
+	for i, v := range semverCompareTests {
+		for j, w := range semverCompareTests {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = +1
+			}
+			if got := semverCompare(v, w); got != want {
+				t.Errorf("semverCompare(%q, %q) = %d, want %d", v, w, got, want)
+			}
+		}
+	}
+	list := []string{"v1.10.0", "v1.2.0", "v1.0.0-rc.1", "v1.2.3"}
+	sort.Sort(byVersion(list))
+	if want := []string{"v1.0.0-rc.1", "v1.2.0", "v1.2.3", "v1.10.0"}; !reflect.DeepEqual(list, want) {
+		t.Errorf("sorted versions = %v, want %v", list, want)
+	}
+}
+
+var semverCanonicalTests = []struct {
+	in, out string
+}{
+	{"v1", "v1.0.0"},
+	{"v1.2", "v1.2.0"},
+	{"v1.2.3", "v1.2.3"},
+	{"v1.2.3-pre", "v1.2.3-pre"},
+	{"v1.2.3+meta", "v1.2.3"},
+	{"v1.2.3-pre+meta", "v1.2.3-pre"},
+	{"1.2.3", ""},
+	{"v01.2.3", ""},
+	{"v1.2.3-", ""},
+	{"v1.2.3-a..b", ""},
+	{"v1.2.3.4", ""},
+}
+
+func TestSemverCanonical(t *testing.T) {
+	for _, tt := range semverCanonicalTests {
+		if out := semverCanonical(tt.in); out != tt.out {
+			t.Errorf("semverCanonical(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
+
+const testGoMod = `// Copyright notice.
+module example.com/m // the main module
+
+require example.com/a v1.0.0
+require (
+	example.com/c v1.2.0
+	"example.com/b" v0.1.0-pre
+)
+
+replace example.com/a => ../a
+replace example.com/b v0.1.0-pre => example.com/fork v0.2.0
+`
+
+const testGoModFormatted = `module example.com/m
+
+require (
+	example.com/a v1.0.0
+	example.com/b v0.1.0-pre
+	example.com/c v1.2.0
+)
+
+replace example.com/a => ../a
+replace example.com/b v0.1.0-pre => example.com/fork v0.2.0
+`
+
+func TestParseModFile(t *testing.T) {
+	f, err := parseModFile("go.mod", []byte(testGoMod))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Module != "example.com/m" {
+		t.Errorf("module = %q, want example.com/m", f.Module)
+	}
+	if v := f.require("example.com/b"); v != "v0.1.0-pre" {
+		t.Errorf("require example.com/b = %q, want v0.1.0-pre", v)
+	}
+	if len(f.Replace) != 2 || f.Replace[0].New.Version != "" || f.Replace[1].Old.Version != "v0.1.0-pre" {
+		t.Errorf("replace = %+v", f.Replace)
+	}
+	if out := string(f.format()); out != testGoModFormatted {
+		t.Errorf("format:\n%s\nwant:\n%s", out, testGoModFormatted)
+	}
+	f2, err := parseModFile("go.mod", f.format())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(f2.Replace, f.Replace) || len(f2.Require) != len(f.Require) {
+		t.Errorf("reparse of formatted file differs: %+v vs %+v", f2, f)
+	}
+}
+
+var badGoModTests = []struct {
+	in, err string
+}{
+	{"require x.com/a v1.0.0\n", "missing module directive"},
+	{"module m\nmodule n\n", "go.mod:2: repeated module directive"},
+	{"module m\nrequire x.com/a 1.0.0\n", `go.mod:2: x.com/a: invalid version "1.0.0": not a semantic version`},
+	{"module m\nrequire x.com/a v1.0\n", `go.mod:2: x.com/a: invalid version "v1.0": should be v1.0.0`},
+	{"module m\nrequire (\nx.com/a v1.0.0\n", "unterminated require block"},
+	{"module m\nreplace x.com/a => x.com/b\n", "go.mod:2: replacement module without version must be directory path"},
+	{"module m\nfrob x\n", "go.mod:2: unknown directive: frob"},
+	{"module ./m\n", "go.mod:1: malformed module path"},
+}
+
+func TestParseBadModFile(t *testing.T) {
+	for _, tt := range badGoModTests {
+		_, err := parseModFile("go.mod", []byte(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("parseModFile(%q) = %v, want error containing %q", tt.in, err, tt.err)
+		}
+	}
+}
+
+func TestEscapePath(t *testing.T) {
+	if out := modEscapePath("github.com/Azure/Go"); out != "github.com/!azure/!go" {
+		t.Errorf("modEscapePath = %q", out)
+	}
+}
+
+// mvsGraph is a module requirement graph for testing.
+// Each key is path@version; the value lists its requirements.
+type mvsGraph map[string][]string
+
+func (g mvsGraph) reqs(m modVersion) ([]modVersion, error) {
+	key := m.String()
+	list, ok := g[key]
+	if !ok {
+		return nil, fmt.Errorf("unknown module")
+	}
+	var out []modVersion
+	for _, r := range list {
+		i := strings.Index(r, "@")
+		out = append(out, modVersion{r[:i], r[i+1:]})
+	}
+	return out, nil
+}
+
+func TestMVSBuildList(t *testing.T) {
+	g := mvsGraph{
+		"m":        {"a@v1.2.0", "b@v1.2.0"},
+		"a@v1.2.0": {"c@v1.1.0"},
+		"b@v1.2.0": {"c@v1.3.0", "d@v1.1.0"},
+		"c@v1.1.0": {},
+		"c@v1.3.0": {"m@v1.0.0"},
+		"d@v1.1.0": {"e@v1.2.0"},
+		"e@v1.2.0": {},
+		"c@v1.4.0": {"f@v1.0.0"}, // not reachable
+	}
+	list, err := mvsBuildList(modVersion{Path: "m"}, g.reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range list {
+		got = append(got, m.String())
+	}
+	want := []string{"m", "a@v1.2.0", "b@v1.2.0", "c@v1.3.0", "d@v1.1.0", "e@v1.2.0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("build list = %v, want %v", got, want)
+	}
+
+	delete(g, "e@v1.2.0")
+	_, err = mvsBuildList(modVersion{Path: "m"}, g.reqs)
+	wantErr := "m\n\trequires b@v1.2.0\n\trequires d@v1.1.0\n\trequires e@v1.2.0: unknown module"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("build list error = %v, want %q", err, wantErr)
+	}
+}
+
+func TestModHash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-modhash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"go.mod":     "module x.com/a\n",
+		"a.go":       "package a\n",
+		"sub/sub.go": "package sub\n",
+	}
+	const prefix = "x.com/a@v1.0.0/"
+	zipfile := filepath.Join(dir, "a.zip")
+	f, err := os.Create(zipfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := zip.NewWriter(f)
+	for name, data := range files {
+		w, err := z.Create(prefix + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(data))
+	}
+	if err := z.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	zh, err := hashZip(zipfile, prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(zh, "h1:") {
+		t.Errorf("hashZip = %q, want h1: prefix", zh)
+	}
+	src := filepath.Join(dir, "src")
+	if err := unzipModule(zipfile, prefix, src); err != nil {
+		t.Fatal(err)
+	}
+	dh, err := hashDir(src, prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dh != zh {
+		t.Errorf("hashDir = %q, hashZip = %q, want equal", dh, zh)
+	}
+	if _, err := hashZip(zipfile, "x.com/b@v1.0.0/"); err == nil {
+		t.Errorf("hashZip with wrong prefix succeeded")
+	}
+}
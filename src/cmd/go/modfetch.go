@@ -0,0 +1,453 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Modules are downloaded from a module proxy named by $GOPROXY.
+// A proxy serves, for each module path and version, the files
+//
+//	<path>/@v/list           list of known versions, one per line
+//	<path>/@v/<version>.info JSON {"Version": ..., "Time": ...}
+//	<path>/@v/<version>.mod  the go.mod file for that version
+//	<path>/@v/<version>.zip  the module source tree
+//
+// where the path is escaped by modEscapePath.  $GOPROXY may be an
+// http or https URL, or a file:/// URL naming a directory laid out
+// the same way, so that a module cache can itself serve as a proxy.
+//
+// Downloaded files are kept in $GOPATH/pkg/mod/cache/download using
+// the same layout, and each module's source tree is unpacked into
+// $GOPATH/pkg/mod/<path>@<version>.
+
+// modCacheRoot returns the root of the module cache.
+func modCacheRoot() (string, error) {
+	list := filepath.SplitList(buildContext.GOPATH)
+	if len(list) == 0 || list[0] == "" {
+		return "", fmt.Errorf("module cache requires $GOPATH to be set. For more details see: go help gopath")
+	}
+	if list[0] == goroot {
+		return "", fmt.Errorf("module cache requires $GOPATH not be set to $GOROOT. For more details see: go help gopath")
+	}
+	return filepath.Join(list[0], "pkg", "mod"), nil
+}
+
+// modDownloadDir returns the directory holding the downloaded files
+// for the module path in the module cache.
+func modDownloadDir(path string) (string, error) {
+	root, err := modCacheRoot()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, "cache", "download", filepath.FromSlash(modEscapePath(path)), "@v"), nil
+}
+
+// modSourceDir returns the directory into which the source tree
+// of module m is unpacked.
+func modSourceDir(m modVersion) (string, error) {
+	root, err := modCacheRoot()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, filepath.FromSlash(modEscapePath(m.Path))+"@"+m.Version), nil
+}
+
+// modEscapePath returns the form of path used in proxy URLs and in
+// the module cache.  So that the module cache can live on a
+// case-insensitive file system, each upper-case letter is replaced
+// by an exclamation mark followed by the corresponding lower-case letter.
+func modEscapePath(path string) string {
+	var buf bytes.Buffer
+	for _, r := range path {
+		if 'A' <= r && r <= 'Z' {
+			buf.WriteByte('!')
+			buf.WriteRune(r + 'a' - 'A')
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// A notFounder is an error that can report whether it
+// means the requested file does not exist.
+type notFounder interface {
+	notFound() bool
+}
+
+// isNotFound reports whether err means a file
+// requested from the proxy does not exist.
+func isNotFound(err error) bool {
+	if nf, ok := err.(notFounder); ok {
+		return nf.notFound()
+	}
+	return os.IsNotExist(err)
+}
+
+// proxyGet returns the content of the named file from the module proxy.
+func proxyGet(name string) ([]byte, error) {
+	proxy := os.Getenv("GOPROXY")
+	switch {
+	case proxy == "" || proxy == "off":
+		return nil, fmt.Errorf("module lookup disabled: $GOPROXY is not set. For more details see: go help modules")
+	case strings.HasPrefix(proxy, "file://"):
+		dir := filepath.FromSlash(strings.TrimPrefix(proxy, "file://"))
+		if buildX {
+			fmt.Fprintf(os.Stderr, "# get %s/%s\n", proxy, name)
+		}
+		return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	case strings.HasPrefix(proxy, "http://") || strings.HasPrefix(proxy, "https://"):
+		url := strings.TrimSuffix(proxy, "/") + "/" + name
+		if buildX {
+			fmt.Fprintf(os.Stderr, "# get %s\n", url)
+		}
+		return httpGET(url)
+	}
+	return nil, fmt.Errorf("invalid $GOPROXY %q: must be http://, https://, or file:/// URL", proxy)
+}
+
+// modVersions returns the list of versions of path known to the proxy,
+// sorted in increasing semver order.  Invalid versions are ignored.
+func modVersions(path string) ([]string, error) {
+	data, err := proxyGet(modEscapePath(path) + "/@v/list")
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, line := range strings.Split(string(data), "\n") {
+		v := strings.TrimSpace(line)
+		if checkModVersion(v) == nil {
+			list = append(list, v)
+		}
+	}
+	sort.Sort(byVersion(list))
+	return list, nil
+}
+
+// modLatest returns the latest version of path: the highest release
+// version or, if there are no release versions, the highest prerelease.
+func modLatest(path string) (string, error) {
+	list, err := modVersions(path)
+	if err != nil {
+		return "", err
+	}
+	if len(list) == 0 {
+		return "", fmt.Errorf("no versions of module %s", path)
+	}
+	for i := len(list) - 1; i >= 0; i-- {
+		if !semverIsPrerelease(list[i]) {
+			return list[i], nil
+		}
+	}
+	return list[len(list)-1], nil
+}
+
+// modQuery resolves the version query for path:
+// "latest", an exact version, or a version prefix like v1 or v1.2,
+// which selects the latest version with that prefix.
+func modQuery(path, query string) (string, error) {
+	if query == "" || query == "latest" {
+		return modLatest(path)
+	}
+	if checkModVersion(query) == nil {
+		return query, nil
+	}
+	if !isSemver(query) || strings.ContainsAny(query, "-+") {
+		return "", fmt.Errorf("invalid version query %q for %s", query, path)
+	}
+	list, err := modVersions(path)
+	if err != nil {
+		return "", err
+	}
+	prefix := query + "."
+	for i := len(list) - 1; i >= 0; i-- {
+		if strings.HasPrefix(list[i], prefix) && !semverIsPrerelease(list[i]) {
+			return list[i], nil
+		}
+	}
+	return "", fmt.Errorf("no version of module %s matching %s", path, query)
+}
+
+// modInfo is the content of a proxy .info file.
+type modInfo struct {
+	Version string
+	Time    string
+}
+
+// modFetchFile returns the named file (mod, zip, or info) for module m,
+// downloading it into the module cache if necessary.
+// It returns the name of the file in the cache.
+func modFetchFile(m modVersion, suffix string) (string, error) {
+	dir, err := modDownloadDir(m.Path)
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Join(dir, m.Version+"."+suffix)
+	if _, err := os.Stat(file); err == nil {
+		return file, nil
+	}
+	if buildV && suffix == "zip" {
+		fmt.Fprintf(os.Stderr, "%s (download)\n", m)
+	}
+	data, err := proxyGet(modEscapePath(m.Path) + "/@v/" + m.Version + "." + suffix)
+	if err != nil {
+		return "", fmt.Errorf("downloading %s: %v", m, err)
+	}
+	if suffix == "info" {
+		var info modInfo
+		if err := json.Unmarshal(data, &info); err != nil || info.Version != m.Version {
+			return "", fmt.Errorf("downloading %s: malformed .info file", m)
+		}
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return "", err
+	}
+	// Write to a temporary file and rename it into place,
+	// so that concurrent go commands never see partial files.
+	tmp := fmt.Sprintf("%s.tmp%d", file, os.Getpid())
+	if err := ioutil.WriteFile(tmp, data, 0666); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return file, nil
+}
+
+// modGoMod returns the go.mod file for module m, checking it
+// against go.sum.
+func modGoMod(m modVersion) ([]byte, error) {
+	file, err := modFetchFile(m, "mod")
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModSum(modVersion{m.Path, m.Version + "/go.mod"}, hashGoMod(data)); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// modDownload downloads module m into the module cache,
+// checking it against go.sum, and returns the directory
+// holding its source tree.
+func modDownload(m modVersion) (string, error) {
+	dir, err := modSourceDir(m)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(dir); err == nil {
+		// Already unpacked.  The hash recorded when the zip
+		// file was downloaded must still agree with go.sum.
+		h, err := modZipHash(m)
+		if err != nil {
+			return "", err
+		}
+		if err := checkModSum(m, h); err != nil {
+			return "", err
+		}
+		return dir, nil
+	}
+
+	zipfile, err := modFetchFile(m, "zip")
+	if err != nil {
+		return "", err
+	}
+	h, err := hashZip(zipfile, m.String()+"/")
+	if err != nil {
+		return "", fmt.Errorf("verifying %s: %v", m, err)
+	}
+	if err := checkModSum(m, h); err != nil {
+		os.Remove(zipfile)
+		return "", err
+	}
+	if err := ioutil.WriteFile(strings.TrimSuffix(zipfile, ".zip")+".ziphash", []byte(h), 0666); err != nil {
+		return "", err
+	}
+
+	tmp := fmt.Sprintf("%s.tmp%d", dir, os.Getpid())
+	os.RemoveAll(tmp)
+	if err := unzipModule(zipfile, m.String()+"/", tmp); err != nil {
+		os.RemoveAll(tmp)
+		return "", fmt.Errorf("unpacking %s: %v", m, err)
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		os.RemoveAll(tmp)
+		if _, err1 := os.Stat(dir); err1 == nil {
+			// Another go command unpacked it first.
+			return dir, nil
+		}
+		return "", err
+	}
+	return dir, nil
+}
+
+// modZipHash returns the hash of the downloaded zip file for m,
+// as recorded in the module cache when the file was downloaded.
+func modZipHash(m modVersion) (string, error) {
+	zipfile, err := modFetchFile(m, "zip")
+	if err != nil {
+		return "", err
+	}
+	hashfile := strings.TrimSuffix(zipfile, ".zip") + ".ziphash"
+	data, err := ioutil.ReadFile(hashfile)
+	if err == nil {
+		return strings.TrimSpace(string(data)), nil
+	}
+	h, err := hashZip(zipfile, m.String()+"/")
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(hashfile, []byte(h), 0666); err != nil {
+		return "", err
+	}
+	return h, nil
+}
+
+// The go.sum file in the main module's root directory records the
+// expected cryptographic hash of each module version used in the build,
+// one per line:
+//
+//	<path> <version> <hash>
+//	<path> <version>/go.mod <hash>
+//
+// The first form covers the module's source tree, the second
+// just its go.mod file.  A hash that does not match the downloaded
+// content is a fatal error.
+
+var modSum struct {
+	sync.Mutex
+	file  string                  // name of go.sum file, "" if not loaded
+	m     map[modVersion][]string // hashes, keyed by path and version
+	dirty bool                    // m has entries not in file
+	used  map[modVersion]bool     // entries consulted during this command
+}
+
+// loadModSum reads the go.sum file, if it exists.
+func loadModSum(file string) error {
+	modSum.Lock()
+	defer modSum.Unlock()
+	modSum.file = file
+	modSum.m = make(map[modVersion][]string)
+	modSum.used = make(map[modVersion]bool)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for n, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 3 || !utf8.ValidString(line) {
+			return fmt.Errorf("%s:%d: malformed go.sum line", file, n+1)
+		}
+		m := modVersion{f[0], f[1]}
+		if !hasString(modSum.m[m], f[2]) {
+			modSum.m[m] = append(modSum.m[m], f[2])
+		}
+	}
+	return nil
+}
+
+// checkModSum checks that the hash h for module m matches go.sum.
+// If go.sum has no entry for m, h is added to it.
+func checkModSum(m modVersion, h string) error {
+	modSum.Lock()
+	defer modSum.Unlock()
+	if modSum.m == nil {
+		// No main module; nothing to check against.
+		return nil
+	}
+	modSum.used[m] = true
+	hashes := modSum.m[m]
+	if len(hashes) == 0 {
+		modSum.m[m] = []string{h}
+		modSum.dirty = true
+		return nil
+	}
+	for _, vh := range hashes {
+		if vh == h {
+			return nil
+		}
+	}
+	return fmt.Errorf("verifying %s: checksum mismatch\n\tdownloaded: %s\n\tgo.sum:     %s\n\nThe downloaded module does not match the hash recorded in go.sum.\nSomeone may have changed its contents after publishing it.",
+		m, h, strings.Join(hashes, ", "))
+}
+
+// writeModSum writes go.sum, if it has changed.
+// If trim is set, entries not consulted by this command are dropped.
+func writeModSum(trim bool) error {
+	modSum.Lock()
+	defer modSum.Unlock()
+	if modSum.file == "" || !modSum.dirty && !trim {
+		return nil
+	}
+	var keys []modVersion
+	for m := range modSum.m {
+		if !trim || modSum.used[m] {
+			keys = append(keys, m)
+		}
+	}
+	sort.Sort(byModSumKey(keys))
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	for _, m := range keys {
+		for _, h := range modSum.m[m] {
+			fmt.Fprintf(w, "%s %s %s\n", m.Path, m.Version, h)
+		}
+	}
+	w.Flush()
+	if old, err := ioutil.ReadFile(modSum.file); err == nil && bytes.Equal(old, buf.Bytes()) {
+		modSum.dirty = false
+		return nil
+	}
+	if buildN {
+		fmt.Fprintf(os.Stderr, "# write %s\n", shortPath(modSum.file))
+		return nil
+	}
+	if err := ioutil.WriteFile(modSum.file, buf.Bytes(), 0666); err != nil {
+		return err
+	}
+	modSum.dirty = false
+	return nil
+}
+
+// byModSumKey sorts go.sum keys by path, then version,
+// with the /go.mod entry for a version after the source entry.
+type byModSumKey []modVersion
+
+func (x byModSumKey) Len() int      { return len(x) }
+func (x byModSumKey) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x byModSumKey) Less(i, j int) bool {
+	if x[i].Path != x[j].Path {
+		return x[i].Path < x[j].Path
+	}
+	vi := strings.TrimSuffix(x[i].Version, "/go.mod")
+	vj := strings.TrimSuffix(x[j].Version, "/go.mod")
+	if vi != vj {
+		return semverCompare(vi, vj) < 0
+	}
+	return len(x[i].Version) < len(x[j].Version)
+}
@@ -0,0 +1,296 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A modVersion identifies a single version of a module:
+// the module path and a semantic version such as v1.2.3.
+type modVersion struct {
+	Path    string
+	Version string
+}
+
+func (m modVersion) String() string {
+	if m.Version == "" {
+		return m.Path
+	}
+	return m.Path + "@" + m.Version
+}
+
+// A modReplace is a replace directive in a go.mod file.
+// If New.Version is empty, New.Path is a directory holding
+// the replacement source tree, relative to the main module root
+// if it is not absolute.
+type modReplace struct {
+	Old modVersion // Old.Version == "" matches every version
+	New modVersion
+}
+
+// A modFile is the parsed form of a go.mod file.
+type modFile struct {
+	Module  string       // module path
+	Require []modVersion // required minimum versions
+	Replace []modReplace // replacements
+}
+
+// parseModFile parses the go.mod file named file with the given content.
+// Only the module directive is needed for the file to be valid; the
+// requirements of a module without dependencies may be omitted.
+func parseModFile(file string, data []byte) (*modFile, error) {
+	f := new(modFile)
+	var block string // directive of the enclosing ( ) block, if any
+	for n, line := range strings.Split(string(data), "\n") {
+		lineErr := func(format string, args ...interface{}) error {
+			return fmt.Errorf("%s:%d: %s", file, n+1, fmt.Sprintf(format, args...))
+		}
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		args, err := modFields(line)
+		if err != nil {
+			return nil, lineErr("%v", err)
+		}
+		if len(args) == 0 {
+			continue
+		}
+		if block != "" {
+			if len(args) == 1 && args[0] == ")" {
+				block = ""
+				continue
+			}
+			args = append([]string{block}, args...)
+		} else if len(args) == 2 && args[1] == "(" {
+			switch args[0] {
+			case "require", "replace":
+				block = args[0]
+				continue
+			}
+			return nil, lineErr("unknown block type: %s", args[0])
+		}
+		switch args[0] {
+		default:
+			return nil, lineErr("unknown directive: %s", args[0])
+		case "module":
+			if len(args) != 2 {
+				return nil, lineErr("usage: module module/path")
+			}
+			if f.Module != "" {
+				return nil, lineErr("repeated module directive")
+			}
+			if err := checkModPath(args[1]); err != nil {
+				return nil, lineErr("%v", err)
+			}
+			f.Module = args[1]
+		case "require":
+			if len(args) != 3 {
+				return nil, lineErr("usage: require module/path v1.2.3")
+			}
+			if err := checkModPath(args[1]); err != nil {
+				return nil, lineErr("%v", err)
+			}
+			if err := checkModVersion(args[2]); err != nil {
+				return nil, lineErr("%s: %v", args[1], err)
+			}
+			f.Require = append(f.Require, modVersion{args[1], args[2]})
+		case "replace":
+			// replace old [v] => new [v]
+			var r modReplace
+			arrow := 2
+			if len(args) >= 3 && args[2] != "=>" {
+				arrow = 3
+			}
+			if len(args) < arrow+2 || len(args) > arrow+3 || args[arrow] != "=>" {
+				return nil, lineErr("usage: replace module/path [v1.2.3] => other/module v1.4.5 or directory")
+			}
+			r.Old.Path = args[1]
+			if arrow == 3 {
+				r.Old.Version = args[2]
+				if err := checkModVersion(r.Old.Version); err != nil {
+					return nil, lineErr("%s: %v", r.Old.Path, err)
+				}
+			}
+			r.New.Path = args[arrow+1]
+			if len(args) == arrow+3 {
+				r.New.Version = args[arrow+2]
+				if err := checkModVersion(r.New.Version); err != nil {
+					return nil, lineErr("%s: %v", r.New.Path, err)
+				}
+			} else if !isModDirPath(r.New.Path) {
+				return nil, lineErr("replacement module without version must be directory path (rooted or starting with ./ or ../)")
+			}
+			f.Replace = append(f.Replace, r)
+		}
+	}
+	if block != "" {
+		return nil, fmt.Errorf("%s: unterminated %s block", file, block)
+	}
+	if f.Module == "" {
+		return nil, fmt.Errorf("%s: missing module directive", file)
+	}
+	return f, nil
+}
+
+// modFields splits a go.mod line into fields, unquoting any
+// fields written as Go string literals.
+func modFields(line string) ([]string, error) {
+	var args []string
+	for {
+		line = strings.TrimLeft(line, " \t\r")
+		if line == "" {
+			return args, nil
+		}
+		if line[0] == '"' || line[0] == '`' {
+			q := line[0]
+			i := 1
+			for i < len(line) && line[i] != q {
+				if q == '"' && line[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(line) {
+				return nil, fmt.Errorf("unterminated quoted string")
+			}
+			s, err := strconv.Unquote(line[:i+1])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, s)
+			line = line[i+1:]
+			continue
+		}
+		i := strings.IndexAny(line, " \t\r")
+		if i < 0 {
+			i = len(line)
+		}
+		args = append(args, line[:i])
+		line = line[i:]
+	}
+}
+
+// isModDirPath reports whether path is a file system path
+// rather than a module path.
+func isModDirPath(path string) bool {
+	return strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../") || strings.HasPrefix(path, "/") ||
+		len(path) >= 3 && path[1] == ':' && (path[2] == '/' || path[2] == '\\')
+}
+
+// checkModPath checks that path is a plausible module path.
+func checkModPath(path string) error {
+	if path == "" {
+		return fmt.Errorf("empty module path")
+	}
+	if isModDirPath(path) {
+		return fmt.Errorf("malformed module path %q: must not be a file system path", path)
+	}
+	for _, elem := range strings.Split(path, "/") {
+		if elem == "" || elem == "." || elem == ".." {
+			return fmt.Errorf("malformed module path %q: invalid path element %q", path, elem)
+		}
+	}
+	for _, r := range path {
+		if makeImportValid(r) != r || r == '@' {
+			return fmt.Errorf("malformed module path %q: invalid char %q", path, r)
+		}
+	}
+	return nil
+}
+
+// checkModVersion checks that v is a canonical semantic version.
+func checkModVersion(v string) error {
+	if !isSemver(v) {
+		return fmt.Errorf("invalid version %q: not a semantic version", v)
+	}
+	if semverCanonical(v) != v {
+		return fmt.Errorf("invalid version %q: should be %s", v, semverCanonical(v))
+	}
+	return nil
+}
+
+// format returns the canonical text of the go.mod file,
+// with requirements sorted by module path.
+// Comments in the original file are not preserved.
+func (f *modFile) format() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "module %s\n", modQuote(f.Module))
+
+	req := append([]modVersion(nil), f.Require...)
+	sort.Sort(byModPath(req))
+	switch len(req) {
+	case 0:
+	case 1:
+		fmt.Fprintf(&buf, "\nrequire %s %s\n", modQuote(req[0].Path), req[0].Version)
+	default:
+		fmt.Fprintf(&buf, "\nrequire (\n")
+		for _, m := range req {
+			fmt.Fprintf(&buf, "\t%s %s\n", modQuote(m.Path), m.Version)
+		}
+		fmt.Fprintf(&buf, ")\n")
+	}
+
+	if len(f.Replace) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, r := range f.Replace {
+		fmt.Fprintf(&buf, "replace %s => %s\n", modQuoteVersion(r.Old), modQuoteVersion(r.New))
+	}
+	return buf.Bytes()
+}
+
+// modQuote quotes s if it cannot be written as a bare go.mod field.
+func modQuote(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"`()") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func modQuoteVersion(m modVersion) string {
+	if m.Version == "" {
+		return modQuote(m.Path)
+	}
+	return modQuote(m.Path) + " " + m.Version
+}
+
+// require returns the version of path required by f, or "".
+func (f *modFile) require(path string) string {
+	for _, m := range f.Require {
+		if m.Path == path {
+			return m.Version
+		}
+	}
+	return ""
+}
+
+// setRequire records that f requires version v of path,
+// replacing any existing requirement for path.
+func (f *modFile) setRequire(path, v string) {
+	for i := range f.Require {
+		if f.Require[i].Path == path {
+			f.Require[i].Version = v
+			return
+		}
+	}
+	f.Require = append(f.Require, modVersion{path, v})
+}
+
+// byModPath sorts module versions by path and then version.
+type byModPath []modVersion
+
+func (x byModPath) Len() int      { return len(x) }
+func (x byModPath) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x byModPath) Less(i, j int) bool {
+	if x[i].Path != x[j].Path {
+		return x[i].Path < x[j].Path
+	}
+	return semverCompare(x[i].Version, x[j].Version) < 0
+}
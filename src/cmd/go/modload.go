@@ -0,0 +1,360 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"strings"
+)
+
+// Module mode.
+//
+// When the current directory or one of its parents contains a go.mod
+// file, the go command runs in module mode: that directory is the root
+// of the main module, and imports are resolved using the main module
+// and the modules in its build list instead of $GOPATH/src.
+// Setting GOMODULES=off disables module mode.
+
+var (
+	modChecked   bool         // whether modRoot has been computed
+	modRoot      string       // directory holding the main module's go.mod, or ""
+	modMain      *modFile     // parsed go.mod of main module
+	modBuildList []modVersion // build list; modBuildList[0] is the main module
+)
+
+// modEnabled reports whether the go command is running in module mode.
+func modEnabled() bool {
+	if !modChecked {
+		modChecked = true
+		modRoot = findModRoot(cwd)
+	}
+	return modRoot != ""
+}
+
+// findModRoot returns the directory containing the go.mod file
+// governing dir, or "" if there is none.
+// Code in $GOROOT never belongs to a module.
+func findModRoot(dir string) string {
+	if os.Getenv("GOMODULES") == "off" || dir == "" {
+		return ""
+	}
+	if _, ok := hasSubdir(goroot, dir); ok {
+		return ""
+	}
+	dir = filepath.Clean(dir)
+	for {
+		if fi, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil && !fi.IsDir() {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// modLoad loads the main module's go.mod and go.sum files
+// and computes the build list, if that has not been done already.
+// Errors are fatal.
+func modLoad() {
+	if modBuildList != nil || !modEnabled() {
+		return
+	}
+	file := filepath.Join(modRoot, "go.mod")
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		fatalf("go: %v", err)
+	}
+	modMain, err = parseModFile(shortPath(file), data)
+	if err != nil {
+		fatalf("go: %v", err)
+	}
+	if err := loadModSum(filepath.Join(modRoot, "go.sum")); err != nil {
+		fatalf("go: %v", err)
+	}
+	atexit(func() {
+		if err := writeModSum(false); err != nil {
+			fmt.Fprintf(os.Stderr, "go: updating go.sum: %v\n", err)
+		}
+	})
+	modReloadBuildList()
+}
+
+// modReloadBuildList recomputes the build list after
+// a change to the main module's requirements.
+func modReloadBuildList() {
+	list, err := mvsBuildList(modVersion{Path: modMain.Module}, modReqs)
+	if err != nil {
+		fatalf("go: %v", err)
+	}
+	modBuildList = list
+}
+
+// modReqs returns the requirements of module version m,
+// as listed in its go.mod file.
+func modReqs(m modVersion) ([]modVersion, error) {
+	if m.Path == modMain.Module && m.Version == "" {
+		return modMain.Require, nil
+	}
+	var data []byte
+	var err error
+	r, replaced := modReplacement(m)
+	switch {
+	case replaced && r.Version == "":
+		data, err = ioutil.ReadFile(filepath.Join(modReplaceDir(r), "go.mod"))
+		if os.IsNotExist(err) {
+			// A replacement directory need not be a module
+			// with its own requirements.
+			return nil, nil
+		}
+	case replaced:
+		data, err = modGoMod(r)
+	default:
+		data, err = modGoMod(m)
+	}
+	if err != nil {
+		return nil, err
+	}
+	f, err := parseModFile("go.mod", data)
+	if err != nil {
+		return nil, err
+	}
+	if !replaced && f.Module != m.Path {
+		return nil, fmt.Errorf("go.mod has unexpected module path %q", f.Module)
+	}
+	return f.Require, nil
+}
+
+// modReplacement returns the replacement for module m
+// given by the main module's replace directives, if any.
+// A replacement for the specific version takes precedence
+// over one for all versions.
+func modReplacement(m modVersion) (modVersion, bool) {
+	found := -1
+	for i, r := range modMain.Replace {
+		if r.Old.Path == m.Path && (r.Old.Version == "" || r.Old.Version == m.Version) {
+			if found < 0 || r.Old.Version != "" {
+				found = i
+			}
+		}
+	}
+	if found < 0 {
+		return modVersion{}, false
+	}
+	return modMain.Replace[found].New, true
+}
+
+// modReplaceDir returns the directory named by a directory replacement.
+func modReplaceDir(r modVersion) string {
+	dir := filepath.FromSlash(r.Path)
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(modRoot, dir)
+	}
+	return dir
+}
+
+// modDir returns the directory holding the source tree of module m,
+// downloading it if necessary.
+func modDir(m modVersion) (string, error) {
+	if m.Path == modMain.Module && m.Version == "" {
+		return modRoot, nil
+	}
+	r, replaced := modReplacement(m)
+	switch {
+	case replaced && r.Version == "":
+		return modReplaceDir(r), nil
+	case replaced:
+		return modDownload(r)
+	}
+	return modDownload(m)
+}
+
+// isModImportPath reports whether path must be resolved using the
+// build list, as opposed to the standard library in $GOROOT.
+// Paths in the standard library have no dot in their first element.
+func isModImportPath(path string) bool {
+	modLoad()
+	if hasPathPrefix(path, modMain.Module) {
+		return true
+	}
+	i := strings.Index(path, "/")
+	if i < 0 {
+		i = len(path)
+	}
+	return strings.Contains(path[:i], ".")
+}
+
+// modImportDir returns the directory and module holding
+// the package with the given import path.
+func modImportDir(path string) (dir string, mod modVersion, err error) {
+	var found []modVersion
+	var dirs []string
+	var providers []string
+	for _, m := range modBuildList {
+		if !hasPathPrefix(path, m.Path) {
+			continue
+		}
+		root, err := modDir(m)
+		if err != nil {
+			return "", modVersion{}, err
+		}
+		d := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(strings.TrimPrefix(path, m.Path), "/")))
+		providers = append(providers, m.String())
+		if fi, err := os.Stat(d); err == nil && fi.IsDir() && !hasNestedModule(root, d) {
+			found = append(found, m)
+			dirs = append(dirs, d)
+		}
+	}
+	switch len(found) {
+	case 0:
+		if len(providers) > 0 {
+			return "", modVersion{}, fmt.Errorf("module %s provides no package %s", strings.Join(providers, ", "), path)
+		}
+		return "", modVersion{}, &modMissingError{path}
+	case 1:
+		return dirs[0], found[0], nil
+	}
+	var list []string
+	for i := range found {
+		list = append(list, fmt.Sprintf("%s in %s", found[i], dirs[i]))
+	}
+	return "", modVersion{}, fmt.Errorf("ambiguous import: found package %s in multiple modules:\n\t%s", path, strings.Join(list, "\n\t"))
+}
+
+// hasNestedModule reports whether dir, inside the module rooted at root,
+// belongs to a different module: whether dir or a parent below root
+// has its own go.mod file.
+func hasNestedModule(root, dir string) bool {
+	for dir != root && len(dir) > len(root) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return true
+		}
+		dir = filepath.Dir(dir)
+	}
+	return false
+}
+
+// modImport is the module-mode equivalent of buildContext.Import
+// for the non-local import path.
+func modImport(path string) (*build.Package, *modVersion, error) {
+	modLoad()
+	dir, m, err := modImportDir(path)
+	if err != nil {
+		return &build.Package{ImportPath: path}, nil, err
+	}
+	bp, err := buildContext.ImportDir(dir, 0)
+	bp.ImportPath = path
+	// Packages in modules are built in the work directory,
+	// not installed, so that each build sees exactly the
+	// versions in the build list.  Commands are still
+	// installed to $GOBIN or the bin directory of the first
+	// $GOPATH entry.
+	bp.Root = ""
+	bp.SrcRoot = ""
+	bp.PkgRoot = ""
+	bp.PkgObj = ""
+	bp.Goroot = false
+	bp.BinDir = gobin
+	if bp.BinDir == "" {
+		if list := filepath.SplitList(buildContext.GOPATH); len(list) > 0 && list[0] != "" {
+			bp.BinDir = filepath.Join(list[0], "bin")
+		}
+	}
+	return bp, &m, err
+}
+
+// modDirImportPath returns the import path of the package in dir,
+// which must be an absolute path, if dir is in the main module.
+func modDirImportPath(dir string) (string, bool) {
+	if !modEnabled() {
+		return "", false
+	}
+	modLoad()
+	rel, ok := hasSubdir(modRoot, dir)
+	if !ok {
+		if filepath.Clean(dir) != modRoot {
+			return "", false
+		}
+		rel = ""
+	}
+	if hasNestedModule(modRoot, filepath.Clean(dir)) {
+		return "", false
+	}
+	return pathpkg.Join(modMain.Module, rel), true
+}
+
+// modMatchPackages returns the packages in the main module and
+// the build list whose import paths satisfy match.  Modules whose
+// paths cannot contain a match, according to treeCanMatch, are skipped.
+func modMatchPackages(match, treeCanMatch func(string) bool, have map[string]bool) []string {
+	modLoad()
+	var pkgs []string
+	for _, m := range modBuildList {
+		if !treeCanMatch(m.Path) {
+			continue
+		}
+		root, err := modDir(m)
+		if err != nil {
+			errorf("go: %v", err)
+			continue
+		}
+		filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+			if err != nil || !fi.IsDir() {
+				return nil
+			}
+			if path != root {
+				// Avoid .foo, _foo, and testdata directory trees,
+				// and directories belonging to nested modules.
+				_, elem := filepath.Split(path)
+				if strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") || elem == "testdata" {
+					return filepath.SkipDir
+				}
+				if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+					return filepath.SkipDir
+				}
+			}
+			rel, _ := filepath.Rel(root, path)
+			name := pathpkg.Join(m.Path, filepath.ToSlash(rel))
+			if !treeCanMatch(name) {
+				return filepath.SkipDir
+			}
+			if have[name] || !match(name) {
+				return nil
+			}
+			have[name] = true
+			if _, err = buildContext.ImportDir(path, 0); err != nil {
+				if _, noGo := err.(*build.NoGoError); noGo {
+					return nil
+				}
+			}
+			pkgs = append(pkgs, name)
+			return nil
+		})
+	}
+	return pkgs
+}
+
+// writeModFile writes the main module's go.mod file, if it has changed.
+func writeModFile() {
+	file := filepath.Join(modRoot, "go.mod")
+	data := modMain.format()
+	if old, err := ioutil.ReadFile(file); err == nil && bytes.Equal(old, data) {
+		return
+	}
+	if buildN {
+		fmt.Fprintf(os.Stderr, "# write %s\n", shortPath(file))
+		return
+	}
+	if err := ioutil.WriteFile(file, data, 0666); err != nil {
+		fatalf("go: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !cmd_go_bootstrap
+
+// This code is compiled into the real 'go' binary, but it is not
+// compiled into the binary that is built during all.bash, so as
+// to avoid needing to build archive/zip and crypto/sha256 during
+// the bootstrap process.
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Module hashes are computed over a summary of the files in a module:
+// for each file, in sorted order, a line giving the SHA-256 hash of its
+// content and its name within the module, prefixed by path@version/.
+// The hash is the SHA-256 of the summary, written in base64
+// after the prefix h1:, which names this algorithm.
+// Because only names and contents take part, the hash of a zip file
+// matches the hash of the tree unpacked from it.
+
+// hashFiles computes the h1: hash of the named files,
+// using open to read their contents.
+func hashFiles(names []string, open func(string) (io.ReadCloser, error)) (string, error) {
+	names = append([]string(nil), names...)
+	sort.Strings(names)
+	summary := sha256.New()
+	for _, name := range names {
+		if strings.Contains(name, "\n") {
+			return "", fmt.Errorf("file names with new lines are not supported")
+		}
+		r, err := open(name)
+		if err != nil {
+			return "", err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, r)
+		r.Close()
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(summary, "%x  %s\n", h.Sum(nil), name)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(summary.Sum(nil)), nil
+}
+
+// hashZip returns the hash of the zip file, all of whose
+// entries must begin with prefix.
+func hashZip(zipfile, prefix string) (string, error) {
+	z, err := zip.OpenReader(zipfile)
+	if err != nil {
+		return "", err
+	}
+	defer z.Close()
+	files := make(map[string]*zip.File)
+	var names []string
+	for _, f := range z.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		if !strings.HasPrefix(f.Name, prefix) {
+			return "", fmt.Errorf("zip file entry %s not in %s", f.Name, strings.TrimSuffix(prefix, "/"))
+		}
+		if files[f.Name] != nil {
+			return "", fmt.Errorf("zip file has duplicate entry %s", f.Name)
+		}
+		files[f.Name] = f
+		names = append(names, f.Name)
+	}
+	return hashFiles(names, func(name string) (io.ReadCloser, error) {
+		return files[name].Open()
+	})
+}
+
+// hashDir returns the hash of the file tree rooted at dir,
+// as if it were unpacked from a zip file whose entries
+// begin with prefix.
+func hashDir(dir, prefix string) (string, error) {
+	var names []string
+	err := filepath.Walk(dir, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		names = append(names, prefix+filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hashFiles(names, func(name string) (io.ReadCloser, error) {
+		return os.Open(filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(name, prefix))))
+	})
+}
+
+// hashGoMod returns the hash of a module's go.mod file.
+func hashGoMod(data []byte) string {
+	h, _ := hashFiles([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	})
+	return h
+}
+
+// unzipModule unpacks the zip file into dir, stripping prefix
+// from the names of its entries.
+func unzipModule(zipfile, prefix, dir string) error {
+	z, err := zip.OpenReader(zipfile)
+	if err != nil {
+		return err
+	}
+	defer z.Close()
+	for _, f := range z.File {
+		if !strings.HasPrefix(f.Name, prefix) {
+			return fmt.Errorf("zip file entry %s not in %s", f.Name, strings.TrimSuffix(prefix, "/"))
+		}
+		name := f.Name[len(prefix):]
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue
+		}
+		for _, elem := range strings.Split(name, "/") {
+			if elem == "" || elem == "." || elem == ".." {
+				return fmt.Errorf("zip file has malformed entry %s", f.Name)
+			}
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0777); err != nil {
+			return err
+		}
+		r, err := f.Open()
+		if err != nil {
+			return err
+		}
+		w, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0444)
+		if err != nil {
+			r.Close()
+			return err
+		}
+		_, err = io.Copy(w, r)
+		r.Close()
+		if err1 := w.Close(); err == nil {
+			err = err1
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Minimal version selection.
+//
+// Each module version lists, in its go.mod file, the minimum
+// versions of the other modules it requires.  The build list for
+// the main module contains, for each module path reachable in the
+// requirement graph, the maximum of the versions required along
+// any path.  That is the oldest version that satisfies every
+// requirement, so it changes only when some go.mod file changes:
+// newly published versions of dependencies are never picked up
+// implicitly, and two builds of the same source tree see the same
+// dependencies.
+
+// A mvsReqs returns the requirements listed by module version m.
+type mvsReqs func(m modVersion) ([]modVersion, error)
+
+// mvsBuildList returns the build list for target, which must be first
+// in the list.  The other modules follow in increasing order by path.
+func mvsBuildList(target modVersion, reqs mvsReqs) ([]modVersion, error) {
+	max := map[string]string{target.Path: target.Version}
+	seen := map[modVersion]bool{target: true}
+	from := map[modVersion]modVersion{} // first module seen requiring each node, for errors
+	work := []modVersion{target}
+	for len(work) > 0 {
+		m := work[0]
+		work = work[1:]
+		list, err := reqs(m)
+		if err != nil {
+			return nil, &mvsError{stack: mvsStack(m, target, from), err: err}
+		}
+		for _, r := range list {
+			if r.Path == target.Path {
+				// The main module is always at its own version.
+				continue
+			}
+			if v, ok := max[r.Path]; !ok || semverCompare(v, r.Version) < 0 {
+				max[r.Path] = r.Version
+			}
+			if !seen[r] {
+				seen[r] = true
+				from[r] = m
+				work = append(work, r)
+			}
+		}
+	}
+
+	list := []modVersion{target}
+	for path, v := range max {
+		if path != target.Path {
+			list = append(list, modVersion{path, v})
+		}
+	}
+	sort.Sort(byModPath(list[1:]))
+	return list, nil
+}
+
+// mvsStack returns the chain of requirements leading from target to m.
+func mvsStack(m, target modVersion, from map[modVersion]modVersion) []modVersion {
+	stack := []modVersion{m}
+	for m != target {
+		m = from[m]
+		stack = append(stack, m)
+	}
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+	return stack
+}
+
+// An mvsError reports a failure to load the requirements of a module,
+// along with the chain of requirements that led to it.
+type mvsError struct {
+	stack []modVersion
+	err   error
+}
+
+func (e *mvsError) Error() string {
+	s := ""
+	for i, m := range e.stack {
+		if i == 0 {
+			s = m.String()
+		} else {
+			s += "\n\trequires " + m.String()
+		}
+	}
+	return fmt.Sprintf("%s: %v", s, e.err)
+}
@@ -16,7 +16,6 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
-	"time"
 	"unicode"
 )
 
@@ -35,6 +34,7 @@ type Package struct {
 	Stale       bool   `json:",omitempty"` // would 'go install' do anything for this package?
 	Root        string `json:",omitempty"` // Go root or Go path dir containing this package
 	ConflictDir string `json:",omitempty"` // Dir is hidden by this other directory
+	Module      string `json:",omitempty"` // module containing package, as path@version (module mode only)
 
 	// Source files
 	GoFiles        []string `json:",omitempty"` // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
@@ -57,8 +57,9 @@ type Package struct {
 	CgoPkgConfig []string `json:",omitempty"` // cgo: pkg-config names
 
 	// Dependency information
-	Imports []string `json:",omitempty"` // import paths used by this package
-	Deps    []string `json:",omitempty"` // all (recursively) imported dependencies
+	Imports   []string          `json:",omitempty"` // import paths used by this package
+	ImportMap map[string]string `json:",omitempty"` // map from import in source to vendored ImportPath
+	Deps      []string          `json:",omitempty"` // all (recursively) imported dependencies
 
 	// Error information
 	Incomplete bool            `json:",omitempty"` // was there an error loading this package or dependencies?
@@ -237,12 +238,20 @@ func loadImport(path string, srcDir string, stk *importStack, importPos []token.
 	// For a local import the identifier is the pseudo-import path
 	// we create from the full directory to the package.
 	// Otherwise it is the usual import path.
+	// An import satisfied by a vendor directory is identified by
+	// its full path, including the vendor element.
 	importPath := path
 	isLocal := build.IsLocalImport(path)
+	useMod := modEnabled() && !isLocal && isModImportPath(path)
 	if isLocal {
 		importPath = dirToImportPath(filepath.Join(srcDir, path))
+	} else if !useMod {
+		importPath = vendoredImportPath(srcDir, path)
 	}
 	if p := packageCache[importPath]; p != nil {
+		if perr := disallowImport(srcDir, path, p, stk); perr != p {
+			return perr
+		}
 		return reusePackage(p, stk)
 	}
 
@@ -257,7 +266,22 @@ func loadImport(path string, srcDir string, stk *importStack, importPos []token.
 	//
 	// TODO: After Go 1, decide when to pass build.AllowBinary here.
 	// See issue 3268 for mistakes to avoid.
-	bp, err := buildContext.Import(path, srcDir, 0)
+	var bp *build.Package
+	var err error
+	if useMod {
+		var m *modVersion
+		bp, m, err = modImport(path)
+		if m != nil {
+			p.Module = m.String()
+		}
+	} else if isLocal {
+		bp, err = buildContext.Import(path, srcDir, 0)
+	} else if strings.HasPrefix(importPath, "cmd/") {
+		bp, err = importCmd(importPath)
+	} else {
+		// The vendor search was done above.
+		bp, err = buildContext.Import(importPath, srcDir, build.IgnoreVendor)
+	}
 	bp.ImportPath = importPath
 	if gobin != "" {
 		bp.BinDir = gobin
@@ -269,9 +293,139 @@ func loadImport(path string, srcDir string, stk *importStack, importPos []token.
 		p.Error.Pos = pos.String()
 	}
 
+	if perr := disallowImport(srcDir, path, p, stk); perr != p {
+		return perr
+	}
 	return p
 }
 
+// vendoredImportPath returns the expansion of path when it appears
+// in code in srcDir and is satisfied by a vendor directory: the
+// import path of the package in the innermost directory named
+// "vendor" in srcDir or one of its parents, up to the root of the
+// Go tree containing srcDir.  If no vendor directory applies,
+// vendoredImportPath returns path unchanged.
+func vendoredImportPath(srcDir, path string) string {
+	if srcDir == "" {
+		return path
+	}
+	for _, root := range buildContext.SrcDirs() {
+		sub, ok := hasSubdir(root, srcDir)
+		if !ok {
+			continue
+		}
+		if strings.Contains("/"+sub+"/", "/testdata/") {
+			break
+		}
+		for {
+			vpath := pathpkg.Join(sub, "vendor", path)
+			if fi, err := os.Stat(filepath.Join(root, filepath.FromSlash(vpath))); err == nil && fi.IsDir() {
+				return vpath
+			}
+			if sub == "" {
+				break
+			}
+			if sub = pathpkg.Dir(sub); sub == "." {
+				sub = ""
+			}
+		}
+		break
+	}
+	return path
+}
+
+// disallowImport checks that the import of p, written as path in
+// code in srcDir, is permitted by the internal and vendor visibility
+// rules.  If it is, disallowImport returns p.  Otherwise it returns
+// a copy of p with an error describing the violation.
+// Packages named on the command line are always permitted.
+func disallowImport(srcDir, path string, p *Package, stk *importStack) *Package {
+	// There was an error loading the package; stop here.
+	if p.Error != nil {
+		return p
+	}
+
+	// The stack includes p.ImportPath.
+	// If that's the only thing on the stack, we started
+	// with a name given on the command line, not an
+	// import.  Anything listed on the command line is fine.
+	if len(*stk) <= 1 {
+		return p
+	}
+
+	var err string
+	if i, ok := findElem(p.ImportPath, "internal"); ok && !hasFilePathPrefix(srcDir, parentDir(p, i)) {
+		// An import of a path containing the element "internal"
+		// is disallowed if the importing code is outside the tree
+		// rooted at the parent of the "internal" directory.
+		err = "use of internal package not allowed"
+	} else if _, ok := findElem(path, "vendor"); ok && !p.local && p.Module == "" {
+		// A vendored package must be imported using the
+		// path that omits the vendor prefix.
+		err = "must be imported as " + vendorlessPath(path)
+	} else if i, ok := findElem(p.ImportPath, "vendor"); ok && !hasFilePathPrefix(srcDir, parentDir(p, i)) {
+		err = "use of vendored package not allowed"
+	} else {
+		return p
+	}
+	perr := *p
+	perr.Error = &PackageError{
+		ImportStack: stk.copy(),
+		Err:         err,
+	}
+	perr.Incomplete = true
+	return &perr
+}
+
+// findElem reports whether the import path contains the path element
+// elem and, if so, returns the index of the last such element.
+func findElem(path, elem string) (index int, ok bool) {
+	switch {
+	case strings.HasSuffix(path, "/"+elem):
+		return len(path) - len(elem), true
+	case strings.Contains(path, "/"+elem+"/"):
+		return strings.LastIndex(path, "/"+elem+"/") + 1, true
+	case path == elem, strings.HasPrefix(path, elem+"/"):
+		return 0, true
+	}
+	return 0, false
+}
+
+// vendorlessPath returns path with everything up to and
+// including the last vendor element removed.
+func vendorlessPath(path string) string {
+	i, ok := findElem(path, "vendor")
+	if !ok {
+		return path
+	}
+	return strings.TrimPrefix(path[i+len("vendor"):], "/")
+}
+
+// parentDir returns the directory corresponding to the parent of the
+// path element starting at p.ImportPath[i], by removing one directory
+// from p.Dir for each remaining element of the import path.
+func parentDir(p *Package, i int) string {
+	dir := p.Dir
+	for n := strings.Count(p.ImportPath[i:], "/") + 1; n > 0; n-- {
+		dir = filepath.Dir(dir)
+	}
+	return dir
+}
+
+// hasFilePathPrefix reports whether the filesystem path s begins with
+// the elements in prefix.
+func hasFilePathPrefix(s, prefix string) bool {
+	s = filepath.Clean(s)
+	prefix = filepath.Clean(prefix)
+	if s == prefix {
+		return true
+	}
+	if !strings.HasSuffix(prefix, string(filepath.Separator)) {
+		prefix += string(filepath.Separator)
+	}
+	return strings.HasPrefix(s, prefix)
+}
+
 // reusePackage reuses package p to satisfy the import at the top
 // of the import stack stk.  If this use causes an import loop,
 // reusePackage updates p's error information to record the loop.
@@ -310,14 +464,18 @@ var goTools = map[string]targetDir{
 	"cmd/addr2line":                        toTool,
 	"cmd/api":                              toTool,
 	"cmd/cgo":                              toTool,
+	"cmd/doc":                              toTool,
 	"cmd/fix":                              toTool,
 	"cmd/link":                             toTool,
 	"cmd/nm":                               toTool,
+	"cmd/objdump":                          toTool,
 	"cmd/pack":                             toTool,
+	"cmd/pprof":                            toTool,
+	"cmd/trace":                            toTool,
+	"cmd/vet":                              toTool,
 	"cmd/yacc":                             toTool,
 	"code.google.com/p/go.tools/cmd/cover": toTool,
 	"code.google.com/p/go.tools/cmd/godoc": toBin,
-	"code.google.com/p/go.tools/cmd/vet":   toTool,
 }
 
 // expandScanner expands a scanner.List error into all the errors in the list.
@@ -486,19 +644,30 @@ func (p *Package) load(stk *importStack, bp *build.Package, err error) *Package
 			continue
 		}
 		p1 := loadImport(path, p.Dir, stk, p.build.ImportPos[path])
-		if p1.local {
-			if !p.local && p.Error == nil {
-				p.Error = &PackageError{
-					ImportStack: stk.copy(),
-					Err:         fmt.Sprintf("local import %q in non-local package", path),
-				}
-				pos := p.build.ImportPos[path]
-				if len(pos) > 0 {
-					p.Error.Pos = pos[0].String()
+		if p1.local && !p.local && p.Error == nil {
+			p.Error = &PackageError{
+				ImportStack: stk.copy(),
+				Err:         fmt.Sprintf("local import %q in non-local package", path),
+			}
+			pos := p.build.ImportPos[path]
+			if len(pos) > 0 {
+				p.Error.Pos = pos[0].String()
+			}
+		}
+		if p1.ImportPath != path {
+			// Local and vendored imports are recorded
+			// using the package's full import path.
+			if !p1.local {
+				if p.ImportMap == nil {
+					p.ImportMap = make(map[string]string)
 				}
+				p.ImportMap[path] = p1.ImportPath
 			}
 			path = p1.ImportPath
 			importPaths[i] = path
+			if i < len(p.Imports) {
+				p.Imports[i] = path
+			}
 		}
 		deps[path] = true
 		imports = append(imports, p1)
@@ -526,6 +695,13 @@ func (p *Package) load(stk *importStack, bp *build.Package, err error) *Package
 			p.DepsErrors = append(p.DepsErrors, p1.Error)
 		}
 	}
+	for _, p1 := range imports {
+		// Errors from disallowImport are recorded only in
+		// the copy of the package returned to this importer.
+		if p1.Error != nil && packageCache[p1.ImportPath] != p1 {
+			p.DepsErrors = append(p.DepsErrors, p1.Error)
+		}
+	}
 
 	// unsafe is a fake package.
 	if p.Standard && (p.ImportPath == "unsafe" || buildContext.Compiler == "gccgo") {
@@ -637,76 +813,70 @@ func isStale(p *Package, topRoot map[string]bool) bool {
 	}
 
 	// Package is stale if completely unbuilt.
-	var built time.Time
-	if fi, err := os.Stat(p.target); err == nil {
-		built = fi.ModTime()
-	}
-	if built.IsZero() {
+	if _, err := os.Stat(p.target); err != nil {
 		return true
 	}
 
-	olderThan := func(file string) bool {
-		fi, err := os.Stat(file)
-		return err != nil || fi.ModTime().After(built)
-	}
-
-	// Package is stale if a dependency is, or if a dependency is newer.
+	// Package is stale if a dependency is.
 	for _, p1 := range p.deps {
-		if p1.Stale || p1.target != "" && olderThan(p1.target) {
+		if p1.Stale {
 			return true
 		}
 	}
 
-	// As a courtesy to developers installing new versions of the compiler
-	// frequently, define that packages are stale if they are
-	// older than the compiler, and commands if they are older than
-	// the linker.  This heuristic will not work if the binaries are
-	// back-dated, as some binary distributions may do, but it does handle
-	// a very common case.
-	// See issue 3036.
-	// Assume code in $GOROOT is up to date, since it may not be writeable.
-	// See issue 4106.
-	if p.Root != goroot {
-		if olderThan(buildToolchain.compiler()) {
-			return true
-		}
-		if p.build.IsCommand() && olderThan(buildToolchain.linker()) {
-			return true
-		}
+	// Have installed copy.  The build cache records the inputs it was
+	// built from: the content of the source files and of the installed
+	// dependencies, the tools, and the flags and settings.  Package is
+	// stale if any of these has changed, whatever the modification
+	// times of the files say.  See 'go help cache'.
+	if ok, recorded := upToDate(p); recorded {
+		return !ok
 	}
 
-	// Have installed copy, probably built using current compilers,
-	// and built after its imported packages.  The only reason now
-	// that we'd have to rebuild it is if the sources were newer than
-	// the package.   If a package p is not in the same tree as any
-	// package named on the command-line, assume it is up-to-date
-	// no matter what the modification times on the source files indicate.
-	// This avoids rebuilding $GOROOT packages when people are
-	// working outside the Go root, and it effectively makes each tree
-	// listed in $GOPATH a separate compilation world.
-	// See issue 3149.
-	if p.Root != "" && !topRoot[p.Root] {
-		return false
-	}
-
-	srcs := stringList(p.GoFiles, p.CFiles, p.CXXFiles, p.MFiles, p.HFiles, p.SFiles, p.CgoFiles, p.SysoFiles, p.SwigFiles, p.SwigCXXFiles)
-	for _, src := range srcs {
-		if olderThan(filepath.Join(p.Dir, src)) {
-			return true
-		}
-	}
-
-	return false
+	// Without a record, as when the build cache is disabled, we cannot
+	// tell what the installed copy was built from.  If a package p is
+	// not in the same tree as any package named on the command-line,
+	// assume it is up-to-date.  This avoids rebuilding $GOROOT packages
+	// when people are working outside the Go root, and it effectively
+	// makes each tree listed in $GOPATH a separate compilation world.
+	// See issue 3149.  Rebuild the packages in those trees.
+	return p.Root == "" || topRoot[p.Root]
 }
 
 var cwd, _ = os.Getwd()
 
 var cmdCache = map[string]*Package{}
 
+// importCmd is like buildContext.Import for the pseudo-paths beginning
+// with cmd/, which denote the commands in the Go command directory and
+// the packages in their subdirectories, such as cmd/pprof/internal/report.
+// The packages are installed alongside the standard packages.
+func importCmd(path string) (*build.Package, error) {
+	bp, err := buildContext.ImportDir(filepath.Join(gorootSrc, path), 0)
+	bp.ImportPath = path
+	bp.Goroot = true
+	bp.BinDir = gorootBin
+	if gobin != "" {
+		bp.BinDir = gobin
+	}
+	bp.Root = goroot
+	bp.SrcRoot = gorootSrc
+	bp.PkgRoot = gorootPkg
+	if bp.Name != "main" {
+		dir := buildContext.GOOS + "_" + buildContext.GOARCH
+		if buildContext.InstallSuffix != "" {
+			dir += "_" + buildContext.InstallSuffix
+		}
+		bp.PkgObj = filepath.Join(gorootPkg, dir, path+".a")
+	}
+	return bp, err
+}
+
 // loadPackage is like loadImport but is used for command-line arguments,
 // not for paths found in import statements.  In addition to ordinary import paths,
 // loadPackage accepts pseudo-paths beginning with cmd/ to denote commands
 // in the Go command directory, as well as paths to those directories.
+// The packages in subdirectories of the commands are loaded as imports.
 func loadPackage(arg string, stk *importStack) *Package {
 	if build.IsLocalImport(arg) {
 		dir := arg
@@ -720,32 +890,14 @@ func loadPackage(arg string, stk *importStack) *Package {
 			arg = sub
 		}
 	}
-	if strings.HasPrefix(arg, "cmd/") {
+	if strings.HasPrefix(arg, "cmd/") && !strings.Contains(arg[4:], "/") {
 		if p := cmdCache[arg]; p != nil {
 			return p
 		}
 		stk.push(arg)
 		defer stk.pop()
 
-		if strings.Contains(arg[4:], "/") {
-			p := &Package{
-				Error: &PackageError{
-					ImportStack: stk.copy(),
-					Err:         fmt.Sprintf("invalid import path: cmd/... is reserved for Go commands"),
-				},
-			}
-			return p
-		}
-
-		bp, err := buildContext.ImportDir(filepath.Join(gorootSrc, arg), 0)
-		bp.ImportPath = arg
-		bp.Goroot = true
-		bp.BinDir = gorootBin
-		if gobin != "" {
-			bp.BinDir = gobin
-		}
-		bp.Root = goroot
-		bp.SrcRoot = gorootSrc
+		bp, err := importCmd(arg)
 		p := new(Package)
 		cmdCache[arg] = p
 		p.load(stk, bp, err)
@@ -764,9 +916,13 @@ func loadPackage(arg string, stk *importStack) *Package {
 	// This lets you run go test ./ioutil in package io and be
 	// referring to io/ioutil rather than a hypothetical import of
 	// "./ioutil".
+	// In module mode, a local path in the main module
+	// is likewise treated as the module's import path.
 	if build.IsLocalImport(arg) {
-		bp, _ := buildContext.ImportDir(filepath.Join(cwd, arg), build.FindOnly)
-		if bp.ImportPath != "" && bp.ImportPath != "." {
+		dir := filepath.Join(cwd, arg)
+		if path, ok := modDirImportPath(dir); ok {
+			arg = path
+		} else if bp, _ := buildContext.ImportDir(dir, build.FindOnly); bp.ImportPath != "" && bp.ImportPath != "." {
 			arg = bp.ImportPath
 		}
 	}
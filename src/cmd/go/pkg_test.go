@@ -71,3 +71,37 @@ func TestParseMetaGoImports(t *testing.T) {
 		}
 	}
 }
+
+var findElemTests = []struct {
+	path  string
+	index int
+	ok    bool
+}{
+	{"internal", 0, true},
+	{"internal/x", 0, true},
+	{"a/internal", 2, true},
+	{"a/internal/b", 2, true},
+	{"a/internal/b/internal/c", 13, true},
+	{"a/internals", 0, false},
+	{"a/xinternal/b", 0, false},
+	{"a/b", 0, false},
+}
+
+func TestFindElem(t *testing.T) {
+	for _, tt := range findElemTests {
+		index, ok := findElem(tt.path, "internal")
+		if index != tt.index || ok != tt.ok {
+			t.Errorf("findElem(%q, \"internal\") = %d, %v, want %d, %v", tt.path, index, ok, tt.index, tt.ok)
+		}
+	}
+	for _, tt := range []struct{ in, out string }{
+		{"a/vendor/x/y", "x/y"},
+		{"vendor/x", "x"},
+		{"a/vendor/b/vendor/x", "x"},
+		{"a/b", "a/b"},
+	} {
+		if out := vendorlessPath(tt.in); out != tt.out {
+			t.Errorf("vendorlessPath(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !cmd_go_bootstrap
+
+// This code is compiled into the real 'go' binary, but it is not
+// compiled into the binary that is built during all.bash, so as
+// to avoid building the debug packages during the bootstrap process.
+
+package main
+
+import (
+	"debug/buildinfo"
+	"strings"
+)
+
+var errNotGoExe = buildinfo.ErrNotGoExe
+
+// readBuildInfo returns the Go version and the lines of
+// build information recorded in the executable file.
+func readBuildInfo(file string) (vers string, lines []string, err error) {
+	bi, err := buildinfo.ReadFile(file)
+	if err != nil {
+		return "", nil, err
+	}
+	vers = bi.GoVersion
+	bi.GoVersion = ""
+	if info := strings.TrimSuffix(bi.String(), "\n"); info != "" {
+		lines = strings.Split(info, "\n")
+	}
+	return vers, lines, nil
+}
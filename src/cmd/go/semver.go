@@ -0,0 +1,243 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "strings"
+
+// Module versions are semantic versions (http://semver.org/) written
+// with a leading v, as in v1.2.3 or v2.0.0-beta.1.  The helpers in this
+// file parse and compare them.  A string that is not a valid version
+// sorts before every valid one.
+
+// A semver is a parsed semantic version.
+type semver struct {
+	major, minor, patch string
+	prerelease          string // including the leading -, if any
+	build               string // including the leading +, if any
+}
+
+// parseSemver parses v, reporting whether it is a valid version.
+// As a convenience, vMAJOR and vMAJOR.MINOR are accepted as
+// shorthands for vMAJOR.0.0 and vMAJOR.MINOR.0.
+func parseSemver(v string) (p semver, ok bool) {
+	if v == "" || v[0] != 'v' {
+		return
+	}
+	if p.major, v, ok = parseNum(v[1:]); !ok {
+		return
+	}
+	if v == "" {
+		p.minor, p.patch = "0", "0"
+		return
+	}
+	if v[0] != '.' {
+		return p, false
+	}
+	if p.minor, v, ok = parseNum(v[1:]); !ok {
+		return
+	}
+	if v == "" {
+		p.patch = "0"
+		return
+	}
+	if v[0] != '.' {
+		return p, false
+	}
+	if p.patch, v, ok = parseNum(v[1:]); !ok {
+		return
+	}
+	if len(v) > 0 && v[0] == '-' {
+		if p.prerelease, v, ok = parseIdents(v, '-'); !ok {
+			return
+		}
+	}
+	if len(v) > 0 && v[0] == '+' {
+		if p.build, v, ok = parseIdents(v, '+'); !ok {
+			return
+		}
+	}
+	if v != "" {
+		return p, false
+	}
+	return p, true
+}
+
+// parseNum parses a decimal number without leading zeros
+// at the start of v and returns the number and the remainder.
+func parseNum(v string) (num, rest string, ok bool) {
+	i := 0
+	for i < len(v) && '0' <= v[i] && v[i] <= '9' {
+		i++
+	}
+	if i == 0 || i > 1 && v[0] == '0' {
+		return "", "", false
+	}
+	return v[:i], v[i:], true
+}
+
+// parseIdents parses a dot-separated list of identifiers
+// introduced by the byte lead, as in a prerelease or build suffix.
+func parseIdents(v string, lead byte) (s, rest string, ok bool) {
+	i := 1
+	start := 1
+	for i < len(v) {
+		c := v[i]
+		if c == '+' && lead == '-' {
+			break
+		}
+		if c == '.' {
+			if i == start {
+				return "", "", false
+			}
+			start = i + 1
+		} else if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '-') {
+			return "", "", false
+		}
+		i++
+	}
+	if i == start {
+		return "", "", false
+	}
+	return v[:i], v[i:], true
+}
+
+// isSemver reports whether v is a valid module version.
+func isSemver(v string) bool {
+	_, ok := parseSemver(v)
+	return ok
+}
+
+// semverCanonical returns the canonical form of v:
+// vMAJOR.MINOR.PATCH with any prerelease suffix but without
+// build metadata, which does not take part in comparisons.
+// It returns "" if v is not a valid version.
+func semverCanonical(v string) string {
+	p, ok := parseSemver(v)
+	if !ok {
+		return ""
+	}
+	return "v" + p.major + "." + p.minor + "." + p.patch + p.prerelease
+}
+
+// semverMajor returns the major version prefix of v, as in "v2",
+// or "" if v is not a valid version.
+func semverMajor(v string) string {
+	p, ok := parseSemver(v)
+	if !ok {
+		return ""
+	}
+	return "v" + p.major
+}
+
+// semverIsPrerelease reports whether v is a prerelease version.
+func semverIsPrerelease(v string) bool {
+	p, ok := parseSemver(v)
+	return ok && p.prerelease != ""
+}
+
+// semverCompare returns -1, 0, or +1 depending on whether
+// v < w, v == w, or v > w.  Invalid versions compare equal
+// to each other and less than all valid versions.
+func semverCompare(v, w string) int {
+	pv, okv := parseSemver(v)
+	pw, okw := parseSemver(w)
+	switch {
+	case !okv && !okw:
+		return 0
+	case !okv:
+		return -1
+	case !okw:
+		return +1
+	}
+	if c := compareInt(pv.major, pw.major); c != 0 {
+		return c
+	}
+	if c := compareInt(pv.minor, pw.minor); c != 0 {
+		return c
+	}
+	if c := compareInt(pv.patch, pw.patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(pv.prerelease, pw.prerelease)
+}
+
+// semverMax returns the larger of v and w.
+func semverMax(v, w string) string {
+	if semverCompare(v, w) < 0 {
+		return w
+	}
+	return v
+}
+
+// compareInt compares two decimal numbers without leading zeros.
+func compareInt(x, y string) int {
+	if x == y {
+		return 0
+	}
+	if len(x) != len(y) {
+		if len(x) < len(y) {
+			return -1
+		}
+		return +1
+	}
+	if x < y {
+		return -1
+	}
+	return +1
+}
+
+// comparePrerelease compares prerelease suffixes as specified
+// by semver: a version without a prerelease sorts after one with,
+// and the dot-separated fields are compared one at a time,
+// numerically if both are numbers and lexically otherwise.
+func comparePrerelease(x, y string) int {
+	if x == y {
+		return 0
+	}
+	if x == "" {
+		return +1
+	}
+	if y == "" {
+		return -1
+	}
+	xs := strings.Split(x[1:], ".")
+	ys := strings.Split(y[1:], ".")
+	for i := 0; i < len(xs) && i < len(ys); i++ {
+		dx, dy := isNum(xs[i]), isNum(ys[i])
+		switch {
+		case xs[i] == ys[i]:
+			continue
+		case dx && dy:
+			return compareInt(xs[i], ys[i])
+		case dx:
+			return -1
+		case dy:
+			return +1
+		case xs[i] < ys[i]:
+			return -1
+		default:
+			return +1
+		}
+	}
+	if len(xs) < len(ys) {
+		return -1
+	}
+	if len(xs) > len(ys) {
+		return +1
+	}
+	return 0
+}
+
+func isNum(s string) bool {
+	_, rest, ok := parseNum(s)
+	return ok && rest == ""
+}
+
+// byVersion sorts a list of versions in increasing semver order.
+type byVersion []string
+
+func (v byVersion) Len() int           { return len(v) }
+func (v byVersion) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+func (v byVersion) Less(i, j int) bool { return semverCompare(v[i], v[j]) < 0 }
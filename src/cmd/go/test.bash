@@ -700,6 +700,75 @@ if ! ./testgo list -f "GOARCH: {{context.GOARCH}}"; then
 	ok=false
 fi
 
+TEST go version -m reports build information
+d=$(mktemp -d -t testgoXXX)
+export GOPATH=$d
+mkdir -p $d/src/hello
+echo 'package main
+import "fmt"
+func main() { fmt.Println("hello") }' >$d/src/hello/hello.go
+if ! ./testgo build -tags 'a b' -o $d/hello hello; then
+	echo build failed
+	ok=false
+elif ! ./testgo version -m $d/hello >$d/out 2>&1; then
+	echo go version -m failed
+	cat $d/out
+	ok=false
+elif ! grep -q "^$d/hello: " $d/out || ! grep -q '^	path	hello$' $d/out || ! grep -q '^	pkg	fmt$' $d/out || ! grep -q '^	build	-tags=a,b$' $d/out; then
+	echo go version -m printed unexpected build information
+	cat $d/out
+	ok=false
+fi
+if ./testgo version $d/src/hello/hello.go >/dev/null 2>&1; then
+	echo go version succeeded on non-executable file
+	ok=false
+fi
+rm -rf $d
+unset GOPATH
+
+TEST packages of commands are found in cmd/ subdirectories
+if ! ./testgo list -f '{{.Dir}} {{.Name}}' cmd/pprof/internal/profile >testdata/cmdpkg.out 2>&1; then
+	echo go list cmd/pprof/internal/profile failed
+	cat testdata/cmdpkg.out
+	ok=false
+elif ! grep -q 'src/cmd/pprof/internal/profile profile$' testdata/cmdpkg.out; then
+	echo go list cmd/pprof/internal/profile printed unexpected package
+	cat testdata/cmdpkg.out
+	ok=false
+elif ! ./testgo list std | grep -q '^cmd/pprof/internal/report$'; then
+	echo go list std does not list cmd/pprof/internal/report
+	ok=false
+elif ! ./testgo list cmd/pprof/... | grep -q '^cmd/pprof$'; then
+	echo go list cmd/pprof/... does not list cmd/pprof
+	ok=false
+fi
+rm -f testdata/cmdpkg.out
+
+TEST staleness follows file content, not modification time
+d=$(mktemp -d -t testgoXXX)
+export GOPATH=$d GOCACHE=$d/cache
+mkdir -p $d/src/p
+echo 'package p
+func F() int { return 1 }' >$d/src/p/p.go
+if ! ./testgo install p; then
+	echo go install p failed
+	ok=false
+elif [ "$(./testgo list -f '{{.Stale}}' p)" != false ]; then
+	echo after go install, p listed as stale
+	ok=false
+elif ! touch -t 209901010000 $d/src/p/p.go || [ "$(./testgo list -f '{{.Stale}}' p)" != false ]; then
+	echo after touching p.go, p listed as stale
+	ok=false
+elif ! echo 'func G() int { return 2 }' >>$d/src/p/p.go || [ "$(./testgo list -f '{{.Stale}}' p)" != true ]; then
+	echo after editing p.go, p not listed as stale
+	ok=false
+elif ! ./testgo install p || [ "$(./testgo list -f '{{.Stale}}' p)" != false ]; then
+	echo after reinstalling p, p listed as stale
+	ok=false
+fi
+rm -rf $d
+unset GOPATH GOCACHE
+
 # clean up
 if $started; then stop; fi
 rm -rf testdata/bin testdata/bin1
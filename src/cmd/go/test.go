@@ -12,6 +12,7 @@ import (
 	"go/doc"
 	"go/parser"
 	"go/token"
+	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -63,6 +64,16 @@ with source in the current directory, including tests, and runs the tests.
 The package is built in a temporary directory so it does not interfere with the
 non-test installation.
 
+When the packages to test are listed explicitly, as in 'go test math'
+or 'go test ./...', go test records the results of successful test
+runs in the build cache.  If the test binary, the flags passed to it,
+and the files in the package directory and its testdata subdirectory
+are unchanged, go test prints the recorded result, with "(cached)"
+in place of the elapsed time, instead of running the test again.
+Only runs using the flags -cpu, -parallel, -run, -short and -v are
+cached; any other test flag disables caching.  The idiomatic way to
+force a test to run is to use -count=1.  See 'go help cache'.
+
 In addition to the build flags, the flags handled by 'go test' itself are:
 
 	-c  Compile the test binary to pkg.test but do not run it.
@@ -99,9 +110,9 @@ The 'go test' command takes both flags that apply to 'go test' itself
 and flags that apply to the resulting test binary.
 
 Several of the flags control profiling and write an execution profile
-suitable for "go tool pprof"; run "go tool pprof help" for more
-information.  The --alloc_space, --alloc_objects, and --show_bytes
-options of pprof control how the information is presented.
+suitable for "go tool pprof"; run "go tool pprof -h" for more
+information.  The -alloc_space, -alloc_objects, -inuse_space and
+-inuse_objects options of pprof control how the information is presented.
 
 The following flags are recognized by the 'go test' command and
 control the execution of any test:
@@ -157,6 +168,11 @@ control the execution of any test:
 	    have passed.
 	    Sets -cover.
 
+	-count n
+	    Run each test and benchmark n times (default 1).
+	    Setting -count explicitly, as in -count=1, also disables
+	    the reuse of cached test results.
+
 	-cpu 1,2,4
 	    Specify a list of GOMAXPROCS values for which the tests or
 	    benchmarks should be executed.  The default is the current value
@@ -173,7 +189,17 @@ control the execution of any test:
 	    Enable more precise (and expensive) memory profiles by setting
 	    runtime.MemProfileRate.  See 'godoc runtime MemProfileRate'.
 	    To profile all memory allocations, use -test.memprofilerate=1
-	    and pass --alloc_space flag to the pprof tool.
+	    and pass the -alloc_space flag to the pprof tool.
+
+	-mutexprofile mutex.out
+	    Write a mutex contention profile to the specified file
+	    when all tests are complete.
+
+	-mutexprofilefraction n
+	    Sample 1 in n stack traces of goroutines holding a
+	    contended mutex.  By default, if -test.mutexprofile is set
+	    without this flag, every contended unlock is recorded,
+	    equivalent to -test.mutexprofilefraction=1.
 
 	-outputdir directory
 	    Place output files from profiling in the specified directory,
@@ -197,6 +223,10 @@ control the execution of any test:
 	-timeout t
 	    If a test runs longer than t, panic.
 
+	-trace trace.out
+	    Write an execution trace to the specified file before exiting.
+	    View it with 'go tool trace trace.out'.
+
 	-v
 	    Verbose output: log all tests as they are run. Also print all
 	    text from Log and Logf calls even if the test succeeds.
@@ -284,6 +314,7 @@ var (
 	testBench        bool
 	testStreamOutput bool // show output as it is generated
 	testShowPass     bool // show passing output
+	testNoCache      bool // test flags prevent reusing cached results
 
 	testKillTimeout = 10 * time.Minute
 )
@@ -336,6 +367,13 @@ func runTest(cmd *Command, args []string) {
 	testStreamOutput = len(pkgArgs) == 0 || testBench ||
 		(len(pkgs) <= 1 && testShowPass)
 
+	// Test results are cached only when packages are listed
+	// explicitly: running plain 'go test' in a directory always
+	// runs the tests.
+	if len(pkgArgs) == 0 || testProfile {
+		testNoCache = true
+	}
+
 	var b builder
 	b.init()
 
@@ -866,13 +904,22 @@ func (b *builder) runTest(a *action) error {
 		return nil
 	}
 
+	testID, cacheable := b.testActionID(a)
+	if cacheable && b.cachedTest(a, testID) {
+		return nil
+	}
+
 	cmd := exec.Command(args[0], args[1:]...)
 	cmd.Dir = a.p.Dir
 	cmd.Env = envForDir(cmd.Dir)
-	var buf bytes.Buffer
+	var buf, cacheBuf bytes.Buffer
 	if testStreamOutput {
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
+		if cacheable {
+			cmd.Stdout = io.MultiWriter(os.Stdout, &cacheBuf)
+			cmd.Stderr = io.MultiWriter(os.Stderr, &cacheBuf)
+		}
 	} else {
 		cmd.Stdout = &buf
 		cmd.Stderr = &buf
@@ -940,6 +987,12 @@ func (b *builder) runTest(a *action) error {
 			a.testOutput.Write(out)
 		}
 		fmt.Fprintf(a.testOutput, "ok  \t%s\t%s%s\n", a.p.ImportPath, t, coveragePercentage(out))
+		if cacheable {
+			if testStreamOutput {
+				out = cacheBuf.Bytes()
+			}
+			b.cache().putBytes(testID, out)
+		}
 		return nil
 	}
 
@@ -955,6 +1008,74 @@ func (b *builder) runTest(a *action) error {
 	return nil
 }
 
+// testActionID returns the build cache action ID for running the
+// test binary built for a, and whether the result can be cached.
+// The ID covers the test binary, the flags passed to it, and the
+// content of the files in the package directory and its testdata
+// tree, which tests commonly read, but not the name of the directory,
+// so that a result is reused in other checkouts of the same sources.
+// Other inputs, like environment variables and files elsewhere, are
+// not tracked; use -count=1 to run tests that depend on them.
+func (b *builder) testActionID(a *action) (cacheID, bool) {
+	if b.cache() == nil || testNoCache {
+		return cacheID{}, false
+	}
+	h := newCacheHash()
+	h.add("go test v1")
+	id, err := hashFile(a.deps[0].target)
+	if err != nil {
+		return cacheID{}, false
+	}
+	h.add("binary %s", id)
+	h.add("exec %q", findExecCmd())
+	h.add("args %q", testArgs)
+	h.add("cover %v", testCover)
+	ok := true
+	filepath.Walk(a.p.Dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			ok = false
+			return err
+		}
+		rel, _ := filepath.Rel(a.p.Dir, path)
+		if fi.IsDir() {
+			if path != a.p.Dir && rel != "testdata" && !strings.HasPrefix(rel, "testdata"+string(filepath.Separator)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() || strings.HasSuffix(path, ".go") && filepath.Dir(path) == a.p.Dir {
+			// Go sources are already part of the test binary.
+			return nil
+		}
+		id, err := hashFile(path)
+		if err != nil {
+			ok = false
+			return err
+		}
+		h.add("file %s %s", filepath.ToSlash(rel), id)
+		return nil
+	})
+	if !ok {
+		return cacheID{}, false
+	}
+	return h.sum(), true
+}
+
+// cachedTest reports whether the build cache holds the result of a
+// previous successful run of the test with the given action ID.
+// If so, it writes the result to a.testOutput as if the test had run.
+func (b *builder) cachedTest(a *action, testID cacheID) bool {
+	out, err := b.cache().getBytes(testID)
+	if err != nil {
+		return false
+	}
+	if testShowPass {
+		a.testOutput.Write(out)
+	}
+	fmt.Fprintf(a.testOutput, "ok  \t%s\t(cached)%s\n", a.p.ImportPath, coveragePercentage(out))
+	return true
+}
+
 // coveragePercentage returns the coverage results (if enabled) for the
 // test. It uncovers the data by scanning the output from the test run.
 func coveragePercentage(out []byte) string {
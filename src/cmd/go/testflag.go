@@ -31,10 +31,13 @@ var usageMessage = `Usage of go test:
   -covermode="set": specifies mode for coverage analysis
   -coverpkg="": comma-separated list of packages for coverage analysis
   -coverprofile="": passes -test.coverprofile to test if -cover
+  -count=1: passes -test.count to test; disables test result caching
   -cpu="": passes -test.cpu to test
   -cpuprofile="": passes -test.cpuprofile to test
   -memprofile="": passes -test.memprofile to test
   -memprofilerate=0: passes -test.memprofilerate to test
+  -mutexprofile="": passes -test.mutexprofile to test
+  -mutexprofilefraction=0: passes -test.mutexprofilefraction to test
   -blockprofile="": pases -test.blockprofile to test
   -blockprofilerate=0: passes -test.blockprofilerate to test
   -outputdir=$PWD: passes -test.outputdir to test
@@ -42,6 +45,7 @@ var usageMessage = `Usage of go test:
   -run="": passes -test.run to test
   -short=false: passes -test.short to test
   -timeout=0: passes -test.timeout to test
+  -trace="": passes -test.trace to test
   -v=false: passes -test.v to test
 `
 
@@ -91,10 +95,13 @@ var testFlagDefn = []*testFlagSpec{
 	{name: "benchtime", passToTest: true},
 	{name: "covermode"},
 	{name: "coverprofile", passToTest: true},
+	{name: "count", passToTest: true},
 	{name: "cpu", passToTest: true},
 	{name: "cpuprofile", passToTest: true},
 	{name: "memprofile", passToTest: true},
 	{name: "memprofilerate", passToTest: true},
+	{name: "mutexprofile", passToTest: true},
+	{name: "mutexprofilefraction", passToTest: true},
 	{name: "blockprofile", passToTest: true},
 	{name: "blockprofilerate", passToTest: true},
 	{name: "outputdir", passToTest: true},
@@ -102,9 +109,21 @@ var testFlagDefn = []*testFlagSpec{
 	{name: "run", passToTest: true},
 	{name: "short", boolVar: new(bool), passToTest: true},
 	{name: "timeout", passToTest: true},
+	{name: "trace", passToTest: true},
 	{name: "v", boolVar: &testV, passToTest: true},
 }
 
+// testCacheableFlags is the set of flags passed to the test binary
+// that do not prevent reusing a cached test result.  The values of
+// the flags are part of the cache key.
+var testCacheableFlags = map[string]bool{
+	"cpu":      true,
+	"parallel": true,
+	"run":      true,
+	"short":    true,
+	"v":        true,
+}
+
 // testFlags processes the command line, grabbing -x and -c, rewriting known flags
 // to have "test" before them, and reading the command line for the 6.out.
 // Unfortunately for us, we need to do our own flag processing because go test
@@ -145,6 +164,7 @@ func testFlags(args []string) (packageNames, passToTest []string) {
 				packageNames = []string{}
 			}
 			passToTest = append(passToTest, args[i])
+			testNoCache = true
 			continue
 		}
 		var err error
@@ -185,9 +205,13 @@ func testFlags(args []string) (packageNames, passToTest []string) {
 			testBench = true
 		case "timeout":
 			testTimeout = value
-		case "blockprofile", "cpuprofile", "memprofile":
+		case "blockprofile", "cpuprofile", "memprofile", "mutexprofile":
 			testProfile = true
 			testNeedBinary = true
+		case "trace":
+			// Traces carry their own symbols, so unlike the
+			// profiles they do not need the test binary.
+			testProfile = true
 		case "coverpkg":
 			testCover = true
 			if value == "" {
@@ -214,6 +238,9 @@ func testFlags(args []string) (packageNames, passToTest []string) {
 		}
 		if f.passToTest {
 			passToTest = append(passToTest, "-test."+f.name+"="+value)
+			if !testCacheableFlags[f.name] {
+				testNoCache = true
+			}
 		}
 	}
 
@@ -31,6 +31,8 @@ type vcsCmd struct {
 	tagSyncCmd     string   // command to sync to specific tag
 	tagSyncDefault string   // command to sync to default tag
 
+	revCmd string // command to print the revision checked out
+
 	scheme  []string
 	pingCmd string
 }
@@ -81,6 +83,8 @@ var vcsHg = &vcsCmd{
 	tagSyncCmd:     "update -r {tag}",
 	tagSyncDefault: "update default",
 
+	revCmd: "identify -i",
+
 	scheme:  []string{"https", "http", "ssh"},
 	pingCmd: "identify {scheme}://{repo}",
 }
@@ -104,6 +108,8 @@ var vcsGit = &vcsCmd{
 	tagSyncCmd:     "checkout {tag}",
 	tagSyncDefault: "checkout master",
 
+	revCmd: "rev-parse HEAD",
+
 	scheme:  []string{"git", "https", "http", "git+ssh"},
 	pingCmd: "ls-remote {scheme}://{repo}",
 }
@@ -123,6 +129,8 @@ var vcsBzr = &vcsCmd{
 	tagSyncCmd:     "update -r {tag}",
 	tagSyncDefault: "update -r revno:-1",
 
+	revCmd: "revno",
+
 	scheme:  []string{"https", "http", "bzr", "bzr+ssh"},
 	pingCmd: "info {scheme}://{repo}",
 }
@@ -210,6 +218,23 @@ func (v *vcsCmd) run1(dir string, cmdline string, keyval []string, verbose bool)
 	return out, nil
 }
 
+// revision returns the revision checked out in the repository at dir,
+// or "" if it cannot be determined.  A revision with local changes
+// may be marked as such, as in Mercurial's trailing "+".
+func (v *vcsCmd) revision(dir string) string {
+	if v.revCmd == "" {
+		return ""
+	}
+	if _, err := exec.LookPath(v.cmd); err != nil {
+		return ""
+	}
+	out, err := v.run1(dir, v.revCmd, nil, false)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
 // ping pings to determine scheme to use.
 func (v *vcsCmd) ping(scheme, repo string) error {
 	return v.runVerboseOnly(".", v.pingCmd, "scheme", scheme, "repo", repo)
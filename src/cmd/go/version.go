@@ -6,20 +6,129 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 )
 
 var cmdVersion = &Command{
-	Run:       runVersion,
-	UsageLine: "version",
+	UsageLine: "version [-m] [file ...]",
 	Short:     "print Go version",
-	Long:      `Version prints the Go version, as reported by runtime.Version.`,
+	Long: `
+Version prints the build information for Go executables.
+
+Go version reports the Go version used to build each of the named
+executable files.
+
+If no files are named on the command line, go version prints its own
+version information, as reported by runtime.Version.
+
+If a directory is named, go version walks that directory, recursively,
+looking for recognized Go binaries and reporting their versions.
+By default, go version does not report unrecognized files found
+during a directory scan.
+
+The -m flag causes go version to print each executable's embedded
+build information, when available: the path of the main package,
+the main module, the modules or, in GOPATH mode, the repositories
+and revisions providing the linked packages, the packages themselves,
+and the build settings.  Each line is indented by a tab.
+
+The build information is recorded by the linker; see 'go doc debug/buildinfo'
+for a way to read it from Go programs.
+	`,
+}
+
+func init() {
+	cmdVersion.Run = runVersion // break init loop
 }
 
+var versionM = cmdVersion.Flag.Bool("m", false, "")
+
 func runVersion(cmd *Command, args []string) {
-	if len(args) != 0 {
-		cmd.Usage()
+	if len(args) == 0 {
+		if *versionM {
+			fmt.Fprintf(os.Stderr, "go version: -m flag requires file arguments\n")
+			setExitStatus(2)
+			return
+		}
+		fmt.Printf("go version %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+		return
+	}
+
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			errorf("%v", err)
+			continue
+		}
+		if info.IsDir() {
+			scanDir(arg)
+		} else {
+			scanFile(arg, info, true)
+		}
 	}
+}
 
-	fmt.Printf("go version %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+// scanDir scans a directory for executables to run scanFile on.
+func scanDir(dir string) {
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			errorf("%v", err)
+			return nil
+		}
+		if info.Mode().IsRegular() || info.Mode()&os.ModeSymlink != 0 {
+			scanFile(path, info, false)
+		}
+		return nil
+	})
+}
+
+// isExe reports whether the file should be considered executable.
+func isExe(file string, info os.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		return strings.HasSuffix(strings.ToLower(file), ".exe")
+	}
+	return info.Mode().IsRegular() && info.Mode()&0111 != 0
+}
+
+// scanFile scans file to try to report the Go and module versions.
+// If mustPrint is true, scanFile will report any error reading file.
+// Otherwise (mustPrint is false, because scanFile is being called
+// by scanDir) scanFile prints nothing for non-Go executables.
+func scanFile(file string, info os.FileInfo, mustPrint bool) {
+	if info.Mode()&os.ModeSymlink != 0 {
+		// Accept file symlinks only.
+		i, err := os.Stat(file)
+		if err != nil || !i.Mode().IsRegular() {
+			if mustPrint {
+				errorf("%s: symlink", file)
+			}
+			return
+		}
+		info = i
+	}
+
+	if !isExe(file, info) {
+		if mustPrint {
+			errorf("%s: not executable file", file)
+		}
+		return
+	}
+
+	vers, lines, err := readBuildInfo(file)
+	if err != nil {
+		if mustPrint || err != errNotGoExe {
+			errorf("%s: %v", file, err)
+		}
+		return
+	}
+
+	fmt.Printf("%s: %s\n", file, vers)
+	if *versionM {
+		for _, line := range lines {
+			fmt.Printf("\t%s\n", line)
+		}
+	}
 }
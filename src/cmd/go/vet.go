@@ -6,16 +6,17 @@ package main
 
 func init() {
 	addBuildFlagsNX(cmdVet)
+	cmdVet.Flag.BoolVar(&vetJSON, "json", false, "")
 }
 
 var cmdVet = &Command{
 	Run:       runVet,
-	UsageLine: "vet [-n] [-x] [packages]",
+	UsageLine: "vet [-n] [-x] [-json] [packages]",
 	Short:     "run go tool vet on packages",
 	Long: `
 Vet runs the Go vet command on the packages named by the import paths.
 
-For more about vet, see 'godoc code.google.com/p/go.tools/cmd/vet'.
+For more about vet, see 'godoc cmd/vet'.
 For more about specifying packages, see 'go help packages'.
 
 To run the vet tool with specific options, run 'go tool vet'.
@@ -23,15 +24,24 @@ To run the vet tool with specific options, run 'go tool vet'.
 The -n flag prints commands that would be executed.
 The -x flag prints commands as they are executed.
 
+The -json flag prints the diagnostics of each package, including
+suggested fixes, to standard output as a JSON object.
+
 See also: go fmt, go fix.
 	`,
 }
 
+var vetJSON bool // -json flag
+
 func runVet(cmd *Command, args []string) {
+	var flags []string
+	if vetJSON {
+		flags = append(flags, "-json")
+	}
 	for _, pkg := range packages(args) {
 		// Use pkg.gofiles instead of pkg.Dir so that
 		// the command only applies to this package,
 		// not to packages in subdirectories.
-		run(tool("vet"), relPaths(stringList(pkg.gofiles, pkg.sfiles)))
+		run(tool("vet"), flags, relPaths(stringList(pkg.gofiles, pkg.sfiles)))
 	}
 }
@@ -29,6 +29,9 @@ The flags are:
 		Apply the rewrite rule to the source before reformatting.
 	-s
 		Try to simplify code (after applying the rewrite rule, if any).
+	-t template,...
+		Apply the type-aware rewrite templates in the named files
+		to the source before reformatting.
 	-w
 		Do not print reformatted sources to standard output.
 		If a file's formatting is different from gofmt's, overwrite it
@@ -48,6 +51,27 @@ In the pattern, single-character lowercase identifiers serve as
 wildcards matching arbitrary sub-expressions; those expressions
 will be substituted for the same identifiers in the replacement.
 
+The rewrite templates named with the -t flag are Go source files
+declaring two functions before and after with identical signatures,
+each consisting of a single return or expression statement:
+
+	package template
+
+	import "os"
+
+	func before(f *os.File) error { return f.Close() }
+	func after(f *os.File) error  { return f.Sync() }
+
+The parameters serve as wildcards that match only sub-expressions of
+the parameter's type, so this template rewrites calls of Close on
+*os.File values but on no other type. Identifiers referring to other
+objects match regardless of how they are qualified. Imports needed by
+the replacement are added and imports no longer used are removed.
+To find the types of expressions, gofmt type-checks each file together
+with the other files of its package in the same directory, using the
+installed packages for imports. Overlapping rewrites are reported as
+errors and not applied.
+
 When gofmt reads from standard input, it accepts either a full Go program
 or a program fragment.  A program fragment must be a syntactically
 valid declaration list, statement list, or expression.  When formatting
@@ -27,6 +27,7 @@ var (
 	list        = flag.Bool("l", false, "list files whose formatting differs from gofmt's")
 	write       = flag.Bool("w", false, "write result to (source) file instead of stdout")
 	rewriteRule = flag.String("r", "", "rewrite rule (e.g., 'a[b:len(a)] -> a[b:]')")
+	rewriteTmpl = flag.String("t", "", "comma-separated list of type-aware rewrite template files")
 	simplifyAST = flag.Bool("s", false, "simplify code")
 	doDiff      = flag.Bool("d", false, "display diffs instead of rewriting files")
 	allErrors   = flag.Bool("e", false, "report all errors (not just the first 10 on different lines)")
@@ -43,7 +44,7 @@ const (
 var (
 	fileSet    = token.NewFileSet() // per process FileSet
 	exitCode   = 0
-	rewrite    func(*ast.File) *ast.File
+	applyRule  func(*ast.File) *ast.File
 	parserMode parser.Mode
 )
 
@@ -92,14 +93,24 @@ func processFile(filename string, in io.Reader, out io.Writer, stdin bool) error
 		return err
 	}
 
-	if rewrite != nil {
+	if applyRule != nil {
 		if adjust == nil {
-			file = rewrite(file)
+			file = applyRule(file)
 		} else {
 			fmt.Fprintf(os.Stderr, "warning: rewrite ignored for incomplete programs\n")
 		}
 	}
 
+	if templates != nil {
+		if adjust == nil {
+			if err := applyTemplates(filename, file, stdin); err != nil {
+				report(err)
+			}
+		} else {
+			fmt.Fprintf(os.Stderr, "warning: templates ignored for incomplete programs\n")
+		}
+	}
+
 	ast.SortImports(fileSet, file)
 
 	if *simplifyAST {
@@ -184,6 +195,7 @@ func gofmtMain() {
 
 	initParserMode()
 	initRewrite()
+	initTemplates()
 
 	if flag.NArg() == 0 {
 		if err := processFile("<standard input>", os.Stdin, os.Stdout, true); err != nil {
@@ -16,6 +16,7 @@ func runTest(t *testing.T, in, out, flags string) {
 	// process flags
 	*simplifyAST = false
 	*rewriteRule = ""
+	*rewriteTmpl = ""
 	stdin := false
 	for _, flag := range strings.Split(flags, " ") {
 		elts := strings.SplitN(flag, "=", 2)
@@ -31,6 +32,8 @@ func runTest(t *testing.T, in, out, flags string) {
 			*rewriteRule = value
 		case "-s":
 			*simplifyAST = true
+		case "-t":
+			*rewriteTmpl = value
 		case "-stdin":
 			// fake flag - pretend input is from stdin
 			stdin = true
@@ -41,6 +44,7 @@ func runTest(t *testing.T, in, out, flags string) {
 
 	initParserMode()
 	initRewrite()
+	initTemplates()
 
 	var buf bytes.Buffer
 	err := processFile(in, nil, &buf, stdin)
@@ -84,6 +88,7 @@ var tests = []struct {
 	{"testdata/rewrite6.input", "-r=fun(x)->Fun(x)"},
 	{"testdata/rewrite7.input", "-r=fun(x...)->Fun(x)"},
 	{"testdata/rewrite8.input", "-r=interface{}->int"},
+	{"testdata/template1.input", "-t=testdata/template1.template"},
 	{"testdata/stdin*.input", "-stdin"},
 	{"testdata/comments.input", ""},
 	{"testdata/import.input", ""},
@@ -18,7 +18,7 @@ import (
 
 func initRewrite() {
 	if *rewriteRule == "" {
-		rewrite = nil // disable any previous rewrite
+		applyRule = nil // disable any previous rewrite
 		return
 	}
 	f := strings.Split(*rewriteRule, "->")
@@ -28,7 +28,7 @@ func initRewrite() {
 	}
 	pattern := parseExpr(f[0], "pattern")
 	replace := parseExpr(f[1], "replacement")
-	rewrite = func(p *ast.File) *ast.File { return rewriteFile(pattern, replace, p) }
+	applyRule = func(p *ast.File) *ast.File { return rewriteFile(pattern, replace, p) }
 }
 
 // parseExpr parses s as an expression.
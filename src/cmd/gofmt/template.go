@@ -0,0 +1,89 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/rewrite"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	templates     []*rewrite.Template
+	typesImporter types.Importer
+)
+
+func initTemplates() {
+	templates = nil // disable any previous templates
+	if *rewriteTmpl == "" {
+		return
+	}
+	typesImporter = importer.Default()
+	for _, filename := range strings.Split(*rewriteTmpl, ",") {
+		f, err := parser.ParseFile(fileSet, filename, nil, 0)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "parsing template %s\n", err)
+			os.Exit(2)
+		}
+		t, err := rewrite.NewTemplate(fileSet, f, typesImporter)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "template %s\n", err)
+			os.Exit(2)
+		}
+		templates = append(templates, t)
+	}
+}
+
+// applyTemplates rewrites file, read from filename, using the templates.
+// Conflicting rewrites are not applied but reported in the result.
+func applyTemplates(filename string, file *ast.File, stdin bool) error {
+	_, err := rewrite.Apply(fileSet, file, typeCheck(filename, file, stdin), templates...)
+	return err
+}
+
+// typeCheck type-checks file, read from filename, together with the other
+// files of its package in the same directory. Type errors are ignored:
+// expressions whose types are unknown don't match any typed wildcards.
+func typeCheck(filename string, file *ast.File, stdin bool) *types.Info {
+	files := []*ast.File{file}
+	if !stdin {
+		dir, base := filepath.Dir(filename), filepath.Base(filename)
+		if p, err := build.ImportDir(dir, 0); err == nil {
+			var names []string
+			names = append(names, p.GoFiles...)
+			names = append(names, p.CgoFiles...)
+			names = append(names, p.TestGoFiles...)
+			names = append(names, p.XTestGoFiles...)
+			for _, name := range names {
+				if name == base {
+					continue
+				}
+				f, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, 0)
+				if err == nil && f.Name.Name == file.Name.Name {
+					files = append(files, f)
+				}
+			}
+		}
+	}
+
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{
+		Importer:    typesImporter,
+		FakeImportC: true,
+		Error:       func(error) {},
+	}
+	conf.Check(file.Name.Name, fileSet, files, info)
+	return info
+}
@@ -0,0 +1,62 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of ELF executables (Linux, FreeBSD, and so on).
+
+package objfile
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+)
+
+func elfLoad(f *os.File) (*File, error) {
+	p, err := elf.NewFile(f)
+	if err != nil {
+		return nil, err
+	}
+	text := p.Section(".text")
+	if text == nil {
+		return nil, fmt.Errorf("no .text section")
+	}
+	obj := &File{textStart: text.Addr}
+	if obj.text, err = text.Data(); err != nil {
+		return nil, err
+	}
+	switch p.Machine {
+	case elf.EM_386:
+		obj.goarch = "386"
+	case elf.EM_X86_64:
+		obj.goarch = "amd64"
+	case elf.EM_ARM:
+		obj.goarch = "arm"
+	default:
+		obj.goarch = p.Machine.String()
+	}
+
+	// A stripped binary has no symbols, which is not an error.
+	elfSyms, _ := p.Symbols()
+	for _, s := range elfSyms {
+		switch elf.ST_TYPE(s.Info) {
+		case elf.STT_SECTION, elf.STT_FILE:
+			continue
+		}
+		i := int(s.Section)
+		if s.Name == "" || i <= 0 || i >= len(p.Sections) {
+			continue
+		}
+		sym := Sym{Addr: s.Value, Size: s.Size, Name: s.Name, Code: 'D'}
+		if p.Sections[i].Flags&elf.SHF_EXECINSTR != 0 {
+			sym.Code = 'T'
+		}
+		if elf.ST_BIND(s.Info) == elf.STB_LOCAL {
+			sym.Code += 'a' - 'A'
+		}
+		obj.syms = append(obj.syms, sym)
+	}
+
+	obj.lines = symbolizeLines(f)
+	return obj, nil
+}
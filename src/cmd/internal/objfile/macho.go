@@ -0,0 +1,56 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of Mach-O executables (OS X).
+
+package objfile
+
+import (
+	"debug/macho"
+	"fmt"
+	"os"
+)
+
+func machoLoad(f *os.File) (*File, error) {
+	p, err := macho.NewFile(f)
+	if err != nil {
+		return nil, err
+	}
+	text := p.Section("__text")
+	if text == nil {
+		return nil, fmt.Errorf("no __text section")
+	}
+	obj := &File{textStart: text.Addr}
+	if obj.text, err = text.Data(); err != nil {
+		return nil, err
+	}
+	switch p.Cpu {
+	case macho.Cpu386:
+		obj.goarch = "386"
+	case macho.CpuAmd64:
+		obj.goarch = "amd64"
+	case macho.CpuArm:
+		obj.goarch = "arm"
+	default:
+		obj.goarch = p.Cpu.String()
+	}
+
+	if p.Symtab != nil {
+		const stab = 0xe0 // N_STAB: debugging symbols
+		for _, s := range p.Symtab.Syms {
+			i := int(s.Sect)
+			if s.Type&stab != 0 || i <= 0 || i > len(p.Sections) {
+				continue
+			}
+			sym := Sym{Addr: s.Value, Name: s.Name, Code: 'D'}
+			if sect := p.Sections[i-1]; sect.Seg == "__TEXT" && sect.Name == "__text" {
+				sym.Code = 'T'
+			}
+			obj.syms = append(obj.syms, sym)
+		}
+	}
+
+	obj.lines = symbolizeLines(f)
+	return obj, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package objfile reads the text, symbols and line tables of ELF,
+// Mach-O, PE and Plan 9 executables and disassembles their code,
+// for the Go tools objdump and pprof.
+package objfile
+
+import (
+	"bytes"
+	"debug/armasm"
+	"debug/gosym"
+	"debug/symbolize"
+	"debug/x86asm"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// A Sym is a symbol defined in an executable.
+type Sym struct {
+	Addr uint64
+	Size uint64
+	Code rune // 'T' or 't' for text symbols, as printed by nm
+	Name string
+}
+
+// IsText reports whether s is a text symbol, one defined
+// in a section holding code.
+func (s Sym) IsText() bool {
+	return s.Code == 'T' || s.Code == 't'
+}
+
+// A File is an executable opened for disassembly.
+type File struct {
+	f         *os.File
+	goarch    string
+	textStart uint64
+	text      []byte
+	syms      []Sym // sorted by address
+	lines     func(pc uint64) (file string, line int)
+}
+
+var loaders = []struct {
+	prefix []byte
+	load   func(*os.File) (*File, error)
+}{
+	{[]byte("\x7FELF"), elfLoad},
+	{[]byte("\xFE\xED\xFA\xCE"), machoLoad},
+	{[]byte("\xFE\xED\xFA\xCF"), machoLoad},
+	{[]byte("\xCE\xFA\xED\xFE"), machoLoad},
+	{[]byte("\xCF\xFA\xED\xFE"), machoLoad},
+	{[]byte("MZ"), peLoad},
+	{[]byte("\x00\x00\x01\xEB"), plan9Load}, // 386
+	{[]byte("\x00\x00\x06\x47"), plan9Load}, // arm
+	{[]byte("\x00\x00\x8A\x97"), plan9Load}, // amd64
+}
+
+// Open opens the named executable and reads its text,
+// symbols and line tables.
+func Open(name string) (*File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := load(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("reading %s: %v", name, err)
+	}
+	obj.f = f
+	return obj, nil
+}
+
+// Close closes the File.
+func (obj *File) Close() error {
+	if obj.f == nil {
+		return nil
+	}
+	return obj.f.Close()
+}
+
+// load reads the text, symbols and line tables of the executable f.
+func load(f *os.File) (*File, error) {
+	buf := make([]byte, 16)
+	io.ReadFull(f, buf)
+	f.Seek(0, 0)
+
+	for _, l := range loaders {
+		if bytes.HasPrefix(buf, l.prefix) {
+			obj, err := l.load(f)
+			if err != nil {
+				return nil, err
+			}
+			sort.Sort(byAddr(obj.syms))
+			// Symbol tables do not always record sizes.
+			// Assume such symbols extend to the next one.
+			for i := range obj.syms {
+				s := &obj.syms[i]
+				if s.Size != 0 {
+					continue
+				}
+				for _, next := range obj.syms[i+1:] {
+					if next.Addr > s.Addr {
+						s.Size = next.Addr - s.Addr
+						break
+					}
+				}
+				if s.Size == 0 && s.IsText() && s.Addr < obj.textEnd() {
+					s.Size = obj.textEnd() - s.Addr
+				}
+			}
+			if obj.lines == nil {
+				obj.lines = noLines
+			}
+			return obj, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown file format")
+}
+
+type byAddr []Sym
+
+func (x byAddr) Len() int           { return len(x) }
+func (x byAddr) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+func (x byAddr) Less(i, j int) bool { return x[i].Addr < x[j].Addr }
+
+func noLines(pc uint64) (string, int) {
+	return "", 0
+}
+
+// symbolizeLines returns a function mapping PCs to source lines
+// using the Go line table and DWARF debugging information in r.
+func symbolizeLines(r io.ReaderAt) func(uint64) (string, int) {
+	s, err := symbolize.New(r)
+	if err != nil {
+		return noLines
+	}
+	return func(pc uint64) (string, int) {
+		frames, err := s.Frames(pc)
+		if err != nil || len(frames) == 0 {
+			return "", 0
+		}
+		return frames[0].File, frames[0].Line
+	}
+}
+
+// gosymLines returns a function mapping PCs to source lines
+// using the Go symbol and line tables symtab and pclntab.
+func gosymLines(symtab, pclntab []byte, textStart uint64) func(uint64) (string, int) {
+	tab, err := gosym.NewTable(symtab, gosym.NewLineTable(pclntab, textStart))
+	if err != nil {
+		return noLines
+	}
+	return func(pc uint64) (string, int) {
+		file, line, fn := tab.PCToLine(pc)
+		if fn == nil {
+			return "", 0
+		}
+		return file, line
+	}
+}
+
+// GOARCH returns the architecture of the code in the executable,
+// such as "amd64", or a description of the unknown machine.
+func (obj *File) GOARCH() string {
+	return obj.goarch
+}
+
+// Symbols returns the symbols of the executable, sorted by address.
+// Symbols without a size in the symbol table extend to the next symbol.
+func (obj *File) Symbols() []Sym {
+	return obj.syms
+}
+
+// Text returns the address and contents of the text section.
+func (obj *File) Text() (start uint64, text []byte) {
+	return obj.textStart, obj.text
+}
+
+func (obj *File) textEnd() uint64 {
+	return obj.textStart + uint64(len(obj.text))
+}
+
+// PCToLine returns the source file and line of the instruction at pc,
+// or "", 0 if they are unknown.
+func (obj *File) PCToLine(pc uint64) (file string, line int) {
+	return obj.lines(pc)
+}
+
+// lookup returns the name and address of the symbol containing addr.
+func (obj *File) lookup(addr uint64) (name string, base uint64) {
+	i := sort.Search(len(obj.syms), func(i int) bool { return obj.syms[i].Addr > addr }) - 1
+	if i < 0 {
+		return "", 0
+	}
+	if s := obj.syms[i]; addr < s.Addr+s.Size {
+		return s.Name, s.Addr
+	}
+	return "", 0
+}
+
+// textReader reads from the text section at the addresses of its
+// contents, for the ARM disassembler to display PC-relative loads.
+type textReader struct {
+	obj *File
+}
+
+func (r textReader) ReadAt(p []byte, off int64) (int, error) {
+	obj, addr := r.obj, uint64(off)
+	if addr < obj.textStart || addr >= obj.textEnd() {
+		return 0, io.EOF
+	}
+	n := copy(p, obj.text[addr-obj.textStart:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Decode disassembles the instruction at pc, returning it in Go and in
+// GNU assembler syntax along with its size in bytes. An instruction
+// that cannot be decoded is returned as "?", with a size of one
+// instruction on fixed-size architectures and one byte otherwise.
+// Code is decoded only for the 386, amd64 and arm architectures.
+func (obj *File) Decode(pc uint64) (asm, gnu string, size int) {
+	var code []byte
+	if obj.textStart <= pc && pc < obj.textEnd() {
+		code = obj.text[pc-obj.textStart:]
+	}
+	switch obj.goarch {
+	case "386", "amd64":
+		mode := 64
+		if obj.goarch == "386" {
+			mode = 32
+		}
+		inst, err := x86asm.Decode(code, mode)
+		if err != nil || inst.Len == 0 {
+			return "?", "?", 1
+		}
+		return x86asm.GoSyntax(inst, pc, obj.lookup), x86asm.GNUSyntax(inst, pc, obj.lookup), inst.Len
+	case "arm":
+		inst, err := armasm.Decode(code, armasm.ModeARM)
+		if err != nil {
+			return "?", "?", 4
+		}
+		return armasm.GoSyntax(inst, pc, obj.lookup, textReader{obj}), armasm.GNUSyntax(inst), inst.Len
+	}
+	return "?", "?", 1
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package objfile
+
+import "testing"
+
+const inlineExec = "../../../pkg/debug/symbolize/testdata/inline-gcc-amd64-linux-exec"
+
+func TestOpen(t *testing.T) {
+	obj, err := Open(inlineExec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+
+	if obj.GOARCH() != "amd64" {
+		t.Errorf("GOARCH() = %q, want amd64", obj.GOARCH())
+	}
+	want := map[string]Sym{
+		"main":  {Addr: 0x1040, Size: 0xd, Code: 'T', Name: "main"},
+		"outer": {Addr: 0x1140, Size: 0x29, Code: 'T', Name: "outer"},
+		"sink":  {Addr: 0x4014, Size: 0x4, Code: 'D', Name: "sink"},
+	}
+	for _, s := range obj.Symbols() {
+		if w, ok := want[s.Name]; ok {
+			if s != w {
+				t.Errorf("symbol %s = %+v, want %+v", s.Name, s, w)
+			}
+			delete(want, s.Name)
+		}
+	}
+	for name := range want {
+		t.Errorf("missing symbol %s", name)
+	}
+
+	start, text := obj.Text()
+	if start > 0x1040 || start+uint64(len(text)) < 0x1169 {
+		t.Errorf("text section [%#x, %#x) does not hold main and outer", start, start+uint64(len(text)))
+	}
+	if file, line := obj.PCToLine(0x1150); file != "/tmp/sym/inline.c" || line != 15 {
+		t.Errorf("PCToLine(0x1150) = %s:%d, want /tmp/sym/inline.c:15", file, line)
+	}
+	if asm, gnu, size := obj.Decode(0x1045); asm != "CALL outer(SB)" || gnu != "callq outer" || size != 5 {
+		t.Errorf("Decode(0x1045) = %q, %q, %d, want %q, %q, 5", asm, gnu, size, "CALL outer(SB)", "callq outer")
+	}
+}
+
+func TestDecodeARM(t *testing.T) {
+	obj := &File{
+		goarch:    "arm",
+		textStart: 0x1000,
+		text: []byte{
+			0x01, 0x00, 0xa0, 0xe3, // mov r0, #1
+			0x04, 0x10, 0x9f, 0xe5, // ldr r1, [pc, #4]
+			0x01, 0x00, 0x80, 0xe0, // add r0, r0, r1
+			0x1e, 0xff, 0x2f, 0xe1, // bx lr
+			0x78, 0x56, 0x34, 0x12, // constant loaded by ldr
+		},
+		syms:  []Sym{{Addr: 0x1000, Size: 0x14, Code: 'T', Name: "f"}},
+		lines: noLines,
+	}
+	want := []struct{ asm, gnu string }{
+		{"MOVW $1, R0", "mov r0, #1"},
+		{"MOVW $0x12345678, R1", "ldr r1, [pc, #4]"},
+		{"ADD R1, R0, R0", "add r0, r0, r1"},
+		{"BX R14", "bx lr"},
+	}
+	pc := obj.textStart
+	for _, w := range want {
+		asm, gnu, size := obj.Decode(pc)
+		if asm != w.asm || gnu != w.gnu || size != 4 {
+			t.Errorf("Decode(%#x) = %q, %q, %d, want %q, %q, 4", pc, asm, gnu, size, w.asm, w.gnu)
+		}
+		pc += 4
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of PE executables (Microsoft Windows).
+
+package objfile
+
+import (
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+func peLoad(f *os.File) (*File, error) {
+	p, err := pe.NewFile(f)
+	if err != nil {
+		return nil, err
+	}
+	base, err := peImageBase(f)
+	if err != nil {
+		return nil, err
+	}
+	text := p.Section(".text")
+	if text == nil {
+		return nil, fmt.Errorf("no .text section")
+	}
+	obj := &File{textStart: base + uint64(text.VirtualAddress)}
+	if obj.text, err = text.Data(); err != nil {
+		return nil, err
+	}
+	// The section is padded in the file.
+	if int(text.VirtualSize) < len(obj.text) {
+		obj.text = obj.text[:text.VirtualSize]
+	}
+	switch p.Machine {
+	case pe.IMAGE_FILE_MACHINE_I386:
+		obj.goarch = "386"
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		obj.goarch = "amd64"
+	case pe.IMAGE_FILE_MACHINE_ARM:
+		obj.goarch = "arm"
+	default:
+		obj.goarch = fmt.Sprintf("machine %#x", p.Machine)
+	}
+
+	for _, s := range p.Symbols {
+		i := int(s.SectionNumber)
+		if i <= 0 || i > len(p.Sections) {
+			continue
+		}
+		const text = 0x20 // IMAGE_SCN_CNT_CODE
+		sect := p.Sections[i-1]
+		sym := Sym{Addr: base + uint64(sect.VirtualAddress) + uint64(s.Value), Name: s.Name, Code: 'D'}
+		if sect.Characteristics&text != 0 {
+			sym.Code = 'T'
+		}
+		obj.syms = append(obj.syms, sym)
+	}
+
+	obj.lines = symbolizeLines(f)
+	return obj, nil
+}
+
+// peImageBase returns the preferred load address recorded in the
+// optional header of the PE executable r.  Package debug/pe does not
+// parse the optional header.
+func peImageBase(r io.ReaderAt) (uint64, error) {
+	var buf [8]byte
+	if _, err := r.ReadAt(buf[:4], 0x3c); err != nil {
+		return 0, err
+	}
+	// The optional header follows the 4-byte signature
+	// and the 20-byte file header.
+	opt := int64(binary.LittleEndian.Uint32(buf[:])) + 4 + 20
+	if _, err := r.ReadAt(buf[:2], opt); err != nil {
+		return 0, err
+	}
+	switch binary.LittleEndian.Uint16(buf[:]) {
+	case 0x10b: // PE32
+		if _, err := r.ReadAt(buf[:4], opt+28); err != nil {
+			return 0, err
+		}
+		return uint64(binary.LittleEndian.Uint32(buf[:])), nil
+	case 0x20b: // PE32+
+		if _, err := r.ReadAt(buf[:8], opt+24); err != nil {
+			return 0, err
+		}
+		return binary.LittleEndian.Uint64(buf[:]), nil
+	}
+	return 0, fmt.Errorf("unknown PE optional header")
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of Plan 9 a.out executables.
+
+package objfile
+
+import (
+	"debug/plan9obj"
+	"fmt"
+	"os"
+)
+
+// plan9Layouts gives the architecture, load address and page size
+// of Plan 9 executables, by magic number.
+var plan9Layouts = map[string]struct {
+	goarch   string
+	load     uint64
+	pageSize uint64
+}{
+	"\x00\x00\x01\xEB": {"386", 0x1000, 0x1000},
+	"\x00\x00\x06\x47": {"arm", 0x1000, 0x1000},
+	"\x00\x00\x8A\x97": {"amd64", 0x200000, 0x200000},
+}
+
+func plan9Load(f *os.File) (*File, error) {
+	p, err := plan9obj.NewFile(f)
+	if err != nil {
+		return nil, err
+	}
+	var magic [4]byte
+	if _, err := f.ReadAt(magic[:], 0); err != nil {
+		return nil, err
+	}
+	layout, ok := plan9Layouts[string(magic[:])]
+	if !ok {
+		return nil, fmt.Errorf("unknown Plan 9 magic number %x", magic)
+	}
+	text := p.Section("text")
+	data := p.Section("data")
+	if text == nil || data == nil {
+		return nil, fmt.Errorf("missing text or data section")
+	}
+	// The header is loaded at the start of the text segment,
+	// and the data segment starts at the next page.
+	obj := &File{goarch: layout.goarch, textStart: layout.load + uint64(text.Offset)}
+	if obj.text, err = text.Data(); err != nil {
+		return nil, err
+	}
+	dataStart := (obj.textEnd() + layout.pageSize - 1) &^ (layout.pageSize - 1)
+
+	plan9Syms, err := p.Symbols()
+	if err != nil {
+		return nil, err
+	}
+	var pclntab, epclntab uint64
+	for _, s := range plan9Syms {
+		switch s.Name {
+		case "runtime.pclntab":
+			pclntab = s.Value
+		case "runtime.epclntab":
+			epclntab = s.Value
+		}
+		switch s.Type {
+		case 'T', 't', 'L', 'l':
+			obj.syms = append(obj.syms, Sym{Addr: s.Value, Name: s.Name, Code: 'T'})
+		case 'D', 'd', 'B', 'b':
+			obj.syms = append(obj.syms, Sym{Addr: s.Value, Name: s.Name, Code: 'D'})
+		}
+	}
+
+	// The Go line table is in the text or data segment,
+	// between the runtime.pclntab and runtime.epclntab symbols.
+	if pclntab != 0 && pclntab < epclntab {
+		var tab []byte
+		switch {
+		case obj.textStart <= pclntab && epclntab <= obj.textEnd():
+			tab = obj.text[pclntab-obj.textStart : epclntab-obj.textStart]
+		case dataStart <= pclntab:
+			tab = make([]byte, epclntab-pclntab)
+			if _, err := data.ReadAt(tab, int64(pclntab-dataStart)); err != nil {
+				tab = nil
+			}
+		}
+		if tab != nil {
+			obj.lines = gosymLines(nil, tab, obj.textStart)
+		}
+	}
+	return obj, nil
+}
@@ -597,6 +597,67 @@ addstring(LSym *s, char *str)
 	return r;
 }
 
+// The build information record starts with this magic string,
+// followed by the pointer size and a flags byte, padded to 32 bytes.
+// Flag 2 means the Go version and the build information follow
+// inline, each as a uvarint length and the bytes of the string.
+// The record is 16-byte aligned in the data segment, where
+// readers such as debug/buildinfo look for it.
+static char buildinfomagic[] = "\xff Go buildinf:";
+
+static void
+addbuildstring(LSym *s, char *str, vlong n)
+{
+	uvlong v;
+	vlong i;
+
+	for(v = n; v >= 0x80; v >>= 7)
+		adduint8(ctxt, s, v | 0x80);
+	adduint8(ctxt, s, v);
+	for(i = 0; i < n; i++)
+		adduint8(ctxt, s, str[i]);
+}
+
+void
+dobuildinfo(void)
+{
+	LSym *s;
+	Biobuf *f;
+	char *info;
+	vlong n;
+
+	info = "";
+	n = 0;
+	if(buildinfofile != nil) {
+		f = Bopen(buildinfofile, OREAD);
+		if(f == nil) {
+			diag("cannot open build information file %s: %r", buildinfofile);
+			errorexit();
+		}
+		n = Bseek(f, 0, 2);
+		Bseek(f, 0, 0);
+		info = mal(n);
+		if(Bread(f, info, n) != n) {
+			diag("short read of build information file %s", buildinfofile);
+			errorexit();
+		}
+		Bterm(f);
+	}
+
+	s = linklookup(ctxt, "go.buildinfo", 0);
+	s->type = SNOPTRDATA;
+	s->reachable = 1;
+	s->align = 16;
+	s->size = 0;
+	symgrow(ctxt, s, 32);
+	memmove(s->p, buildinfomagic, strlen(buildinfomagic));
+	s->p[14] = PtrSize;
+	s->p[15] = 2;
+	s->size = 32;
+	addbuildstring(s, getgoversion(), strlen(getgoversion()));
+	addbuildstring(s, info, n);
+}
+
 void
 dosymtype(void)
 {
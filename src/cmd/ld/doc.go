@@ -68,6 +68,10 @@ Options new in this version:
 		as displayed in the symbol table printed by "go tool nm".
 	-race
 		Link with race detection libraries.
+	-buildinfo file
+		Embed the build information in file, as written by the go command,
+		in the binary.  The binary always records the Go version of the linker.
+		Tools such as "go version" read the information back.
 	-B value
 		Add a NT_GNU_BUILD_ID note when using ELF.  The value
 		should start with 0x and be an even number of hex digits.
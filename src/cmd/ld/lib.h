@@ -81,6 +81,7 @@ EXTERN	char*	flag_installsuffix;
 EXTERN	int	flag_race;
 EXTERN	int flag_shared;
 EXTERN	char*	tracksym;
+EXTERN	char*	buildinfofile;
 EXTERN	char*	interpreter;
 EXTERN	char*	tmpdir;
 EXTERN	char*	extld;
@@ -206,6 +207,7 @@ int	decodetype_structfieldcount(LSym *s);
 char*	decodetype_structfieldname(LSym *s, int i);
 vlong	decodetype_structfieldoffs(LSym *s, int i);
 LSym*	decodetype_structfieldtype(LSym *s, int i);
+void	dobuildinfo(void);
 void	dodata(void);
 void	dostkcheck(void);
 void	dostkoff(void);
@@ -94,6 +94,7 @@ main(int argc, char *argv[])
 	flagfn2("X", "name value: define string data", addstrdata);
 	flagcount("Z", "clear stack frame on entry", &debug['Z']);
 	flagcount("a", "disassemble output", &debug['a']);
+	flagstr("buildinfo", "file: embed build information read from file", &buildinfofile);
 	flagcount("c", "dump call graph", &debug['c']);
 	flagcount("d", "disable dynamic executable", &debug['d']);
 	flagstr("extld", "linker to run in external mode", &extld);
@@ -165,6 +166,7 @@ main(int argc, char *argv[])
 	}
 
 	deadcode();
+	dobuildinfo();
 	paramspace = "SP";	/* (FP) now (SP) on output */
 
 	doelf();
@@ -0,0 +1,218 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Objdump disassembles executable files.
+//
+// Usage:
+//
+//	go tool objdump [-s symregexp] [-S] [-gnu] binary
+//
+// Objdump prints a disassembly of all text symbols (code) in the binary.
+// If the -s option is present, objdump only disassembles
+// symbols with names matching the regular expression.
+//
+// The -S flag causes objdump to print each source line
+// before the instructions compiled from it.
+//
+// The -gnu flag causes objdump to print the GNU assembler syntax
+// of each instruction next to the Go assembler syntax.
+//
+// Alternate usage:
+//
+//	go tool objdump binary start end
+//
+// In this mode, objdump disassembles the binary starting at the start address and
+// stopping at the end address. The start and end addresses are program
+// counters written in hexadecimal with optional leading 0x prefix.
+// In this mode, objdump prints a sequence of stanzas of the form:
+//
+//	file:line
+//	 address: assembly
+//	 address: assembly
+//	 ...
+//
+// Each stanza gives the disassembly for a contiguous range of addresses
+// all mapped to the same original source file and line number.
+// This mode is intended for use by pprof.
+//
+// Objdump disassembles 386, amd64 and arm code in ELF, Mach-O, PE
+// and Plan 9 executables.  Source lines are found using the Go line
+// tables and, for C code linked using cgo, the DWARF debugging
+// information in the binary.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"cmd/internal/objfile"
+)
+
+var (
+	symregexp = flag.String("s", "", "only dump symbols matching this regexp")
+	printCode = flag.Bool("S", false, "print source lines alongside assembly")
+	gnuAsm    = flag.Bool("gnu", false, "print GNU assembly next to Go assembly")
+
+	symRE *regexp.Regexp
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: go tool objdump [-s symregexp] [-S] [-gnu] binary [start end]\n\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("objdump: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 && flag.NArg() != 3 {
+		usage()
+	}
+
+	if *symregexp != "" {
+		re, err := regexp.Compile(*symregexp)
+		if err != nil {
+			log.Fatalf("invalid -s regexp: %v", err)
+		}
+		symRE = re
+	}
+
+	obj, err := objfile.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer obj.Close()
+
+	switch obj.GOARCH() {
+	case "386", "amd64", "arm":
+		// ok
+	default:
+		log.Fatalf("reading %s: disassembly of %q binaries not supported", flag.Arg(0), obj.GOARCH())
+	}
+
+	stdout := bufio.NewWriter(os.Stdout)
+	defer stdout.Flush()
+
+	if flag.NArg() == 1 {
+		dump(stdout, obj)
+		return
+	}
+
+	// Disassembly of PC range, for pprof.
+	start, err := strconv.ParseUint(strings.TrimPrefix(flag.Arg(1), "0x"), 16, 64)
+	if err != nil {
+		log.Fatalf("invalid start PC: %v", err)
+	}
+	end, err := strconv.ParseUint(strings.TrimPrefix(flag.Arg(2), "0x"), 16, 64)
+	if err != nil {
+		log.Fatalf("invalid end PC: %v", err)
+	}
+	dumpRange(stdout, obj, start, end)
+}
+
+// dump prints the disassembly of the text symbols matching -s.
+func dump(w io.Writer, obj *objfile.File) {
+	var src sourceCache
+	textStart, text := obj.Text()
+	textEnd := textStart + uint64(len(text))
+	for _, sym := range obj.Symbols() {
+		if !sym.IsText() || symRE != nil && !symRE.MatchString(sym.Name) {
+			continue
+		}
+		if sym.Addr < textStart || sym.Addr >= textEnd {
+			// In a section other than the text section.
+			continue
+		}
+		end := sym.Addr + sym.Size
+		if end > textEnd {
+			end = textEnd
+		}
+		file, _ := obj.PCToLine(sym.Addr)
+		fmt.Fprintf(w, "TEXT %s(SB) %s\n", sym.Name, file)
+
+		tw := tabwriter.NewWriter(w, 1, 8, 1, '\t', 0)
+		lastFile, lastLine := "", 0
+		for pc := sym.Addr; pc < end; {
+			asm, gnu, size := obj.Decode(pc)
+			if pc+uint64(size) > end {
+				size = int(end - pc)
+			}
+			file, line := obj.PCToLine(pc)
+			if *printCode && (file != lastFile || line != lastLine) {
+				if text := src.line(file, line); text != "" {
+					// Source lines contain tabs of their own,
+					// so keep them out of the table.
+					tw.Flush()
+					fmt.Fprintf(w, "  %s\n", text)
+				}
+			}
+			lastFile, lastLine = file, line
+			if file == "" {
+				file = "?"
+			}
+			fmt.Fprintf(tw, "\t%s:%d\t%#x\t%x\t%s", filepath.Base(file), line, pc, text[pc-textStart:pc-textStart+uint64(size)], asm)
+			if *gnuAsm {
+				fmt.Fprintf(tw, "\t// %s", gnu)
+			}
+			fmt.Fprintf(tw, "\n")
+			pc += uint64(size)
+		}
+		tw.Flush()
+		fmt.Fprintf(w, "\n")
+	}
+}
+
+// dumpRange prints the disassembly of the PCs from start up to end
+// in the format expected by pprof.
+func dumpRange(w io.Writer, obj *objfile.File, start, end uint64) {
+	lastFile, lastLine := "", 0
+	for pc := start; pc < end; {
+		file, line := obj.PCToLine(pc)
+		if file != "" && (file != lastFile || line != lastLine) {
+			fmt.Fprintf(w, "%s:%d\n", file, line)
+		}
+		lastFile, lastLine = file, line
+		asm, _, size := obj.Decode(pc)
+		fmt.Fprintf(w, " %x: %s\n", pc, asm)
+		pc += uint64(size)
+	}
+}
+
+// A sourceCache holds the lines of source files read for -S.
+type sourceCache struct {
+	files map[string][][]byte
+}
+
+// line returns the text of the given line of file,
+// or "" if the file cannot be read.
+func (c *sourceCache) line(file string, line int) string {
+	if c.files == nil {
+		c.files = make(map[string][][]byte)
+	}
+	lines, ok := c.files[file]
+	if !ok {
+		if data, err := ioutil.ReadFile(file); err == nil {
+			lines = bytes.Split(data, []byte("\n"))
+		}
+		c.files[file] = lines
+	}
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	return string(bytes.TrimRight(lines[line-1], " \t\r"))
+}
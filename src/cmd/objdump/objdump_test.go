@@ -0,0 +1,84 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"cmd/internal/objfile"
+)
+
+const inlineExec = "../../pkg/debug/symbolize/testdata/inline-gcc-amd64-linux-exec"
+
+func loadFile(t *testing.T, name string) *objfile.File {
+	obj, err := objfile.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+const dumpOuter = `TEXT outer(SB) /tmp/sym/inline.c
+	inline.c:8	0x1140	8d447f03	LEAL 0x3(DI)(DI*2), AX
+	inline.c:8	0x1144	8905ca2e0000	MOVL AX, sink(SB)
+	inline.c:15	0x114a	8b05c42e0000	MOVL sink(SB), AX
+	inline.c:15	0x1150	83c002		ADDL $0x2, AX
+	inline.c:15	0x1153	8905bb2e0000	MOVL AX, sink(SB)
+	inline.c:22	0x1159	8b05b52e0000	MOVL sink(SB), AX
+	inline.c:22	0x115f	83e801		SUBL $0x1, AX
+	inline.c:22	0x1162	8905ac2e0000	MOVL AX, sink(SB)
+	inline.c:23	0x1168	c3		RET
+
+`
+
+const dumpMainGNU = `TEXT main(SB) /tmp/sym/inline.c
+	inline.c:28	0x1040	bf04000000	MOVL $0x4, DI	// mov $0x4,%edi
+	inline.c:28	0x1045	e8f6000000	CALL outer(SB)	// callq outer
+	inline.c:30	0x104a	31c0		XORL AX, AX	// xor %eax,%eax
+	inline.c:30	0x104c	c3		RET		// retq
+
+`
+
+func TestDump(t *testing.T) {
+	obj := loadFile(t, inlineExec)
+	defer obj.Close()
+	defer func() { symRE = nil; *gnuAsm = false }()
+
+	var buf bytes.Buffer
+	symRE = regexp.MustCompile(`^outer$`)
+	dump(&buf, obj)
+	if buf.String() != dumpOuter {
+		t.Errorf("dump of outer:\n%s\nwant:\n%s", buf.String(), dumpOuter)
+	}
+
+	buf.Reset()
+	symRE = regexp.MustCompile(`^main$`)
+	*gnuAsm = true
+	dump(&buf, obj)
+	if buf.String() != dumpMainGNU {
+		t.Errorf("dump -gnu of main:\n%s\nwant:\n%s", buf.String(), dumpMainGNU)
+	}
+}
+
+const rangeOuter = `/tmp/sym/inline.c:8
+ 1140: LEAL 0x3(DI)(DI*2), AX
+ 1144: MOVL AX, sink(SB)
+/tmp/sym/inline.c:15
+ 114a: MOVL sink(SB), AX
+ 1150: ADDL $0x2, AX
+ 1153: MOVL AX, sink(SB)
+`
+
+func TestDumpRange(t *testing.T) {
+	obj := loadFile(t, inlineExec)
+	defer obj.Close()
+	var buf bytes.Buffer
+	dumpRange(&buf, obj, 0x1140, 0x1159)
+	if buf.String() != rangeOuter {
+		t.Errorf("dump of range:\n%s\nwant:\n%s", buf.String(), rangeOuter)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"cmd/pprof/internal/profile"
+)
+
+// isSource reports whether arg names a profile rather than
+// the profiled executable: a URL, a host:port, or a file
+// that is not an executable.
+func isSource(arg string) bool {
+	f, err := os.Open(arg)
+	if err != nil {
+		return true
+	}
+	defer f.Close()
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(f, magic); err != nil {
+		return true
+	}
+	for _, m := range [][]byte{
+		[]byte("\x7fELF"),
+		[]byte("\xfe\xed\xfa\xce"), // Mach-O
+		[]byte("\xfe\xed\xfa\xcf"),
+		[]byte("\xce\xfa\xed\xfe"),
+		[]byte("\xcf\xfa\xed\xfe"),
+		[]byte("MZ"),             // PE
+		{0x00, 0x00, 0x01, 0xeb}, // Plan 9 386
+		{0x00, 0x00, 0x8a, 0x97}, // Plan 9 amd64
+	} {
+		if bytes.HasPrefix(magic, m) {
+			return false
+		}
+	}
+	return true
+}
+
+// fetch reads the profile at source, a file name or a URL.  A URL
+// without a scheme means http and one without a path means the CPU
+// profile of net/http/pprof, collected for the given number of
+// seconds.  For profiles fetched over HTTP, fetch also returns the
+// URL of the program's symbol server.
+func fetch(source string, seconds int, timeout time.Duration) (p *profile.Profile, symURL string, err error) {
+	if _, err := os.Stat(source); err == nil || !strings.Contains(source, ":") {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, "", err
+		}
+		defer f.Close()
+		p, err := profile.Parse(f)
+		if err != nil {
+			return nil, "", fmt.Errorf("parsing %s: %v", source, err)
+		}
+		return p, "", nil
+	}
+
+	u, err := profileURL(source, seconds)
+	if err != nil {
+		return nil, "", err
+	}
+	if strings.HasSuffix(u.Path, "/debug/pprof/profile") {
+		timeout += time.Duration(seconds) * time.Second
+		fmt.Fprintf(os.Stderr, "Fetching profile from %s\nPlease wait... (%v)\n", u, time.Duration(seconds)*time.Second)
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return nil, "", fmt.Errorf("fetching %s: %v", u, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("fetching %s: %s", u, resp.Status)
+	}
+	if p, err = profile.Parse(resp.Body); err != nil {
+		return nil, "", fmt.Errorf("parsing %s: %v", u, err)
+	}
+	sym := *u
+	sym.Path = "/debug/pprof/symbol"
+	sym.RawQuery = ""
+	return p, sym.String(), nil
+}
+
+// profileURL returns the URL of the profile named by source.
+func profileURL(source string, seconds int) (*url.URL, error) {
+	if !strings.Contains(source, "://") {
+		source = "http://" + source
+	}
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("%s: no such file or URL", source)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/debug/pprof/profile"
+	}
+	if strings.HasSuffix(u.Path, "/debug/pprof/profile") && u.Query().Get("seconds") == "" {
+		q := u.Query()
+		q.Set("seconds", fmt.Sprint(seconds))
+		u.RawQuery = q.Encode()
+	}
+	return u, nil
+}
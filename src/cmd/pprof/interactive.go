@@ -0,0 +1,184 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"cmd/pprof/internal/profile"
+)
+
+const interactiveHelp = `Commands:
+  top [n]          print the n heaviest functions (default 10)
+  tree             print each function with its callers and callees
+  peek regexp      print the callers and callees of the matching functions
+  list regexp      print the annotated source of the matching functions
+  disasm regexp    print the annotated disassembly of the matching functions
+  dot [file]       write the call graph in Graphviz dot format
+  svg [file]       write the call graph as an SVG image
+  flame [file]     write a flame graph as an SVG image
+  raw              print a text dump of the profile
+  help             print this message
+  quit             exit pprof
+
+Options, shown by "options" and set by "name=value":
+  sample_index     the sample type to report
+  granularity      functions, lines, addresses or files
+  nodecount        the maximum number of nodes to show (0 for all)
+  nodefraction     hide nodes below this fraction of the total
+  edgefraction     hide graph edges below this fraction of the total
+  cum              sort by cumulative value (true or false)
+  focus, ignore, hide, tagfocus, tagignore
+                   regexps filtering the samples, as the flags of the same names
+`
+
+// interactive runs the interactive shell, reading commands from in
+// and writing reports to out until the input ends or the user quits.
+func interactive(in io.Reader, out io.Writer, p *profile.Profile, cfg *config) {
+	var types []string
+	for _, t := range p.SampleType {
+		types = append(types, t.Type)
+	}
+	fmt.Fprintf(out, "Entering interactive mode (type \"help\" for commands)\n")
+	fmt.Fprintf(out, "Sample types: %s\n", strings.Join(types, ", "))
+	s := bufio.NewScanner(in)
+	for {
+		fmt.Fprintf(out, "(pprof) ")
+		if !s.Scan() {
+			fmt.Fprintln(out)
+			return
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		if line == "quit" || line == "exit" {
+			return
+		}
+		if err := command(out, line, p, cfg); err != nil {
+			fmt.Fprintf(out, "%v\n", err)
+		}
+	}
+}
+
+// command runs the shell command line.
+func command(out io.Writer, line string, p *profile.Profile, cfg *config) error {
+	if i := strings.Index(line, "="); i >= 0 {
+		if name := strings.TrimSpace(line[:i]); !strings.ContainsAny(name, " \t") {
+			return cfg.set(name, strings.TrimSpace(line[i+1:]))
+		}
+	}
+	f := strings.Fields(line)
+	cmd, args := f[0], f[1:]
+	switch cmd {
+	case "help":
+		fmt.Fprint(out, interactiveHelp)
+		return nil
+	case "options":
+		cfg.print(out)
+		return nil
+	case "top":
+		c := *cfg
+		c.nodeCount = 10
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("top: bad count %q", args[0])
+			}
+			c.nodeCount = n
+		}
+		return run(out, "top", "", p, &c)
+	case "tree", "raw":
+		return run(out, cmd, "", p, cfg)
+	case "peek", "list", "disasm":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: %s regexp", cmd)
+		}
+		return run(out, cmd, args[0], p, cfg)
+	case "dot", "svg", "flame":
+		// Graphs go to a file, by default one named after the report.
+		name := "profile." + cmd
+		if cmd == "flame" {
+			name = "profile.flame.svg"
+		}
+		if len(args) > 0 {
+			name = args[0]
+		}
+		w, err := os.Create(name)
+		if err != nil {
+			return err
+		}
+		if err := run(w, cmd, "", p, cfg); err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Wrote %s\n", name)
+		return nil
+	}
+	return fmt.Errorf("unknown command %q; type \"help\" for commands", cmd)
+}
+
+// set sets the option name to value.
+func (cfg *config) set(name, value string) error {
+	var err error
+	switch name {
+	case "sample_index":
+		cfg.sampleIndex = value
+	case "granularity":
+		switch value {
+		case "functions", "lines", "addresses", "files":
+			cfg.granularity = value
+		default:
+			return fmt.Errorf("unknown granularity %q", value)
+		}
+	case "nodecount":
+		cfg.nodeCount, err = strconv.Atoi(value)
+	case "nodefraction":
+		cfg.nodeFraction, err = strconv.ParseFloat(value, 64)
+	case "edgefraction":
+		cfg.edgeFraction, err = strconv.ParseFloat(value, 64)
+	case "cum":
+		cfg.cumSort, err = strconv.ParseBool(value)
+	case "focus":
+		cfg.focus = value
+	case "ignore":
+		cfg.ignore = value
+	case "hide":
+		cfg.hide = value
+	case "tagfocus":
+		cfg.tagFocus = value
+	case "tagignore":
+		cfg.tagIgnore = value
+	default:
+		return fmt.Errorf("unknown option %q", name)
+	}
+	if err != nil {
+		return fmt.Errorf("bad value %q for %s: %v", value, name, err)
+	}
+	return nil
+}
+
+// print prints the options.
+func (cfg *config) print(w io.Writer) {
+	fmt.Fprintf(w, "sample_index=%s\n", cfg.sampleIndex)
+	fmt.Fprintf(w, "granularity=%s\n", cfg.granularity)
+	fmt.Fprintf(w, "nodecount=%d\n", cfg.nodeCount)
+	fmt.Fprintf(w, "nodefraction=%g\n", cfg.nodeFraction)
+	fmt.Fprintf(w, "edgefraction=%g\n", cfg.edgeFraction)
+	fmt.Fprintf(w, "cum=%v\n", cfg.cumSort)
+	fmt.Fprintf(w, "focus=%s\n", cfg.focus)
+	fmt.Fprintf(w, "ignore=%s\n", cfg.ignore)
+	fmt.Fprintf(w, "hide=%s\n", cfg.hide)
+	fmt.Fprintf(w, "tagfocus=%s\n", cfg.tagFocus)
+	fmt.Fprintf(w, "tagignore=%s\n", cfg.tagIgnore)
+}
@@ -0,0 +1,319 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of the legacy profile formats written by runtime/pprof
+// before it wrote profile.proto: the binary CPU profile format of
+// google-perftools and the text formats of the heap, contention and
+// count profiles.
+
+package profile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var errNotCPU = errors.New("not a legacy CPU profile")
+
+// parseCPU parses a legacy binary CPU profile.  The profile is a
+// sequence of words, in the byte order and word size of the profiled
+// program, starting with the header
+//
+//	0, 3, 0, period in microseconds, 0
+//
+// followed by records of the form
+//
+//	count, depth, pc[depth]
+//
+// and ending with the record 0, 1, 0.
+func parseCPU(data []byte) (*Profile, error) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for _, size := range []int{8, 4} {
+			word := func(i int) uint64 {
+				if size == 8 {
+					return order.Uint64(data[i*8:])
+				}
+				return uint64(order.Uint32(data[i*4:]))
+			}
+			n := len(data) / size
+			if n < 5 || word(0) != 0 || word(1) != 3 || word(2) != 0 || word(4) != 0 {
+				continue
+			}
+			words := make([]uint64, n)
+			for i := range words {
+				words[i] = word(i)
+			}
+			return cpuProfile(words)
+		}
+	}
+	return nil, errNotCPU
+}
+
+func cpuProfile(words []uint64) (*Profile, error) {
+	period := int64(words[3]) * 1000 // nanoseconds
+	p := &Profile{
+		SampleType: []*ValueType{
+			{"samples", "count"},
+			{"cpu", "nanoseconds"},
+		},
+		PeriodType: &ValueType{"cpu", "nanoseconds"},
+		Period:     period,
+	}
+	b := newLocBuilder(p)
+	words = words[5:]
+	for {
+		if len(words) < 2 {
+			return nil, fmt.Errorf("malformed CPU profile: truncated")
+		}
+		count, depth := words[0], words[1]
+		if uint64(len(words)-2) < depth {
+			return nil, fmt.Errorf("malformed CPU profile: truncated")
+		}
+		stk := words[2 : 2+depth]
+		words = words[2+depth:]
+		if count == 0 && depth == 1 && stk[0] == 0 {
+			break // end of data
+		}
+		s := &Sample{Value: []int64{int64(count), int64(count) * period}}
+		for i, pc := range stk {
+			// Program counters other than the first are
+			// return addresses; back up into the call.
+			if i > 0 && pc > 0 {
+				pc--
+			}
+			s.Location = append(s.Location, b.location(pc))
+		}
+		p.Sample = append(p.Sample, s)
+	}
+	return p, nil
+}
+
+// A locBuilder creates the locations of a profile, one per address.
+type locBuilder struct {
+	p    *Profile
+	locs map[uint64]*Location
+}
+
+func newLocBuilder(p *Profile) *locBuilder {
+	return &locBuilder{p: p, locs: make(map[uint64]*Location)}
+}
+
+func (b *locBuilder) location(addr uint64) *Location {
+	l := b.locs[addr]
+	if l == nil {
+		l = &Location{ID: uint64(len(b.p.Location) + 1), Address: addr}
+		b.locs[addr] = l
+		b.p.Location = append(b.p.Location, l)
+	}
+	return l
+}
+
+// stack parses a list of hexadecimal return addresses,
+// backing each up into its call instruction.
+func (b *locBuilder) stack(s string) ([]*Location, error) {
+	var locs []*Location
+	for _, f := range strings.Fields(s) {
+		addr, err := strconv.ParseUint(strings.TrimPrefix(f, "0x"), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed address %q", f)
+		}
+		if addr > 0 {
+			addr--
+		}
+		locs = append(locs, b.location(addr))
+	}
+	return locs, nil
+}
+
+var (
+	heapHeaderRE       = regexp.MustCompile(`^heap profile: *(\d+): *(\d+) *\[ *(\d+): *(\d+) *\] *@ *(heap(?:_v2)?)/(\d+)`)
+	heapSampleRE       = regexp.MustCompile(`^(\d+): *(\d+) *\[ *(\d+): *(\d+) *\] *@([ x0-9a-f]*)`)
+	contentionHeaderRE = regexp.MustCompile(`^--- (contention|mutex):`)
+	contentionSampleRE = regexp.MustCompile(`^(\d+) +(\d+) +@([ x0-9a-f]*)`)
+	countHeaderRE      = regexp.MustCompile(`^(\w+) profile: total \d+`)
+	countSampleRE      = regexp.MustCompile(`^(\d+) @([ x0-9a-f]*)`)
+)
+
+// isLegacyText reports whether data is a legacy text profile.
+func isLegacyText(data []byte) bool {
+	line := data
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	return heapHeaderRE.Match(line) || contentionHeaderRE.Match(line) || countHeaderRE.Match(line)
+}
+
+// parseLegacyText parses a legacy text profile.
+// Lines starting with # are comments.
+func parseLegacyText(data []byte) (*Profile, error) {
+	lines := strings.Split(string(data), "\n")
+	var body []string
+	for _, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			body = append(body, line)
+		}
+	}
+	switch header := lines[0]; {
+	case heapHeaderRE.MatchString(header):
+		return parseHeap(heapHeaderRE.FindStringSubmatch(header), body)
+	case contentionHeaderRE.MatchString(header):
+		return parseContention(body)
+	default:
+		return parseCount(countHeaderRE.FindStringSubmatch(header)[1], body)
+	}
+}
+
+// parseHeap parses the body of a legacy heap profile with the given
+// header fields.  Each line has the form
+//
+//	inuse objects: inuse bytes [alloc objects: alloc bytes] @ stack
+func parseHeap(header []string, body []string) (*Profile, error) {
+	rate, _ := strconv.ParseInt(header[6], 10, 64)
+	if header[5] == "heap" {
+		// Early versions of the C++ heap profiler reported
+		// twice the sampling rate, and Go followed suit.
+		rate /= 2
+	}
+	p := &Profile{
+		SampleType: []*ValueType{
+			{"alloc_objects", "count"},
+			{"alloc_space", "bytes"},
+			{"inuse_objects", "count"},
+			{"inuse_space", "bytes"},
+		},
+		PeriodType: &ValueType{"space", "bytes"},
+		Period:     rate,
+	}
+	b := newLocBuilder(p)
+	for _, line := range body {
+		m := heapSampleRE.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("malformed heap profile line: %q", line)
+		}
+		var v [4]int64
+		for i := range v {
+			v[i], _ = strconv.ParseInt(m[i+1], 10, 64)
+		}
+		stk, err := b.stack(m[5])
+		if err != nil {
+			return nil, err
+		}
+		inuseObjects, inuseBytes := scaleHeapSample(v[0], v[1], rate)
+		allocObjects, allocBytes := scaleHeapSample(v[2], v[3], rate)
+		p.Sample = append(p.Sample, &Sample{
+			Location: stk,
+			Value:    []int64{allocObjects, allocBytes, inuseObjects, inuseBytes},
+		})
+	}
+	return p, nil
+}
+
+// scaleHeapSample adjusts the counts of a heap profile record for
+// the sampling of allocations: an allocation of size bytes is
+// recorded with probability 1-exp(-size/rate).
+func scaleHeapSample(count, size, rate int64) (int64, int64) {
+	if count == 0 || size == 0 {
+		return 0, 0
+	}
+	if rate <= 1 {
+		return count, size
+	}
+	avgSize := float64(size) / float64(count)
+	scale := 1 / (1 - math.Exp(-avgSize/float64(rate)))
+	return int64(float64(count) * scale), int64(float64(size) * scale)
+}
+
+// parseContention parses the body of a legacy block or mutex
+// profile.  The body starts with the attributes
+//
+//	cycles/second=N
+//	sampling period=N
+//
+// followed by lines of the form
+//
+//	cycles count @ stack
+func parseContention(body []string) (*Profile, error) {
+	p := &Profile{
+		SampleType: []*ValueType{
+			{"contentions", "count"},
+			{"delay", "nanoseconds"},
+		},
+		PeriodType: &ValueType{"contentions", "count"},
+		Period:     1,
+	}
+	cpn := 1.0 // cycles per nanosecond
+	for len(body) > 0 {
+		i := strings.Index(body[0], "=")
+		if i < 0 {
+			break
+		}
+		key, value := strings.TrimSpace(body[0][:i]), strings.TrimSpace(body[0][i+1:])
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed contention profile attribute: %q", body[0])
+		}
+		switch key {
+		case "cycles/second":
+			if n > 0 {
+				cpn = float64(n) / 1e9
+			}
+		case "sampling period":
+			p.Period = n
+		}
+		body = body[1:]
+	}
+
+	b := newLocBuilder(p)
+	for _, line := range body {
+		m := contentionSampleRE.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("malformed contention profile line: %q", line)
+		}
+		cycles, _ := strconv.ParseInt(m[1], 10, 64)
+		count, _ := strconv.ParseInt(m[2], 10, 64)
+		stk, err := b.stack(m[3])
+		if err != nil {
+			return nil, err
+		}
+		p.Sample = append(p.Sample, &Sample{
+			Location: stk,
+			Value:    []int64{count, int64(float64(cycles) / cpn)},
+		})
+	}
+	return p, nil
+}
+
+// parseCount parses the body of a legacy profile of the given name
+// that counts stacks, such as the goroutine profile.  Each line has
+// the form
+//
+//	count @ stack
+func parseCount(name string, body []string) (*Profile, error) {
+	p := &Profile{
+		SampleType: []*ValueType{{name, "count"}},
+		PeriodType: &ValueType{name, "count"},
+		Period:     1,
+	}
+	b := newLocBuilder(p)
+	for _, line := range body {
+		m := countSampleRE.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("malformed %s profile line: %q", name, line)
+		}
+		count, _ := strconv.ParseInt(m[1], 10, 64)
+		stk, err := b.stack(m[2])
+		if err != nil {
+			return nil, err
+		}
+		p.Sample = append(p.Sample, &Sample{Location: stk, Value: []int64{count}})
+	}
+	return p, nil
+}
@@ -0,0 +1,536 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package profile represents a profile as read by the pprof tool.
+//
+// The representation follows profile.proto, the format written by
+// package runtime/pprof: a profile is a set of samples, each holding
+// values of the sample types of the profile for one call stack, and
+// the stacks refer to locations that are described, when known, by
+// the functions and source lines they belong to.  Parse also reads
+// the legacy formats written by earlier versions of runtime/pprof.
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A Profile is a profiled program's samples together with
+// the locations, functions and mappings they refer to.
+type Profile struct {
+	SampleType []*ValueType
+	Sample     []*Sample
+	Mapping    []*Mapping
+	Location   []*Location
+	Function   []*Function
+
+	TimeNanos     int64
+	DurationNanos int64
+	PeriodType    *ValueType
+	Period        int64
+}
+
+// A ValueType describes the values of a sample, such as
+// alloc_space measured in bytes.
+type ValueType struct {
+	Type string
+	Unit string
+}
+
+// A Sample is a call stack, leaf first, with one value
+// for each sample type of its profile.
+type Sample struct {
+	Location []*Location
+	Value    []int64
+	Label    map[string][]string
+}
+
+// A Mapping is a region of the profiled program's address space
+// mapped from a binary or shared library.
+type Mapping struct {
+	ID      uint64
+	Start   uint64
+	Limit   uint64
+	Offset  uint64
+	File    string
+	BuildID string
+
+	HasFunctions    bool
+	HasFilenames    bool
+	HasLineNumbers  bool
+	HasInlineFrames bool
+}
+
+// A Location is a program counter.  Its Line holds the source lines
+// of the program counter, innermost first: when functions have been
+// inlined there is one line for each of them.
+type Location struct {
+	ID      uint64
+	Mapping *Mapping
+	Address uint64
+	Line    []Line
+}
+
+// A Line is a source line of a function.
+type Line struct {
+	Function *Function
+	Line     int64
+}
+
+// A Function is a function of the profiled program.
+type Function struct {
+	ID         uint64
+	Name       string
+	SystemName string
+	Filename   string
+	StartLine  int64
+}
+
+// Parse reads a profile in profile.proto format, gzipped or not,
+// or in one of the legacy formats written by runtime/pprof.
+func Parse(r io.Reader) (*Profile, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing profile: %v", err)
+		}
+		data, err = ioutil.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing profile: %v", err)
+		}
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty profile")
+	}
+
+	var p *Profile
+	if isLegacyText(data) {
+		p, err = parseLegacyText(data)
+	} else if p, err = parseCPU(data); err == errNotCPU {
+		p, err = parseProto(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := p.CheckValid(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// CheckValid reports whether p is internally consistent:
+// each sample has one value for each sample type, and all
+// references to locations, functions and mappings resolve.
+func (p *Profile) CheckValid() error {
+	if len(p.SampleType) == 0 && len(p.Sample) > 0 {
+		return fmt.Errorf("profile has samples but no sample types")
+	}
+	mappings := make(map[*Mapping]bool, len(p.Mapping))
+	for _, m := range p.Mapping {
+		mappings[m] = true
+	}
+	functions := make(map[*Function]bool, len(p.Function))
+	for _, f := range p.Function {
+		functions[f] = true
+	}
+	locations := make(map[*Location]bool, len(p.Location))
+	for _, l := range p.Location {
+		locations[l] = true
+		if l.Mapping != nil && !mappings[l.Mapping] {
+			return fmt.Errorf("location %d refers to unknown mapping", l.ID)
+		}
+		for _, ln := range l.Line {
+			if ln.Function == nil || !functions[ln.Function] {
+				return fmt.Errorf("location %d refers to unknown function", l.ID)
+			}
+		}
+	}
+	for _, s := range p.Sample {
+		if len(s.Value) != len(p.SampleType) {
+			return fmt.Errorf("sample has %d values, want %d", len(s.Value), len(p.SampleType))
+		}
+		for _, l := range s.Location {
+			if !locations[l] {
+				return fmt.Errorf("sample refers to unknown location")
+			}
+		}
+	}
+	return nil
+}
+
+// Symbolized reports whether all locations in p
+// have source line information.
+func (p *Profile) Symbolized() bool {
+	for _, l := range p.Location {
+		if len(l.Line) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SampleIndex returns the index of the named sample type.
+// The name "" means the last sample type, which is the
+// default for reports.
+func (p *Profile) SampleIndex(name string) (int, error) {
+	if len(p.SampleType) == 0 {
+		return 0, fmt.Errorf("profile has no sample types")
+	}
+	if name == "" {
+		return len(p.SampleType) - 1, nil
+	}
+	var names []string
+	for i, t := range p.SampleType {
+		if t.Type == name {
+			return i, nil
+		}
+		names = append(names, t.Type)
+	}
+	return 0, fmt.Errorf("sample index %q not in profile; have %s", name, strings.Join(names, ", "))
+}
+
+// Copy returns a copy of p whose samples can be filtered and scaled
+// without changing p.  The copy shares the locations, functions and
+// mappings of p.
+func (p *Profile) Copy() *Profile {
+	q := *p
+	q.Sample = make([]*Sample, len(p.Sample))
+	for i, s := range p.Sample {
+		q.Sample[i] = &Sample{
+			Location: append([]*Location(nil), s.Location...),
+			Value:    append([]int64(nil), s.Value...),
+			Label:    s.Label,
+		}
+	}
+	return &q
+}
+
+// Scale multiplies the values of the samples in p by ratio,
+// rounding to the nearest integer.
+func (p *Profile) Scale(ratio float64) {
+	if ratio == 1 {
+		return
+	}
+	for _, s := range p.Sample {
+		for i, v := range s.Value {
+			f := float64(v) * ratio
+			if f < 0 {
+				s.Value[i] = int64(f - 0.5)
+			} else {
+				s.Value[i] = int64(f + 0.5)
+			}
+		}
+	}
+}
+
+// Compatible reports whether p and q have the same sample types
+// and so can be merged.
+func (p *Profile) Compatible(q *Profile) error {
+	if len(p.SampleType) != len(q.SampleType) {
+		return fmt.Errorf("incompatible profiles: %d and %d sample types", len(p.SampleType), len(q.SampleType))
+	}
+	for i, t := range p.SampleType {
+		if *t != *q.SampleType[i] {
+			return fmt.Errorf("incompatible sample types %s/%s and %s/%s", t.Type, t.Unit, q.SampleType[i].Type, q.SampleType[i].Unit)
+		}
+	}
+	return nil
+}
+
+// Merge returns a profile holding the samples of all the given
+// profiles, which must be compatible.  Samples with the same stack
+// and labels are combined.  The time of the result is the earliest
+// of the profiles and its duration is their total.
+func Merge(profs []*Profile) (*Profile, error) {
+	if len(profs) == 0 {
+		return nil, fmt.Errorf("no profiles to merge")
+	}
+	for _, q := range profs[1:] {
+		if err := profs[0].Compatible(q); err != nil {
+			return nil, err
+		}
+	}
+
+	m := &merger{
+		p: &Profile{
+			SampleType: profs[0].SampleType,
+			PeriodType: profs[0].PeriodType,
+			Period:     profs[0].Period,
+		},
+		samples:   make(map[string]*Sample),
+		locations: make(map[string]*Location),
+		functions: make(map[Function]*Function),
+		mappings:  make(map[Mapping]*Mapping),
+	}
+	for _, q := range profs {
+		if m.p.TimeNanos == 0 || q.TimeNanos != 0 && q.TimeNanos < m.p.TimeNanos {
+			m.p.TimeNanos = q.TimeNanos
+		}
+		m.p.DurationNanos += q.DurationNanos
+		for _, s := range q.Sample {
+			m.addSample(s)
+		}
+	}
+	return m.p, nil
+}
+
+// A merger accumulates the samples of merged profiles,
+// sharing the locations, functions and mappings they have in common.
+type merger struct {
+	p         *Profile
+	samples   map[string]*Sample
+	locations map[string]*Location
+	functions map[Function]*Function // key has ID 0
+	mappings  map[Mapping]*Mapping   // key has ID 0
+}
+
+func (m *merger) addSample(s *Sample) {
+	ns := &Sample{Label: s.Label}
+	var key bytes.Buffer
+	for _, l := range s.Location {
+		nl := m.location(l)
+		ns.Location = append(ns.Location, nl)
+		fmt.Fprintf(&key, "%d ", nl.ID)
+	}
+	fmt.Fprint(&key, labelKey(s.Label))
+	if old := m.samples[key.String()]; old != nil {
+		for i, v := range s.Value {
+			old.Value[i] += v
+		}
+		return
+	}
+	ns.Value = append([]int64(nil), s.Value...)
+	m.samples[key.String()] = ns
+	m.p.Sample = append(m.p.Sample, ns)
+}
+
+func (m *merger) location(l *Location) *Location {
+	nl := &Location{Address: l.Address}
+	if l.Mapping != nil {
+		nl.Mapping = m.mapping(l.Mapping)
+	}
+	var key bytes.Buffer
+	fmt.Fprintf(&key, "%x", l.Address)
+	if nl.Mapping != nil {
+		fmt.Fprintf(&key, " m%d", nl.Mapping.ID)
+	}
+	for _, ln := range l.Line {
+		nf := m.function(ln.Function)
+		nl.Line = append(nl.Line, Line{nf, ln.Line})
+		fmt.Fprintf(&key, " %d:%d", nf.ID, ln.Line)
+	}
+	if old := m.locations[key.String()]; old != nil {
+		return old
+	}
+	nl.ID = uint64(len(m.p.Location) + 1)
+	m.locations[key.String()] = nl
+	m.p.Location = append(m.p.Location, nl)
+	return nl
+}
+
+func (m *merger) function(f *Function) *Function {
+	key := *f
+	key.ID = 0
+	if old := m.functions[key]; old != nil {
+		return old
+	}
+	nf := new(Function)
+	*nf = key
+	nf.ID = uint64(len(m.p.Function) + 1)
+	m.functions[key] = nf
+	m.p.Function = append(m.p.Function, nf)
+	return nf
+}
+
+func (m *merger) mapping(mp *Mapping) *Mapping {
+	key := *mp
+	key.ID = 0
+	if old := m.mappings[key]; old != nil {
+		return old
+	}
+	nm := new(Mapping)
+	*nm = key
+	nm.ID = uint64(len(m.p.Mapping) + 1)
+	m.mappings[key] = nm
+	m.p.Mapping = append(m.p.Mapping, nm)
+	return nm
+}
+
+// labelKey returns a canonical encoding of labels.
+func labelKey(labels map[string][]string) string {
+	var keys []string
+	for k, vs := range labels {
+		for _, v := range vs {
+			keys = append(keys, k+"="+v)
+		}
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// FilterSamplesByName keeps only the samples that have a location
+// matching focus, if set, and no location matching ignore, if set.
+// A location matches if the name of one of its functions does.
+// It also removes the locations matching hide, if set, from the stacks.
+// FilterSamplesByName reports whether each regexp matched any location.
+func (p *Profile) FilterSamplesByName(focus, ignore, hide *regexp.Regexp) (fm, im, hm bool) {
+	matches := func(re *regexp.Regexp, l *Location) bool {
+		for _, ln := range l.Line {
+			if re.MatchString(ln.Function.Name) {
+				return true
+			}
+		}
+		return re.MatchString(fmt.Sprintf("%#x", l.Address))
+	}
+	kept := p.Sample[:0]
+	for _, s := range p.Sample {
+		keep := focus == nil
+		for _, l := range s.Location {
+			if ignore != nil && matches(ignore, l) {
+				im = true
+				keep = false
+				break
+			}
+			if focus != nil && !keep && matches(focus, l) {
+				fm = true
+				keep = true
+			}
+		}
+		if keep {
+			kept = append(kept, s)
+		}
+	}
+	p.Sample = kept
+
+	if hide != nil {
+		for _, s := range p.Sample {
+			locs := s.Location[:0]
+			for _, l := range s.Location {
+				if matches(hide, l) {
+					hm = true
+					continue
+				}
+				locs = append(locs, l)
+			}
+			s.Location = locs
+		}
+	}
+	return
+}
+
+// FilterSamplesByLabel keeps only the samples with a label key=value
+// matching focus, if set, and no label matching ignore, if set.
+// It reports whether each regexp matched any label.
+func (p *Profile) FilterSamplesByLabel(focus, ignore *regexp.Regexp) (fm, im bool) {
+	matches := func(re *regexp.Regexp, s *Sample) bool {
+		for k, vs := range s.Label {
+			for _, v := range vs {
+				if re.MatchString(k + "=" + v) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	kept := p.Sample[:0]
+	for _, s := range p.Sample {
+		if focus != nil {
+			if !matches(focus, s) {
+				continue
+			}
+			fm = true
+		}
+		if ignore != nil && matches(ignore, s) {
+			im = true
+			continue
+		}
+		kept = append(kept, s)
+	}
+	p.Sample = kept
+	return
+}
+
+// String returns a text dump of p, listing its samples,
+// locations and mappings.
+func (p *Profile) String() string {
+	var b bytes.Buffer
+	if p.PeriodType != nil {
+		fmt.Fprintf(&b, "PeriodType: %s %s\n", p.PeriodType.Type, p.PeriodType.Unit)
+	}
+	fmt.Fprintf(&b, "Period: %d\n", p.Period)
+	if p.TimeNanos != 0 {
+		fmt.Fprintf(&b, "Time: %v\n", time.Unix(0, p.TimeNanos))
+	}
+	if p.DurationNanos != 0 {
+		fmt.Fprintf(&b, "Duration: %v\n", time.Duration(p.DurationNanos))
+	}
+
+	fmt.Fprintf(&b, "Samples:\n")
+	var types []string
+	for _, t := range p.SampleType {
+		types = append(types, t.Type+"/"+t.Unit)
+	}
+	fmt.Fprintf(&b, "%s\n", strings.Join(types, " "))
+	for _, s := range p.Sample {
+		for _, v := range s.Value {
+			fmt.Fprintf(&b, " %10d", v)
+		}
+		fmt.Fprintf(&b, ":")
+		for _, l := range s.Location {
+			fmt.Fprintf(&b, " %d", l.ID)
+		}
+		fmt.Fprintf(&b, "\n")
+		if len(s.Label) > 0 {
+			fmt.Fprintf(&b, "                %s\n", labelKey(s.Label))
+		}
+	}
+
+	fmt.Fprintf(&b, "Locations:\n")
+	for _, l := range p.Location {
+		fmt.Fprintf(&b, "%6d: %#x", l.ID, l.Address)
+		if l.Mapping != nil {
+			fmt.Fprintf(&b, " M=%d", l.Mapping.ID)
+		}
+		if len(l.Line) == 0 {
+			fmt.Fprintf(&b, "\n")
+		}
+		for i, ln := range l.Line {
+			if i > 0 {
+				fmt.Fprintf(&b, "%*s", 24, "")
+			}
+			fmt.Fprintf(&b, " %s %s:%d\n", ln.Function.Name, ln.Function.Filename, ln.Line)
+		}
+	}
+
+	fmt.Fprintf(&b, "Mappings:\n")
+	for _, m := range p.Mapping {
+		fmt.Fprintf(&b, "%d: %#x/%#x/%#x %s %s", m.ID, m.Start, m.Limit, m.Offset, m.File, m.BuildID)
+		if m.HasFunctions {
+			fmt.Fprintf(&b, " [FN]")
+		}
+		if m.HasFilenames {
+			fmt.Fprintf(&b, " [FL]")
+		}
+		if m.HasLineNumbers {
+			fmt.Fprintf(&b, " [LN]")
+		}
+		if m.HasInlineFrames {
+			fmt.Fprintf(&b, " [IN]")
+		}
+		fmt.Fprintf(&b, "\n")
+	}
+	return b.String()
+}
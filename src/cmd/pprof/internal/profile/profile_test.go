@@ -0,0 +1,261 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// stacks returns the location addresses of the samples of p.
+func stacks(p *Profile) [][]uint64 {
+	var stks [][]uint64
+	for _, s := range p.Sample {
+		var stk []uint64
+		for _, l := range s.Location {
+			stk = append(stk, l.Address)
+		}
+		stks = append(stks, stk)
+	}
+	return stks
+}
+
+// values returns the values of the samples of p.
+func values(p *Profile) [][]int64 {
+	var vs [][]int64
+	for _, s := range p.Sample {
+		vs = append(vs, s.Value)
+	}
+	return vs
+}
+
+func TestParseLegacyCPU(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for _, size := range []int{4, 8} {
+			var buf bytes.Buffer
+			for _, w := range []uint64{
+				0, 3, 0, 10000, 0, // header: 10ms period
+				2, 2, 0x1000, 0x2001,
+				1, 1, 0x1000,
+				0, 1, 0,
+			} {
+				if size == 4 {
+					binary.Write(&buf, order, uint32(w))
+				} else {
+					binary.Write(&buf, order, w)
+				}
+			}
+			p, err := Parse(&buf)
+			if err != nil {
+				t.Fatalf("%v/%d: %v", order, size, err)
+			}
+			if p.Period != 10e6 {
+				t.Errorf("%v/%d: period %d, want 10ms", order, size, p.Period)
+			}
+			if got, want := stacks(p), [][]uint64{{0x1000, 0x2000}, {0x1000}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("%v/%d: stacks %#x, want %#x", order, size, got, want)
+			}
+			if got, want := values(p), [][]int64{{2, 20e6}, {1, 10e6}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("%v/%d: values %v, want %v", order, size, got, want)
+			}
+			if len(p.Location) != 2 {
+				t.Errorf("%v/%d: %d locations, want 2", order, size, len(p.Location))
+			}
+		}
+	}
+}
+
+var legacyTextTests = []struct {
+	name   string
+	text   string
+	types  string
+	stacks [][]uint64
+	values [][]int64
+}{
+	{
+		"heap",
+		`heap profile: 1: 1024 [2: 2048] @ heap/1
+1: 1024 [2: 2048] @ 0x1001 0x2001
+0: 0 [1: 16] @ 0x3001
+
+# runtime.MemStats
+# Alloc = 1024
+`,
+		"alloc_objects/count alloc_space/bytes inuse_objects/count inuse_space/bytes",
+		[][]uint64{{0x1000, 0x2000}, {0x3000}},
+		[][]int64{{2, 2048, 1, 1024}, {1, 16, 0, 0}},
+	},
+	{
+		"contention",
+		`--- contention:
+cycles/second=2000000000
+sampling period=1
+4000 2 @ 0x1001 0x2001
+# 0x1000 sync.(*Mutex).Lock+0x10 mutex.go:1
+`,
+		"contentions/count delay/nanoseconds",
+		[][]uint64{{0x1000, 0x2000}},
+		[][]int64{{2, 2000}},
+	},
+	{
+		"goroutine",
+		`goroutine profile: total 4
+3 @ 0x1001 0x2001
+#	0x1000	main.f+0x10	main.go:1
+
+1 @ 0x3001
+`,
+		"goroutine/count",
+		[][]uint64{{0x1000, 0x2000}, {0x3000}},
+		[][]int64{{3}, {1}},
+	},
+}
+
+func TestParseLegacyText(t *testing.T) {
+	for _, tt := range legacyTextTests {
+		p, err := Parse(strings.NewReader(tt.text))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		var types []string
+		for _, st := range p.SampleType {
+			types = append(types, st.Type+"/"+st.Unit)
+		}
+		if got := strings.Join(types, " "); got != tt.types {
+			t.Errorf("%s: sample types %s, want %s", tt.name, got, tt.types)
+		}
+		if got := stacks(p); !reflect.DeepEqual(got, tt.stacks) {
+			t.Errorf("%s: stacks %#x, want %#x", tt.name, got, tt.stacks)
+		}
+		if got := values(p); !reflect.DeepEqual(got, tt.values) {
+			t.Errorf("%s: values %v, want %v", tt.name, got, tt.values)
+		}
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"goroutine profile: total 1\nx @ 0x1\n",
+		"heap profile: 1: 1 [1: 1] @ heap/1\n1: 1 [1: 1] @ 0xzz\n",
+		"\x0a\x05\x08", // truncated message
+	} {
+		if _, err := Parse(strings.NewReader(text)); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", text)
+		}
+	}
+}
+
+// testProfile returns a symbolized profile with two samples.
+func testProfile() *Profile {
+	m := &Mapping{ID: 1, Start: 0x1000, Limit: 0x5000, File: "/bin/prog", HasFunctions: true}
+	f1 := &Function{ID: 1, Name: "main.f", SystemName: "main.f", Filename: "main.go", StartLine: 10}
+	f2 := &Function{ID: 2, Name: "main.main", SystemName: "main.main", Filename: "main.go", StartLine: 20}
+	f3 := &Function{ID: 3, Name: "main.g", SystemName: "main.g", Filename: "g.go", StartLine: 1}
+	l1 := &Location{ID: 1, Mapping: m, Address: 0x1010, Line: []Line{{f1, 12}}}
+	l2 := &Location{ID: 2, Mapping: m, Address: 0x2020, Line: []Line{{f3, 3}, {f2, 22}}}
+	return &Profile{
+		SampleType: []*ValueType{{"samples", "count"}, {"cpu", "nanoseconds"}},
+		Sample: []*Sample{
+			{Location: []*Location{l1, l2}, Value: []int64{3, 30}, Label: map[string][]string{"k": {"v"}}},
+			{Location: []*Location{l2}, Value: []int64{1, 10}},
+		},
+		Mapping:       []*Mapping{m},
+		Location:      []*Location{l1, l2},
+		Function:      []*Function{f1, f2, f3},
+		TimeNanos:     1e9,
+		DurationNanos: 2e9,
+		PeriodType:    &ValueType{"cpu", "nanoseconds"},
+		Period:        10,
+	}
+}
+
+func TestWriteParse(t *testing.T) {
+	p := testProfile()
+	var buf bytes.Buffer
+	if err := p.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	q, err := Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := q.String(), p.String(); got != want {
+		t.Errorf("round trip:\n%s\nwant:\n%s", got, want)
+	}
+	if q.TimeNanos != p.TimeNanos || q.Period != p.Period || *q.PeriodType != *p.PeriodType {
+		t.Errorf("round trip lost time, period or period type")
+	}
+	if !reflect.DeepEqual(q.Function[2], p.Function[2]) {
+		t.Errorf("function %+v, want %+v", q.Function[2], p.Function[2])
+	}
+}
+
+func TestMergeDiff(t *testing.T) {
+	p, base := testProfile(), testProfile()
+	base.Sample = append(base.Sample, &Sample{Location: base.Sample[1].Location, Value: []int64{1, 10}, Label: map[string][]string{"k": {"w"}}})
+	base.Sample[0].Value = []int64{1, 10}
+	base.Scale(-1)
+	d, err := Merge([]*Profile{p, base})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values(d), [][]int64{{2, 20}, {0, 0}, {-1, -10}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("diff values %v, want %v", got, want)
+	}
+	if len(d.Location) != 2 || len(d.Function) != 3 || len(d.Mapping) != 1 {
+		t.Errorf("diff has %d locations, %d functions, %d mappings; want 2, 3, 1", len(d.Location), len(d.Function), len(d.Mapping))
+	}
+	if d.DurationNanos != 4e9 {
+		t.Errorf("diff duration %d, want 4e9", d.DurationNanos)
+	}
+	if err := d.CheckValid(); err != nil {
+		t.Error(err)
+	}
+
+	q := testProfile()
+	q.SampleType = q.SampleType[:1]
+	if _, err := Merge([]*Profile{p, q}); err == nil {
+		t.Errorf("merging incompatible profiles succeeded")
+	}
+}
+
+func TestFilterSamples(t *testing.T) {
+	p := testProfile().Copy()
+	fm, im, _ := p.FilterSamplesByName(regexp.MustCompile(`main\.f`), nil, nil)
+	if !fm || im || len(p.Sample) != 1 {
+		t.Errorf("focus: matched %v, %v, %d samples; want true, false, 1", fm, im, len(p.Sample))
+	}
+
+	p = testProfile()
+	_, im, hm := p.FilterSamplesByName(nil, regexp.MustCompile(`main\.f`), regexp.MustCompile(`main\.g`))
+	if !im || !hm || len(p.Sample) != 1 || len(p.Sample[0].Location) != 0 {
+		t.Errorf("ignore and hide: %v, %v, %v", im, hm, stacks(p))
+	}
+
+	p = testProfile()
+	fm, _ = p.FilterSamplesByLabel(regexp.MustCompile(`^k=v$`), nil)
+	if !fm || len(p.Sample) != 1 || p.Sample[0].Value[0] != 3 {
+		t.Errorf("tag focus: matched %v, values %v", fm, values(p))
+	}
+}
+
+func TestCopy(t *testing.T) {
+	p := testProfile()
+	q := p.Copy()
+	q.Scale(2)
+	q.FilterSamplesByName(nil, nil, regexp.MustCompile(`main\.f`))
+	if got, want := values(p), [][]int64{{3, 30}, {1, 10}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("values of original %v, want %v", got, want)
+	}
+	if len(p.Sample[0].Location) != 2 {
+		t.Errorf("original stack changed by filtering the copy")
+	}
+}
@@ -0,0 +1,578 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Encoding and decoding of profile.proto messages.
+// See https://github.com/google/pprof/blob/master/proto/profile.proto.
+
+package profile
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Field numbers of the messages in profile.proto.
+const (
+	// message Profile
+	tagProfile_SampleType    = 1  // repeated ValueType
+	tagProfile_Sample        = 2  // repeated Sample
+	tagProfile_Mapping       = 3  // repeated Mapping
+	tagProfile_Location      = 4  // repeated Location
+	tagProfile_Function      = 5  // repeated Function
+	tagProfile_StringTable   = 6  // repeated string
+	tagProfile_TimeNanos     = 9  // int64
+	tagProfile_DurationNanos = 10 // int64
+	tagProfile_PeriodType    = 11 // ValueType
+	tagProfile_Period        = 12 // int64
+
+	// message ValueType
+	tagValueType_Type = 1 // int64 (string table index)
+	tagValueType_Unit = 2 // int64 (string table index)
+
+	// message Sample
+	tagSample_Location = 1 // repeated uint64
+	tagSample_Value    = 2 // repeated int64
+	tagSample_Label    = 3 // repeated Label
+
+	// message Label
+	tagLabel_Key = 1 // int64 (string table index)
+	tagLabel_Str = 2 // int64 (string table index)
+
+	// message Mapping
+	tagMapping_ID              = 1  // uint64
+	tagMapping_Start           = 2  // uint64
+	tagMapping_Limit           = 3  // uint64
+	tagMapping_Offset          = 4  // uint64
+	tagMapping_Filename        = 5  // int64 (string table index)
+	tagMapping_BuildID         = 6  // int64 (string table index)
+	tagMapping_HasFunctions    = 7  // bool
+	tagMapping_HasFilenames    = 8  // bool
+	tagMapping_HasLineNumbers  = 9  // bool
+	tagMapping_HasInlineFrames = 10 // bool
+
+	// message Location
+	tagLocation_ID        = 1 // uint64
+	tagLocation_MappingID = 2 // uint64
+	tagLocation_Address   = 3 // uint64
+	tagLocation_Line      = 4 // repeated Line
+
+	// message Line
+	tagLine_FunctionID = 1 // uint64
+	tagLine_Line       = 2 // int64
+
+	// message Function
+	tagFunction_ID         = 1 // uint64
+	tagFunction_Name       = 2 // int64 (string table index)
+	tagFunction_SystemName = 3 // int64 (string table index)
+	tagFunction_Filename   = 4 // int64 (string table index)
+	tagFunction_StartLine  = 5 // int64
+)
+
+var errMalformed = errors.New("malformed profile: bad protocol buffer encoding")
+
+// A field is a decoded field of a protocol buffer message.
+// Varint fields have their value in x, length-delimited fields in b.
+type field struct {
+	tag  int
+	wire int
+	x    uint64
+	b    []byte
+}
+
+// decodeFields calls f with each field of the message in data.
+func decodeFields(data []byte, f func(field) error) error {
+	for len(data) > 0 {
+		key, n := decodeVarint(data)
+		if n == 0 {
+			return errMalformed
+		}
+		data = data[n:]
+		fld := field{tag: int(key >> 3), wire: int(key & 7)}
+		switch fld.wire {
+		case 0:
+			fld.x, n = decodeVarint(data)
+			if n == 0 {
+				return errMalformed
+			}
+			data = data[n:]
+		case 1:
+			if len(data) < 8 {
+				return errMalformed
+			}
+			data = data[8:]
+			continue
+		case 2:
+			fld.x, n = decodeVarint(data)
+			if n == 0 || uint64(len(data)-n) < fld.x {
+				return errMalformed
+			}
+			fld.b, data = data[n:n+int(fld.x)], data[n+int(fld.x):]
+		case 5:
+			if len(data) < 4 {
+				return errMalformed
+			}
+			data = data[4:]
+			continue
+		default:
+			return errMalformed
+		}
+		if err := f(fld); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeVarint(data []byte) (uint64, int) {
+	var x uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		x |= uint64(data[i]&0x7f) << (7 * uint(i))
+		if data[i] < 0x80 {
+			return x, i + 1
+		}
+	}
+	return 0, 0
+}
+
+// uint64s appends the values of a repeated field, packed or not, to list.
+func (f field) uint64s(list []uint64) ([]uint64, error) {
+	if f.wire == 0 {
+		return append(list, f.x), nil
+	}
+	b := f.b
+	for len(b) > 0 {
+		x, n := decodeVarint(b)
+		if n == 0 {
+			return nil, errMalformed
+		}
+		list = append(list, x)
+		b = b[n:]
+	}
+	return list, nil
+}
+
+// parseProto decodes an uncompressed profile.proto message.
+func parseProto(data []byte) (*Profile, error) {
+	// Read the string table first: other messages refer to it by index.
+	var strs []string
+	err := decodeFields(data, func(f field) error {
+		if f.tag == tagProfile_StringTable {
+			if f.wire != 2 {
+				return errMalformed
+			}
+			strs = append(strs, string(f.b))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(strs) == 0 || strs[0] != "" {
+		return nil, fmt.Errorf("malformed profile: string table must start with empty string")
+	}
+	var badString error
+	str := func(i uint64) string {
+		if i >= uint64(len(strs)) {
+			badString = fmt.Errorf("malformed profile: string index %d out of range", i)
+			return ""
+		}
+		return strs[i]
+	}
+	valueType := func(b []byte) (*ValueType, error) {
+		t := new(ValueType)
+		err := decodeFields(b, func(f field) error {
+			switch f.tag {
+			case tagValueType_Type:
+				t.Type = str(f.x)
+			case tagValueType_Unit:
+				t.Unit = str(f.x)
+			}
+			return nil
+		})
+		return t, err
+	}
+
+	// Locations refer to functions and mappings, and samples
+	// to locations, by ids that are resolved once all are read.
+	p := new(Profile)
+	var sampleLocs [][]uint64
+	type lineRef struct {
+		loc  *Location
+		fn   []uint64
+		line []int64
+	}
+	var locRefs []lineRef
+	var locMappings []uint64
+	err = decodeFields(data, func(f field) error {
+		switch f.tag {
+		case tagProfile_SampleType:
+			t, err := valueType(f.b)
+			p.SampleType = append(p.SampleType, t)
+			return err
+		case tagProfile_PeriodType:
+			t, err := valueType(f.b)
+			p.PeriodType = t
+			return err
+		case tagProfile_Period:
+			p.Period = int64(f.x)
+		case tagProfile_TimeNanos:
+			p.TimeNanos = int64(f.x)
+		case tagProfile_DurationNanos:
+			p.DurationNanos = int64(f.x)
+		case tagProfile_Sample:
+			s := new(Sample)
+			var locs []uint64
+			err := decodeFields(f.b, func(f field) error {
+				var err error
+				switch f.tag {
+				case tagSample_Location:
+					locs, err = f.uint64s(locs)
+				case tagSample_Value:
+					var v []uint64
+					v, err = f.uint64s(nil)
+					for _, x := range v {
+						s.Value = append(s.Value, int64(x))
+					}
+				case tagSample_Label:
+					var key, value string
+					err = decodeFields(f.b, func(f field) error {
+						switch f.tag {
+						case tagLabel_Key:
+							key = str(f.x)
+						case tagLabel_Str:
+							value = str(f.x)
+						}
+						return nil
+					})
+					if value != "" {
+						if s.Label == nil {
+							s.Label = make(map[string][]string)
+						}
+						s.Label[key] = append(s.Label[key], value)
+					}
+				}
+				return err
+			})
+			p.Sample = append(p.Sample, s)
+			sampleLocs = append(sampleLocs, locs)
+			return err
+		case tagProfile_Mapping:
+			m := new(Mapping)
+			err := decodeFields(f.b, func(f field) error {
+				switch f.tag {
+				case tagMapping_ID:
+					m.ID = f.x
+				case tagMapping_Start:
+					m.Start = f.x
+				case tagMapping_Limit:
+					m.Limit = f.x
+				case tagMapping_Offset:
+					m.Offset = f.x
+				case tagMapping_Filename:
+					m.File = str(f.x)
+				case tagMapping_BuildID:
+					m.BuildID = str(f.x)
+				case tagMapping_HasFunctions:
+					m.HasFunctions = f.x != 0
+				case tagMapping_HasFilenames:
+					m.HasFilenames = f.x != 0
+				case tagMapping_HasLineNumbers:
+					m.HasLineNumbers = f.x != 0
+				case tagMapping_HasInlineFrames:
+					m.HasInlineFrames = f.x != 0
+				}
+				return nil
+			})
+			p.Mapping = append(p.Mapping, m)
+			return err
+		case tagProfile_Location:
+			l := new(Location)
+			ref := lineRef{loc: l}
+			var mapping uint64
+			err := decodeFields(f.b, func(f field) error {
+				switch f.tag {
+				case tagLocation_ID:
+					l.ID = f.x
+				case tagLocation_MappingID:
+					mapping = f.x
+				case tagLocation_Address:
+					l.Address = f.x
+				case tagLocation_Line:
+					var fn uint64
+					var line int64
+					err := decodeFields(f.b, func(f field) error {
+						switch f.tag {
+						case tagLine_FunctionID:
+							fn = f.x
+						case tagLine_Line:
+							line = int64(f.x)
+						}
+						return nil
+					})
+					ref.fn = append(ref.fn, fn)
+					ref.line = append(ref.line, line)
+					return err
+				}
+				return nil
+			})
+			p.Location = append(p.Location, l)
+			locRefs = append(locRefs, ref)
+			locMappings = append(locMappings, mapping)
+			return err
+		case tagProfile_Function:
+			fn := new(Function)
+			err := decodeFields(f.b, func(f field) error {
+				switch f.tag {
+				case tagFunction_ID:
+					fn.ID = f.x
+				case tagFunction_Name:
+					fn.Name = str(f.x)
+				case tagFunction_SystemName:
+					fn.SystemName = str(f.x)
+				case tagFunction_Filename:
+					fn.Filename = str(f.x)
+				case tagFunction_StartLine:
+					fn.StartLine = int64(f.x)
+				}
+				return nil
+			})
+			p.Function = append(p.Function, fn)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if badString != nil {
+		return nil, badString
+	}
+
+	// Resolve ids.
+	mappings := make(map[uint64]*Mapping)
+	for _, m := range p.Mapping {
+		if m.ID == 0 || mappings[m.ID] != nil {
+			return nil, fmt.Errorf("malformed profile: bad mapping id %d", m.ID)
+		}
+		mappings[m.ID] = m
+	}
+	functions := make(map[uint64]*Function)
+	for _, fn := range p.Function {
+		if fn.ID == 0 || functions[fn.ID] != nil {
+			return nil, fmt.Errorf("malformed profile: bad function id %d", fn.ID)
+		}
+		functions[fn.ID] = fn
+	}
+	locations := make(map[uint64]*Location)
+	for i, l := range p.Location {
+		if l.ID == 0 || locations[l.ID] != nil {
+			return nil, fmt.Errorf("malformed profile: bad location id %d", l.ID)
+		}
+		locations[l.ID] = l
+		if id := locMappings[i]; id != 0 {
+			if l.Mapping = mappings[id]; l.Mapping == nil {
+				return nil, fmt.Errorf("malformed profile: location %d refers to missing mapping %d", l.ID, id)
+			}
+		}
+		ref := locRefs[i]
+		for j, id := range ref.fn {
+			fn := functions[id]
+			if fn == nil {
+				return nil, fmt.Errorf("malformed profile: location %d refers to missing function %d", l.ID, id)
+			}
+			l.Line = append(l.Line, Line{fn, ref.line[j]})
+		}
+	}
+	for i, s := range p.Sample {
+		for _, id := range sampleLocs[i] {
+			l := locations[id]
+			if l == nil {
+				return nil, fmt.Errorf("malformed profile: sample refers to missing location %d", id)
+			}
+			s.Location = append(s.Location, l)
+		}
+	}
+	return p, nil
+}
+
+// A buffer is a protocol buffer encoder.
+type buffer struct {
+	data    []byte
+	strings []string
+	strmap  map[string]int64
+}
+
+func (b *buffer) varint(x uint64) {
+	for x >= 0x80 {
+		b.data = append(b.data, byte(x)|0x80)
+		x >>= 7
+	}
+	b.data = append(b.data, byte(x))
+}
+
+func (b *buffer) uint64(tag int, x uint64) {
+	if x == 0 {
+		return
+	}
+	b.varint(uint64(tag) << 3)
+	b.varint(x)
+}
+
+func (b *buffer) int64(tag int, x int64) {
+	b.uint64(tag, uint64(x))
+}
+
+func (b *buffer) bool(tag int, x bool) {
+	if x {
+		b.uint64(tag, 1)
+	}
+}
+
+func (b *buffer) bytes(tag int, x []byte) {
+	b.varint(uint64(tag)<<3 | 2)
+	b.varint(uint64(len(x)))
+	b.data = append(b.data, x...)
+}
+
+// packed writes x as a packed repeated field.
+func (b *buffer) packed(tag int, x []uint64) {
+	if len(x) == 0 {
+		return
+	}
+	var p buffer
+	for _, u := range x {
+		p.varint(u)
+	}
+	b.bytes(tag, p.data)
+}
+
+// message writes the message encoded by f as field tag.
+func (b *buffer) message(tag int, f func(*buffer)) {
+	m := buffer{strings: b.strings, strmap: b.strmap}
+	f(&m)
+	b.strings = m.strings
+	b.bytes(tag, m.data)
+}
+
+// string returns the string table index of s.
+func (b *buffer) string(s string) int64 {
+	i, ok := b.strmap[s]
+	if !ok {
+		i = int64(len(b.strings))
+		b.strings = append(b.strings, s)
+		b.strmap[s] = i
+	}
+	return i
+}
+
+// Write writes p to w as a gzipped profile.proto message.
+// Ids are renumbered from 1.
+func (p *Profile) Write(w io.Writer) error {
+	b := &buffer{strings: []string{""}, strmap: map[string]int64{"": 0}}
+	valueType := func(t *ValueType) func(*buffer) {
+		return func(b *buffer) {
+			b.int64(tagValueType_Type, b.string(t.Type))
+			b.int64(tagValueType_Unit, b.string(t.Unit))
+		}
+	}
+
+	mapIDs := make(map[*Mapping]uint64)
+	for i, m := range p.Mapping {
+		mapIDs[m] = uint64(i + 1)
+	}
+	funcIDs := make(map[*Function]uint64)
+	for i, fn := range p.Function {
+		funcIDs[fn] = uint64(i + 1)
+	}
+	locIDs := make(map[*Location]uint64)
+	for i, l := range p.Location {
+		locIDs[l] = uint64(i + 1)
+	}
+
+	for _, t := range p.SampleType {
+		b.message(tagProfile_SampleType, valueType(t))
+	}
+	for _, s := range p.Sample {
+		b.message(tagProfile_Sample, func(b *buffer) {
+			var locs, values []uint64
+			for _, l := range s.Location {
+				locs = append(locs, locIDs[l])
+			}
+			for _, v := range s.Value {
+				values = append(values, uint64(v))
+			}
+			b.packed(tagSample_Location, locs)
+			b.packed(tagSample_Value, values)
+			var keys []string
+			for k := range s.Label {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				for _, v := range s.Label[k] {
+					b.message(tagSample_Label, func(b *buffer) {
+						b.int64(tagLabel_Key, b.string(k))
+						b.int64(tagLabel_Str, b.string(v))
+					})
+				}
+			}
+		})
+	}
+	for _, m := range p.Mapping {
+		b.message(tagProfile_Mapping, func(b *buffer) {
+			b.uint64(tagMapping_ID, mapIDs[m])
+			b.uint64(tagMapping_Start, m.Start)
+			b.uint64(tagMapping_Limit, m.Limit)
+			b.uint64(tagMapping_Offset, m.Offset)
+			b.int64(tagMapping_Filename, b.string(m.File))
+			b.int64(tagMapping_BuildID, b.string(m.BuildID))
+			b.bool(tagMapping_HasFunctions, m.HasFunctions)
+			b.bool(tagMapping_HasFilenames, m.HasFilenames)
+			b.bool(tagMapping_HasLineNumbers, m.HasLineNumbers)
+			b.bool(tagMapping_HasInlineFrames, m.HasInlineFrames)
+		})
+	}
+	for _, l := range p.Location {
+		b.message(tagProfile_Location, func(b *buffer) {
+			b.uint64(tagLocation_ID, locIDs[l])
+			if l.Mapping != nil {
+				b.uint64(tagLocation_MappingID, mapIDs[l.Mapping])
+			}
+			b.uint64(tagLocation_Address, l.Address)
+			for _, ln := range l.Line {
+				b.message(tagLocation_Line, func(b *buffer) {
+					b.uint64(tagLine_FunctionID, funcIDs[ln.Function])
+					b.int64(tagLine_Line, ln.Line)
+				})
+			}
+		})
+	}
+	for _, fn := range p.Function {
+		b.message(tagProfile_Function, func(b *buffer) {
+			b.uint64(tagFunction_ID, funcIDs[fn])
+			b.int64(tagFunction_Name, b.string(fn.Name))
+			b.int64(tagFunction_SystemName, b.string(fn.SystemName))
+			b.int64(tagFunction_Filename, b.string(fn.Filename))
+			b.int64(tagFunction_StartLine, fn.StartLine)
+		})
+	}
+	b.int64(tagProfile_TimeNanos, p.TimeNanos)
+	b.int64(tagProfile_DurationNanos, p.DurationNanos)
+	if p.PeriodType != nil {
+		b.message(tagProfile_PeriodType, valueType(p.PeriodType))
+	}
+	b.int64(tagProfile_Period, p.Period)
+	for _, s := range b.strings {
+		b.bytes(tagProfile_StringTable, []byte(s))
+	}
+
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(b.data); err != nil {
+		return err
+	}
+	return zw.Close()
+}
@@ -0,0 +1,136 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// Dot writes the call graph in Graphviz dot format.  The size of
+// the text of a node grows with its flat value and the width of an
+// edge with its weight; nodes and edges that grew in a diff profile
+// are red, those that shrank are green.
+func (r *Report) Dot(w io.Writer) error {
+	nodes, dropped := r.selectNodes()
+	ids := make(map[*node]int)
+	var maxFlat int64
+	for i, n := range nodes {
+		ids[n] = i + 1
+		if abs(n.flat) > maxFlat {
+			maxFlat = abs(n.flat)
+		}
+	}
+
+	title := r.opts.Title
+	if title == "" {
+		title = "unnamed"
+	}
+	fmt.Fprintf(w, "digraph %s {\n", quote(title))
+	fmt.Fprintf(w, "node [style=filled fillcolor=\"#f8f8f8\"]\n")
+	fmt.Fprintf(w, "subgraph cluster_L { \"legend\" [shape=box fontsize=16 label=%s] }\n",
+		quote(strings.Join(r.legend(nodes, dropped), `\l`)+`\l`))
+
+	for _, n := range nodes {
+		label := strings.Replace(dotName(n.info), `\`, `\\`, -1)
+		label = strings.Replace(label, "\n", `\n`, -1)
+		label += fmt.Sprintf(`\n%s (%s)`, r.Value(n.flat), percent(n.flat, r.total))
+		if n.cum != n.flat {
+			label += fmt.Sprintf(`\nof %s (%s)`, r.Value(n.cum), percent(n.cum, r.total))
+		}
+		size := 8.0
+		if maxFlat > 0 {
+			size += 32 * math.Sqrt(float64(abs(n.flat))/float64(maxFlat))
+		}
+		fmt.Fprintf(w, "N%d [label=%s fontsize=%.0f shape=box tooltip=%s color=%s fillcolor=%s]\n",
+			ids[n], quote(label), size, quote(fmt.Sprintf("%s (%s)", n.info, r.Value(n.cum))),
+			quote(r.color(n.cum, false)), quote(r.color(n.cum, true)))
+	}
+
+	minEdge := int64(r.opts.EdgeFraction * float64(r.total))
+	for _, n := range nodes {
+		for _, e := range sortedEdges(n.out) {
+			if ids[e.node] == 0 || abs(e.weight) <= minEdge && minEdge > 0 {
+				continue
+			}
+			width := 1.0
+			if r.total > 0 {
+				width = 1 + 5*float64(abs(e.weight))/float64(r.total)
+			}
+			fmt.Fprintf(w, "N%d -> N%d [label=\" %s\" weight=%d penwidth=%.1f color=%s tooltip=%s]\n",
+				ids[n], ids[e.node], r.Value(e.weight), 1+100*abs(e.weight)/max(r.total, 1), width,
+				quote(r.color(e.weight, false)), quote(fmt.Sprintf("%s -> %s (%s)", n.info.name, e.node.info.name, r.Value(e.weight))))
+		}
+	}
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// dotName returns the name of a node as shown in a graph,
+// with the package path on its own line.
+func dotName(info nodeInfo) string {
+	name := info.name
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		if j := strings.Index(name[i:], "."); j >= 0 {
+			name = name[:i+j] + "\n" + name[i+j+1:]
+		}
+	} else if j := strings.Index(name, "."); j >= 0 && info.file != name {
+		name = name[:j] + "\n" + name[j+1:]
+	}
+	if info.address != 0 {
+		name = fmt.Sprintf("%#x\n%s", info.address, name)
+	}
+	if info.line != 0 {
+		name = fmt.Sprintf("%s\n%s:%d", name, baseName(info.file), info.line)
+	}
+	return name
+}
+
+func baseName(file string) string {
+	if i := strings.LastIndex(file, "/"); i >= 0 {
+		return file[i+1:]
+	}
+	return file
+}
+
+// color returns the color for a node or edge of the given value:
+// shades of red for positive values and of green for negative ones,
+// fading to gray as the value becomes small.  If fill is set it
+// returns the lighter color used to fill nodes.
+func (r *Report) color(v int64, fill bool) string {
+	f := 0.0
+	if r.total > 0 {
+		f = math.Sqrt(float64(abs(v)) / float64(r.total))
+	}
+	if fill {
+		f /= 8
+	}
+	gray, light := 0xb2, 0xff
+	if fill {
+		gray = 0xee
+	}
+	// Interpolate from gray toward the saturated color.
+	hi := gray + int(float64(light-gray)*f)
+	lo := gray - int(float64(gray)*f)
+	if v < 0 {
+		return fmt.Sprintf("#%02x%02x%02x", lo, hi, lo)
+	}
+	return fmt.Sprintf("#%02x%02x%02x", hi, lo, lo)
+}
+
+// quote returns s as a quoted dot string.  Escape sequences
+// such as \n and \l in s are passed through.
+func quote(s string) string {
+	return `"` + strings.Replace(s, `"`, `\"`, -1) + `"`
+}
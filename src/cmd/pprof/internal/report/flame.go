@@ -0,0 +1,137 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html"
+	"io"
+	"sort"
+)
+
+// A frame is a node of the tree of call stacks drawn
+// by a flame graph.
+type frame struct {
+	name     string
+	value    int64
+	children map[string]*frame
+}
+
+func (f *frame) child(name string) *frame {
+	c := f.children[name]
+	if c == nil {
+		c = &frame{name: name, children: make(map[string]*frame)}
+		f.children[name] = c
+	}
+	return c
+}
+
+// sortedChildren returns the children of f in alphabetical order,
+// so that identical stacks line up across flame graphs.
+func (f *frame) sortedChildren() []*frame {
+	var names []string
+	for name := range f.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var cs []*frame
+	for _, name := range names {
+		cs = append(cs, f.children[name])
+	}
+	return cs
+}
+
+const (
+	flameWidth       = 1200
+	flameFrameHeight = 16
+	flameCharWidth   = 7
+)
+
+// Flame writes a flame graph of the profile as a self-contained SVG
+// image.  Each box is a function on the stack; its width is the value
+// of the samples in which it appears and the boxes above it are the
+// functions it calls.  Negative values, as in diff profiles, are left
+// out.
+func (r *Report) Flame(w io.Writer) error {
+	root := &frame{name: "root", children: make(map[string]*frame)}
+	depth := 0
+	for _, s := range r.prof.Sample {
+		v := s.Value[r.opts.SampleIndex]
+		if v <= 0 {
+			continue
+		}
+		var names []string // leaf first
+		for _, l := range s.Location {
+			for _, info := range locationInfo(l, "functions") {
+				names = append(names, info.name)
+			}
+		}
+		root.value += v
+		f := root
+		for i := len(names) - 1; i >= 0; i-- {
+			f = f.child(names[i])
+			f.value += v
+		}
+		if len(names) > depth {
+			depth = len(names)
+		}
+	}
+	if root.value == 0 {
+		return fmt.Errorf("no samples with positive values")
+	}
+
+	height := (depth + 3) * flameFrameHeight
+	fmt.Fprintf(w, `<?xml version="1.0" standalone="no"?>
+<svg version="1.1" width="%d" height="%d" xmlns="http://www.w3.org/2000/svg">
+<style>
+text { font-family: monospace; font-size: 12px; fill: #000; pointer-events: none; }
+rect { stroke: #fff; stroke-width: 0.5; }
+</style>
+<rect x="0" y="0" width="%d" height="%d" fill="#fff"/>
+`, flameWidth, height, flameWidth, height)
+	title := r.opts.Title
+	if title == "" {
+		title = "Flame Graph"
+	}
+	fmt.Fprintf(w, "<text x=\"%d\" y=\"%d\">%s</text>\n", 4, flameFrameHeight-4, html.EscapeString(title))
+
+	scale := float64(flameWidth) / float64(root.value)
+	var draw func(f *frame, x float64, level int)
+	draw = func(f *frame, x float64, level int) {
+		width := float64(f.value) * scale
+		if width < 0.5 {
+			return
+		}
+		y := height - (level+1)*flameFrameHeight
+		tip := fmt.Sprintf("%s (%s, %s)", f.name, r.Value(f.value), percent(f.value, root.value))
+		fmt.Fprintf(w, "<g><title>%s</title><rect x=\"%.1f\" y=\"%d\" width=\"%.1f\" height=\"%d\" fill=\"%s\"/>",
+			html.EscapeString(tip), x, y, width, flameFrameHeight, flameColor(f.name))
+		if n := int(width-4) / flameCharWidth; n >= 3 {
+			label := f.name
+			if len(label) > n {
+				label = label[:n-2] + ".."
+			}
+			fmt.Fprintf(w, "<text x=\"%.1f\" y=\"%d\">%s</text>", x+2, y+flameFrameHeight-4, html.EscapeString(label))
+		}
+		fmt.Fprintf(w, "</g>\n")
+		for _, c := range f.sortedChildren() {
+			draw(c, x, level+1)
+			x += float64(c.value) * scale
+		}
+	}
+	draw(root, 0, 0)
+	fmt.Fprintf(w, "</svg>\n")
+	return nil
+}
+
+// flameColor returns a warm color derived from name,
+// so that a function has the same color everywhere.
+func flameColor(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	x := h.Sum32()
+	return fmt.Sprintf("rgb(%d,%d,%d)", 205+x%50, 80+(x>>8)%150, (x>>16)%55)
+}
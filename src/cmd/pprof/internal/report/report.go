@@ -0,0 +1,470 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package report generates the reports of the pprof tool:
+// text listings of the heaviest functions, call graphs in
+// Graphviz dot format, flame graphs, and annotated source
+// and disassembly.
+package report
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"cmd/pprof/internal/profile"
+)
+
+// Options control the generation of a report.
+type Options struct {
+	SampleIndex  int     // index of the sample value to report
+	Granularity  string  // "functions" (default), "files", "lines" or "addresses"
+	NodeCount    int     // maximum number of nodes to show, or 0 for no limit
+	NodeFraction float64 // hide nodes below this fraction of the total
+	EdgeFraction float64 // hide graph edges below this fraction of the total
+	CumSort      bool    // sort by cumulative rather than flat value
+	Title        string  // name of the profiled program, for headers
+}
+
+// A Report is a profile prepared for reporting.
+type Report struct {
+	prof  *profile.Profile
+	opts  Options
+	unit  string
+	total int64 // sum of the absolute values of the samples
+	g     *graph
+}
+
+// New returns a report of p.  The profile must not be changed
+// while the report is in use.
+func New(p *profile.Profile, opts Options) *Report {
+	if opts.Granularity == "" {
+		opts.Granularity = "functions"
+	}
+	r := &Report{prof: p, opts: opts}
+	if opts.SampleIndex < len(p.SampleType) {
+		r.unit = p.SampleType[opts.SampleIndex].Unit
+	}
+	for _, s := range p.Sample {
+		r.total += abs(s.Value[opts.SampleIndex])
+	}
+	r.g = r.newGraph(opts.Granularity)
+	return r
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// A nodeInfo identifies a node of the graph: a function,
+// file, line or address, depending on the granularity.
+type nodeInfo struct {
+	name    string
+	file    string
+	line    int64
+	address uint64
+}
+
+// String returns the name of the node as shown in reports.
+func (n nodeInfo) String() string {
+	s := n.name
+	if n.address != 0 {
+		s = fmt.Sprintf("%016x %s", n.address, s)
+	}
+	if n.line != 0 {
+		s = fmt.Sprintf("%s %s:%d", s, filepath.Base(n.file), n.line)
+	}
+	return s
+}
+
+// A node is a node of the call graph.  Its flat value is the total
+// of the samples in which it is the leaf; its cumulative value also
+// includes the samples in which it is on the stack.
+type node struct {
+	info      nodeInfo
+	flat, cum int64
+	in, out   map[*node]int64 // edge weights by caller and callee
+}
+
+// A graph is the call graph of a profile.
+type graph struct {
+	nodes []*node
+}
+
+// newGraph returns the call graph of the report's profile
+// at the given granularity.
+func (r *Report) newGraph(granularity string) *graph {
+	byInfo := make(map[nodeInfo]*node)
+	locNodes := make(map[*profile.Location][]*node)
+	nodesOf := func(l *profile.Location) []*node {
+		if ns, ok := locNodes[l]; ok {
+			return ns
+		}
+		var ns []*node
+		for _, info := range locationInfo(l, granularity) {
+			n := byInfo[info]
+			if n == nil {
+				n = &node{info: info, in: make(map[*node]int64), out: make(map[*node]int64)}
+				byInfo[info] = n
+			}
+			ns = append(ns, n)
+		}
+		locNodes[l] = ns
+		return ns
+	}
+
+	type edge struct{ caller, callee *node }
+	for _, s := range r.prof.Sample {
+		v := s.Value[r.opts.SampleIndex]
+		if v == 0 {
+			continue
+		}
+		var stk []*node // leaf first
+		for _, l := range s.Location {
+			for _, n := range nodesOf(l) {
+				if len(stk) > 0 && stk[len(stk)-1] == n && granularity == "files" {
+					continue
+				}
+				stk = append(stk, n)
+			}
+		}
+		if len(stk) == 0 {
+			continue
+		}
+		stk[0].flat += v
+		seen := make(map[*node]bool)
+		seenEdge := make(map[edge]bool)
+		for i, n := range stk {
+			if !seen[n] {
+				seen[n] = true
+				n.cum += v
+			}
+			if i+1 < len(stk) {
+				e := edge{stk[i+1], n}
+				if e.caller != e.callee && !seenEdge[e] {
+					seenEdge[e] = true
+					e.caller.out[e.callee] += v
+					e.callee.in[e.caller] += v
+				}
+			}
+		}
+	}
+
+	g := new(graph)
+	for _, n := range byInfo {
+		g.nodes = append(g.nodes, n)
+	}
+	return g
+}
+
+// locationInfo returns the nodes of location l at the given
+// granularity, innermost first.
+func locationInfo(l *profile.Location, granularity string) []nodeInfo {
+	if len(l.Line) == 0 {
+		info := nodeInfo{name: fmt.Sprintf("%#x", l.Address)}
+		if granularity == "addresses" {
+			info = nodeInfo{name: "?", address: l.Address}
+		}
+		return []nodeInfo{info}
+	}
+	var infos []nodeInfo
+	for _, ln := range l.Line {
+		fn := ln.Function
+		var info nodeInfo
+		switch granularity {
+		case "files":
+			info.name = fn.Filename
+		case "lines":
+			info = nodeInfo{name: fn.Name, file: fn.Filename, line: ln.Line}
+		case "addresses":
+			info = nodeInfo{name: fn.Name, file: fn.Filename, line: ln.Line, address: l.Address}
+		default:
+			info.name = fn.Name
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// sortedNodes returns the nodes of the report's graph sorted by
+// decreasing absolute flat or, with CumSort, cumulative value.
+func (r *Report) sortedNodes() []*node {
+	nodes := append([]*node(nil), r.g.nodes...)
+	sort.Sort(nodeSorter{nodes, r.opts.CumSort})
+	return nodes
+}
+
+type nodeSorter struct {
+	nodes []*node
+	cum   bool
+}
+
+func (x nodeSorter) Len() int      { return len(x.nodes) }
+func (x nodeSorter) Swap(i, j int) { x.nodes[i], x.nodes[j] = x.nodes[j], x.nodes[i] }
+func (x nodeSorter) Less(i, j int) bool {
+	a, b := x.nodes[i], x.nodes[j]
+	av, bv := abs(a.flat), abs(b.flat)
+	if x.cum {
+		av, bv = abs(a.cum), abs(b.cum)
+	}
+	if av != bv {
+		return av > bv
+	}
+	if ac, bc := abs(a.cum), abs(b.cum); ac != bc {
+		return ac > bc
+	}
+	return a.info.String() < b.info.String()
+}
+
+// selectNodes returns the sorted nodes that pass the NodeFraction
+// and NodeCount limits, and the number of nodes dropped for being
+// below NodeFraction.
+func (r *Report) selectNodes() (nodes []*node, dropped int) {
+	min := int64(r.opts.NodeFraction * float64(r.total))
+	for _, n := range r.sortedNodes() {
+		if abs(n.cum) <= min && min > 0 {
+			dropped++
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	if r.opts.NodeCount > 0 && len(nodes) > r.opts.NodeCount {
+		nodes = nodes[:r.opts.NodeCount]
+	}
+	return nodes, dropped
+}
+
+// Total returns the total of the absolute values of the samples.
+func (r *Report) Total() int64 {
+	return r.total
+}
+
+// Value formats v in the unit of the report.
+func (r *Report) Value(v int64) string {
+	return formatValue(v, r.unit)
+}
+
+// formatValue formats v, measured in unit, for display.
+func formatValue(v int64, unit string) string {
+	switch unit {
+	case "nanoseconds":
+		return formatDuration(v)
+	case "bytes":
+		return formatBytes(v)
+	}
+	return fmt.Sprint(v)
+}
+
+func formatDuration(ns int64) string {
+	d := time.Duration(ns)
+	switch a := abs(ns); {
+	case a == 0:
+		return "0"
+	case a < int64(time.Microsecond):
+		return fmt.Sprintf("%dns", ns)
+	case a < int64(time.Millisecond):
+		return trimFloat(float64(d)/float64(time.Microsecond)) + "us"
+	case a < int64(time.Second):
+		return trimFloat(float64(d)/float64(time.Millisecond)) + "ms"
+	case a < int64(time.Minute):
+		return trimFloat(d.Seconds()) + "s"
+	case a < int64(time.Hour):
+		return trimFloat(d.Minutes()) + "mins"
+	}
+	return trimFloat(d.Hours()) + "hrs"
+}
+
+func formatBytes(b int64) string {
+	units := []string{"B", "kB", "MB", "GB", "TB"}
+	f := float64(b)
+	i := 0
+	for math.Abs(f) >= 1024 && i+1 < len(units) {
+		f /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%dB", b)
+	}
+	return trimFloat(f) + units[i]
+}
+
+// trimFloat formats f with two decimal places, dropping trailing zeros.
+func trimFloat(f float64) string {
+	s := fmt.Sprintf("%.2f", f)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// percent formats v as a percentage of total.
+func percent(v, total int64) string {
+	if total == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.2f%%", 100*float64(v)/float64(total))
+}
+
+// Header returns the lines describing the profile
+// that head each report.
+func (r *Report) Header() []string {
+	p := r.prof
+	var lines []string
+	if r.opts.Title != "" {
+		lines = append(lines, "File: "+r.opts.Title)
+	}
+	if r.opts.SampleIndex < len(p.SampleType) {
+		lines = append(lines, "Type: "+p.SampleType[r.opts.SampleIndex].Type)
+	}
+	if p.TimeNanos != 0 {
+		lines = append(lines, "Time: "+time.Unix(0, p.TimeNanos).Format("Jan 2, 2006 at 3:04pm (MST)"))
+	}
+	if p.DurationNanos != 0 {
+		line := "Duration: " + formatDuration(p.DurationNanos)
+		if r.unit == "nanoseconds" {
+			line += fmt.Sprintf(", Total samples = %s (%.2f%%)", r.Value(r.total), 100*float64(r.total)/float64(p.DurationNanos))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// legend returns the header lines followed by a summary
+// of the nodes shown.
+func (r *Report) legend(nodes []*node, dropped int) []string {
+	lines := r.Header()
+	var shown int64
+	for _, n := range nodes {
+		shown += abs(n.flat)
+	}
+	lines = append(lines, fmt.Sprintf("Showing nodes accounting for %s, %s of %s total",
+		r.Value(shown), percent(shown, r.total), r.Value(r.total)))
+	if dropped > 0 {
+		min := int64(r.opts.NodeFraction * float64(r.total))
+		lines = append(lines, fmt.Sprintf("Dropped %d nodes (cum <= %s)", dropped, r.Value(min)))
+	}
+	if n := len(r.g.nodes) - dropped; len(nodes) < n {
+		lines = append(lines, fmt.Sprintf("Showing top %d nodes out of %d", len(nodes), n))
+	}
+	return lines
+}
+
+// Top writes the nodes with the highest values, one per line.
+func (r *Report) Top(w io.Writer) error {
+	nodes, dropped := r.selectNodes()
+	for _, line := range r.legend(nodes, dropped) {
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintf(w, "%10s %6s %6s %10s %6s\n", "flat", "flat%", "sum%", "cum", "cum%")
+	var sum int64
+	for _, n := range nodes {
+		sum += n.flat
+		fmt.Fprintf(w, "%10s %6s %6s %10s %6s  %s\n",
+			r.Value(n.flat), percent(n.flat, r.total), percent(sum, r.total),
+			r.Value(n.cum), percent(n.cum, r.total), n.info)
+	}
+	return nil
+}
+
+// A TopEntry is a line of the top report.
+type TopEntry struct {
+	Name      string
+	Flat, Cum string
+	FlatPct   string
+	SumPct    string
+	CumPct    string
+}
+
+// TopEntries returns the lines of the top report,
+// for formatting by the caller.
+func (r *Report) TopEntries() []TopEntry {
+	nodes, _ := r.selectNodes()
+	var entries []TopEntry
+	var sum int64
+	for _, n := range nodes {
+		sum += n.flat
+		entries = append(entries, TopEntry{
+			Name:    n.info.String(),
+			Flat:    r.Value(n.flat),
+			FlatPct: percent(n.flat, r.total),
+			SumPct:  percent(sum, r.total),
+			Cum:     r.Value(n.cum),
+			CumPct:  percent(n.cum, r.total),
+		})
+	}
+	return entries
+}
+
+// Tree writes, for each node shown, its callers and callees
+// with the weights of the calls.  If the focus function is
+// non-nil, only the nodes for which it returns true are shown.
+func (r *Report) Tree(w io.Writer, focus func(name string) bool) error {
+	nodes, dropped := r.selectNodes()
+	legend := r.legend(nodes, dropped)
+	if focus != nil {
+		// Peek at the matching nodes regardless of the limits.
+		nodes, legend = nil, r.Header()
+		for _, n := range r.sortedNodes() {
+			if focus(n.info.name) {
+				nodes = append(nodes, n)
+			}
+		}
+		if len(nodes) == 0 {
+			return fmt.Errorf("no matches found for regexp")
+		}
+	}
+	for _, line := range legend {
+		fmt.Fprintln(w, line)
+	}
+	sep := strings.Repeat("-", 61) + "+-------------\n"
+	fmt.Fprint(w, sep)
+	fmt.Fprintf(w, "%10s %6s %6s %10s %6s  %10s %6s | %s\n", "flat", "flat%", "sum%", "cum", "cum%", "calls", "calls%", "context")
+	fmt.Fprint(w, sep)
+	var sum int64
+	for _, n := range nodes {
+		sum += n.flat
+		for _, e := range sortedEdges(n.in) {
+			fmt.Fprintf(w, "%43s %10s %6s |   %s\n", "", r.Value(e.weight), percent(e.weight, n.cum), e.node.info)
+		}
+		fmt.Fprintf(w, "%10s %6s %6s %10s %6s %18s | %s\n",
+			r.Value(n.flat), percent(n.flat, r.total), percent(sum, r.total),
+			r.Value(n.cum), percent(n.cum, r.total), "", n.info)
+		for _, e := range sortedEdges(n.out) {
+			fmt.Fprintf(w, "%43s %10s %6s |   %s\n", "", r.Value(e.weight), percent(e.weight, n.cum), e.node.info)
+		}
+		fmt.Fprint(w, sep)
+	}
+	return nil
+}
+
+type weightedNode struct {
+	node   *node
+	weight int64
+}
+
+type byWeight []weightedNode
+
+func (x byWeight) Len() int      { return len(x) }
+func (x byWeight) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x byWeight) Less(i, j int) bool {
+	if a, b := abs(x[i].weight), abs(x[j].weight); a != b {
+		return a > b
+	}
+	return x[i].node.info.String() < x[j].node.info.String()
+}
+
+// sortedEdges returns the edges in m by decreasing weight.
+func sortedEdges(m map[*node]int64) []weightedNode {
+	var edges []weightedNode
+	for n, w := range m {
+		edges = append(edges, weightedNode{n, w})
+	}
+	sort.Sort(byWeight(edges))
+	return edges
+}
@@ -0,0 +1,323 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"cmd/pprof/internal/profile"
+)
+
+// testProfile returns a CPU profile of a program in which main calls
+// f and g, g calls f, and f is recursive.  The source is in file.
+func testProfile(file string) *profile.Profile {
+	fmain := &profile.Function{ID: 1, Name: "main.main", Filename: file, StartLine: 3}
+	ff := &profile.Function{ID: 2, Name: "main.f", Filename: file, StartLine: 8}
+	fg := &profile.Function{ID: 3, Name: "main.g", Filename: file, StartLine: 12}
+	loc := func(id, addr uint64, fn *profile.Function, line int64) *profile.Location {
+		return &profile.Location{ID: id, Address: addr, Line: []profile.Line{{Function: fn, Line: line}}}
+	}
+	lmain1 := loc(1, 0x1004, fmain, 4)
+	lmain2 := loc(2, 0x1008, fmain, 5)
+	lf := loc(3, 0x2004, ff, 9)
+	lfrec := loc(4, 0x2008, ff, 10)
+	lg := loc(5, 0x3004, fg, 13)
+	ms := int64(1e6)
+	sample := func(v int64, locs ...*profile.Location) *profile.Sample {
+		return &profile.Sample{Location: locs, Value: []int64{v, v * 10 * ms}}
+	}
+	return &profile.Profile{
+		SampleType: []*profile.ValueType{{Type: "samples", Unit: "count"}, {Type: "cpu", Unit: "nanoseconds"}},
+		Sample: []*profile.Sample{
+			sample(5, lf, lmain1),
+			sample(2, lf, lfrec, lmain1),
+			sample(2, lf, lg, lmain2),
+			sample(1, lg, lmain2),
+		},
+		Location:      []*profile.Location{lmain1, lmain2, lf, lfrec, lg},
+		Function:      []*profile.Function{fmain, ff, fg},
+		DurationNanos: 1e9,
+		PeriodType:    &profile.ValueType{Type: "cpu", Unit: "nanoseconds"},
+		Period:        10 * ms,
+	}
+}
+
+func newReport(p *profile.Profile, opts Options) *Report {
+	opts.SampleIndex = 1
+	return New(p, opts)
+}
+
+func TestGraph(t *testing.T) {
+	r := newReport(testProfile("main.go"), Options{})
+	nodes := make(map[string]*node)
+	for _, n := range r.g.nodes {
+		nodes[n.info.name] = n
+	}
+	ms := int64(1e6)
+	for _, tt := range []struct {
+		name      string
+		flat, cum int64
+	}{
+		{"main.main", 0, 100 * ms},
+		{"main.f", 90 * ms, 90 * ms}, // recursion is counted once
+		{"main.g", 10 * ms, 30 * ms},
+	} {
+		n := nodes[tt.name]
+		if n == nil {
+			t.Errorf("no node for %s", tt.name)
+			continue
+		}
+		if n.flat != tt.flat || n.cum != tt.cum {
+			t.Errorf("%s: flat, cum = %d, %d, want %d, %d", tt.name, n.flat, n.cum, tt.flat, tt.cum)
+		}
+	}
+	if w := nodes["main.main"].out[nodes["main.f"]]; w != 70*ms {
+		t.Errorf("main.main -> main.f weight %d, want 70ms", w)
+	}
+	if w := nodes["main.f"].out[nodes["main.f"]]; w != 0 {
+		t.Errorf("main.f has self edge of weight %d", w)
+	}
+	if w := nodes["main.g"].in[nodes["main.main"]]; w != 30*ms {
+		t.Errorf("main.main -> main.g weight %d, want 30ms", w)
+	}
+}
+
+func TestTop(t *testing.T) {
+	r := newReport(testProfile("main.go"), Options{Title: "prog"})
+	var buf bytes.Buffer
+	if err := r.Top(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `File: prog
+Type: cpu
+Duration: 1s, Total samples = 100ms (10.00%)
+Showing nodes accounting for 100ms, 100.00% of 100ms total
+      flat  flat%   sum%        cum   cum%
+      90ms 90.00% 90.00%       90ms 90.00%  main.f
+      10ms 10.00% 100.00%       30ms 30.00%  main.g
+         0  0.00% 100.00%      100ms 100.00%  main.main
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Top:\n%s\nwant:\n%s", got, want)
+	}
+
+	r = newReport(testProfile("main.go"), Options{CumSort: true, NodeCount: 2})
+	entries := r.TopEntries()
+	if len(entries) != 2 || entries[0].Name != "main.main" || entries[1].Name != "main.f" {
+		t.Errorf("TopEntries with CumSort, NodeCount 2: %+v", entries)
+	}
+}
+
+func TestGranularity(t *testing.T) {
+	for _, tt := range []struct {
+		granularity string
+		want        string
+	}{
+		{"lines", "main.f main.go:9"},
+		{"files", "main.go"},
+		{"addresses", "0000000000002004 main.f main.go:9"},
+	} {
+		r := newReport(testProfile("main.go"), Options{Granularity: tt.granularity})
+		if got := r.TopEntries()[0].Name; got != tt.want {
+			t.Errorf("%s: top entry %q, want %q", tt.granularity, got, tt.want)
+		}
+	}
+}
+
+func TestTree(t *testing.T) {
+	r := newReport(testProfile("main.go"), Options{})
+	var buf bytes.Buffer
+	if err := r.Tree(&buf, func(name string) bool { return name == "main.g" }); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"30ms 100.00% |   main.main",
+		"| main.g",
+		"20ms 66.67% |   main.f",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("peek output lacks %q:\n%s", want, out)
+		}
+	}
+	if err := r.Tree(&buf, func(string) bool { return false }); err == nil {
+		t.Errorf("peek with no matches succeeded")
+	}
+}
+
+func TestList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pprof")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "main.go")
+	src := "package main\n\nfunc main() {\n\tf()\n\tg()\n}\n\nfunc f() {\n\tfor {\n\t\tf()\n\t}\n}\nfunc g() { f() }\n"
+	if err := ioutil.WriteFile(file, []byte(src), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	r := newReport(testProfile(file), Options{})
+	var buf bytes.Buffer
+	if err := r.List(&buf, regexp.MustCompile(`^main\.f$`)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"ROUTINE ======================== main.f in " + file,
+		"      90ms       90ms (flat, cum) 90.00% of Total",
+		"         .          .      8:func f() {",
+		"      90ms       90ms      9:\tfor {",
+		"         .       20ms     10:\t\tf()",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("list output lacks %q:\n%s", want, out)
+		}
+	}
+	if err := r.List(&buf, regexp.MustCompile(`nothing`)); err == nil {
+		t.Errorf("list with no matches succeeded")
+	}
+}
+
+type fakeObjTool struct{}
+
+func (fakeObjTool) Symbols(binary string, re *regexp.Regexp) ([]Sym, error) {
+	var syms []Sym
+	for _, s := range []Sym{{"main.main", 0x1000, 0x2000}, {"main.f", 0x2000, 0x3000}} {
+		if re.MatchString(s.Name) {
+			syms = append(syms, s)
+		}
+	}
+	return syms, nil
+}
+
+func (fakeObjTool) Disasm(binary string, start, end uint64) ([]Inst, error) {
+	return []Inst{
+		{start, "PUSHQ BP", "main.go", 8},
+		{start + 2, "CALL main.f(SB)", "main.go", 9},
+		{start + 7, "JMP 0x2000", "main.go", 10},
+	}, nil
+}
+
+func TestDisasm(t *testing.T) {
+	r := newReport(testProfile("main.go"), Options{})
+	var buf bytes.Buffer
+	if err := r.Disasm(&buf, fakeObjTool{}, "prog", regexp.MustCompile(`main\.f`)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"ROUTINE ======================== main.f",
+		"      90ms       90ms (flat, cum) 90.00% of Total",
+		"      90ms       90ms     2002: CALL main.f(SB)",
+		"         .       20ms     2007: JMP 0x2000",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("disasm output lacks %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	r := newReport(testProfile("main.go"), Options{Title: "prog"})
+	var buf bytes.Buffer
+	if err := r.Dot(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`digraph "prog" {`,
+		`N1 [label="main\nf\n90ms (90.00%)"`,
+		`N3 -> N1 [label=" 70ms"`,
+		`N2 -> N1 [label=" 20ms"`,
+		`N3 -> N2 [label=" 30ms"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dot output lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "N1 -> N1") {
+		t.Errorf("dot output has self edge:\n%s", out)
+	}
+}
+
+func TestFlame(t *testing.T) {
+	r := newReport(testProfile("main.go"), Options{})
+	var buf bytes.Buffer
+	if err := r.Flame(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d := xml.NewDecoder(&buf)
+	var titles []string
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			break
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "title" {
+			var s string
+			if err := d.DecodeElement(&s, &se); err != nil {
+				t.Fatal(err)
+			}
+			titles = append(titles, s)
+		}
+	}
+	want := []string{
+		"root (100ms, 100.00%)",
+		"main.main (100ms, 100.00%)",
+		"main.f (70ms, 70.00%)",
+		"main.f (20ms, 20.00%)",
+		"main.g (30ms, 30.00%)",
+		"main.f (20ms, 20.00%)",
+	}
+	if strings.Join(titles, "\n") != strings.Join(want, "\n") {
+		t.Errorf("flame graph frames:\n%s\nwant:\n%s", strings.Join(titles, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDiff(t *testing.T) {
+	p, base := testProfile("main.go"), testProfile("main.go")
+	base.Sample = base.Sample[:1] // only main.main -> main.f
+	base.Scale(-1)
+	d, err := profile.Merge([]*profile.Profile{p, base})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := newReport(d, Options{})
+	var buf bytes.Buffer
+	r.Top(&buf)
+	if !strings.Contains(buf.String(), "40ms 80.00% 80.00%       40ms 80.00%  main.f") {
+		t.Errorf("diff top:\n%s", buf.String())
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	for _, tt := range []struct {
+		v    int64
+		unit string
+		want string
+	}{
+		{0, "nanoseconds", "0"},
+		{500, "nanoseconds", "500ns"},
+		{1500, "nanoseconds", "1.5us"},
+		{-20e6, "nanoseconds", "-20ms"},
+		{90e9, "nanoseconds", "1.5mins"},
+		{512, "bytes", "512B"},
+		{1536, "bytes", "1.5kB"},
+		{3 << 30, "bytes", "3GB"},
+		{42, "count", "42"},
+	} {
+		if got := formatValue(tt.v, tt.unit); got != tt.want {
+			t.Errorf("formatValue(%d, %s) = %s, want %s", tt.v, tt.unit, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// lineValues accumulates the flat and cumulative values of the
+// source lines or addresses of the profile, and of their functions.
+type lineValues struct {
+	flat, cum     map[lineKey]int64
+	fnFlat, fnCum map[string]int64
+}
+
+type lineKey struct {
+	file string
+	line int64
+	addr uint64
+}
+
+// values returns the flat and cumulative values of the lines of
+// the functions matching re, or, with byAddr, of their addresses.
+func (r *Report) values(re *regexp.Regexp, byAddr bool) *lineValues {
+	lv := &lineValues{
+		flat:   make(map[lineKey]int64),
+		cum:    make(map[lineKey]int64),
+		fnFlat: make(map[string]int64),
+		fnCum:  make(map[string]int64),
+	}
+	for _, s := range r.prof.Sample {
+		v := s.Value[r.opts.SampleIndex]
+		if v == 0 {
+			continue
+		}
+		seen := make(map[lineKey]bool)
+		seenFn := make(map[string]bool)
+		for i, l := range s.Location {
+			for j, ln := range l.Line {
+				if !re.MatchString(ln.Function.Name) {
+					continue
+				}
+				k := lineKey{file: ln.Function.Filename, line: ln.Line}
+				if byAddr {
+					k = lineKey{addr: l.Address}
+				}
+				name := ln.Function.Name
+				if i == 0 && j == 0 {
+					lv.flat[k] += v
+					lv.fnFlat[name] += v
+				}
+				if !seen[k] {
+					seen[k] = true
+					lv.cum[k] += v
+				}
+				if !seenFn[name] {
+					seenFn[name] = true
+					lv.fnCum[name] += v
+				}
+			}
+		}
+	}
+	return lv
+}
+
+// A sourceFunc is a function to be listed.
+type sourceFunc struct {
+	name      string
+	file      string
+	min, max  int64 // range of lines with samples
+	startLine int64
+}
+
+// List writes the source of the functions matching re,
+// annotating each line with its flat and cumulative values.
+func (r *Report) List(w io.Writer, re *regexp.Regexp) error {
+	lv := r.values(re, false)
+
+	funcs := make(map[string]*sourceFunc)
+	for _, l := range r.prof.Location {
+		for _, ln := range l.Line {
+			fn := ln.Function
+			if !re.MatchString(fn.Name) {
+				continue
+			}
+			k := lineKey{file: fn.Filename, line: ln.Line}
+			if lv.cum[k] == 0 {
+				continue
+			}
+			f := funcs[fn.Name]
+			if f == nil {
+				f = &sourceFunc{name: fn.Name, file: fn.Filename, min: ln.Line, max: ln.Line, startLine: fn.StartLine}
+				funcs[fn.Name] = f
+			}
+			if ln.Line < f.min {
+				f.min = ln.Line
+			}
+			if ln.Line > f.max {
+				f.max = ln.Line
+			}
+		}
+	}
+	if len(funcs) == 0 {
+		return fmt.Errorf("no matches found for regexp: %s", re)
+	}
+	var names []string
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, line := range r.Header() {
+		fmt.Fprintln(w, line)
+	}
+	for _, name := range names {
+		f := funcs[name]
+		flat, cum := lv.fnFlat[name], lv.fnCum[name]
+		fmt.Fprintf(w, "ROUTINE ======================== %s in %s\n", f.name, f.file)
+		fmt.Fprintf(w, "%10s %10s (flat, cum) %s of Total\n", r.Value(flat), r.Value(cum), percent(cum, r.total))
+
+		start, end := f.min-5, f.max+5
+		if f.startLine > 0 && f.startLine < f.min {
+			start = f.startLine
+		}
+		if start < 1 {
+			start = 1
+		}
+		src, err := readSource(f.file, start, end)
+		if err != nil {
+			fmt.Fprintf(w, " Error: %v\n", err)
+		}
+		for n := start; n <= end; n++ {
+			k := lineKey{file: f.file, line: n}
+			text, ok := src[n]
+			if !ok && lv.cum[k] == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "%10s %10s %6d:%s\n", valueOrDot(lv.flat[k], r), valueOrDot(lv.cum[k], r), n, text)
+		}
+	}
+	return nil
+}
+
+func valueOrDot(v int64, r *Report) string {
+	if v == 0 {
+		return "."
+	}
+	return r.Value(v)
+}
+
+// readSource returns the lines start through end of file,
+// looking for the file under $GOROOT if it is not found
+// at its recorded path.
+func readSource(file string, start, end int64) (map[int64]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		if i := strings.LastIndex(file, "/src/"); i >= 0 {
+			f, err = os.Open(filepath.Join(runtime.GOROOT(), filepath.FromSlash(file[i+1:])))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	lines := make(map[int64]string)
+	s := bufio.NewScanner(f)
+	for n := int64(1); s.Scan() && n <= end; n++ {
+		if n >= start {
+			lines[n] = s.Text()
+		}
+	}
+	return lines, s.Err()
+}
+
+// An ObjTool gives access to the symbols and instructions
+// of the profiled binaries.
+type ObjTool interface {
+	// Symbols returns the text symbols of binary
+	// whose names match re.
+	Symbols(binary string, re *regexp.Regexp) ([]Sym, error)
+
+	// Disasm returns the instructions of binary with
+	// addresses in the range [start, end).
+	Disasm(binary string, start, end uint64) ([]Inst, error)
+}
+
+// A Sym is a text symbol of a binary.
+type Sym struct {
+	Name       string
+	Start, End uint64
+}
+
+// An Inst is a disassembled instruction.
+type Inst struct {
+	Addr uint64
+	Text string
+	File string
+	Line int
+}
+
+// Disasm writes the disassembly of the functions of binary matching
+// re, annotating each instruction with its flat and cumulative values.
+func (r *Report) Disasm(w io.Writer, obj ObjTool, binary string, re *regexp.Regexp) error {
+	syms, err := obj.Symbols(binary, re)
+	if err != nil {
+		return err
+	}
+	lv := r.values(re, true)
+
+	for _, line := range r.Header() {
+		fmt.Fprintln(w, line)
+	}
+	found := false
+	for _, sym := range syms {
+		insts, err := obj.Disasm(binary, sym.Start, sym.End)
+		if err != nil {
+			return err
+		}
+		// Attribute each sampled address to the
+		// instruction containing it.
+		flat := make([]int64, len(insts))
+		cum := make([]int64, len(insts))
+		symFlat, symCum := lv.fnFlat[sym.Name], lv.fnCum[sym.Name]
+		for k := range lv.cum {
+			if k.addr < sym.Start || k.addr >= sym.End {
+				continue
+			}
+			i := sort.Search(len(insts), func(i int) bool { return insts[i].Addr > k.addr }) - 1
+			if i < 0 {
+				continue
+			}
+			flat[i] += lv.flat[k]
+			cum[i] += lv.cum[k]
+		}
+		if symCum == 0 {
+			continue
+		}
+		found = true
+		fmt.Fprintf(w, "ROUTINE ======================== %s\n", sym.Name)
+		fmt.Fprintf(w, "%10s %10s (flat, cum) %s of Total\n", r.Value(symFlat), r.Value(symCum), percent(symCum, r.total))
+		file, line := "", 0
+		for i, inst := range insts {
+			if inst.File != file || inst.Line != line {
+				file, line = inst.File, inst.Line
+				fmt.Fprintf(w, "%10s %10s %8s %s:%d\n", "", "", "", filepath.Base(file), line)
+			}
+			fmt.Fprintf(w, "%10s %10s %8x: %s\n", valueOrDot(flat[i], r), valueOrDot(cum[i], r), inst.Addr, inst.Text)
+		}
+	}
+	if !found {
+		return fmt.Errorf("no matches found for regexp: %s", re)
+	}
+	return nil
+}
+
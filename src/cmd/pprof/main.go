@@ -0,0 +1,443 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Pprof interprets and displays profiles of Go programs.
+//
+// Usage:
+//
+//	go tool pprof [options] [binary] source...
+//
+// Each source is a profile file or the URL of a profile served by
+// package net/http/pprof.  A source of the form host:port means the
+// CPU profile at http://host:port/debug/pprof/profile, collected for
+// the number of seconds given by -seconds.  Profiles may be in the
+// profile.proto format written by package runtime/pprof or in the
+// legacy formats of earlier versions: CPU, heap, block, mutex and
+// goroutine profiles are all understood.  Several sources are merged
+// into a single profile.
+//
+// The binary is the profiled executable.  Pprof uses its symbol and
+// line tables to attribute the samples to functions and source lines,
+// and the disassemblers in debug/x86asm and debug/armasm to list its
+// instructions.
+// Without a binary, pprof uses the executable recorded in the
+// profile or, for profiles fetched over HTTP, asks the program
+// itself for the names of its functions.
+//
+// The report is chosen by one of the output flags:
+//
+//	-top            the heaviest functions, one per line
+//	-tree           each function with its callers and callees
+//	-peek=regexp    like -tree, for the functions matching regexp
+//	-list=regexp    annotated source of the functions matching regexp
+//	-disasm=regexp  annotated disassembly of the functions matching regexp
+//	-dot            the call graph in Graphviz dot format
+//	-svg            the call graph as an SVG image (requires Graphviz)
+//	-flame          a flame graph as an SVG image
+//	-raw            a text dump of the profile
+//	-proto          the profile in profile.proto format
+//
+// With -http=addr, pprof serves the reports as an interactive web
+// interface at addr.  Without an output flag, pprof starts an
+// interactive shell accepting the same reports as commands; type
+// help for details.
+//
+// The -base flag names a profile to subtract from the sources, so
+// that reports show the differences between two profiles: what grew
+// is positive and what shrank negative.
+//
+// The value reported for each sample is chosen with -sample_index;
+// heap profiles also accept -inuse_space, -inuse_objects,
+// -alloc_space and -alloc_objects.  The remaining flags choose the
+// granularity of the report (-functions, -lines, -addresses, -files),
+// prune it (-nodecount, -nodefraction, -edgefraction), and restrict
+// it to the samples with a function matching -focus, with none
+// matching -ignore, or with a label matching -tagfocus (labels are
+// written key=value).  Functions matching -hide are removed from the
+// stacks.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"cmd/pprof/internal/profile"
+	"cmd/pprof/internal/report"
+)
+
+var (
+	// Output.
+	topFlag    = flag.Bool("top", false, "print the heaviest functions")
+	treeFlag   = flag.Bool("tree", false, "print each function with its callers and callees")
+	peekFlag   = flag.String("peek", "", "print the callers and callees of the functions matching regexp")
+	listFlag   = flag.String("list", "", "print the annotated source of the functions matching regexp")
+	disasmFlag = flag.String("disasm", "", "print the annotated disassembly of the functions matching regexp")
+	dotFlag    = flag.Bool("dot", false, "print the call graph in Graphviz dot format")
+	svgFlag    = flag.Bool("svg", false, "print the call graph as an SVG image")
+	flameFlag  = flag.Bool("flame", false, "print a flame graph as an SVG image")
+	rawFlag    = flag.Bool("raw", false, "print a text dump of the profile")
+	protoFlag  = flag.Bool("proto", false, "print the profile in profile.proto format")
+	outputFlag = flag.String("output", "", "write the report to file instead of standard output")
+	httpFlag   = flag.String("http", "", "serve an interactive web interface at addr (e.g., 'localhost:8080')")
+
+	// Sources.
+	baseFlag      = flag.String("base", "", "subtract the profile in source from the profiles")
+	secondsFlag   = flag.Int("seconds", 30, "duration of CPU profiles fetched over HTTP")
+	timeoutFlag   = flag.Duration("timeout", 60*time.Second, "timeout of HTTP requests, in addition to -seconds")
+	symbolizeFlag = flag.String("symbolize", "", "symbolization: local, remote or none (default: as available)")
+
+	// Report options.
+	sampleIndexFlag  = flag.String("sample_index", "", "report the sample values of this type (default: the last type)")
+	inuseSpaceFlag   = flag.Bool("inuse_space", false, "same as -sample_index=inuse_space")
+	inuseObjectsFlag = flag.Bool("inuse_objects", false, "same as -sample_index=inuse_objects")
+	allocSpaceFlag   = flag.Bool("alloc_space", false, "same as -sample_index=alloc_space")
+	allocObjectsFlag = flag.Bool("alloc_objects", false, "same as -sample_index=alloc_objects")
+	functionsFlag    = flag.Bool("functions", false, "report at the granularity of functions (default)")
+	linesFlag        = flag.Bool("lines", false, "report at the granularity of source lines")
+	addressesFlag    = flag.Bool("addresses", false, "report at the granularity of instructions")
+	filesFlag        = flag.Bool("files", false, "report at the granularity of source files")
+	nodeCountFlag    = flag.Int("nodecount", 0, "show at most this many nodes (default: all, or 80 in graphs)")
+	nodeFracFlag     = flag.Float64("nodefraction", 0.005, "hide nodes below this fraction of the total")
+	edgeFracFlag     = flag.Float64("edgefraction", 0.001, "hide graph edges below this fraction of the total")
+	cumFlag          = flag.Bool("cum", false, "sort by cumulative rather than flat value")
+	focusFlag        = flag.String("focus", "", "only use samples with a function matching regexp")
+	ignoreFlag       = flag.String("ignore", "", "skip samples with a function matching regexp")
+	hideFlag         = flag.String("hide", "", "remove the functions matching regexp from the stacks")
+	tagFocusFlag     = flag.String("tagfocus", "", "only use samples with a label key=value matching regexp")
+	tagIgnoreFlag    = flag.String("tagignore", "", "skip samples with a label key=value matching regexp")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: go tool pprof [options] [binary] source...\n\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+// binary is the profiled executable, if known, and title
+// the name shown in reports.
+var binary, title string
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("pprof: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+	}
+	// The binary, if present, is the first argument that is
+	// an executable rather than a profile.
+	if len(args) > 1 && !isSource(args[0]) {
+		binary, args = args[0], args[1:]
+	}
+
+	p, err := load(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *baseFlag != "" {
+		base, err := load([]string{*baseFlag})
+		if err != nil {
+			log.Fatal(err)
+		}
+		base.Scale(-1)
+		dur := p.DurationNanos
+		if p, err = profile.Merge([]*profile.Profile{p, base}); err != nil {
+			log.Fatalf("subtracting base profile: %v", err)
+		}
+		p.DurationNanos = dur
+	}
+	title = binary
+	if title == "" {
+		title = mainBinary(p)
+	}
+
+	cfg := configFromFlags()
+	if *httpFlag != "" {
+		serveWeb(*httpFlag, p, cfg)
+		return
+	}
+	cmd, arg := commandFromFlags()
+	if cmd == "" {
+		interactive(os.Stdin, os.Stdout, p, cfg)
+		return
+	}
+	var buf bytes.Buffer
+	if err := run(&buf, cmd, arg, p, cfg); err != nil {
+		log.Fatal(err)
+	}
+	w := io.Writer(os.Stdout)
+	if *outputFlag != "" {
+		f, err := os.Create(*outputFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load fetches, merges and symbolizes the profiles in sources.
+func load(sources []string) (*profile.Profile, error) {
+	var profs []*profile.Profile
+	for _, src := range sources {
+		p, symURL, err := fetch(src, *secondsFlag, *timeoutFlag)
+		if err != nil {
+			return nil, err
+		}
+		if err := symbolizeProfile(p, *symbolizeFlag, binary, symURL); err != nil {
+			return nil, fmt.Errorf("symbolizing %s: %v", src, err)
+		}
+		profs = append(profs, p)
+	}
+	if len(profs) == 1 {
+		return profs[0], nil
+	}
+	return profile.Merge(profs)
+}
+
+// A config holds the options that shape a report.
+// They are set by flags and changed by the interactive
+// shell and the web interface.
+type config struct {
+	sampleIndex  string
+	granularity  string
+	nodeCount    int
+	nodeFraction float64
+	edgeFraction float64
+	cumSort      bool
+	focus        string
+	ignore       string
+	hide         string
+	tagFocus     string
+	tagIgnore    string
+}
+
+func configFromFlags() *config {
+	cfg := &config{
+		sampleIndex:  *sampleIndexFlag,
+		granularity:  "functions",
+		nodeCount:    *nodeCountFlag,
+		nodeFraction: *nodeFracFlag,
+		edgeFraction: *edgeFracFlag,
+		cumSort:      *cumFlag,
+		focus:        *focusFlag,
+		ignore:       *ignoreFlag,
+		hide:         *hideFlag,
+		tagFocus:     *tagFocusFlag,
+		tagIgnore:    *tagIgnoreFlag,
+	}
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{
+		{*inuseSpaceFlag, "inuse_space"},
+		{*inuseObjectsFlag, "inuse_objects"},
+		{*allocSpaceFlag, "alloc_space"},
+		{*allocObjectsFlag, "alloc_objects"},
+	} {
+		if f.set {
+			cfg.sampleIndex = f.name
+		}
+	}
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{
+		{*functionsFlag, "functions"},
+		{*linesFlag, "lines"},
+		{*addressesFlag, "addresses"},
+		{*filesFlag, "files"},
+	} {
+		if f.set {
+			cfg.granularity = f.name
+		}
+	}
+	return cfg
+}
+
+// commandFromFlags returns the report requested by the output
+// flags, or "" if there is none.
+func commandFromFlags() (cmd, arg string) {
+	switch {
+	case *topFlag:
+		return "top", ""
+	case *treeFlag:
+		return "tree", ""
+	case *peekFlag != "":
+		return "peek", *peekFlag
+	case *listFlag != "":
+		return "list", *listFlag
+	case *disasmFlag != "":
+		return "disasm", *disasmFlag
+	case *dotFlag:
+		return "dot", ""
+	case *svgFlag:
+		return "svg", ""
+	case *flameFlag:
+		return "flame", ""
+	case *rawFlag:
+		return "raw", ""
+	case *protoFlag:
+		return "proto", ""
+	}
+	return "", ""
+}
+
+// newReport returns the report of p with the options in cfg.
+// The samples are filtered on a copy of p.
+func newReport(p *profile.Profile, cfg *config) (*report.Report, error) {
+	p, err := filter(p, cfg)
+	if err != nil {
+		return nil, err
+	}
+	i, err := p.SampleIndex(cfg.sampleIndex)
+	if err != nil {
+		return nil, err
+	}
+	return report.New(p, report.Options{
+		SampleIndex:  i,
+		Granularity:  cfg.granularity,
+		NodeCount:    cfg.nodeCount,
+		NodeFraction: cfg.nodeFraction,
+		EdgeFraction: cfg.edgeFraction,
+		CumSort:      cfg.cumSort,
+		Title:        title,
+	}), nil
+}
+
+// filter returns a copy of p holding the samples that pass
+// the filters of cfg.
+func filter(p *profile.Profile, cfg *config) (*profile.Profile, error) {
+	var res [5]*regexp.Regexp
+	for i, s := range []string{cfg.focus, cfg.ignore, cfg.hide, cfg.tagFocus, cfg.tagIgnore} {
+		if s == "" {
+			continue
+		}
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("parsing regexp %q: %v", s, err)
+		}
+		res[i] = re
+	}
+	p = p.Copy()
+	fm, im, hm := p.FilterSamplesByName(res[0], res[1], res[2])
+	tfm, tim := p.FilterSamplesByLabel(res[3], res[4])
+	for _, f := range []struct {
+		name    string
+		re      *regexp.Regexp
+		matched bool
+	}{
+		{"focus", res[0], fm},
+		{"ignore", res[1], im},
+		{"hide", res[2], hm},
+		{"tagfocus", res[3], tfm},
+		{"tagignore", res[4], tim},
+	} {
+		if f.re != nil && !f.matched {
+			log.Printf("warning: %s expression %q matched no samples", f.name, f.re)
+		}
+	}
+	return p, nil
+}
+
+// run writes the report of p named by cmd to w.
+// Arg is the regexp of the peek, list and disasm reports.
+func run(w io.Writer, cmd, arg string, p *profile.Profile, cfg *config) error {
+	switch cmd {
+	case "raw":
+		_, err := io.WriteString(w, p.String())
+		return err
+	case "proto":
+		return p.Write(w)
+	}
+
+	c := *cfg
+	if c.nodeCount == 0 && (cmd == "dot" || cmd == "svg") {
+		c.nodeCount = 80
+	}
+	var re *regexp.Regexp
+	switch cmd {
+	case "peek", "list", "disasm":
+		if arg == "" {
+			return fmt.Errorf("%s requires a regexp", cmd)
+		}
+		var err error
+		if re, err = regexp.Compile(arg); err != nil {
+			return fmt.Errorf("parsing regexp %q: %v", arg, err)
+		}
+	}
+	r, err := newReport(p, &c)
+	if err != nil {
+		return err
+	}
+	switch cmd {
+	case "top":
+		return r.Top(w)
+	case "tree":
+		return r.Tree(w, nil)
+	case "peek":
+		return r.Tree(w, re.MatchString)
+	case "list":
+		return r.List(w, re)
+	case "disasm":
+		bin := binary
+		if bin == "" {
+			bin = mainBinary(p)
+		}
+		if bin == "" {
+			return fmt.Errorf("disasm requires the binary")
+		}
+		return r.Disasm(w, objTool{}, bin, re)
+	case "dot":
+		return r.Dot(w)
+	case "svg":
+		var dot bytes.Buffer
+		if err := r.Dot(&dot); err != nil {
+			return err
+		}
+		return dotToSVG(w, &dot)
+	case "flame":
+		return r.Flame(w)
+	}
+	return fmt.Errorf("unknown report %q", cmd)
+}
+
+// dotToSVG renders the graph in dot to w using Graphviz.
+func dotToSVG(w io.Writer, dot io.Reader) error {
+	cmd := exec.Command("dot", "-Tsvg")
+	cmd.Stdin = dot
+	cmd.Stdout = w
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			return fmt.Errorf("dot: %v: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return fmt.Errorf("svg output requires Graphviz dot: %v", err)
+	}
+	return nil
+}
+
+// mainBinary returns the executable recorded in the first
+// mapping of p, if any.
+func mainBinary(p *profile.Profile) string {
+	if len(p.Mapping) > 0 {
+		return p.Mapping[0].File
+	}
+	return ""
+}
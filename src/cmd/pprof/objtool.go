@@ -0,0 +1,69 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	"cmd/internal/objfile"
+	"cmd/pprof/internal/report"
+)
+
+// objTool implements report.ObjTool by reading the binary
+// and disassembling its code with cmd/internal/objfile.
+type objTool struct{}
+
+// Symbols returns the text symbols of binary matching re: those
+// defined in a section holding code and lying in the text section.
+func (objTool) Symbols(binary string, re *regexp.Regexp) ([]report.Sym, error) {
+	obj, err := objfile.Open(binary)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	textStart, text := obj.Text()
+	textEnd := textStart + uint64(len(text))
+	var syms []report.Sym
+	for _, s := range obj.Symbols() {
+		if !s.IsText() || s.Addr < textStart || s.Addr >= textEnd || !re.MatchString(s.Name) {
+			continue
+		}
+		end := s.Addr + s.Size
+		if end > textEnd {
+			end = textEnd
+		}
+		if end > s.Addr {
+			syms = append(syms, report.Sym{Name: s.Name, Start: s.Addr, End: end})
+		}
+	}
+	return syms, nil
+}
+
+// Disasm returns the instructions of binary in [start, end),
+// with the source lines they were compiled from.
+func (objTool) Disasm(binary string, start, end uint64) ([]report.Inst, error) {
+	obj, err := objfile.Open(binary)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	switch obj.GOARCH() {
+	case "386", "amd64", "arm":
+		// ok
+	default:
+		return nil, fmt.Errorf("reading %s: disassembly of %q binaries not supported", binary, obj.GOARCH())
+	}
+	var insts []report.Inst
+	for pc := start; pc < end; {
+		asm, _, size := obj.Decode(pc)
+		file, line := obj.PCToLine(pc)
+		insts = append(insts, report.Inst{Addr: pc, Text: asm, File: file, Line: line})
+		pc += uint64(size)
+	}
+	return insts, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"cmd/pprof/internal/report"
+)
+
+func TestProfileURL(t *testing.T) {
+	for _, tt := range []struct {
+		source string
+		want   string
+	}{
+		{"localhost:6060", "http://localhost:6060/debug/pprof/profile?seconds=5"},
+		{"localhost:6060/debug/pprof/heap", "http://localhost:6060/debug/pprof/heap"},
+		{"https://host:443/debug/pprof/profile?seconds=1", "https://host:443/debug/pprof/profile?seconds=1"},
+	} {
+		u, err := profileURL(tt.source, 5)
+		if err != nil {
+			t.Errorf("profileURL(%q): %v", tt.source, err)
+			continue
+		}
+		if u.String() != tt.want {
+			t.Errorf("profileURL(%q) = %s, want %s", tt.source, u, tt.want)
+		}
+	}
+}
+
+func TestParseSymbols(t *testing.T) {
+	names, err := parseSymbols(strings.NewReader("num_symbols: 1\n0x1000 main.main\n0x2000 main.(*T).M\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[uint64]string{0x1000: "main.main", 0x2000: "main.(*T).M"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("parseSymbols = %v, want %v", names, want)
+	}
+	if _, err := parseSymbols(strings.NewReader("zz main.main\n")); err == nil {
+		t.Errorf("parseSymbols accepted a malformed address")
+	}
+}
+
+const inlineExec = "../../pkg/debug/symbolize/testdata/inline-gcc-amd64-linux-exec"
+
+func TestObjToolSymbols(t *testing.T) {
+	syms, err := objTool{}.Symbols(inlineExec, regexp.MustCompile(`^(main|outer|sink)$`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []report.Sym{
+		{Name: "main", Start: 0x1040, End: 0x104d},
+		{Name: "outer", Start: 0x1140, End: 0x1169},
+	}
+	if !reflect.DeepEqual(syms, want) {
+		t.Errorf("Symbols = %v, want %v", syms, want)
+	}
+}
+
+func TestObjToolDisasm(t *testing.T) {
+	insts, err := objTool{}.Disasm(inlineExec, 0x1140, 0x1159)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const file = "/tmp/sym/inline.c"
+	want := []report.Inst{
+		{Addr: 0x1140, Text: "LEAL 0x3(DI)(DI*2), AX", File: file, Line: 8},
+		{Addr: 0x1144, Text: "MOVL AX, sink(SB)", File: file, Line: 8},
+		{Addr: 0x114a, Text: "MOVL sink(SB), AX", File: file, Line: 15},
+		{Addr: 0x1150, Text: "ADDL $0x2, AX", File: file, Line: 15},
+		{Addr: 0x1153, Text: "MOVL AX, sink(SB)", File: file, Line: 15},
+	}
+	if !reflect.DeepEqual(insts, want) {
+		t.Errorf("Disasm = %v, want %v", insts, want)
+	}
+}
+
+func TestConfigSet(t *testing.T) {
+	cfg := &config{granularity: "functions"}
+	for _, line := range []string{"nodecount=5", "cum=true", "focus=main\\.f", "granularity=lines"} {
+		if err := command(new(bytes.Buffer), line, nil, cfg); err != nil {
+			t.Errorf("%s: %v", line, err)
+		}
+	}
+	want := config{granularity: "lines", nodeCount: 5, cumSort: true, focus: `main\.f`}
+	if *cfg != want {
+		t.Errorf("config = %+v, want %+v", *cfg, want)
+	}
+	for _, line := range []string{"nodecount=x", "granularity=bytes", "color=red"} {
+		if err := command(new(bytes.Buffer), line, nil, cfg); err == nil {
+			t.Errorf("%s: no error", line)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"debug/symbolize"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"cmd/pprof/internal/profile"
+)
+
+// symbolizeProfile adds functions and source lines to the locations
+// of p that lack them.  Mode "local" reads the symbol tables of
+// binary, or of the executable recorded in p; mode "remote" asks the
+// symbol server at symURL; mode "none" does nothing; and the default
+// mode "" tries local symbolization first, then remote symbolization,
+// and otherwise leaves the addresses unsymbolized.
+func symbolizeProfile(p *profile.Profile, mode, binary, symURL string) error {
+	if mode == "none" || p.Symbolized() {
+		return nil
+	}
+	if binary == "" {
+		binary = mainBinary(p)
+	}
+	switch mode {
+	case "local":
+		return symbolizeLocal(p, binary)
+	case "remote":
+		return symbolizeRemote(p, symURL)
+	case "":
+		err := symbolizeLocal(p, binary)
+		if err != nil && symURL != "" {
+			err = symbolizeRemote(p, symURL)
+		}
+		if err != nil {
+			log.Printf("warning: reporting addresses: %v", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown symbolization mode %q", mode)
+}
+
+// unsymbolized returns the locations of p without source lines
+// that belong to the main executable.
+func unsymbolized(p *profile.Profile) []*profile.Location {
+	var exe *profile.Mapping
+	if len(p.Mapping) > 0 {
+		exe = p.Mapping[0]
+	}
+	var locs []*profile.Location
+	for _, l := range p.Location {
+		if len(l.Line) == 0 && (l.Mapping == nil || l.Mapping == exe) {
+			locs = append(locs, l)
+		}
+	}
+	return locs
+}
+
+// A funcTable creates the functions of a profile, one for each name
+// and file.
+type funcTable struct {
+	p     *profile.Profile
+	funcs map[[2]string]*profile.Function
+}
+
+func newFuncTable(p *profile.Profile) *funcTable {
+	t := &funcTable{p: p, funcs: make(map[[2]string]*profile.Function)}
+	for _, fn := range p.Function {
+		t.funcs[[2]string{fn.Name, fn.Filename}] = fn
+	}
+	return t
+}
+
+// lookup returns the function of the given name and file, calling
+// startLine to find its first line when it is first created.
+func (t *funcTable) lookup(name, file string, startLine func() int64) *profile.Function {
+	key := [2]string{name, file}
+	fn := t.funcs[key]
+	if fn == nil {
+		fn = &profile.Function{
+			ID:         uint64(len(t.p.Function) + 1),
+			Name:       name,
+			SystemName: name,
+			Filename:   file,
+			StartLine:  startLine(),
+		}
+		t.funcs[key] = fn
+		t.p.Function = append(t.p.Function, fn)
+	}
+	return fn
+}
+
+// symbolizeLocal symbolizes p using the tables of binary.
+func symbolizeLocal(p *profile.Profile, binary string) error {
+	if binary == "" {
+		return fmt.Errorf("profile does not name its executable")
+	}
+	s, err := symbolize.Open(binary)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	locs := unsymbolized(p)
+	pcs := make([]uint64, len(locs))
+	for i, l := range locs {
+		pcs[i] = l.Address
+	}
+	frames, err := s.Symbolize(pcs)
+	if err != nil {
+		return err
+	}
+	t := newFuncTable(p)
+	for i, l := range locs {
+		for _, f := range frames[i] {
+			entry := f.Entry
+			fn := t.lookup(f.Func, f.File, func() int64 {
+				if entry == 0 {
+					return 0
+				}
+				fs, err := s.Frames(entry)
+				if err != nil || len(fs) == 0 {
+					return 0
+				}
+				return int64(fs[len(fs)-1].Line)
+			})
+			l.Line = append(l.Line, profile.Line{Function: fn, Line: int64(f.Line)})
+		}
+	}
+	if len(p.Mapping) > 0 {
+		m := p.Mapping[0]
+		m.HasFunctions, m.HasFilenames, m.HasLineNumbers, m.HasInlineFrames = true, true, true, true
+	}
+	return nil
+}
+
+// symbolizeRemote symbolizes p using the symbol server of
+// net/http/pprof at symURL, which knows only function names.
+func symbolizeRemote(p *profile.Profile, symURL string) error {
+	if symURL == "" {
+		return fmt.Errorf("no symbol server for profile")
+	}
+	locs := unsymbolized(p)
+	if len(locs) == 0 {
+		return nil
+	}
+	var addrs []string
+	for _, l := range locs {
+		addrs = append(addrs, fmt.Sprintf("%#x", l.Address))
+	}
+	resp, err := http.Post(symURL, "text/plain", strings.NewReader(strings.Join(addrs, "+")))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("symbol server %s: %s", symURL, resp.Status)
+	}
+	names, err := parseSymbols(resp.Body)
+	if err != nil {
+		return err
+	}
+	t := newFuncTable(p)
+	noLine := func() int64 { return 0 }
+	for _, l := range locs {
+		if name, ok := names[l.Address]; ok {
+			l.Line = []profile.Line{{Function: t.lookup(name, "", noLine)}}
+		}
+	}
+	return nil
+}
+
+// parseSymbols parses the response of a symbol server: a line
+// "num_symbols: N" followed by lines "address name".
+func parseSymbols(r io.Reader) (map[uint64]string, error) {
+	names := make(map[uint64]string)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if strings.HasPrefix(line, "num_symbols:") {
+			continue
+		}
+		f := strings.SplitN(line, " ", 2)
+		if len(f) != 2 {
+			continue
+		}
+		addr, err := strconv.ParseUint(f[0], 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed symbol server line %q", line)
+		}
+		names[addr] = f[1]
+	}
+	return names, s.Err()
+}
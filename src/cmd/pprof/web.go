@@ -0,0 +1,227 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"cmd/pprof/internal/profile"
+	"cmd/pprof/internal/report"
+)
+
+// The profile and options served by the web interface.
+// Requests override the options with query parameters.
+var (
+	webProfile *profile.Profile
+	webConfig  *config
+)
+
+// serveWeb serves the web interface for p at addr.
+func serveWeb(addr string, p *profile.Profile, cfg *config) {
+	webProfile, webConfig = p, cfg
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to create server socket: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Serving web interface at http://%s\n", ln.Addr())
+	log.Fatal(http.Serve(ln, nil))
+}
+
+func init() {
+	http.HandleFunc("/", httpGraph)
+	http.HandleFunc("/top", httpTop)
+	http.HandleFunc("/flamegraph", httpFlame)
+	http.HandleFunc("/peek", httpText("peek"))
+	http.HandleFunc("/source", httpText("list"))
+	http.HandleFunc("/disasm", httpText("disasm"))
+}
+
+// webOptions are the options that may be set by query parameters.
+var webOptions = []string{"sample_index", "granularity", "focus", "ignore", "hide", "tagfocus", "tagignore", "nodecount"}
+
+// A webPage holds the data of the page template.
+type webPage struct {
+	Title       string
+	Nav         []webLink
+	Options     map[string]string
+	SampleTypes []string
+	F           string // regexp of the peek, source and disasm views
+	NeedF       bool
+	Header      []string
+	Error       string
+	SVG         template.HTML
+	Text        string
+	Top         []report.TopEntry
+}
+
+type webLink struct {
+	Name string
+	URL  template.URL
+}
+
+// newPage returns the page for the request, with the options
+// overridden by its query parameters.
+func newPage(r *http.Request, title string) (*webPage, *config) {
+	q := r.URL.Query()
+	cfg := *webConfig
+	pg := &webPage{Title: title, Options: make(map[string]string), F: q.Get("f")}
+	for _, name := range webOptions {
+		if v, ok := q[name]; ok && len(v) > 0 {
+			if err := cfg.set(name, v[0]); err != nil && pg.Error == "" {
+				pg.Error = err.Error()
+			}
+			pg.Options[name] = v[0]
+		}
+	}
+	for _, t := range webProfile.SampleType {
+		pg.SampleTypes = append(pg.SampleTypes, t.Type)
+	}
+	for _, l := range []struct{ name, path string }{
+		{"Graph", "/"},
+		{"Top", "/top"},
+		{"Flame Graph", "/flamegraph"},
+		{"Peek", "/peek"},
+		{"Source", "/source"},
+		{"Disassembly", "/disasm"},
+	} {
+		u := url.URL{Path: l.path, RawQuery: r.URL.RawQuery}
+		pg.Nav = append(pg.Nav, webLink{l.name, template.URL(u.String())})
+	}
+	return pg, &cfg
+}
+
+// render executes the page template, reporting err on the page.
+func render(w http.ResponseWriter, pg *webPage, err error) {
+	if err != nil && pg.Error == "" {
+		pg.Error = err.Error()
+	}
+	if err := templPage.Execute(w, pg); err != nil {
+		log.Printf("rendering %s: %v", pg.Title, err)
+	}
+}
+
+// httpGraph serves the call graph.
+func httpGraph(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	pg, cfg := newPage(r, "Graph")
+	var buf bytes.Buffer
+	err := run(&buf, "svg", "", webProfile, cfg)
+	if err == nil {
+		pg.SVG = template.HTML(stripXMLHeader(buf.Bytes()))
+	}
+	render(w, pg, err)
+}
+
+// httpFlame serves the flame graph.
+func httpFlame(w http.ResponseWriter, r *http.Request) {
+	pg, cfg := newPage(r, "Flame Graph")
+	var buf bytes.Buffer
+	err := run(&buf, "flame", "", webProfile, cfg)
+	if err == nil {
+		pg.SVG = template.HTML(stripXMLHeader(buf.Bytes()))
+	}
+	render(w, pg, err)
+}
+
+// stripXMLHeader removes the XML declarations that precede
+// the <svg> element of an image, so that it can be inlined.
+func stripXMLHeader(svg []byte) []byte {
+	if i := bytes.Index(svg, []byte("<svg")); i >= 0 {
+		return svg[i:]
+	}
+	return svg
+}
+
+// httpTop serves the table of the heaviest functions.
+func httpTop(w http.ResponseWriter, r *http.Request) {
+	pg, cfg := newPage(r, "Top")
+	rpt, err := newReport(webProfile, cfg)
+	if err == nil {
+		pg.Header = rpt.Header()
+		pg.Top = rpt.TopEntries()
+	}
+	render(w, pg, err)
+}
+
+// httpText returns a handler serving the text report cmd
+// for the functions matching the f query parameter.
+func httpText(cmd string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pg, cfg := newPage(r, map[string]string{"peek": "Peek", "list": "Source", "disasm": "Disassembly"}[cmd])
+		pg.NeedF = true
+		if pg.F == "" {
+			render(w, pg, nil)
+			return
+		}
+		var buf bytes.Buffer
+		err := run(&buf, cmd, pg.F, webProfile, cfg)
+		pg.Text = buf.String()
+		render(w, pg, err)
+	}
+}
+
+var templPage = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>{{.Title}} - go tool pprof</title>
+<style>
+body { font-family: sans-serif; margin: 0; }
+#nav { background: #eee; padding: 6px 10px; border-bottom: 1px solid #ccc; }
+#nav a { margin-right: 12px; }
+#nav form { display: inline; }
+#nav input { width: 9em; }
+#content { padding: 10px; }
+.error { color: #b00; }
+table { border-collapse: collapse; }
+td, th { padding: 1px 8px; text-align: right; font-family: monospace; }
+td.name { text-align: left; }
+tr:nth-child(even) { background: #f4f4f4; }
+pre { font-size: 13px; }
+</style>
+</head>
+<body>
+<div id="nav">
+{{range .Nav}}<a href="{{.URL}}">{{.Name}}</a>{{end}}
+<form action="" method="get">
+{{if .NeedF}}function <input name="f" value="{{.F}}">{{end}}
+focus <input name="focus" value="{{index .Options "focus"}}">
+ignore <input name="ignore" value="{{index .Options "ignore"}}">
+hide <input name="hide" value="{{index .Options "hide"}}">
+{{if .SampleTypes}}<select name="sample_index">
+<option value="">(default)</option>
+{{$cur := index .Options "sample_index"}}
+{{range .SampleTypes}}<option value="{{.}}"{{if eq . $cur}} selected{{end}}>{{.}}</option>{{end}}
+</select>{{end}}
+<input type="submit" value="Apply">
+</form>
+</div>
+<div id="content">
+<h2>{{.Title}}</h2>
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{if .NeedF}}{{if not .F}}<p>Enter a regular expression matching the functions to show.</p>{{end}}{{end}}
+{{range .Header}}{{.}}<br>{{end}}
+{{if .Top}}
+<table>
+<tr><th>flat</th><th>flat%</th><th>sum%</th><th>cum</th><th>cum%</th><th></th></tr>
+{{range .Top}}<tr><td>{{.Flat}}</td><td>{{.FlatPct}}</td><td>{{.SumPct}}</td><td>{{.Cum}}</td><td>{{.CumPct}}</td><td class="name">{{.Name}}</td></tr>
+{{end}}
+</table>
+{{end}}
+{{if .SVG}}<div>{{.SVG}}</div>{{end}}
+{{if .Text}}<pre>{{.Text}}</pre>{{end}}
+</div>
+</body>
+</html>
+`))
@@ -0,0 +1,150 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Goroutine-related profiles.
+
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"runtime/trace"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+func init() {
+	http.HandleFunc("/goroutines", httpGoroutines)
+	http.HandleFunc("/goroutine", httpGoroutine)
+}
+
+// gtype describes a group of goroutines grouped by start PC.
+type gtype struct {
+	ID       uint64 // Unique identifier (PC).
+	Name     string // Start function.
+	N        int    // Total number of goroutines in this group.
+	ExecTime int64  // Total execution time of all goroutines in this group.
+}
+
+type gtypeList []gtype
+
+func (l gtypeList) Len() int           { return len(l) }
+func (l gtypeList) Less(i, j int) bool { return l[i].ExecTime > l[j].ExecTime }
+func (l gtypeList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+type gdescList []*trace.GDesc
+
+func (l gdescList) Len() int           { return len(l) }
+func (l gdescList) Less(i, j int) bool { return l[i].TotalTime > l[j].TotalTime }
+func (l gdescList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+var gs struct {
+	once sync.Once
+	m    map[uint64]*trace.GDesc
+}
+
+// goroutines returns the statistics of all goroutines in the trace.
+func goroutines() map[uint64]*trace.GDesc {
+	gs.once.Do(func() {
+		gs.m = trace.GoroutineStats(events)
+	})
+	return gs.m
+}
+
+// httpGoroutines serves the list of goroutine groups.
+func httpGoroutines(w http.ResponseWriter, r *http.Request) {
+	gss := make(map[uint64]gtype)
+	for _, g := range goroutines() {
+		if g.Name == "" {
+			// Goroutines created before tracing started
+			// and never seen running have no start function.
+			continue
+		}
+		gs1 := gss[g.PC]
+		gs1.ID = g.PC
+		gs1.Name = g.Name
+		gs1.N++
+		gs1.ExecTime += g.ExecTime
+		gss[g.PC] = gs1
+	}
+	var glist gtypeList
+	for _, v := range gss {
+		glist = append(glist, v)
+	}
+	sort.Sort(glist)
+	if err := templGoroutines.Execute(w, glist); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+	}
+}
+
+var templGoroutines = template.Must(template.New("").Funcs(templFuncs).Parse(`
+<html>
+<head><title>Goroutines</title></head>
+<body>
+Goroutines: <br>
+{{range $}}
+  <a href="/goroutine?id={{.ID}}">{{.Name}}</a> N={{.N}} Exec={{duration .ExecTime}}<br>
+{{end}}
+</body>
+</html>
+`))
+
+// httpGoroutine serves the goroutines of one group, selected by start PC.
+func httpGoroutine(w http.ResponseWriter, r *http.Request) {
+	pc, err := strconv.ParseUint(r.FormValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse id parameter '%v': %v", r.FormValue("id"), err), http.StatusBadRequest)
+		return
+	}
+	var glist gdescList
+	for _, g := range goroutines() {
+		if g.PC != pc || g.Name == "" {
+			continue
+		}
+		glist = append(glist, g)
+	}
+	sort.Sort(glist)
+	if err := templGoroutine.Execute(w, glist); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+	}
+}
+
+var templGoroutine = template.Must(template.New("").Funcs(templFuncs).Parse(`
+<html>
+<head><title>Goroutines</title></head>
+<body>
+<table border="1" sortable="1">
+<tr>
+<th> Goroutine </th>
+<th> Total time </th>
+<th> Execution </th>
+<th> Scheduler wait </th>
+<th> Network wait </th>
+<th> Sync block </th>
+<th> Blocking syscall </th>
+<th> GC </th>
+</tr>
+{{range $}}
+  <tr>
+    <td> <a href="/trace?goid={{.ID}}">{{.ID}}</a> </td>
+    <td> {{duration .TotalTime}} </td>
+    <td> {{duration .ExecTime}} </td>
+    <td> {{duration .SchedWaitTime}} </td>
+    <td> {{duration .IOTime}} </td>
+    <td> {{duration .BlockTime}} </td>
+    <td> {{duration .SyscallTime}} </td>
+    <td> {{duration .GCTime}} </td>
+  </tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+var templFuncs = template.FuncMap{
+	"duration": func(ns int64) string { return time.Duration(ns).String() },
+}
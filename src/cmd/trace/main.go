@@ -0,0 +1,97 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Trace is a tool for viewing execution traces.
+//
+// Usage:
+//
+//	go tool trace [-http=addr] trace.out
+//
+// Trace files are written by runtime/trace.Start, by the
+// /debug/pprof/trace handler of net/http/pprof, and by
+// go test -trace=trace.out.
+//
+// Trace parses the file and serves a web interface at addr
+// (by default a free port on localhost) with two views:
+// a timeline of the goroutines running on each processor, with
+// garbage collections, heap size and goroutine events such as
+// creation, blocking and unblocking; and an analysis that groups
+// goroutines by the function they started in and reports how long
+// each spent running, waiting to be scheduled, blocked and in
+// system calls.  The timeline can be restricted to one goroutine
+// from the analysis.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"runtime/trace"
+)
+
+var httpFlag = flag.String("http", "localhost:0", "HTTP service address (e.g., ':6060')")
+
+// events holds the parsed trace.
+var events []*trace.Event
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: go tool trace [-http=addr] trace.out\n\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("trace: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
+		usage()
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	events, err = trace.Parse(bufio.NewReader(f))
+	f.Close()
+	if err != nil {
+		log.Fatalf("reading %s: %v", flag.Arg(0), err)
+	}
+
+	ln, err := net.Listen("tcp", *httpFlag)
+	if err != nil {
+		log.Fatalf("failed to create server socket: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Serving trace at http://%s\n", ln.Addr())
+	log.Fatal(http.Serve(ln, nil))
+}
+
+func init() {
+	http.HandleFunc("/", httpMain)
+}
+
+// httpMain serves the index page.
+func httpMain(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Write(templMain)
+}
+
+var templMain = []byte(`
+<html>
+<head><title>go tool trace</title></head>
+<body>
+<a href="/trace">View trace</a><br>
+<a href="/goroutines">Goroutine analysis</a><br>
+</body>
+</html>
+`)
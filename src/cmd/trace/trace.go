@@ -0,0 +1,480 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"runtime/trace"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	http.HandleFunc("/trace", httpTrace)
+	http.HandleFunc("/jsontrace", httpJsonTrace)
+}
+
+// httpTrace serves the timeline viewer.  The viewer loads the
+// timeline from /jsontrace, passing on the goid parameter, if any.
+func httpTrace(w http.ResponseWriter, r *http.Request) {
+	if err := templTrace.Execute(w, r.FormValue("goid")); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// httpJsonTrace serves the timeline in the Chrome trace event format,
+// so it can also be loaded into chrome://tracing.  If the goid
+// parameter is set, only the events of that goroutine are included.
+func httpJsonTrace(w http.ResponseWriter, r *http.Request) {
+	var goid uint64
+	if s := r.FormValue("goid"); s != "" {
+		var err error
+		goid, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to parse goid parameter '%v': %v", s, err), http.StatusBadRequest)
+			return
+		}
+	}
+	data := generateTrace(events, goid)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to serialize trace: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// ViewerData is the top-level object of the trace event format.
+type ViewerData struct {
+	Events   []*ViewerEvent `json:"traceEvents"`
+	TimeUnit string         `json:"displayTimeUnit"`
+}
+
+// ViewerEvent is one event in the trace event format.
+// Times are in microseconds.
+type ViewerEvent struct {
+	Name  string      `json:"name,omitempty"`
+	Phase string      `json:"ph"`
+	Time  float64     `json:"ts"`
+	Dur   float64     `json:"dur,omitempty"`
+	Pid   uint64      `json:"pid"`
+	Tid   uint64      `json:"tid"`
+	Scope string      `json:"s,omitempty"`
+	Arg   interface{} `json:"args,omitempty"`
+}
+
+// Rows of the timeline other than the processors, which use their
+// P number as the thread id.
+const (
+	gcTid      = 1 << 20 // garbage collections and sweeps
+	syscallTid = gcTid + 1
+)
+
+type nameArg struct {
+	Name string `json:"name"`
+}
+
+type sortIndexArg struct {
+	Index int `json:"sort_index"`
+}
+
+type heapArg struct {
+	Allocated uint64
+	NextGC    uint64
+}
+
+type sliceArg struct {
+	Goroutine uint64 `json:"goroutine,omitempty"`
+	End       string `json:"end,omitempty"`
+	Stack     string `json:"stack,omitempty"`
+}
+
+// traceContext holds the state of generateTrace.
+type traceContext struct {
+	data  ViewerData
+	goid  uint64 // goroutine to show, or 0 for all
+	names map[uint64]string
+	procs map[int]bool
+	heap  heapArg
+}
+
+// generateTrace converts events to the trace event format:
+// a row per processor with a slice for each time a goroutine ran,
+// ended by the event that stopped it, instant events for goroutine
+// creation, unblocking and system calls, a row for garbage
+// collection and a counter for the heap size.
+func generateTrace(events []*trace.Event, goid uint64) ViewerData {
+	ctx := &traceContext{
+		goid:  goid,
+		names: make(map[uint64]string),
+		procs: make(map[int]bool),
+	}
+	ctx.data.TimeUnit = "ns"
+	var end int64
+	if len(events) > 0 {
+		end = events[len(events)-1].Ts
+	}
+	for _, ev := range events {
+		switch ev.Type {
+		case trace.EvGoCreate:
+			ctx.names[ev.Args[0]] = ev.Fn
+			if ctx.show(ev.G) || ctx.show(ev.Args[0]) {
+				ctx.emitInstant(ev, fmt.Sprintf("create G%d %s", ev.Args[0], ev.Fn))
+			}
+		case trace.EvGoStart:
+			if ctx.show(ev.G) {
+				ctx.emitSlice(ev, ev.Link, end)
+			}
+		case trace.EvGoUnblock:
+			if ctx.show(ev.G) || ctx.show(ev.Args[0]) {
+				ctx.emitInstant(ev, fmt.Sprintf("unblock G%d", ev.Args[0]))
+			}
+		case trace.EvGoSysCall:
+			if ctx.show(ev.G) {
+				ctx.emitInstant(ev, "syscall")
+			}
+		case trace.EvGoSysExit:
+			if ctx.show(ev.G) {
+				ctx.emitInstant(ev, fmt.Sprintf("syscall exit G%d", ev.G))
+			}
+		case trace.EvGCStart:
+			ctx.emitRange(ev, "GC", end)
+		case trace.EvGCSweepStart:
+			// Overlapping sweeps on several Ps are merged
+			// into the first one's range.
+			if ev.Link != nil {
+				ctx.emitRange(ev, "Sweep", end)
+			}
+		case trace.EvHeapAlloc:
+			ctx.heap.Allocated = ev.Args[0]
+			ctx.emitHeap(ev)
+		case trace.EvNextGC:
+			ctx.heap.NextGC = ev.Args[0]
+			ctx.emitHeap(ev)
+		}
+	}
+
+	for p := range ctx.procs {
+		ctx.emitThread(uint64(p), fmt.Sprintf("Proc %d", p), p)
+	}
+	ctx.emitThread(gcTid, "GC", -2)
+	ctx.emitThread(syscallTid, "Syscalls", 1<<20)
+	return ctx.data
+}
+
+// show reports whether the events of goroutine g are shown.
+func (ctx *traceContext) show(g uint64) bool {
+	return ctx.goid == 0 || ctx.goid == g
+}
+
+func (ctx *traceContext) emit(e *ViewerEvent) {
+	ctx.data.Events = append(ctx.data.Events, e)
+}
+
+// time converts nanoseconds to the microseconds of the event format.
+func (ctx *traceContext) time(ts int64) float64 {
+	return float64(ts) / 1e3
+}
+
+// tid returns the row of ev.
+func (ctx *traceContext) tid(ev *trace.Event) uint64 {
+	if ev.P == trace.NoP {
+		return syscallTid
+	}
+	ctx.procs[ev.P] = true
+	return uint64(ev.P)
+}
+
+func (ctx *traceContext) emitSlice(ev, end *trace.Event, last int64) {
+	arg := &sliceArg{Goroutine: ev.G}
+	ts := last
+	if end != nil {
+		ts = end.Ts
+		arg.End = trace.EventDescriptions[end.Type].Name
+		arg.Stack = stackString(end.Stk)
+	}
+	ctx.emit(&ViewerEvent{
+		Name:  fmt.Sprintf("G%d %s", ev.G, ctx.names[ev.G]),
+		Phase: "X",
+		Time:  ctx.time(ev.Ts),
+		Dur:   ctx.time(ts - ev.Ts),
+		Tid:   ctx.tid(ev),
+		Arg:   arg,
+	})
+}
+
+func (ctx *traceContext) emitRange(ev *trace.Event, name string, last int64) {
+	ts := last
+	if ev.Link != nil {
+		ts = ev.Link.Ts
+	}
+	ctx.emit(&ViewerEvent{
+		Name:  name,
+		Phase: "X",
+		Time:  ctx.time(ev.Ts),
+		Dur:   ctx.time(ts - ev.Ts),
+		Tid:   gcTid,
+		Arg:   &sliceArg{Stack: stackString(ev.Stk)},
+	})
+}
+
+func (ctx *traceContext) emitInstant(ev *trace.Event, name string) {
+	ctx.emit(&ViewerEvent{
+		Name:  name,
+		Phase: "i",
+		Scope: "t",
+		Time:  ctx.time(ev.Ts),
+		Tid:   ctx.tid(ev),
+		Arg:   &sliceArg{Goroutine: ev.G, Stack: stackString(ev.Stk)},
+	})
+}
+
+func (ctx *traceContext) emitHeap(ev *trace.Event) {
+	heap := ctx.heap
+	ctx.emit(&ViewerEvent{
+		Name:  "Heap",
+		Phase: "C",
+		Time:  ctx.time(ev.Ts),
+		Arg:   &heap,
+	})
+}
+
+func (ctx *traceContext) emitThread(tid uint64, name string, index int) {
+	ctx.emit(&ViewerEvent{Name: "thread_name", Phase: "M", Tid: tid, Arg: &nameArg{name}})
+	ctx.emit(&ViewerEvent{Name: "thread_sort_index", Phase: "M", Tid: tid, Arg: &sortIndexArg{index}})
+}
+
+// stackString formats stk one frame per line.
+func stackString(stk []*trace.Frame) string {
+	var lines []string
+	for _, f := range stk {
+		lines = append(lines, fmt.Sprintf("%s %s:%d", f.Fn, f.File, f.Line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// templTrace is a self-contained timeline viewer for the output of
+// /jsontrace.  It draws a row per thread id with the slices and
+// instant events on it, and the counters in a row above them.
+// The wheel zooms, dragging pans, and clicking shows an event's
+// details.
+var templTrace = template.Must(template.New("").Parse(`
+<html>
+<head>
+<title>Trace</title>
+<style>
+body { margin: 0; font: 12px sans-serif; }
+#timeline { display: block; cursor: crosshair; }
+#details { padding: 4px 8px; border-top: 1px solid #888; white-space: pre; font-family: monospace; }
+</style>
+</head>
+<body>
+<canvas id="timeline"></canvas>
+<div id="details">Loading trace...</div>
+<script>
+(function() {
+var labelWidth = 120, rowHeight = 20, axisHeight = 20, counterHeight = 40;
+var canvas = document.getElementById("timeline");
+var details = document.getElementById("details");
+var ctx = canvas.getContext("2d");
+var rows = [], counters = [], maxCounter = 1;
+var start = 0, end = 1, viewStart = 0, viewEnd = 1;
+
+function load(data) {
+	var byTid = {};
+	function row(tid) {
+		if (!byTid[tid]) {
+			byTid[tid] = {tid: tid, name: "Thread " + tid, index: tid, slices: [], instants: []};
+			rows.push(byTid[tid]);
+		}
+		return byTid[tid];
+	}
+	start = Infinity;
+	end = -Infinity;
+	data.traceEvents.forEach(function(e) {
+		if (e.ph == "M") {
+			if (e.name == "thread_name") row(e.tid).name = e.args.name;
+			if (e.name == "thread_sort_index") row(e.tid).index = e.args.sort_index;
+			return;
+		}
+		start = Math.min(start, e.ts);
+		end = Math.max(end, e.ts + (e.dur || 0));
+		if (e.ph == "X") row(e.tid).slices.push(e);
+		else if (e.ph == "i") row(e.tid).instants.push(e);
+		else if (e.ph == "C") {
+			counters.push(e);
+			maxCounter = Math.max(maxCounter, e.args.Allocated, e.args.NextGC);
+		}
+	});
+	rows = rows.filter(function(r) { return r.slices.length + r.instants.length > 0; });
+	rows.sort(function(a, b) { return a.index - b.index; });
+	if (start > end) start = end = 0;
+	if (end == start) end = start + 1;
+	viewStart = start;
+	viewEnd = end;
+	details.textContent = "Scroll to zoom, drag to pan, click an event for details.";
+	resize();
+}
+
+function x(ts) {
+	return labelWidth + (ts - viewStart) / (viewEnd - viewStart) * (canvas.width - labelWidth);
+}
+
+function ts(x) {
+	return viewStart + (x - labelWidth) / (canvas.width - labelWidth) * (viewEnd - viewStart);
+}
+
+function color(name) {
+	var h = 0;
+	for (var i = 0; i < name.length; i++) h = (h * 31 + name.charCodeAt(i)) % 360;
+	return "hsl(" + h + ",60%,70%)";
+}
+
+function rowTop(i) {
+	return axisHeight + counterHeight + i * rowHeight;
+}
+
+function draw() {
+	ctx.clearRect(0, 0, canvas.width, canvas.height);
+	ctx.font = "11px sans-serif";
+	ctx.textBaseline = "middle";
+
+	// Time axis, in milliseconds from the start of the trace.
+	var step = Math.pow(10, Math.floor(Math.log(viewEnd - viewStart) / Math.LN10));
+	ctx.fillStyle = "#000";
+	ctx.strokeStyle = "#ddd";
+	for (var t = Math.ceil(viewStart / step) * step; t < viewEnd; t += step) {
+		ctx.beginPath();
+		ctx.moveTo(x(t), 0);
+		ctx.lineTo(x(t), canvas.height);
+		ctx.stroke();
+		ctx.fillText(((t - start) / 1000).toFixed(3) + "ms", x(t) + 2, axisHeight / 2);
+	}
+
+	// Heap counters.
+	ctx.fillText("Heap", 4, axisHeight + counterHeight / 2);
+	["Allocated", "NextGC"].forEach(function(name, k) {
+		ctx.strokeStyle = k == 0 ? "#4a4" : "#a44";
+		ctx.beginPath();
+		counters.forEach(function(c, i) {
+			var y = axisHeight + counterHeight - c.args[name] / maxCounter * (counterHeight - 4);
+			var next = i + 1 < counters.length ? counters[i + 1].ts : end;
+			ctx.lineTo(x(c.ts), y);
+			ctx.lineTo(x(next), y);
+		});
+		ctx.stroke();
+	});
+
+	rows.forEach(function(r, i) {
+		var y = rowTop(i);
+		ctx.fillStyle = "#000";
+		ctx.fillText(r.name, 4, y + rowHeight / 2);
+		r.slices.forEach(function(e) {
+			var x0 = Math.max(x(e.ts), labelWidth), x1 = x(e.ts + e.dur);
+			if (x1 < labelWidth || x0 > canvas.width) return;
+			ctx.fillStyle = color(e.name);
+			ctx.fillRect(x0, y + 1, Math.max(x1 - x0, 1), rowHeight - 2);
+			if (x1 - x0 > 30) {
+				ctx.save();
+				ctx.beginPath();
+				ctx.rect(x0, y, x1 - x0, rowHeight);
+				ctx.clip();
+				ctx.fillStyle = "#000";
+				ctx.fillText(e.name, x0 + 2, y + rowHeight / 2);
+				ctx.restore();
+			}
+		});
+		ctx.fillStyle = "#000";
+		r.instants.forEach(function(e) {
+			var x0 = x(e.ts);
+			if (x0 < labelWidth || x0 > canvas.width) return;
+			ctx.beginPath();
+			ctx.moveTo(x0, y + rowHeight - 6);
+			ctx.lineTo(x0 - 3, y + rowHeight);
+			ctx.lineTo(x0 + 3, y + rowHeight);
+			ctx.fill();
+		});
+	});
+}
+
+function resize() {
+	canvas.width = window.innerWidth;
+	canvas.height = rowTop(rows.length) + 4;
+	draw();
+}
+
+// find returns the event under the point (px, py), if any.
+function find(px, py) {
+	var i = Math.floor((py - rowTop(0)) / rowHeight);
+	if (i < 0 || i >= rows.length) return null;
+	var t = ts(px), slop = 3 * (viewEnd - viewStart) / canvas.width;
+	var r = rows[i];
+	for (var j = 0; j < r.instants.length; j++) {
+		if (Math.abs(r.instants[j].ts - t) <= slop) return r.instants[j];
+	}
+	for (var j = 0; j < r.slices.length; j++) {
+		var e = r.slices[j];
+		if (e.ts - slop <= t && t <= e.ts + e.dur + slop) return e;
+	}
+	return null;
+}
+
+function show(e) {
+	var s = e.name + "\nStart: " + ((e.ts - start) / 1000).toFixed(3) + "ms";
+	if (e.ph == "X") s += "\nDuration: " + (e.dur / 1000).toFixed(3) + "ms";
+	if (e.args.end) s += "\nEnded by: " + e.args.end;
+	if (e.args.stack) s += "\n\n" + e.args.stack;
+	details.textContent = s;
+}
+
+var dragX = null, dragged = false;
+canvas.addEventListener("mousedown", function(ev) {
+	dragX = ev.offsetX;
+	dragged = false;
+});
+window.addEventListener("mouseup", function() {
+	dragX = null;
+});
+canvas.addEventListener("mousemove", function(ev) {
+	if (dragX === null) return;
+	var d = ts(dragX) - ts(ev.offsetX);
+	viewStart += d;
+	viewEnd += d;
+	dragX = ev.offsetX;
+	dragged = true;
+	draw();
+});
+canvas.addEventListener("click", function(ev) {
+	if (dragged) return;
+	var e = find(ev.offsetX, ev.offsetY);
+	if (e) show(e);
+});
+canvas.addEventListener("wheel", function(ev) {
+	ev.preventDefault();
+	var t = ts(Math.max(ev.offsetX, labelWidth));
+	var f = ev.deltaY < 0 ? 0.8 : 1.25;
+	viewStart = t - (t - viewStart) * f;
+	viewEnd = t + (viewEnd - t) * f;
+	if (viewEnd - viewStart < 0.001) viewEnd = viewStart + 0.001;
+	draw();
+});
+window.addEventListener("resize", resize);
+
+var req = new XMLHttpRequest();
+req.open("GET", "/jsontrace?goid={{.}}");
+req.onload = function() {
+	if (req.status != 200) {
+		details.textContent = "Failed to load trace: " + req.responseText;
+		return;
+	}
+	load(JSON.parse(req.responseText));
+};
+req.send();
+})();
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,47 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Vet examines Go source code and reports suspicious constructs, such as
+Printf calls whose arguments do not align with the format string. Vet
+uses heuristics that do not guarantee all reports are genuine problems,
+but it can find errors not caught by the compilers.
+
+It is normally invoked through the go command:
+
+	go vet [packages]
+
+which runs vet on the named packages. It can also be invoked directly:
+
+	go tool vet [flags] [packages | files]
+
+Vet is built from the analyzers of the go/analysis framework, using the
+go/analysis/multichecker driver. It runs these analyzers:
+
+	copylocks    check for locks erroneously passed by value
+	lostcancel   check cancel func returned by context.WithCancel is called
+	printf       check consistency of Printf format strings and arguments
+	structtag    check that struct field tags conform to reflect.StructTag.Get
+	unreachable  check for unreachable code
+
+By default all of them run. Naming some analyzers as flags, as in
+-printf, runs only those; disabling some, as in -printf=false, runs all
+the others. Flags of an analyzer are prefixed with its name, for
+example -printf.funcs; 'go tool vet -help' lists them all.
+
+The experimental shadow analyzer, which checks for possible unintended
+shadowing of variables, reports many false positives and so runs only
+when named on the command line, as in -shadow or -shadow.strict.
+
+Diagnostics are printed to standard error, and the exit status is 1 if
+any were reported. The -json flag prints them to standard output as a
+JSON object instead, including the suggested fixes, and the -fix flag
+applies the suggested fixes to the source files.
+
+Other tools can be built from the same framework: a custom checker
+combining vet's analyzers with its own is a main package calling
+multichecker.Main, as described in the documentation of package
+go/analysis/multichecker.
+*/
+package main
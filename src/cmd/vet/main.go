@@ -0,0 +1,55 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/analysis"
+	"go/analysis/multichecker"
+	"go/analysis/passes/copylock"
+	"go/analysis/passes/lostcancel"
+	"go/analysis/passes/printf"
+	"go/analysis/passes/shadow"
+	"go/analysis/passes/structtag"
+	"go/analysis/passes/unreachable"
+	"os"
+	"strings"
+)
+
+func main() {
+	analyzers := []*analysis.Analyzer{
+		copylock.Analyzer,
+		lostcancel.Analyzer,
+		printf.Analyzer,
+		structtag.Analyzer,
+		unreachable.Analyzer,
+	}
+	// The shadow analyzer is experimental and reports many false
+	// positives, so it runs only when named on the command line.
+	if named("shadow", os.Args[1:]) {
+		analyzers = append(analyzers, shadow.Analyzer)
+	}
+	multichecker.Main(analyzers...)
+}
+
+// named reports whether the flags in args name the analyzer,
+// as in -shadow or -shadow.strict.
+func named(analyzer string, args []string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name := strings.TrimLeft(arg, "-")
+		if i := strings.Index(name, "="); i >= 0 {
+			name = name[:i]
+		}
+		if name == analyzer || strings.HasPrefix(name, analyzer+".") {
+			return true
+		}
+	}
+	return false
+}
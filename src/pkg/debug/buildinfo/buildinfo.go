@@ -0,0 +1,325 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package buildinfo reads the build information that the Go linker
+// embeds in the binaries it writes: the Go version used to build the
+// binary and, for binaries built by the go command, the path of the main
+// package, the packages linked into the binary, the versions of the
+// modules or repositories providing them and the build settings.
+//
+// The information makes it possible to audit binaries for vulnerable or
+// outdated packages without access to the sources they were built from.
+// Build information can be read from ELF, Mach-O, PE and Plan 9 binaries.
+package buildinfo
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"debug/plan9obj"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// BuildInfo is the build information recorded in a Go binary.
+type BuildInfo struct {
+	GoVersion string         // version of the Go toolchain that built the binary, such as "go1.3"
+	Path      string         // import path of the main package
+	Main      Dep            // main module, when built in module mode
+	Deps      []*Dep         // modules or repositories providing the linked packages
+	Packages  []string       // import paths of the packages linked into the binary
+	Settings  []BuildSetting // settings used to build the binary
+}
+
+// A Dep describes a module, or in GOPATH mode a version control
+// repository, providing packages linked into the binary.
+type Dep struct {
+	Path    string // module path, or import path of the repository root
+	Version string // module version, or revision checked out when building
+	VCS     string // version control system holding the revision, such as "git"
+	Replace *Dep   // replacement module, if any
+}
+
+// A BuildSetting is a key-value pair describing one setting that
+// influenced the build, such as "-tags" or "GOARCH".
+type BuildSetting struct {
+	Key, Value string
+}
+
+// String returns the build information in the textual form that
+// the go command embeds in binaries, preceded by the Go version.
+// The result can be read back by Parse.
+func (bi *BuildInfo) String() string {
+	var buf bytes.Buffer
+	if bi.GoVersion != "" {
+		fmt.Fprintf(&buf, "go\t%s\n", bi.GoVersion)
+	}
+	if bi.Path != "" {
+		fmt.Fprintf(&buf, "path\t%s\n", bi.Path)
+	}
+	if bi.Main.Path != "" {
+		fmt.Fprintf(&buf, "mod\t%s\t%s\n", bi.Main.Path, bi.Main.Version)
+	}
+	for _, d := range bi.Deps {
+		if d.VCS != "" {
+			fmt.Fprintf(&buf, "dep\t%s\t%s\t%s\n", d.Path, d.Version, d.VCS)
+		} else {
+			fmt.Fprintf(&buf, "dep\t%s\t%s\n", d.Path, d.Version)
+		}
+		if r := d.Replace; r != nil {
+			fmt.Fprintf(&buf, "=>\t%s\t%s\n", r.Path, r.Version)
+		}
+	}
+	for _, p := range bi.Packages {
+		fmt.Fprintf(&buf, "pkg\t%s\n", p)
+	}
+	for _, s := range bi.Settings {
+		fmt.Fprintf(&buf, "build\t%s=%s\n", s.Key, s.Value)
+	}
+	return buf.String()
+}
+
+// Parse parses build information in the form returned by String.
+// Lines with unknown keys, and extra fields after the main module's
+// version, are ignored, so that newer versions of the go command
+// may record more information.
+func Parse(data string) (*BuildInfo, error) {
+	bi := new(BuildInfo)
+	var last *Dep
+	for lineno, line := range strings.Split(data, "\n") {
+		if line == "" {
+			continue
+		}
+		f := strings.Split(line, "\t")
+		bad := func() (*BuildInfo, error) {
+			return nil, fmt.Errorf("buildinfo: line %d: invalid %s line: %q", lineno+1, f[0], line)
+		}
+		switch f[0] {
+		case "go":
+			if len(f) != 2 {
+				return bad()
+			}
+			bi.GoVersion = f[1]
+		case "path":
+			if len(f) != 2 {
+				return bad()
+			}
+			bi.Path = f[1]
+		case "mod":
+			if len(f) < 3 {
+				return bad()
+			}
+			bi.Main = Dep{Path: f[1], Version: f[2]}
+		case "dep":
+			if len(f) != 3 && len(f) != 4 {
+				return bad()
+			}
+			last = &Dep{Path: f[1], Version: f[2]}
+			if len(f) == 4 {
+				last.VCS = f[3]
+			}
+			bi.Deps = append(bi.Deps, last)
+		case "=>":
+			if len(f) != 3 || last == nil || last.Replace != nil {
+				return bad()
+			}
+			last.Replace = &Dep{Path: f[1], Version: f[2]}
+		case "pkg":
+			if len(f) != 2 {
+				return bad()
+			}
+			bi.Packages = append(bi.Packages, f[1])
+		case "build":
+			if len(f) != 2 || f[1] == "" {
+				return bad()
+			}
+			// Values may contain '='; keys only as their first byte
+			// (for example, "-ldflags=-X main.v=1").
+			i := strings.Index(f[1][1:], "=")
+			if i < 0 {
+				return bad()
+			}
+			bi.Settings = append(bi.Settings, BuildSetting{f[1][:i+1], f[1][i+2:]})
+		}
+	}
+	return bi, nil
+}
+
+// ErrNotGoExe is returned when a file is not a Go binary
+// or carries no build information.
+var ErrNotGoExe = errors.New("not a Go executable")
+
+// ReadFile returns the build information embedded in the named binary.
+func ReadFile(name string) (*BuildInfo, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read returns the build information embedded in the binary read from r.
+func Read(r io.ReaderAt) (*BuildInfo, error) {
+	vers, info, err := readRaw(r)
+	if err != nil {
+		return nil, err
+	}
+	bi, err := Parse(info)
+	if err != nil {
+		return nil, err
+	}
+	bi.GoVersion = vers
+	return bi, nil
+}
+
+// The build information record written by the linker starts with
+// buildInfoMagic, followed by the pointer size and a flags byte,
+// padded to buildInfoHeaderSize bytes. When flagsVersionInline is set,
+// the Go version and the build information follow, each as a uvarint
+// length and the bytes of the string. The record is aligned to
+// buildInfoAlign bytes.
+const (
+	buildInfoMagic      = "\xff Go buildinf:"
+	buildInfoAlign      = 16
+	buildInfoHeaderSize = 32
+	flagsVersionInline  = 0x2
+)
+
+// A data is a region of a binary that may hold the build information.
+type data struct {
+	addr uint64 // address of the region when loaded, or 0
+	r    io.ReaderAt
+	size int64
+}
+
+// maxDataSize limits how much of a binary is searched.
+const maxDataSize = 64 << 20
+
+func readRaw(r io.ReaderAt) (vers, info string, err error) {
+	var ident [4]byte
+	if _, err := r.ReadAt(ident[:], 0); err != nil {
+		return "", "", ErrNotGoExe
+	}
+	var regions []data
+	switch {
+	case bytes.Equal(ident[:], []byte(elf.ELFMAG)):
+		f, err := elf.NewFile(r)
+		if err != nil {
+			return "", "", ErrNotGoExe
+		}
+		for _, s := range f.Sections {
+			if s.Type == elf.SHT_PROGBITS && s.Flags&(elf.SHF_ALLOC|elf.SHF_WRITE) == elf.SHF_ALLOC|elf.SHF_WRITE {
+				regions = append(regions, data{s.Addr, s, int64(s.Size)})
+			}
+		}
+	case isMachO(ident[:]):
+		f, err := macho.NewFile(r)
+		if err != nil {
+			return "", "", ErrNotGoExe
+		}
+		for _, s := range f.Sections {
+			if s.Seg == "__DATA" && s.Flags&0xff != 1 { // not S_ZEROFILL
+				regions = append(regions, data{s.Addr, s, int64(s.Size)})
+			}
+		}
+	case ident[0] == 'M' && ident[1] == 'Z':
+		f, err := pe.NewFile(r)
+		if err != nil {
+			return "", "", ErrNotGoExe
+		}
+		const writableData = 0x80000000 | 0x00000040 // IMAGE_SCN_MEM_WRITE | IMAGE_SCN_CNT_INITIALIZED_DATA
+		for _, s := range f.Sections {
+			if s.Characteristics&writableData == writableData {
+				regions = append(regions, data{uint64(s.VirtualAddress), s, int64(s.Size)})
+			}
+		}
+	default:
+		f, err := plan9obj.NewFile(r)
+		if err != nil {
+			return "", "", ErrNotGoExe
+		}
+		// The data segment starts on a page boundary,
+		// so offsets within it are as aligned as addresses.
+		if s := f.Section("data"); s != nil {
+			regions = append(regions, data{0, s, int64(s.Size)})
+		}
+	}
+
+	for _, d := range regions {
+		vers, info, ok := search(d)
+		if ok {
+			return vers, info, nil
+		}
+	}
+	return "", "", ErrNotGoExe
+}
+
+func isMachO(ident []byte) bool {
+	switch binary.LittleEndian.Uint32(ident) {
+	case macho.Magic32, macho.Magic64:
+		return true
+	}
+	switch binary.BigEndian.Uint32(ident) {
+	case macho.Magic32, macho.Magic64:
+		return true
+	}
+	return false
+}
+
+// search looks for the build information record in d.
+func search(d data) (vers, info string, ok bool) {
+	size := d.size
+	if size > maxDataSize {
+		size = maxDataSize
+	}
+	buf := make([]byte, size)
+	n, _ := d.r.ReadAt(buf, 0)
+	buf = buf[:n]
+	for off := 0; ; {
+		i := bytes.Index(buf[off:], []byte(buildInfoMagic))
+		if i < 0 {
+			return "", "", false
+		}
+		off += i
+		if (d.addr+uint64(off))%buildInfoAlign == 0 && len(buf)-off >= buildInfoHeaderSize {
+			if vers, info, ok := decode(buf[off:]); ok {
+				return vers, info, true
+			}
+		}
+		off++
+	}
+}
+
+// decode decodes the build information record at the start of b.
+func decode(b []byte) (vers, info string, ok bool) {
+	ptrSize := int(b[len(buildInfoMagic)])
+	flags := b[len(buildInfoMagic)+1]
+	if ptrSize != 4 && ptrSize != 8 || flags&flagsVersionInline == 0 {
+		return "", "", false
+	}
+	b = b[buildInfoHeaderSize:]
+	vers, b, ok = decodeString(b)
+	if !ok {
+		return "", "", false
+	}
+	info, _, ok = decodeString(b)
+	if !ok {
+		return "", "", false
+	}
+	return vers, info, true
+}
+
+func decodeString(b []byte) (s string, rest []byte, ok bool) {
+	n, w := binary.Uvarint(b)
+	if w <= 0 || n > uint64(len(b)-w) {
+		return "", nil, false
+	}
+	return string(b[w : w+int(n)]), b[w+int(n):], true
+}
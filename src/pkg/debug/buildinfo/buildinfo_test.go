@@ -0,0 +1,169 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"os"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+var testInfo = &BuildInfo{
+	GoVersion: "go1.3",
+	Path:      "example.com/cmd/hello",
+	Main:      Dep{Path: "example.com/cmd", Version: "(devel)"},
+	Deps: []*Dep{
+		{Path: "example.com/greet", Version: "v1.2.0"},
+		{Path: "example.com/quote", Version: "v0.1.0", Replace: &Dep{Path: "../quote"}},
+		{Path: "example.org/legacy", Version: "0123456789abcdef0123456789abcdef01234567", VCS: "git"},
+	},
+	Packages: []string{"example.com/cmd/hello", "example.com/greet", "example.com/quote", "example.org/legacy", "fmt", "runtime"},
+	Settings: []BuildSetting{
+		{"-compiler", "gc"},
+		{"-ldflags", "-X main.version=1.0"},
+		{"-tags", "netgo,osusergo"},
+		{"GOARCH", "amd64"},
+		{"GOOS", "linux"},
+	},
+}
+
+const testInfoString = `go	go1.3
+path	example.com/cmd/hello
+mod	example.com/cmd	(devel)
+dep	example.com/greet	v1.2.0
+dep	example.com/quote	v0.1.0
+=>	../quote	
+dep	example.org/legacy	0123456789abcdef0123456789abcdef01234567	git
+pkg	example.com/cmd/hello
+pkg	example.com/greet
+pkg	example.com/quote
+pkg	example.org/legacy
+pkg	fmt
+pkg	runtime
+build	-compiler=gc
+build	-ldflags=-X main.version=1.0
+build	-tags=netgo,osusergo
+build	GOARCH=amd64
+build	GOOS=linux
+`
+
+func TestString(t *testing.T) {
+	if s := testInfo.String(); s != testInfoString {
+		t.Errorf("String() = %q, want %q", s, testInfoString)
+	}
+}
+
+func TestParse(t *testing.T) {
+	bi, err := Parse(testInfoString + "future\tkey\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(bi, testInfo) {
+		t.Errorf("Parse = %+v, want %+v", bi, testInfo)
+	}
+	for _, bad := range []string{
+		"path\ta\tb\n",
+		"dep\texample.com/greet\n",
+		"=>\t../quote\t\n",
+		"build\t-compiler\n",
+	} {
+		if _, err := Parse(bad); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+// record returns a build information record as written by the linker.
+func record(ptrSize int, vers, info string) []byte {
+	b := make([]byte, buildInfoHeaderSize)
+	copy(b, buildInfoMagic)
+	b[len(buildInfoMagic)] = byte(ptrSize)
+	b[len(buildInfoMagic)+1] = flagsVersionInline
+	for _, s := range []string{vers, info} {
+		var n [binary.MaxVarintLen64]byte
+		b = append(b, n[:binary.PutUvarint(n[:], uint64(len(s)))]...)
+		b = append(b, s...)
+	}
+	return b
+}
+
+// elfFile returns an executable holding data in its .noptrdata section,
+// loaded at address 0x500000.
+func elfFile(t *testing.T, data []byte) []byte {
+	w := &elf.Writer{
+		FileHeader: elf.FileHeader{
+			Class:     elf.ELFCLASS64,
+			Data:      elf.ELFDATA2LSB,
+			Version:   elf.EV_CURRENT,
+			ByteOrder: binary.LittleEndian,
+			Type:      elf.ET_EXEC,
+			Machine:   elf.EM_X86_64,
+		},
+	}
+	w.AddSection(elf.SectionHeader{Name: ".text", Type: elf.SHT_PROGBITS, Flags: elf.SHF_ALLOC | elf.SHF_EXECINSTR, Addr: 0x401000, Addralign: 16}, make([]byte, 16))
+	w.AddSection(elf.SectionHeader{Name: ".noptrdata", Type: elf.SHT_PROGBITS, Flags: elf.SHF_ALLOC | elf.SHF_WRITE, Addr: 0x500000, Addralign: 32}, data)
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadELF(t *testing.T) {
+	info := testInfoString[len("go\tgo1.3\n"):]
+	rec := record(8, "go1.3", info)
+
+	// A misaligned copy of the record, as might appear in
+	// a string constant, is skipped.
+	data := append(make([]byte, 8), rec...)
+	data = append(data, make([]byte, 16-len(data)%16)...)
+	data = append(data, rec...)
+	bi, err := Read(bytes.NewReader(elfFile(t, data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(bi, testInfo) {
+		t.Errorf("Read = %+v, want %+v", bi, testInfo)
+	}
+
+	if _, err := Read(bytes.NewReader(elfFile(t, append(make([]byte, 8), rec...)))); err != ErrNotGoExe {
+		t.Errorf("Read of misaligned record: err = %v, want ErrNotGoExe", err)
+	}
+	truncated := rec[:len(rec)-1]
+	if _, err := Read(bytes.NewReader(elfFile(t, truncated))); err != ErrNotGoExe {
+		t.Errorf("Read of truncated record: err = %v, want ErrNotGoExe", err)
+	}
+}
+
+func TestReadNotGo(t *testing.T) {
+	for _, file := range []string{
+		"../elf/testdata/gcc-amd64-linux-exec",
+		"../macho/testdata/gcc-amd64-darwin-exec",
+		"../pe/testdata/gcc-386-mingw-exec",
+		"buildinfo_test.go",
+	} {
+		if _, err := ReadFile(file); err != ErrNotGoExe {
+			t.Errorf("ReadFile(%s): err = %v, want ErrNotGoExe", file, err)
+		}
+	}
+}
+
+func TestReadSelf(t *testing.T) {
+	switch runtime.GOOS {
+	case "nacl":
+		t.Skipf("cannot read own executable on %s", runtime.GOOS)
+	}
+	bi, err := ReadFile(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bi.GoVersion != runtime.Version() {
+		t.Errorf("GoVersion = %q, want %q", bi.GoVersion, runtime.Version())
+	}
+}
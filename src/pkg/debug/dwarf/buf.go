@@ -163,6 +163,26 @@ func (b *buf) addr() uint64 {
 	return 0
 }
 
+// unitLength reads the initial length of a unit, such as a line
+// number program or a call frame entry, and reports whether the
+// unit uses the 64-bit DWARF format.
+func (b *buf) unitLength() (length Offset, dwarf64 bool) {
+	length = Offset(b.uint32())
+	if length == 0xffffffff {
+		dwarf64 = true
+		l := b.uint64()
+		if l != uint64(Offset(l)) {
+			b.error("unit length overflow")
+			return 0, true
+		}
+		length = Offset(l)
+	} else if length >= 0xfffffff0 {
+		b.error("unit length has reserved value")
+		return 0, false
+	}
+	return
+}
+
 func (b *buf) error(s string) {
 	if b.err == nil {
 		b.data = nil
@@ -452,3 +452,91 @@ const (
 	encUnsignedChar   = 0x08
 	encImaginaryFloat = 0x09
 )
+
+// Statement program standard opcode encodings.
+const (
+	lnsCopy           = 1
+	lnsAdvancePC      = 2
+	lnsAdvanceLine    = 3
+	lnsSetFile        = 4
+	lnsSetColumn      = 5
+	lnsNegateStmt     = 6
+	lnsSetBasicBlock  = 7
+	lnsConstAddPC     = 8
+	lnsFixedAdvancePC = 9
+
+	// DWARF 3
+	lnsSetPrologueEnd   = 10
+	lnsSetEpilogueBegin = 11
+	lnsSetISA           = 12
+)
+
+// Statement program extended opcode encodings.
+const (
+	lneEndSequence = 1
+	lneSetAddress  = 2
+	lneDefineFile  = 3
+
+	// DWARF 4
+	lneSetDiscriminator = 4
+)
+
+// Call frame instruction encodings.
+// The primary opcodes are in the top two bits of the instruction;
+// the extended opcodes, with primary opcode 0, in the low six bits.
+const (
+	cfaAdvanceLoc = 0x40 /* low 6 bits: delta */
+	cfaOffset     = 0x80 /* low 6 bits: register; 1 op, ULEB128 offset */
+	cfaRestore    = 0xC0 /* low 6 bits: register */
+
+	cfaNop             = 0x00
+	cfaSetLoc          = 0x01 /* 1 op, address */
+	cfaAdvanceLoc1     = 0x02 /* 1 op, 1-byte delta */
+	cfaAdvanceLoc2     = 0x03 /* 1 op, 2-byte delta */
+	cfaAdvanceLoc4     = 0x04 /* 1 op, 4-byte delta */
+	cfaOffsetExtended  = 0x05 /* 2 op, ULEB128 register; ULEB128 offset */
+	cfaRestoreExtended = 0x06 /* 1 op, ULEB128 register */
+	cfaUndefined       = 0x07 /* 1 op, ULEB128 register */
+	cfaSameValue       = 0x08 /* 1 op, ULEB128 register */
+	cfaRegister        = 0x09 /* 2 op, ULEB128 register; ULEB128 register */
+	cfaRememberState   = 0x0A
+	cfaRestoreState    = 0x0B
+	cfaDefCFA          = 0x0C /* 2 op, ULEB128 register; ULEB128 offset */
+	cfaDefCFARegister  = 0x0D /* 1 op, ULEB128 register */
+	cfaDefCFAOffset    = 0x0E /* 1 op, ULEB128 offset */
+	/* next eight new in Dwarf v3 */
+	cfaDefCFAExpression = 0x0F /* 1 op, block */
+	cfaExpression       = 0x10 /* 2 op, ULEB128 register; block */
+	cfaOffsetExtendedSf = 0x11 /* 2 op, ULEB128 register; SLEB128 offset */
+	cfaDefCFASf         = 0x12 /* 2 op, ULEB128 register; SLEB128 offset */
+	cfaDefCFAOffsetSf   = 0x13 /* 1 op, SLEB128 offset */
+	cfaValOffset        = 0x14 /* 2 op, ULEB128 register; ULEB128 offset */
+	cfaValOffsetSf      = 0x15 /* 2 op, ULEB128 register; SLEB128 offset */
+	cfaValExpression    = 0x16 /* 2 op, ULEB128 register; block */
+	/* 0x1C-0x3F reserved for user-specific */
+	cfaGNUArgsSize               = 0x2E /* 1 op, ULEB128 size */
+	cfaGNUNegativeOffsetExtended = 0x2F /* 2 op, ULEB128 register; ULEB128 offset */
+)
+
+// Pointer encodings used by the .eh_frame section,
+// as defined by the Linux Standard Base.
+const (
+	ehPeAbsptr  = 0x00
+	ehPeUleb128 = 0x01
+	ehPeUdata2  = 0x02
+	ehPeUdata4  = 0x03
+	ehPeUdata8  = 0x04
+	ehPeSleb128 = 0x09
+	ehPeSdata2  = 0x0A
+	ehPeSdata4  = 0x0B
+	ehPeSdata8  = 0x0C
+
+	ehPePcrel   = 0x10
+	ehPeTextrel = 0x20
+	ehPeDatarel = 0x30
+	ehPeFuncrel = 0x40
+	ehPeAligned = 0x50
+
+	ehPeIndirect = 0x80
+	ehPeOmit     = 0xFF
+)
@@ -308,18 +308,14 @@ func (r *Reader) Seek(off Offset) {
 		return
 	}
 
-	// TODO(rsc): binary search (maybe a new package)
-	var i int
-	var u *unit
-	for i = range d.unit {
-		u = &d.unit[i]
-		if u.off <= off && off < u.off+Offset(len(u.data)) {
-			r.unit = i
-			r.b = makeBuf(r.d, u, "info", off, u.data[off-u.off:])
-			return
-		}
+	i := d.offsetToUnit(off)
+	if i == -1 {
+		r.err = errors.New("offset out of range")
+		return
 	}
-	r.err = errors.New("offset out of range")
+	u := &d.unit[i]
+	r.unit = i
+	r.b = makeBuf(r.d, u, "info", off, u.data[off-u.off:])
 }
 
 // maybeNextUnit advances to the next unit if this one is finished.
@@ -399,3 +395,81 @@ func (r *Reader) clone() typeReader {
 func (r *Reader) offset() Offset {
 	return r.b.off
 }
+
+// Ranges returns the PC ranges covered by e, a slice of [low,high) pairs.
+// Only some entry types, such as TagCompileUnit or TagSubprogram, have PC
+// ranges; for others, this will return nil with no error.
+func (d *Data) Ranges(e *Entry) ([][2]uint64, error) {
+	var ret [][2]uint64
+
+	i := d.offsetToUnit(e.Offset)
+	if i == -1 {
+		return nil, errors.New("no unit for entry")
+	}
+	u := &d.unit[i]
+
+	// A high PC given as a constant, new in DWARF 4,
+	// is an offset from the low PC.
+	low, lowOK := e.Val(AttrLowpc).(uint64)
+	var high uint64
+	var highOK bool
+	switch v := e.Val(AttrHighpc).(type) {
+	case uint64:
+		high, highOK = v, true
+	case int64:
+		high, highOK = low+uint64(v), true
+	}
+	if lowOK && highOK {
+		ret = append(ret, [2]uint64{low, high})
+	}
+
+	ranges, rangesOK := e.Val(AttrRanges).(int64)
+	if !rangesOK || d.ranges == nil {
+		return ret, nil
+	}
+	if ranges < 0 || ranges > int64(len(d.ranges)) {
+		return nil, errors.New("invalid range offset")
+	}
+
+	// The initial base address is the lowpc attribute
+	// of the enclosing compilation unit.
+	// Although DWARF specifies the lowpc attribute,
+	// comments in gdb/dwarf2read.c say that some versions
+	// of GCC use the entrypc attribute, so we check that too.
+	cu := e
+	if e.Tag != TagCompileUnit {
+		b := makeBuf(d, u, "info", u.off, u.data)
+		cu = b.entry(u.atable, u.base)
+		if b.err != nil {
+			return nil, b.err
+		}
+	}
+	var base uint64
+	if cuEntry, ok := cu.Val(AttrEntrypc).(uint64); ok {
+		base = cuEntry
+	} else if cuLow, ok := cu.Val(AttrLowpc).(uint64); ok {
+		base = cuLow
+	}
+
+	// A range list is a sequence of address pairs, ended by a
+	// pair of zeros.  A pair whose first address is the largest
+	// representable address selects a new base address.
+	maxAddr := ^uint64(0) >> uint(64-8*u.addrsize())
+	b := makeBuf(d, u, "ranges", Offset(ranges), d.ranges[ranges:])
+	for len(b.data) > 0 {
+		low := b.addr()
+		high := b.addr()
+		if b.err != nil {
+			return nil, b.err
+		}
+		if low == 0 && high == 0 {
+			break
+		}
+		if low == maxAddr {
+			base = high
+		} else {
+			ret = append(ret, [2]uint64{base + low, base + high})
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dwarf_test
+
+import (
+	. "debug/dwarf"
+	"reflect"
+	"testing"
+)
+
+func TestRanges(t *testing.T) {
+	d := elfData(t, "testdata/line-gcc.elf")
+	want := map[string][][2]uint64{
+		"line1.c": {{0x1129, 0x116d}},
+		"main":    {{0x114e, 0x116d}},
+		"f1":      {{0x1129, 0x114e}},
+		"line2.c": {{0x116d, 0x1179}, {0x1179, 0x11a9}}, // DW_AT_ranges
+		"f2":      {{0x1179, 0x11a9}},
+		"f3":      {{0x116d, 0x1179}},
+	}
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			t.Fatal("r.Next:", err)
+		}
+		if e == nil {
+			break
+		}
+		name, _ := e.Val(AttrName).(string)
+		w, ok := want[name]
+		if !ok || e.Val(AttrDeclaration) != nil {
+			continue
+		}
+		delete(want, name)
+		got, err := d.Ranges(e)
+		if err != nil {
+			t.Errorf("%s: Ranges: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, w) {
+			t.Errorf("%s: Ranges = %#x, want %#x", name, got, w)
+		}
+	}
+	for name := range want {
+		t.Errorf("entry %s not found", name)
+	}
+}
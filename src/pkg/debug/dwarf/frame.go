@@ -0,0 +1,669 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// DWARF call frame information.
+// The ``frame'' section, and the .eh_frame section used for
+// exception handling, describe for each range of instructions how
+// to compute the canonical frame address (CFA) of the current frame
+// and where the registers of the calling frame were saved.
+// That is enough to unwind the stack.
+
+package dwarf
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A CIE is a common information entry, holding the information
+// shared by the frame description entries that refer to it.
+type CIE struct {
+	Offset                Offset // offset of the entry within its section
+	Version               int
+	Augmentation          string
+	AddressSize           int
+	SegmentSize           int
+	CodeAlignmentFactor   uint64
+	DataAlignmentFactor   int64
+	ReturnAddressRegister uint64
+
+	// SignalFrame reports whether the frames described by the
+	// entry are those of signal handlers (augmentation "S").
+	SignalFrame bool
+
+	// InitialInstructions are the call frame instructions that
+	// set up the unwind rules in effect at the start of each
+	// frame description entry.
+	InitialInstructions []byte
+
+	fdeEncoding  uint8  // encoding of addresses in FDEs
+	lsdaEncoding uint8  // encoding of the LSDA pointer in FDEs
+	augData      bool   // whether FDEs have augmentation data
+	instOff      Offset // offset of InitialInstructions
+}
+
+// An FDE is a frame description entry, holding the call frame
+// instructions for one range of PCs, usually a single function.
+type FDE struct {
+	Offset Offset // offset of the entry within its section
+	CIE    *CIE
+	Begin  uint64 // first PC described by the entry
+	End    uint64 // PC following the last one described by the entry
+
+	// LSDA is the address of the language-specific data area
+	// of the function, from .eh_frame augmentation data, or 0.
+	LSDA uint64
+
+	// Instructions are the call frame instructions that
+	// describe how the unwind rules change within the range.
+	Instructions []byte
+
+	sec     *frameSection
+	instOff Offset // offset of Instructions
+}
+
+// A FrameTable holds the frame description entries of a ``frame''
+// or .eh_frame section, in order of increasing PC.
+type FrameTable struct {
+	fdes []*FDE
+}
+
+// A frameSection is a call frame section being decoded.
+type frameSection struct {
+	d    *Data
+	name string
+	data []byte
+	addr uint64 // address of the section when loaded
+	eh   bool   // .eh_frame rather than .debug_frame
+}
+
+// frameFormat is the data format of a call frame entry.
+type frameFormat struct {
+	asize int
+	is64  bool
+}
+
+func (f frameFormat) version() int {
+	return 0
+}
+
+func (f frameFormat) dwarf64() (bool, bool) {
+	return f.is64, true
+}
+
+func (f frameFormat) addrsize() int {
+	return f.asize
+}
+
+// FrameTable returns the frame description entries of the
+// ``frame'' section.
+// If there is no such section, it returns nil, nil.
+func (d *Data) FrameTable() (*FrameTable, error) {
+	if d.frame == nil {
+		return nil, nil
+	}
+	sec := &frameSection{d: d, name: "frame", data: d.frame}
+	return sec.parse()
+}
+
+// EHFrameTable returns the frame description entries of an .eh_frame
+// section, which has the same layout as the ``frame'' section but
+// encodes some addresses relative to their own location.
+// The data is the contents of the section, and addr the address at
+// which the section is loaded.
+func (d *Data) EHFrameTable(data []byte, addr uint64) (*FrameTable, error) {
+	sec := &frameSection{d: d, name: "eh_frame", data: data, addr: addr, eh: true}
+	return sec.parse()
+}
+
+// FDEs returns the frame description entries of the table,
+// in order of increasing PC.
+func (t *FrameTable) FDEs() []*FDE {
+	return t.fdes
+}
+
+// FDEForPC returns the frame description entry covering pc.
+// If there is none, it returns ErrUnknownPC.
+func (t *FrameTable) FDEForPC(pc uint64) (*FDE, error) {
+	i := sort.Search(len(t.fdes), func(i int) bool {
+		return t.fdes[i].Begin > pc
+	})
+	if i > 0 {
+		if f := t.fdes[i-1]; pc < f.End {
+			return f, nil
+		}
+	}
+	return nil, ErrUnknownPC
+}
+
+type fdesByPC []*FDE
+
+func (s fdesByPC) Len() int           { return len(s) }
+func (s fdesByPC) Less(i, j int) bool { return s[i].Begin < s[j].Begin }
+func (s fdesByPC) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// parse decodes the entries of the section.
+func (s *frameSection) parse() (*FrameTable, error) {
+	asize := 0
+	if len(s.d.unit) > 0 {
+		asize = s.d.unit[0].asize
+	}
+
+	type pending struct {
+		b      buf
+		cieOff Offset
+		off    Offset
+	}
+	var fdes []pending
+	cies := make(map[Offset]*CIE)
+
+	b := makeBuf(s.d, unknownFormat{}, s.name, 0, s.data)
+	for len(b.data) > 0 {
+		off := b.off
+		length, dwarf64 := b.unitLength()
+		if b.err != nil {
+			return nil, b.err
+		}
+		if length == 0 {
+			if s.eh {
+				// A zero length terminates .eh_frame.
+				break
+			}
+			return nil, DecodeError{s.name, off, "zero length entry"}
+		}
+		if int(length) > len(b.data) {
+			return nil, DecodeError{s.name, off, "entry extends past end of section"}
+		}
+		start := b.off
+		eb := makeBuf(s.d, frameFormat{asize, dwarf64}, s.name, start, b.bytes(int(length)))
+
+		var id uint64
+		if dwarf64 {
+			id = eb.uint64()
+		} else {
+			id = uint64(eb.uint32())
+		}
+		var cieOff Offset
+		switch {
+		case s.eh && id == 0,
+			!s.eh && !dwarf64 && id == 0xffffffff,
+			!s.eh && dwarf64 && id == 0xffffffffffffffff:
+			cie, err := s.parseCIE(&eb, off, asize)
+			if err != nil {
+				return nil, err
+			}
+			cies[off] = cie
+			continue
+		case s.eh:
+			// The CIE pointer is relative to its own location.
+			cieOff = start - Offset(id)
+		default:
+			cieOff = Offset(id)
+		}
+		fdes = append(fdes, pending{eb, cieOff, off})
+	}
+
+	t := new(FrameTable)
+	for _, p := range fdes {
+		cie := cies[p.cieOff]
+		if cie == nil {
+			return nil, DecodeError{s.name, p.off, "FDE refers to missing CIE at offset 0x" + strconv.FormatInt(int64(p.cieOff), 16)}
+		}
+		f, err := s.parseFDE(&p.b, p.off, cie)
+		if err != nil {
+			return nil, err
+		}
+		t.fdes = append(t.fdes, f)
+	}
+	sort.Stable(fdesByPC(t.fdes))
+	return t, nil
+}
+
+// parseCIE decodes the CIE at offset off, following its id in b.
+func (s *frameSection) parseCIE(b *buf, off Offset, asize int) (*CIE, error) {
+	cie := &CIE{
+		Offset:       off,
+		Version:      int(b.uint8()),
+		AddressSize:  asize,
+		fdeEncoding:  ehPeAbsptr,
+		lsdaEncoding: ehPeOmit,
+	}
+	if cie.Version != 1 && cie.Version != 3 && cie.Version != 4 {
+		return nil, DecodeError{s.name, off, "unsupported CIE version " + strconv.Itoa(cie.Version)}
+	}
+	cie.Augmentation = b.string()
+	if cie.Version == 4 {
+		cie.AddressSize = int(b.uint8())
+		cie.SegmentSize = int(b.uint8())
+		b.format = frameFormat{cie.AddressSize, b.format.(frameFormat).is64}
+	}
+	if cie.Augmentation == "eh" {
+		// Old GCC: address of exception table.
+		b.addr()
+	}
+	cie.CodeAlignmentFactor = b.uint()
+	cie.DataAlignmentFactor = b.int()
+	if cie.Version == 1 {
+		cie.ReturnAddressRegister = uint64(b.uint8())
+	} else {
+		cie.ReturnAddressRegister = b.uint()
+	}
+
+	switch aug := cie.Augmentation; {
+	case strings.HasPrefix(aug, "z"):
+		// The augmentation data has its own length,
+		// so unknown augmentations can be skipped.
+		cie.augData = true
+		n := int(b.uint())
+		ab := makeBuf(s.d, b.format, s.name, b.off, b.bytes(n))
+	Aug:
+		for _, c := range aug[1:] {
+			switch c {
+			case 'R':
+				cie.fdeEncoding = ab.uint8()
+			case 'L':
+				cie.lsdaEncoding = ab.uint8()
+			case 'P':
+				// Personality routine; not used.
+				s.pointer(&ab, ab.uint8())
+			case 'S':
+				cie.SignalFrame = true
+			default:
+				break Aug
+			}
+		}
+		if ab.err != nil {
+			return nil, ab.err
+		}
+	case aug != "" && aug != "eh":
+		return nil, DecodeError{s.name, off, "unsupported CIE augmentation " + strconv.Quote(aug)}
+	}
+
+	cie.instOff = b.off
+	cie.InitialInstructions = b.bytes(len(b.data))
+	if b.err != nil {
+		return nil, b.err
+	}
+	return cie, nil
+}
+
+// parseFDE decodes the FDE at offset off, following its CIE pointer in b.
+func (s *frameSection) parseFDE(b *buf, off Offset, cie *CIE) (*FDE, error) {
+	b.format = frameFormat{cie.AddressSize, b.format.(frameFormat).is64}
+	f := &FDE{Offset: off, CIE: cie, sec: s}
+	f.Begin = s.pointer(b, cie.fdeEncoding)
+	// The size of the range is not relative to anything.
+	f.End = f.Begin + s.pointer(b, cie.fdeEncoding&0x0F)
+	if cie.augData {
+		n := int(b.uint())
+		ab := makeBuf(s.d, b.format, s.name, b.off, b.bytes(n))
+		if cie.lsdaEncoding != ehPeOmit {
+			f.LSDA = s.pointer(&ab, cie.lsdaEncoding)
+		}
+		if ab.err != nil {
+			return nil, ab.err
+		}
+	}
+	f.instOff = b.off
+	f.Instructions = b.bytes(len(b.data))
+	if b.err != nil {
+		return nil, b.err
+	}
+	return f, nil
+}
+
+// pointer reads an address encoded as enc, one of the
+// .eh_frame pointer encodings.  The ``frame'' section
+// always uses ehPeAbsptr.
+func (s *frameSection) pointer(b *buf, enc uint8) uint64 {
+	if enc == ehPeOmit {
+		return 0
+	}
+	pos := s.addr + uint64(b.off)
+	if enc&0x70 == ehPeAligned {
+		if n := b.format.addrsize(); n > 0 {
+			if r := int(pos % uint64(n)); r != 0 {
+				b.skip(n - r)
+			}
+		}
+	}
+
+	var v uint64
+	switch enc & 0x0F {
+	case ehPeAbsptr:
+		v = b.addr()
+	case ehPeUleb128:
+		v = b.uint()
+	case ehPeUdata2:
+		v = uint64(b.uint16())
+	case ehPeUdata4:
+		v = uint64(b.uint32())
+	case ehPeUdata8:
+		v = b.uint64()
+	case ehPeSleb128:
+		v = uint64(b.int())
+	case ehPeSdata2:
+		v = uint64(int16(b.uint16()))
+	case ehPeSdata4:
+		v = uint64(int32(b.uint32()))
+	case ehPeSdata8:
+		v = b.uint64()
+	default:
+		b.error("unknown pointer encoding 0x" + strconv.FormatInt(int64(enc), 16))
+		return 0
+	}
+
+	switch enc & 0x70 {
+	case ehPeAbsptr, ehPeAligned:
+	case ehPePcrel:
+		v += pos
+	default:
+		b.error("unsupported pointer encoding 0x" + strconv.FormatInt(int64(enc), 16))
+		return 0
+	}
+	// An indirect pointer is the address of the value,
+	// which is not available here.
+	return v
+}
+
+// A RuleKind is the kind of a RegisterRule.
+type RuleKind int
+
+const (
+	// RuleUndefined means the register cannot be recovered.
+	RuleUndefined RuleKind = iota
+	// RuleSameValue means the register has not been modified.
+	RuleSameValue
+	// RuleOffset means the register was saved at the address CFA+Offset.
+	RuleOffset
+	// RuleValOffset means the register's value is CFA+Offset.
+	RuleValOffset
+	// RuleRegister means the register was saved in register Reg.
+	RuleRegister
+	// RuleExpression means the register was saved at the address
+	// computed by the DWARF expression Expression, with the CFA
+	// pushed on the stack.
+	RuleExpression
+	// RuleValExpression means the register's value is computed by
+	// the DWARF expression Expression, with the CFA pushed on the
+	// stack.
+	RuleValExpression
+	// RuleCFA means the value, used only for the CFA, is
+	// register Reg plus Offset.
+	RuleCFA
+)
+
+var ruleKindNames = [...]string{
+	RuleUndefined:     "undefined",
+	RuleSameValue:     "same value",
+	RuleOffset:        "offset",
+	RuleValOffset:     "val offset",
+	RuleRegister:      "register",
+	RuleExpression:    "expression",
+	RuleValExpression: "val expression",
+	RuleCFA:           "cfa",
+}
+
+func (k RuleKind) String() string {
+	if 0 <= int(k) && int(k) < len(ruleKindNames) {
+		return ruleKindNames[k]
+	}
+	return "RuleKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// A RegisterRule says how to recover the value a register
+// had in the calling frame, or, for the CFA, how to compute it.
+type RegisterRule struct {
+	Kind       RuleKind
+	Reg        uint64 // for RuleRegister and RuleCFA
+	Offset     int64  // for RuleOffset, RuleValOffset and RuleCFA
+	Expression []byte // for RuleExpression and RuleValExpression
+}
+
+// A FrameRow holds the unwind rules in effect at a range of PCs.
+type FrameRow struct {
+	// Loc is the first PC to which the rules apply.
+	Loc uint64
+
+	// CFA says how to compute the canonical frame address.
+	// Its Kind is RuleCFA or RuleExpression.
+	CFA RegisterRule
+
+	// Regs holds the rules for the registers, by DWARF register
+	// number.  The rules for registers not in Regs are defined
+	// by the architecture's ABI.
+	Regs map[uint64]RegisterRule
+
+	// ReturnAddress is the register holding the return address.
+	ReturnAddress uint64
+}
+
+// A frameState is the part of a FrameRow that the
+// remember and restore state instructions save.
+type frameState struct {
+	cfa  RegisterRule
+	regs map[uint64]RegisterRule
+}
+
+func (s frameState) clone() frameState {
+	regs := make(map[uint64]RegisterRule, len(s.regs))
+	for r, rule := range s.regs {
+		regs[r] = rule
+	}
+	return frameState{s.cfa, regs}
+}
+
+// A frameMachine executes call frame instructions.
+type frameMachine struct {
+	fde     *FDE
+	loc     uint64
+	state   frameState
+	initial frameState // state after the CIE's initial instructions
+	stack   []frameState
+}
+
+// RowForPC returns the unwind rules in effect at pc, which must be in
+// the range of PCs described by the entry.
+func (f *FDE) RowForPC(pc uint64) (*FrameRow, error) {
+	if pc < f.Begin || pc >= f.End {
+		return nil, ErrUnknownPC
+	}
+	m, err := f.exec(pc, nil)
+	if err != nil {
+		return nil, err
+	}
+	row := m.row()
+	return &row, nil
+}
+
+// Rows returns the unwind rules of the entry, one row for each
+// range of PCs in which the rules differ, in order of increasing PC.
+func (f *FDE) Rows() ([]FrameRow, error) {
+	var rows []FrameRow
+	m, err := f.exec(f.End-1, func(m *frameMachine) {
+		rows = append(rows, m.row())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(rows, m.row()), nil
+}
+
+// exec executes the instructions of f up to the row covering pc,
+// calling emit, if not nil, with each completed row before it.
+func (f *FDE) exec(pc uint64, emit func(*frameMachine)) (*frameMachine, error) {
+	s := f.sec
+	cie := f.CIE
+	format := frameFormat{cie.AddressSize, false}
+
+	m := &frameMachine{
+		fde:   f,
+		loc:   f.Begin,
+		state: frameState{regs: make(map[uint64]RegisterRule)},
+	}
+	b := makeBuf(s.d, format, s.name, cie.instOff, cie.InitialInstructions)
+	m.run(&b, ^uint64(0), nil)
+	if b.err != nil {
+		return nil, b.err
+	}
+	m.initial = m.state.clone()
+
+	b = makeBuf(s.d, format, s.name, f.instOff, f.Instructions)
+	m.run(&b, pc, emit)
+	if b.err != nil {
+		return nil, b.err
+	}
+	return m, nil
+}
+
+// row returns the current row of the table.
+func (m *frameMachine) row() FrameRow {
+	st := m.state.clone()
+	return FrameRow{
+		Loc:           m.loc,
+		CFA:           st.cfa,
+		Regs:          st.regs,
+		ReturnAddress: m.fde.CIE.ReturnAddressRegister,
+	}
+}
+
+// run executes the instructions in b until they are exhausted or
+// the location would advance past pc.  Before each advance of the
+// location, it calls emit, if not nil, to record the current row.
+func (m *frameMachine) run(b *buf, pc uint64, emit func(*frameMachine)) {
+	cie := m.fde.CIE
+	caf := cie.CodeAlignmentFactor
+	daf := cie.DataAlignmentFactor
+
+	setLoc := func(loc uint64) bool {
+		if loc > pc {
+			return false
+		}
+		if emit != nil && loc != m.loc {
+			emit(m)
+		}
+		m.loc = loc
+		return true
+	}
+	setReg := func(r uint64, kind RuleKind, off int64) {
+		m.state.regs[r] = RegisterRule{Kind: kind, Offset: off}
+	}
+
+	for len(b.data) > 0 && b.err == nil {
+		op := b.uint8()
+		switch op & 0xC0 {
+		case cfaAdvanceLoc:
+			if !setLoc(m.loc + uint64(op&0x3F)*caf) {
+				return
+			}
+			continue
+		case cfaOffset:
+			setReg(uint64(op&0x3F), RuleOffset, int64(b.uint())*daf)
+			continue
+		case cfaRestore:
+			m.restore(uint64(op & 0x3F))
+			continue
+		}
+
+		switch op {
+		case cfaNop:
+
+		case cfaSetLoc:
+			if !setLoc(m.fde.sec.pointer(b, cie.fdeEncoding)) {
+				return
+			}
+		case cfaAdvanceLoc1:
+			if !setLoc(m.loc + uint64(b.uint8())*caf) {
+				return
+			}
+		case cfaAdvanceLoc2:
+			if !setLoc(m.loc + uint64(b.uint16())*caf) {
+				return
+			}
+		case cfaAdvanceLoc4:
+			if !setLoc(m.loc + uint64(b.uint32())*caf) {
+				return
+			}
+
+		case cfaOffsetExtended:
+			r := b.uint()
+			setReg(r, RuleOffset, int64(b.uint())*daf)
+		case cfaOffsetExtendedSf:
+			r := b.uint()
+			setReg(r, RuleOffset, b.int()*daf)
+		case cfaGNUNegativeOffsetExtended:
+			r := b.uint()
+			setReg(r, RuleOffset, -int64(b.uint())*daf)
+		case cfaValOffset:
+			r := b.uint()
+			setReg(r, RuleValOffset, int64(b.uint())*daf)
+		case cfaValOffsetSf:
+			r := b.uint()
+			setReg(r, RuleValOffset, b.int()*daf)
+		case cfaRestoreExtended:
+			m.restore(b.uint())
+		case cfaUndefined:
+			setReg(b.uint(), RuleUndefined, 0)
+		case cfaSameValue:
+			setReg(b.uint(), RuleSameValue, 0)
+		case cfaRegister:
+			r := b.uint()
+			m.state.regs[r] = RegisterRule{Kind: RuleRegister, Reg: b.uint()}
+		case cfaExpression:
+			r := b.uint()
+			m.state.regs[r] = RegisterRule{Kind: RuleExpression, Expression: b.bytes(int(b.uint()))}
+		case cfaValExpression:
+			r := b.uint()
+			m.state.regs[r] = RegisterRule{Kind: RuleValExpression, Expression: b.bytes(int(b.uint()))}
+
+		case cfaRememberState:
+			m.stack = append(m.stack, m.state.clone())
+		case cfaRestoreState:
+			if len(m.stack) == 0 {
+				b.error("DW_CFA_restore_state without DW_CFA_remember_state")
+				return
+			}
+			m.state = m.stack[len(m.stack)-1]
+			m.stack = m.stack[:len(m.stack)-1]
+
+		case cfaDefCFA:
+			r := b.uint()
+			m.state.cfa = RegisterRule{Kind: RuleCFA, Reg: r, Offset: int64(b.uint())}
+		case cfaDefCFASf:
+			r := b.uint()
+			m.state.cfa = RegisterRule{Kind: RuleCFA, Reg: r, Offset: b.int() * daf}
+		case cfaDefCFARegister:
+			m.state.cfa.Kind = RuleCFA
+			m.state.cfa.Reg = b.uint()
+			m.state.cfa.Expression = nil
+		case cfaDefCFAOffset:
+			m.state.cfa.Kind = RuleCFA
+			m.state.cfa.Offset = int64(b.uint())
+			m.state.cfa.Expression = nil
+		case cfaDefCFAOffsetSf:
+			m.state.cfa.Kind = RuleCFA
+			m.state.cfa.Offset = b.int() * daf
+			m.state.cfa.Expression = nil
+		case cfaDefCFAExpression:
+			m.state.cfa = RegisterRule{Kind: RuleExpression, Expression: b.bytes(int(b.uint()))}
+
+		case cfaGNUArgsSize:
+			b.uint()
+
+		default:
+			b.error("unknown call frame instruction 0x" + strconv.FormatInt(int64(op), 16))
+		}
+	}
+}
+
+// restore sets the rule for register r to the one
+// established by the CIE's initial instructions.
+func (m *frameMachine) restore(r uint64) {
+	if rule, ok := m.initial.regs[r]; ok {
+		m.state.regs[r] = rule
+	} else {
+		delete(m.state.regs, r)
+	}
+}
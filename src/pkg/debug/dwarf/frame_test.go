@@ -0,0 +1,165 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dwarf_test
+
+import (
+	. "debug/dwarf"
+	"debug/elf"
+	"reflect"
+	"testing"
+)
+
+// DWARF register numbers on amd64.
+const (
+	regRBX = 3
+	regRBP = 6
+	regRSP = 7
+	regRA  = 16
+)
+
+func cfa(reg uint64, off int64) RegisterRule {
+	return RegisterRule{Kind: RuleCFA, Reg: reg, Offset: off}
+}
+
+func saved(off int64) RegisterRule {
+	return RegisterRule{Kind: RuleOffset, Offset: off}
+}
+
+// The rows of the frame of a function compiled without optimization,
+// which keeps the frame pointer in rbp.
+func frameRows(begin uint64) []FrameRow {
+	return []FrameRow{
+		{Loc: begin, CFA: cfa(regRSP, 8), Regs: map[uint64]RegisterRule{regRA: saved(-8)}},
+		{Loc: begin + 1, CFA: cfa(regRSP, 16), Regs: map[uint64]RegisterRule{regRBP: saved(-16), regRA: saved(-8)}},
+		{Loc: begin + 4, CFA: cfa(regRBP, 16), Regs: map[uint64]RegisterRule{regRBP: saved(-16), regRA: saved(-8)}},
+	}
+}
+
+func TestFrameTable(t *testing.T) {
+	d := elfData(t, "testdata/line-gcc.elf")
+	tab, err := d.FrameTable()
+	if err != nil {
+		t.Fatal("d.FrameTable:", err)
+	}
+	if tab == nil {
+		t.Fatal("d.FrameTable: no .debug_frame section")
+	}
+
+	want := []struct {
+		begin, end uint64
+		rows       []FrameRow
+	}{
+		{0x1129, 0x114e, append(frameRows(0x1129),
+			FrameRow{Loc: 0x114d, CFA: cfa(regRSP, 8), Regs: map[uint64]RegisterRule{regRBP: saved(-16), regRA: saved(-8)}})},
+		{0x114e, 0x116d, append(frameRows(0x114e),
+			FrameRow{Loc: 0x116c, CFA: cfa(regRSP, 8), Regs: map[uint64]RegisterRule{regRBP: saved(-16), regRA: saved(-8)}})},
+	}
+	fdes := tab.FDEs()
+	if len(fdes) != len(want) {
+		t.Fatalf("got %d FDEs, want %d", len(fdes), len(want))
+	}
+	for i, fde := range fdes {
+		w := want[i]
+		if fde.Begin != w.begin || fde.End != w.end {
+			t.Errorf("FDE %d covers [%#x, %#x), want [%#x, %#x)", i, fde.Begin, fde.End, w.begin, w.end)
+			continue
+		}
+		if fde.CIE.CodeAlignmentFactor != 1 || fde.CIE.DataAlignmentFactor != -8 || fde.CIE.ReturnAddressRegister != regRA {
+			t.Errorf("FDE %d: CIE %+v", i, fde.CIE)
+		}
+		for j := range w.rows {
+			w.rows[j].ReturnAddress = regRA
+		}
+		rows, err := fde.Rows()
+		if err != nil {
+			t.Errorf("FDE %d: Rows: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(rows, w.rows) {
+			t.Errorf("FDE %d: got rows %+v, want %+v", i, rows, w.rows)
+		}
+	}
+
+	for _, pc := range []uint64{0x1100, 0x116d} {
+		if _, err := tab.FDEForPC(pc); err != ErrUnknownPC {
+			t.Errorf("FDEForPC(%#x) = %v, want ErrUnknownPC", pc, err)
+		}
+	}
+}
+
+func TestEHFrameTable(t *testing.T) {
+	f, err := elf.Open("testdata/line-gcc.elf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sec := f.Section(".eh_frame")
+	if sec == nil {
+		t.Fatal("no .eh_frame section")
+	}
+	data, err := sec.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := f.DWARF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tab, err := d.EHFrameTable(data, sec.Addr)
+	if err != nil {
+		t.Fatal("d.EHFrameTable:", err)
+	}
+
+	// f3 has no frame of its own.
+	fde, err := tab.FDEForPC(0x1170)
+	if err != nil {
+		t.Fatal("FDEForPC(0x1170):", err)
+	}
+	if fde.Begin != 0x116d || fde.End != 0x1179 {
+		t.Errorf("FDEForPC(0x1170) covers [%#x, %#x), want [0x116d, 0x1179)", fde.Begin, fde.End)
+	}
+	row, err := fde.RowForPC(0x1178)
+	if err != nil {
+		t.Fatal("RowForPC(0x1178):", err)
+	}
+	wantRow := &FrameRow{Loc: 0x116d, CFA: cfa(regRSP, 8), Regs: map[uint64]RegisterRule{regRA: saved(-8)}, ReturnAddress: regRA}
+	if !reflect.DeepEqual(row, wantRow) {
+		t.Errorf("RowForPC(0x1178) = %+v, want %+v", row, wantRow)
+	}
+
+	// f2 saves rbx and adjusts the stack pointer.
+	fde, err = tab.FDEForPC(0x1180)
+	if err != nil {
+		t.Fatal("FDEForPC(0x1180):", err)
+	}
+	if fde.Begin != 0x1179 || fde.End != 0x11a9 {
+		t.Errorf("FDEForPC(0x1180) covers [%#x, %#x), want [0x1179, 0x11a9)", fde.Begin, fde.End)
+	}
+	saveRBX := map[uint64]RegisterRule{regRBX: saved(-16), regRA: saved(-8)}
+	tests := []struct {
+		pc  uint64
+		row FrameRow
+	}{
+		{0x1179, FrameRow{Loc: 0x1179, CFA: cfa(regRSP, 8), Regs: map[uint64]RegisterRule{regRA: saved(-8)}}},
+		{0x117a, FrameRow{Loc: 0x117a, CFA: cfa(regRSP, 16), Regs: saveRBX}},
+		{0x1190, FrameRow{Loc: 0x117e, CFA: cfa(regRSP, 32), Regs: saveRBX}},
+		{0x11a7, FrameRow{Loc: 0x11a7, CFA: cfa(regRSP, 16), Regs: saveRBX}},
+		{0x11a8, FrameRow{Loc: 0x11a8, CFA: cfa(regRSP, 8), Regs: saveRBX}},
+	}
+	for _, tt := range tests {
+		tt.row.ReturnAddress = regRA
+		row, err := fde.RowForPC(tt.pc)
+		if err != nil {
+			t.Errorf("RowForPC(%#x): %v", tt.pc, err)
+			continue
+		}
+		if !reflect.DeepEqual(*row, tt.row) {
+			t.Errorf("RowForPC(%#x) = %+v, want %+v", tt.pc, *row, tt.row)
+		}
+	}
+	if _, err := fde.RowForPC(0x11a9); err != ErrUnknownPC {
+		t.Errorf("RowForPC(0x11a9) = %v, want ErrUnknownPC", err)
+	}
+}
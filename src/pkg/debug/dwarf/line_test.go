@@ -0,0 +1,281 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dwarf_test
+
+import (
+	. "debug/dwarf"
+	"io"
+	"testing"
+)
+
+var (
+	file1C = &LineFile{Name: "/tmp/lt/line1.c"}
+	file1H = &LineFile{Name: "/tmp/lt/line1.h"}
+	file2C = &LineFile{Name: "/tmp/lt/line2.c"}
+)
+
+var lineGCCWant = []LineEntry{
+	{Address: 0x1129, File: file1H, Line: 6, Column: 1, IsStmt: true},
+	{Address: 0x112d, File: file1H, Line: 9, Column: 8, IsStmt: true},
+	{Address: 0x1134, File: file1H, Line: 9, Column: 2, IsStmt: true},
+	{Address: 0x1136, File: file1H, Line: 10, Column: 10, IsStmt: true, Discriminator: 3},
+	{Address: 0x1140, File: file1H, Line: 9, Column: 22, IsStmt: true, Discriminator: 3},
+	{Address: 0x1144, File: file1H, Line: 9, Column: 15, IsStmt: true, Discriminator: 1},
+	{Address: 0x114a, File: file1H, Line: 11, Column: 1, IsStmt: true},
+	{Address: 0x114e, File: file1C, Line: 21, Column: 1, IsStmt: true},
+	{Address: 0x1152, File: file1C, Line: 22, Column: 2, IsStmt: true},
+	{Address: 0x115c, File: file1C, Line: 23, Column: 2, IsStmt: true},
+	{Address: 0x116b, File: file1C, Line: 24, Column: 1, IsStmt: true},
+	{Address: 0x116d, File: file1C, Line: 24, Column: 1, IsStmt: true, EndSequence: true},
+	{Address: 0x116d, File: file2C, Line: 6, Column: 1, IsStmt: true},
+	{Address: 0x116d, File: file2C, Line: 7, Column: 2, IsStmt: true},
+	{Address: 0x116d, File: file2C, Line: 7, Column: 5},
+	{Address: 0x116f, File: file2C, Line: 7, Column: 4},
+	{Address: 0x1173, File: file2C, Line: 8, Column: 3, IsStmt: true},
+	{Address: 0x1173, File: file2C, Line: 8, Column: 6},
+	{Address: 0x1178, File: file2C, Line: 9, Column: 1},
+	{Address: 0x1179, File: file2C, Line: 9, Column: 1, EndSequence: true},
+	{Address: 0x1179, File: file2C, Line: 12, Column: 1, IsStmt: true},
+	{Address: 0x117e, File: file2C, Line: 13, Column: 2, IsStmt: true},
+	{Address: 0x117e, File: file2C, Line: 14, Column: 2, IsStmt: true},
+	{Address: 0x117e, File: file2C, Line: 14, Column: 8},
+	{Address: 0x1186, File: file2C, Line: 14, Column: 15, IsStmt: true},
+	{Address: 0x1186, File: file2C, Line: 15, Column: 3},
+	{Address: 0x118b, File: file2C, Line: 15, Column: 3, IsStmt: true, Discriminator: 3},
+	{Address: 0x1193, File: file2C, Line: 14, Column: 22, IsStmt: true, Discriminator: 3},
+	{Address: 0x119e, File: file2C, Line: 14, Column: 15, IsStmt: true, Discriminator: 3},
+	{Address: 0x11a3, File: file2C, Line: 16, Column: 1},
+	{Address: 0x11a9, File: file2C, Line: 16, Column: 1, EndSequence: true},
+}
+
+func TestLineELFGCC(t *testing.T) {
+	testLineTable(t, lineGCCWant, elfData(t, "testdata/line-gcc.elf"))
+}
+
+func TestLineSeekPC(t *testing.T) {
+	d := elfData(t, "testdata/line-gcc.elf")
+	tests := []struct {
+		pc   uint64
+		want int // index into lineGCCWant, or -1 for ErrUnknownPC
+	}{
+		{0x1128, -1},
+		{0x1129, 0},
+		{0x1135, 2},
+		{0x116c, 10},
+		{0x116d, 14},
+		{0x1178, 18},
+		{0x1179, 20},
+		{0x118a, 25},
+		{0x11a8, 29},
+		{0x11a9, -1},
+	}
+	for _, tt := range tests {
+		cu, lr := lineReaderForPC(t, d, tt.pc)
+		if lr == nil {
+			if tt.want != -1 {
+				t.Errorf("pc %#x: no compilation unit", tt.pc)
+			}
+			continue
+		}
+		var entry LineEntry
+		err := lr.SeekPC(tt.pc, &entry)
+		if tt.want == -1 {
+			if err != ErrUnknownPC {
+				t.Errorf("pc %#x: SeekPC = %v, want ErrUnknownPC", tt.pc, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("pc %#x: SeekPC: %v", tt.pc, err)
+			continue
+		}
+		if !compareLines(entry, lineGCCWant[tt.want]) {
+			t.Errorf("pc %#x in %v: got %+v, want %+v", tt.pc, cu.Val(AttrName), entry, lineGCCWant[tt.want])
+		}
+
+		// SeekPC positions the reader on the following entry.
+		var next LineEntry
+		if err := lr.Next(&next); err != nil {
+			t.Errorf("pc %#x: Next after SeekPC: %v", tt.pc, err)
+		} else if !compareLines(next, lineGCCWant[tt.want+1]) {
+			t.Errorf("pc %#x: Next after SeekPC got %+v, want %+v", tt.pc, next, lineGCCWant[tt.want+1])
+		}
+	}
+}
+
+// lineReaderForPC returns the compilation unit whose ranges cover pc
+// and its line reader.  Both are nil if there is no such unit.
+func lineReaderForPC(t *testing.T, d *Data, pc uint64) (*Entry, *LineReader) {
+	r := d.Reader()
+	for {
+		cu, err := r.Next()
+		if err != nil {
+			t.Fatal("r.Next:", err)
+		}
+		if cu == nil {
+			return nil, nil
+		}
+		if cu.Tag != TagCompileUnit {
+			continue
+		}
+		r.SkipChildren()
+		ranges, err := d.Ranges(cu)
+		if err != nil {
+			t.Fatal("d.Ranges:", err)
+		}
+		for _, rng := range ranges {
+			if rng[0] <= pc && pc < rng[1] {
+				lr, err := d.LineReader(cu)
+				if err != nil {
+					t.Fatal("d.LineReader:", err)
+				}
+				return cu, lr
+			}
+		}
+	}
+}
+
+func testLineTable(t *testing.T, want []LineEntry, d *Data) {
+	// Read line table from DWARF data.
+	var got []LineEntry
+	dr := d.Reader()
+	for {
+		ent, err := dr.Next()
+		if err != nil {
+			t.Fatal("dr.Next:", err)
+		} else if ent == nil {
+			break
+		}
+
+		if ent.Tag != TagCompileUnit {
+			dr.SkipChildren()
+			continue
+		}
+
+		// Decode CU's line table.
+		lr, err := d.LineReader(ent)
+		if err != nil {
+			t.Fatal("d.LineReader:", err)
+		} else if lr == nil {
+			continue
+		}
+
+		for {
+			var line LineEntry
+			err := lr.Next(&line)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatal("lr.Next:", err)
+			}
+			got = append(got, line)
+		}
+	}
+
+	// Compare line tables.
+	if !compareLineTables(got, want) {
+		t.Log("Line tables do not match. Got:")
+		dumpLines(t, got)
+		t.Log("Want:")
+		dumpLines(t, want)
+		t.FailNow()
+	}
+}
+
+func compareLines(a, b LineEntry) bool {
+	if a.File == nil || b.File == nil {
+		if a.File != b.File {
+			return false
+		}
+	} else if a.File.Name != b.File.Name {
+		return false
+	}
+	a.File, b.File = nil, nil
+	return a == b
+}
+
+func compareLineTables(a, b []LineEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !compareLines(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func dumpLines(t *testing.T, lines []LineEntry) {
+	for _, l := range lines {
+		t.Logf("  %+v File:%+v", l, l.File)
+	}
+}
+
+func TestLineReaderTellSeek(t *testing.T) {
+	d := elfData(t, "testdata/line-gcc.elf")
+	cu, err := d.Reader().Next()
+	if err != nil {
+		t.Fatal("r.Next:", err)
+	}
+	lr, err := d.LineReader(cu)
+	if err != nil {
+		t.Fatal("d.LineReader:", err)
+	}
+
+	var entry LineEntry
+	for i := 0; i < 3; i++ {
+		if err := lr.Next(&entry); err != nil {
+			t.Fatal("lr.Next:", err)
+		}
+	}
+	pos := lr.Tell()
+	var want, got LineEntry
+	if err := lr.Next(&want); err != nil {
+		t.Fatal("lr.Next:", err)
+	}
+
+	// Seek back after reading the rest of the table.
+	for lr.Next(&entry) == nil {
+	}
+	lr.Seek(pos)
+	if err := lr.Next(&got); err != nil {
+		t.Fatal("lr.Next after Seek:", err)
+	}
+	if !compareLines(got, want) {
+		t.Errorf("after Seek got %+v, want %+v", got, want)
+	}
+
+	lr.Reset()
+	if err := lr.Next(&got); err != nil {
+		t.Fatal("lr.Next after Reset:", err)
+	}
+	if !compareLines(got, lineGCCWant[0]) {
+		t.Errorf("after Reset got %+v, want %+v", got, lineGCCWant[0])
+	}
+}
+
+func TestLineReaderFiles(t *testing.T) {
+	d := elfData(t, "testdata/line-gcc.elf")
+	cu, err := d.Reader().Next()
+	if err != nil {
+		t.Fatal("r.Next:", err)
+	}
+	lr, err := d.LineReader(cu)
+	if err != nil {
+		t.Fatal("d.LineReader:", err)
+	}
+	files := lr.Files()
+	var names []string
+	for _, f := range files[1:] {
+		names = append(names, f.Name)
+	}
+	if len(files) == 0 || files[0] != nil {
+		t.Fatalf("Files()[0] = %v, want nil", files)
+	}
+	if len(names) != 2 || names[0] != file1C.Name || names[1] != file1H.Name {
+		t.Errorf("Files() names = %q, want [%q %q]", names, file1C.Name, file1H.Name)
+	}
+}
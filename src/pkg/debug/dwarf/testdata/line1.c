@@ -0,0 +1,24 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Linux ELF:
+gcc -gdwarf-4 -O0 -fno-asynchronous-unwind-tables -c line1.c
+gcc -gdwarf-4 -O1 -ffunction-sections -c line2.c
+gcc -o line-gcc.elf line1.o line2.o
+
+The call frame information of line1.c is in .debug_frame, that of
+line2.c in .eh_frame.  Because its functions are in separate sections,
+the compilation unit of line2.c has a DW_AT_ranges attribute.
+*/
+
+#include "line1.h"
+
+void f2();
+
+int main()
+{
+	f1();
+	f2();
+}
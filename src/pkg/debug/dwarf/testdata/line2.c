@@ -0,0 +1,16 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+void f3(int *x)
+{
+	if(*x & 1)
+		*x += 3;
+}
+
+void f2()
+{
+	int x;
+	for(x = 0; x < 10; x++)
+		f3(&x);
+}
@@ -409,6 +409,7 @@ const (
 	SHF_OS_NONCONFORMING SectionFlag = 0x100      /* OS-specific processing required. */
 	SHF_GROUP            SectionFlag = 0x200      /* Member of section group. */
 	SHF_TLS              SectionFlag = 0x400      /* Section contains TLS data. */
+	SHF_COMPRESSED       SectionFlag = 0x800      /* Section is compressed. */
 	SHF_MASKOS           SectionFlag = 0x0ff00000 /* OS-specific semantics. */
 	SHF_MASKPROC         SectionFlag = 0xf0000000 /* Processor-specific semantics. */
 )
@@ -424,11 +425,34 @@ var shfStrings = []intName{
 	{0x100, "SHF_OS_NONCONFORMING"},
 	{0x200, "SHF_GROUP"},
 	{0x400, "SHF_TLS"},
+	{0x800, "SHF_COMPRESSED"},
 }
 
 func (i SectionFlag) String() string   { return flagName(uint32(i), shfStrings, false) }
 func (i SectionFlag) GoString() string { return flagName(uint32(i), shfStrings, true) }
 
+// Section compression type.
+type CompressionType int
+
+const (
+	COMPRESS_ZLIB   CompressionType = 1          /* ZLIB compression. */
+	COMPRESS_LOOS   CompressionType = 0x60000000 /* First OS-specific. */
+	COMPRESS_HIOS   CompressionType = 0x6fffffff /* Last OS-specific. */
+	COMPRESS_LOPROC CompressionType = 0x70000000 /* First processor-specific type. */
+	COMPRESS_HIPROC CompressionType = 0x7fffffff /* Last processor-specific type. */
+)
+
+var compressionStrings = []intName{
+	{1, "COMPRESS_ZLIB"},
+	{0x60000000, "COMPRESS_LOOS"},
+	{0x6fffffff, "COMPRESS_HIOS"},
+	{0x70000000, "COMPRESS_LOPROC"},
+	{0x7fffffff, "COMPRESS_HIPROC"},
+}
+
+func (i CompressionType) String() string   { return stringName(uint32(i), compressionStrings, false) }
+func (i CompressionType) GoString() string { return stringName(uint32(i), compressionStrings, true) }
+
 // Prog.Type
 type ProgType int
 
@@ -1321,6 +1345,13 @@ type Section32 struct {
 	Entsize   uint32 /* Size of each entry in section. */
 }
 
+// ELF32 Compression header.
+type Chdr32 struct {
+	Type      uint32 /* Compression format. */
+	Size      uint32 /* Uncompressed size. */
+	Addralign uint32 /* Uncompressed alignment. */
+}
+
 // ELF32 Program header.
 type Prog32 struct {
 	Type   uint32 /* Entry type. */
@@ -1415,6 +1446,14 @@ type Section64 struct {
 	Entsize   uint64 /* Size of each entry in section. */
 }
 
+// ELF64 Compression header.
+type Chdr64 struct {
+	Type      uint32 /* Compression format. */
+	_         uint32 /* Reserved. */
+	Size      uint64 /* Uncompressed size. */
+	Addralign uint64 /* Uncompressed alignment. */
+}
+
 // ELF64 Program header.
 type Prog64 struct {
 	Type   uint32 /* Entry type. */
@@ -7,12 +7,14 @@ package elf
 
 import (
 	"bytes"
+	"compress/zlib"
 	"debug/dwarf"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 )
 
 // TODO: error reporting detail
@@ -42,6 +44,7 @@ type File struct {
 	closer    io.Closer
 	gnuNeed   []verneed
 	gnuVersym []byte
+	flags     uint32 // processor-specific flags from the file header
 }
 
 // A SectionHeader represents a single ELF section header.
@@ -56,6 +59,12 @@ type SectionHeader struct {
 	Info      uint32
 	Addralign uint64
 	Entsize   uint64
+
+	// FileSize is the size of this section in the file in bytes.
+	// If a section is compressed, FileSize is the size of the
+	// compressed data, while Size (above) is the size of the
+	// uncompressed data.
+	FileSize uint64
 }
 
 // A Section represents a single section in an ELF file.
@@ -68,12 +77,24 @@ type Section struct {
 	// If a client wants Read and Seek it must use
 	// Open() to avoid fighting over the seek offset
 	// with other clients.
+	//
+	// ReaderAt may be nil if the section is not easily available
+	// in a random-access form. For example, a compressed section
+	// may have a nil ReaderAt.
 	io.ReaderAt
 	sr *io.SectionReader
+
+	compressionType   CompressionType
+	compressionOffset int64
 }
 
 // Data reads and returns the contents of the ELF section.
+// Even if the section is stored compressed in the ELF file,
+// Data returns uncompressed data.
 func (s *Section) Data() ([]byte, error) {
+	if s.Flags&SHF_COMPRESSED != 0 {
+		return s.uncompressedData()
+	}
 	dat := make([]byte, s.sr.Size())
 	n, err := s.sr.ReadAt(dat, 0)
 	if n == len(dat) {
@@ -82,6 +103,24 @@ func (s *Section) Data() ([]byte, error) {
 	return dat[0:n], err
 }
 
+// uncompressedData reads and decompresses the contents
+// of a compressed section.
+func (s *Section) uncompressedData() ([]byte, error) {
+	if s.compressionType != COMPRESS_ZLIB {
+		return nil, &FormatError{int64(s.Offset), "unsupported compression type", s.compressionType}
+	}
+	zr, err := zlib.NewReader(io.NewSectionReader(s.sr, s.compressionOffset, int64(s.FileSize)-s.compressionOffset))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	dat := make([]byte, s.Size)
+	if _, err := io.ReadFull(zr, dat); err != nil {
+		return nil, err
+	}
+	return dat, nil
+}
+
 // stringTable reads and returns the string table given by the
 // specified link value.
 func (f *File) stringTable(link uint32) ([]byte, error) {
@@ -92,7 +131,31 @@ func (f *File) stringTable(link uint32) ([]byte, error) {
 }
 
 // Open returns a new ReadSeeker reading the ELF section.
-func (s *Section) Open() io.ReadSeeker { return io.NewSectionReader(s.sr, 0, 1<<63-1) }
+// Even if the section is stored compressed in the ELF file,
+// the ReadSeeker reads uncompressed data.
+func (s *Section) Open() io.ReadSeeker {
+	if s.Flags&SHF_COMPRESSED != 0 {
+		dat, err := s.uncompressedData()
+		if err != nil {
+			return &errorReader{err}
+		}
+		return bytes.NewReader(dat)
+	}
+	return io.NewSectionReader(s.sr, 0, 1<<63-1)
+}
+
+// An errorReader is a ReadSeeker that fails all operations with err.
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func (r *errorReader) Seek(offset int64, whence int) (int64, error) {
+	return 0, r.err
+}
 
 // A ProgHeader represents a single ELF program header.
 type ProgHeader struct {
@@ -245,6 +308,7 @@ func NewFile(r io.ReaderAt) (*File, error) {
 		f.Type = Type(hdr.Type)
 		f.Machine = Machine(hdr.Machine)
 		f.Entry = uint64(hdr.Entry)
+		f.flags = hdr.Flags
 		if v := Version(hdr.Version); v != f.Version {
 			return nil, &FormatError{0, "mismatched ELF version", v}
 		}
@@ -264,6 +328,7 @@ func NewFile(r io.ReaderAt) (*File, error) {
 		f.Type = Type(hdr.Type)
 		f.Machine = Machine(hdr.Machine)
 		f.Entry = uint64(hdr.Entry)
+		f.flags = hdr.Flags
 		if v := Version(hdr.Version); v != f.Version {
 			return nil, &FormatError{0, "mismatched ELF version", v}
 		}
@@ -342,7 +407,7 @@ func NewFile(r io.ReaderAt) (*File, error) {
 				Flags:     SectionFlag(sh.Flags),
 				Addr:      uint64(sh.Addr),
 				Offset:    uint64(sh.Off),
-				Size:      uint64(sh.Size),
+				FileSize:  uint64(sh.Size),
 				Link:      uint32(sh.Link),
 				Info:      uint32(sh.Info),
 				Addralign: uint64(sh.Addralign),
@@ -358,7 +423,7 @@ func NewFile(r io.ReaderAt) (*File, error) {
 				Type:      SectionType(sh.Type),
 				Flags:     SectionFlag(sh.Flags),
 				Offset:    uint64(sh.Off),
-				Size:      uint64(sh.Size),
+				FileSize:  uint64(sh.Size),
 				Addr:      uint64(sh.Addr),
 				Link:      uint32(sh.Link),
 				Info:      uint32(sh.Info),
@@ -366,8 +431,33 @@ func NewFile(r io.ReaderAt) (*File, error) {
 				Entsize:   uint64(sh.Entsize),
 			}
 		}
-		s.sr = io.NewSectionReader(r, int64(s.Offset), int64(s.Size))
-		s.ReaderAt = s.sr
+		s.sr = io.NewSectionReader(r, int64(s.Offset), int64(s.FileSize))
+		if s.Flags&SHF_COMPRESSED == 0 || s.Type == SHT_NOBITS {
+			s.ReaderAt = s.sr
+			s.Size = s.FileSize
+		} else {
+			// Read the compression header.
+			switch f.Class {
+			case ELFCLASS32:
+				ch := new(Chdr32)
+				if err := binary.Read(s.sr, f.ByteOrder, ch); err != nil {
+					return nil, err
+				}
+				s.compressionType = CompressionType(ch.Type)
+				s.Size = uint64(ch.Size)
+				s.Addralign = uint64(ch.Addralign)
+				s.compressionOffset = int64(binary.Size(ch))
+			case ELFCLASS64:
+				ch := new(Chdr64)
+				if err := binary.Read(s.sr, f.ByteOrder, ch); err != nil {
+					return nil, err
+				}
+				s.compressionType = CompressionType(ch.Type)
+				s.Size = ch.Size
+				s.Addralign = ch.Addralign
+				s.compressionOffset = int64(binary.Size(ch))
+			}
+		}
 		f.Sections[i] = s
 	}
 
@@ -517,10 +607,15 @@ func (f *File) Section(name string) *Section {
 }
 
 // applyRelocations applies relocations to dst. rels is a relocations section
-// in RELA format.
+// in REL or RELA format, as used by the architecture of f.
 func (f *File) applyRelocations(dst []byte, rels []byte) error {
-	if f.Class == ELFCLASS64 && f.Machine == EM_X86_64 {
+	switch {
+	case f.Class == ELFCLASS64 && f.Machine == EM_X86_64:
 		return f.applyRelocationsAMD64(dst, rels)
+	case f.Class == ELFCLASS32 && f.Machine == EM_386:
+		return f.applyRelocations386(dst, rels)
+	case f.Class == ELFCLASS32 && f.Machine == EM_ARM:
+		return f.applyRelocationsARM(dst, rels)
 	}
 
 	return errors.New("not implemented")
@@ -570,70 +665,176 @@ func (f *File) applyRelocationsAMD64(dst []byte, rels []byte) error {
 	return nil
 }
 
-func (f *File) DWARF() (*dwarf.Data, error) {
-	// There are many other DWARF sections, but these
-	// are the required ones, and the debug/dwarf package
-	// does not use the others, so don't bother loading them.
-	var names = [...]string{"abbrev", "info", "str"}
-	var dat [len(names)][]byte
-	for i, name := range names {
-		name = ".debug_" + name
-		s := f.Section(name)
-		if s == nil {
+func (f *File) applyRelocations386(dst []byte, rels []byte) error {
+	if len(rels)%8 != 0 {
+		return errors.New("length of relocation section is not a multiple of 8")
+	}
+
+	symbols, _, err := f.getSymbols(SHT_SYMTAB)
+	if err != nil {
+		return err
+	}
+
+	b := bytes.NewReader(rels)
+	var rel Rel32
+
+	for b.Len() > 0 {
+		binary.Read(b, f.ByteOrder, &rel)
+		symNo := R_SYM32(rel.Info)
+		t := R_386(R_TYPE32(rel.Info))
+
+		if symNo == 0 || symNo > uint32(len(symbols)) {
 			continue
 		}
-		b, err := s.Data()
-		if err != nil && uint64(len(b)) < s.Size {
+		sym := &symbols[symNo-1]
+
+		if t == R_386_32 {
+			if uint64(rel.Off)+4 > uint64(len(dst)) {
+				continue
+			}
+			// The addend is stored in the location to be relocated.
+			val := f.ByteOrder.Uint32(dst[rel.Off : rel.Off+4])
+			val += uint32(sym.Value)
+			f.ByteOrder.PutUint32(dst[rel.Off:rel.Off+4], val)
+		}
+	}
+
+	return nil
+}
+
+func (f *File) applyRelocationsARM(dst []byte, rels []byte) error {
+	if len(rels)%8 != 0 {
+		return errors.New("length of relocation section is not a multiple of 8")
+	}
+
+	symbols, _, err := f.getSymbols(SHT_SYMTAB)
+	if err != nil {
+		return err
+	}
+
+	b := bytes.NewReader(rels)
+	var rel Rel32
+
+	for b.Len() > 0 {
+		binary.Read(b, f.ByteOrder, &rel)
+		symNo := R_SYM32(rel.Info)
+		t := R_ARM(R_TYPE32(rel.Info))
+
+		if symNo == 0 || symNo > uint32(len(symbols)) {
+			continue
+		}
+		sym := &symbols[symNo-1]
+
+		if t == R_ARM_ABS32 {
+			if uint64(rel.Off)+4 > uint64(len(dst)) {
+				continue
+			}
+			// The addend is stored in the location to be relocated.
+			val := f.ByteOrder.Uint32(dst[rel.Off : rel.Off+4])
+			val += uint32(sym.Value)
+			f.ByteOrder.PutUint32(dst[rel.Off:rel.Off+4], val)
+		}
+	}
+
+	return nil
+}
+
+// dwarfSectionData returns the contents of the DWARF section with
+// index i, decompressing a GNU-style .zdebug section and applying
+// the relocations of a relocatable object.
+func (f *File) dwarfSectionData(i int, s *Section) ([]byte, error) {
+	b, err := s.Data()
+	if err != nil && uint64(len(b)) < s.Size {
+		return nil, err
+	}
+
+	if strings.HasPrefix(s.Name, ".zdebug_") {
+		// The contents are "ZLIB", the uncompressed size
+		// as a big-endian uint64, and the zlib stream.
+		if len(b) < 12 || string(b[:4]) != "ZLIB" {
+			return nil, &FormatError{int64(s.Offset), "invalid compressed DWARF section", s.Name}
+		}
+		dlen := binary.BigEndian.Uint64(b[4:12])
+		zr, err := zlib.NewReader(bytes.NewReader(b[12:]))
+		if err != nil {
 			return nil, err
 		}
-		dat[i] = b
+		dbuf := make([]byte, dlen)
+		_, err = io.ReadFull(zr, dbuf)
+		zr.Close()
+		if err != nil {
+			return nil, err
+		}
+		b = dbuf
 	}
 
-	// If there's a relocation table for .debug_info, we have to process it
-	// now otherwise the data in .debug_info is invalid for x86-64 objects.
-	rela := f.Section(".rela.debug_info")
-	if rela != nil && rela.Type == SHT_RELA && f.Machine == EM_X86_64 {
-		data, err := rela.Data()
+	if f.Type != ET_REL {
+		// The linker has already applied the relocations.
+		return b, nil
+	}
+	for _, r := range f.Sections {
+		if r.Type != SHT_RELA && r.Type != SHT_REL {
+			continue
+		}
+		if int(r.Info) != i {
+			continue
+		}
+		rd, err := r.Data()
 		if err != nil {
 			return nil, err
 		}
-		err = f.applyRelocations(dat[1], data)
+		err = f.applyRelocations(b, rd)
 		if err != nil {
 			return nil, err
 		}
 	}
+	return b, nil
+}
 
-	abbrev, info, str := dat[0], dat[1], dat[2]
-	d, err := dwarf.New(abbrev, nil, nil, info, nil, nil, nil, str)
+func (f *File) DWARF() (*dwarf.Data, error) {
+	// There are many other DWARF sections, but these
+	// are the ones the debug/dwarf package uses.
+	// Don't bother loading others.
+	// The sections may be compressed, either with SHF_COMPRESSED
+	// or in the older GNU .zdebug format.
+	var names = [...]string{"abbrev", "frame", "info", "line", "ranges", "str"}
+	var dat [len(names)][]byte
+	for i, s := range f.Sections {
+		var suffix string
+		switch {
+		case strings.HasPrefix(s.Name, ".debug_"):
+			suffix = s.Name[len(".debug_"):]
+		case strings.HasPrefix(s.Name, ".zdebug_"):
+			suffix = s.Name[len(".zdebug_"):]
+		default:
+			continue
+		}
+		for j, name := range names {
+			if name != suffix || dat[j] != nil {
+				continue
+			}
+			b, err := f.dwarfSectionData(i, s)
+			if err != nil {
+				return nil, err
+			}
+			dat[j] = b
+		}
+	}
+
+	abbrev, frame, info, line, ranges, str := dat[0], dat[1], dat[2], dat[3], dat[4], dat[5]
+	d, err := dwarf.New(abbrev, nil, frame, info, line, nil, ranges, str)
 	if err != nil {
 		return nil, err
 	}
 
 	// Look for DWARF4 .debug_types sections.
 	for i, s := range f.Sections {
-		if s.Name == ".debug_types" {
-			b, err := s.Data()
-			if err != nil && uint64(len(b)) < s.Size {
+		if s.Name == ".debug_types" || s.Name == ".zdebug_types" {
+			b, err := f.dwarfSectionData(i, s)
+			if err != nil {
 				return nil, err
 			}
 
-			for _, r := range f.Sections {
-				if r.Type != SHT_RELA && r.Type != SHT_REL {
-					continue
-				}
-				if int(r.Info) != i {
-					continue
-				}
-				rd, err := r.Data()
-				if err != nil {
-					return nil, err
-				}
-				err = f.applyRelocations(b, rd)
-				if err != nil {
-					return nil, err
-				}
-			}
-
 			err = d.AddTypes(fmt.Sprintf("types-%d", i), b)
 			if err != nil {
 				return nil, err
@@ -0,0 +1,654 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package elf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+/*
+ * ELF writer
+ */
+
+// A WriterSection is a section of an ELF file assembled by a Writer.
+type WriterSection struct {
+	SectionHeader
+
+	// Data holds the contents of the section as stored in the file.
+	// For a section with SHF_COMPRESSED set, it begins with the
+	// compression header.  Data is ignored for SHT_NOBITS sections.
+	Data []byte
+}
+
+// A Writer assembles an ELF file from a file header, program headers
+// and sections, and writes it out.
+//
+// As in a File, Sections[0] is the null section, and the Link and
+// Info fields of the section headers hold indexes into Sections.
+// RemoveSections updates them, along with the symbol tables, as
+// sections are removed.
+//
+// WriteTo lays out the file.  The ELF header comes first, followed
+// by the program headers.  A section with a nonzero Offset is written
+// at that offset; this keeps the layout of the segments of a file read
+// by NewWriter.  The other sections are placed after all of those,
+// in order, and are aligned to their Addralign.  A section with
+// SHF_ALLOC set is further placed at an offset congruent to its Addr
+// modulo the alignment of the PT_LOAD segment containing it.
+// The section headers come last.  The size written for a section is
+// the length of its Data, except for SHT_NOBITS sections, which keep
+// their Size.
+//
+// A program header whose Off and Filesz are both zero has them
+// computed from the sections it contains: those with SHF_ALLOC set
+// whose addresses lie within [Vaddr, Vaddr+Memsz).  Other program
+// headers are written unchanged.
+//
+// The section header string table, named .shstrtab, is rebuilt by
+// WriteTo, and added if missing.
+type Writer struct {
+	FileHeader
+	Flags    uint32 // processor-specific flags
+	Progs    []*ProgHeader
+	Sections []*WriterSection
+}
+
+// NewWriter returns a Writer holding the contents of f, to be
+// modified and written out.  It keeps the file offsets of the
+// sections with SHF_ALLOC set, and clears those of the other
+// sections so that they are laid out anew.
+func NewWriter(f *File) (*Writer, error) {
+	w := &Writer{
+		FileHeader: f.FileHeader,
+		Flags:      f.flags,
+	}
+	for _, p := range f.Progs {
+		ph := p.ProgHeader
+		w.Progs = append(w.Progs, &ph)
+	}
+	for _, s := range f.Sections {
+		ws := &WriterSection{SectionHeader: s.SectionHeader}
+		if s.Type != SHT_NOBITS {
+			ws.Data = make([]byte, s.FileSize)
+			if _, err := s.sr.ReadAt(ws.Data, 0); err != nil && s.FileSize > 0 {
+				return nil, err
+			}
+			ws.Size = s.FileSize
+		}
+		if s.Flags&SHF_COMPRESSED != 0 {
+			// Addralign holds the alignment of the uncompressed
+			// data; the compression header is word-aligned.
+			ws.Addralign = uint64(w.wordSize())
+		}
+		if s.Flags&SHF_ALLOC == 0 {
+			ws.Offset = 0
+		}
+		w.Sections = append(w.Sections, ws)
+	}
+	return w, nil
+}
+
+// Section returns the section with the given name,
+// or nil if there is no such section.
+func (w *Writer) Section(name string) *WriterSection {
+	for _, s := range w.Sections {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// AddSection appends a section with the given header and contents.
+// The Offset of hdr is normally zero, letting WriteTo place the section.
+func (w *Writer) AddSection(hdr SectionHeader, data []byte) *WriterSection {
+	if len(w.Sections) == 0 {
+		w.Sections = append(w.Sections, &WriterSection{})
+	}
+	s := &WriterSection{SectionHeader: hdr, Data: data}
+	if hdr.Type != SHT_NOBITS {
+		s.Size = uint64(len(data))
+	}
+	w.Sections = append(w.Sections, s)
+	return s
+}
+
+// AddNote appends a section of type SHT_NOTE with the given name,
+// holding a single note with the given owner name, type and descriptor.
+// For example, a GNU build ID is a note of type 3 owned by "GNU"
+// in a section named .note.gnu.build-id.
+func (w *Writer) AddNote(section, name string, typ uint32, desc []byte) *WriterSection {
+	var buf bytes.Buffer
+	bo := w.byteOrder()
+	var hdr [12]byte
+	bo.PutUint32(hdr[0:], uint32(len(name)+1))
+	bo.PutUint32(hdr[4:], uint32(len(desc)))
+	bo.PutUint32(hdr[8:], typ)
+	buf.Write(hdr[:])
+	buf.WriteString(name)
+	buf.WriteByte(0)
+	pad4(&buf)
+	buf.Write(desc)
+	pad4(&buf)
+	return w.AddSection(SectionHeader{Name: section, Type: SHT_NOTE, Addralign: 4}, buf.Bytes())
+}
+
+// pad4 pads buf with zeros to a multiple of 4 bytes.
+func pad4(buf *bytes.Buffer) {
+	for buf.Len()%4 != 0 {
+		buf.WriteByte(0)
+	}
+}
+
+// AddSymbols appends a symbol table section named .symtab, holding
+// syms, and its string table .strtab.  As with File.Symbols, syms
+// omits the null symbol at index 0.  The local symbols must come
+// before the others.  The Section of each symbol is an index into
+// w.Sections.
+func (w *Writer) AddSymbols(syms []Symbol) (*WriterSection, error) {
+	if w.Section(".symtab") != nil {
+		return nil, errors.New("elf: file already has a symbol table")
+	}
+	bo := w.byteOrder()
+	var strtab stringTable
+	strtab.add("")
+	var buf bytes.Buffer
+	nlocal := 1
+	for i, s := range syms {
+		if ST_BIND(s.Info) == STB_LOCAL {
+			if nlocal != i+1 {
+				return nil, fmt.Errorf("elf: local symbol %s follows global symbols", s.Name)
+			}
+			nlocal++
+		}
+	}
+	var symsize int
+	switch w.Class {
+	case ELFCLASS32:
+		symsize = Sym32Size
+		binary.Write(&buf, bo, &Sym32{})
+		for _, s := range syms {
+			binary.Write(&buf, bo, &Sym32{
+				Name:  strtab.add(s.Name),
+				Value: uint32(s.Value),
+				Size:  uint32(s.Size),
+				Info:  s.Info,
+				Other: s.Other,
+				Shndx: uint16(s.Section),
+			})
+		}
+	case ELFCLASS64:
+		symsize = Sym64Size
+		binary.Write(&buf, bo, &Sym64{})
+		for _, s := range syms {
+			binary.Write(&buf, bo, &Sym64{
+				Name:  strtab.add(s.Name),
+				Info:  s.Info,
+				Other: s.Other,
+				Shndx: uint16(s.Section),
+				Value: s.Value,
+				Size:  s.Size,
+			})
+		}
+	default:
+		return nil, &FormatError{0, "unknown ELF class", w.Class}
+	}
+	symtab := w.AddSection(SectionHeader{
+		Name:      ".symtab",
+		Type:      SHT_SYMTAB,
+		Info:      uint32(nlocal),
+		Addralign: uint64(w.wordSize()),
+		Entsize:   uint64(symsize),
+	}, buf.Bytes())
+	symtab.Link = uint32(len(w.Sections))
+	w.AddSection(SectionHeader{Name: ".strtab", Type: SHT_STRTAB, Addralign: 1}, strtab.data)
+	return symtab, nil
+}
+
+// RemoveSections removes the sections for which remove returns true,
+// along with the relocation sections that apply to them.
+// It renumbers the section indexes in the remaining section headers,
+// symbol tables and section groups.  Symbols defined in a removed
+// section become undefined.
+func (w *Writer) RemoveSections(remove func(*WriterSection) bool) {
+	n := len(w.Sections)
+	removed := make([]bool, n)
+	for i, s := range w.Sections {
+		removed[i] = i > 0 && remove(s)
+	}
+	for i, s := range w.Sections {
+		if (s.Type == SHT_REL || s.Type == SHT_RELA) && s.Info != 0 && int(s.Info) < n && removed[s.Info] {
+			removed[i] = true
+		}
+	}
+
+	index := make([]uint32, n)
+	var kept []*WriterSection
+	for i, s := range w.Sections {
+		if !removed[i] {
+			index[i] = uint32(len(kept))
+			kept = append(kept, s)
+		}
+	}
+	renumber := func(x uint32) uint32 {
+		if x == 0 || int(x) >= n {
+			return x
+		}
+		return index[x]
+	}
+
+	bo := w.byteOrder()
+	for _, s := range kept {
+		s.Link = renumber(s.Link)
+		if s.Type == SHT_REL || s.Type == SHT_RELA || s.Flags&SHF_INFO_LINK != 0 {
+			s.Info = renumber(s.Info)
+		}
+		switch s.Type {
+		case SHT_SYMTAB, SHT_DYNSYM:
+			// Shndx is at offset 14 in both Sym32 and Sym64.
+			size := Sym32Size
+			if w.Class == ELFCLASS64 {
+				size = Sym64Size
+			}
+			for off := 0; off+size <= len(s.Data); off += size {
+				b := s.Data[off+14 : off+16]
+				if x := bo.Uint16(b); x < uint16(SHN_LORESERVE) {
+					bo.PutUint16(b, uint16(renumber(uint32(x))))
+				}
+			}
+		case SHT_GROUP:
+			// A flag word followed by the member section indexes.
+			for off := 4; off+4 <= len(s.Data); off += 4 {
+				b := s.Data[off : off+4]
+				bo.PutUint32(b, renumber(bo.Uint32(b)))
+			}
+		}
+	}
+	w.Sections = kept
+}
+
+// StripDWARF removes the DWARF debugging information sections,
+// whether or not they are compressed, and their relocations.
+func (w *Writer) StripDWARF() {
+	w.RemoveSections(func(s *WriterSection) bool {
+		return s.Flags&SHF_ALLOC == 0 && (strings.HasPrefix(s.Name, ".debug_") || strings.HasPrefix(s.Name, ".zdebug_"))
+	})
+}
+
+// A stringTable accumulates the contents of an ELF string table.
+type stringTable struct {
+	data []byte
+	off  map[string]uint32
+}
+
+// add adds s to the table, if not already present,
+// and returns its offset.
+func (t *stringTable) add(s string) uint32 {
+	if off, ok := t.off[s]; ok {
+		return off
+	}
+	if t.off == nil {
+		t.off = make(map[string]uint32)
+	}
+	off := uint32(len(t.data))
+	t.data = append(t.data, s...)
+	t.data = append(t.data, 0)
+	t.off[s] = off
+	return off
+}
+
+// byteOrder returns the byte order of the file being written.
+func (w *Writer) byteOrder() binary.ByteOrder {
+	if w.ByteOrder != nil {
+		return w.ByteOrder
+	}
+	if w.Data == ELFDATA2MSB {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+// wordSize returns the size of an address in the file being written.
+func (w *Writer) wordSize() int {
+	if w.Class == ELFCLASS64 {
+		return 8
+	}
+	return 4
+}
+
+// fileSize returns the number of bytes s occupies in the file.
+func (s *WriterSection) fileSize() uint64 {
+	if s.Type == SHT_NOBITS {
+		return 0
+	}
+	return uint64(len(s.Data))
+}
+
+// align returns x rounded up to a multiple of a.
+func align(x, a uint64) uint64 {
+	if a <= 1 {
+		return x
+	}
+	return (x + a - 1) / a * a
+}
+
+// layout assigns file offsets to the sections and program headers
+// and returns the offset of the section header table.
+func (w *Writer) layout(hdrsize uint64) (shoff uint64, err error) {
+	regions := []fileRegion{{"file header", 0, hdrsize}}
+	end := hdrsize
+	for _, s := range w.Sections[1:] {
+		if s.Offset == 0 {
+			continue
+		}
+		if size := s.fileSize(); size > 0 {
+			regions = append(regions, fileRegion{s.Name, s.Offset, s.Offset + size})
+		}
+		if e := s.Offset + s.fileSize(); e > end {
+			end = e
+		}
+	}
+
+	for _, s := range w.Sections[1:] {
+		if s.Offset != 0 {
+			continue
+		}
+		a := s.Addralign
+		if s.Flags&SHF_ALLOC != 0 {
+			for _, p := range w.Progs {
+				if p.Type == PT_LOAD && p.Vaddr <= s.Addr && s.Addr < p.Vaddr+p.Memsz && p.Align > a {
+					a = p.Align
+				}
+			}
+		}
+		off := align(end, a)
+		if s.Flags&SHF_ALLOC != 0 && a > 1 {
+			off += s.Addr % a
+			if off-a >= end {
+				off -= a
+			}
+		}
+		s.Offset = off
+		if size := s.fileSize(); size > 0 {
+			regions = append(regions, fileRegion{s.Name, off, off + size})
+			end = off + size
+		}
+	}
+
+	sort.Sort(byOffset(regions))
+	for i := 1; i < len(regions); i++ {
+		if regions[i].off < regions[i-1].end {
+			return 0, fmt.Errorf("elf: section %s overlaps %s", regions[i].name, regions[i-1].name)
+		}
+	}
+
+	for _, p := range w.Progs {
+		if p.Off != 0 || p.Filesz != 0 {
+			continue
+		}
+		var first *WriterSection
+		var fileEnd uint64
+		for _, s := range w.Sections[1:] {
+			if s.Flags&SHF_ALLOC == 0 || s.Addr < p.Vaddr || s.Addr >= p.Vaddr+p.Memsz {
+				continue
+			}
+			if first == nil || s.Addr < first.Addr {
+				first = s
+			}
+			if e := s.Offset + s.fileSize(); s.fileSize() > 0 && e > fileEnd {
+				fileEnd = e
+			}
+		}
+		if first == nil {
+			continue
+		}
+		if first.Offset < first.Addr-p.Vaddr {
+			return 0, fmt.Errorf("elf: section %s is too close to the start of the file for its segment", first.Name)
+		}
+		p.Off = first.Offset - (first.Addr - p.Vaddr)
+		if fileEnd > p.Off {
+			p.Filesz = fileEnd - p.Off
+		}
+	}
+
+	return align(end, uint64(w.wordSize())), nil
+}
+
+// A fileRegion is a range of file offsets used by part of the file.
+type fileRegion struct {
+	name     string
+	off, end uint64
+}
+
+type byOffset []fileRegion
+
+func (s byOffset) Len() int           { return len(s) }
+func (s byOffset) Less(i, j int) bool { return s[i].off < s[j].off }
+func (s byOffset) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// WriteTo lays out the file and writes it to out.
+func (w *Writer) WriteTo(out io.Writer) (n int64, err error) {
+	var ehsize, phentsize, shentsize uint64
+	switch w.Class {
+	case ELFCLASS32:
+		ehsize, phentsize, shentsize = 52, 32, 40
+	case ELFCLASS64:
+		ehsize, phentsize, shentsize = 64, 56, 64
+	default:
+		return 0, &FormatError{0, "unknown ELF class", w.Class}
+	}
+	switch w.Data {
+	case ELFDATA2LSB, ELFDATA2MSB:
+	default:
+		return 0, &FormatError{0, "unknown ELF data encoding", w.Data}
+	}
+	if len(w.Sections) == 0 {
+		w.Sections = append(w.Sections, &WriterSection{})
+	}
+	if w.Sections[0].Type != SHT_NULL {
+		return 0, errors.New("elf: first section is not the null section")
+	}
+
+	// Rebuild the section header string table.
+	shstrndx := -1
+	for i, s := range w.Sections {
+		if s.Name == ".shstrtab" && s.Type == SHT_STRTAB {
+			shstrndx = i
+		}
+	}
+	if shstrndx < 0 {
+		shstrndx = len(w.Sections)
+		w.AddSection(SectionHeader{Name: ".shstrtab", Type: SHT_STRTAB, Addralign: 1}, nil)
+	}
+	if len(w.Sections) >= int(SHN_LORESERVE) {
+		return 0, errors.New("elf: too many sections")
+	}
+	var shstrtab stringTable
+	shstrtab.add("")
+	names := make([]uint32, len(w.Sections))
+	for i, s := range w.Sections {
+		names[i] = shstrtab.add(s.Name)
+	}
+	w.Sections[shstrndx].Data = shstrtab.data
+	if w.Sections[shstrndx].Flags&SHF_ALLOC == 0 {
+		w.Sections[shstrndx].Offset = 0
+	}
+
+	phoff := uint64(0)
+	if len(w.Progs) > 0 {
+		phoff = ehsize
+	}
+	shoff, err := w.layout(ehsize + uint64(len(w.Progs))*phentsize)
+	if err != nil {
+		return 0, err
+	}
+
+	version := w.Version
+	if version == EV_NONE {
+		version = EV_CURRENT
+	}
+	var ident [EI_NIDENT]byte
+	copy(ident[:], ELFMAG)
+	ident[EI_CLASS] = byte(w.Class)
+	ident[EI_DATA] = byte(w.Data)
+	ident[EI_VERSION] = byte(version)
+	ident[EI_OSABI] = byte(w.OSABI)
+	ident[EI_ABIVERSION] = w.ABIVersion
+
+	bo := w.byteOrder()
+	cw := &countWriter{w: out}
+	switch w.Class {
+	case ELFCLASS32:
+		binary.Write(cw, bo, &Header32{
+			Ident:     ident,
+			Type:      uint16(w.Type),
+			Machine:   uint16(w.Machine),
+			Version:   uint32(version),
+			Entry:     uint32(w.Entry),
+			Phoff:     uint32(phoff),
+			Shoff:     uint32(shoff),
+			Flags:     w.Flags,
+			Ehsize:    uint16(ehsize),
+			Phentsize: uint16(phentsize),
+			Phnum:     uint16(len(w.Progs)),
+			Shentsize: uint16(shentsize),
+			Shnum:     uint16(len(w.Sections)),
+			Shstrndx:  uint16(shstrndx),
+		})
+		for _, p := range w.Progs {
+			binary.Write(cw, bo, &Prog32{
+				Type:   uint32(p.Type),
+				Off:    uint32(p.Off),
+				Vaddr:  uint32(p.Vaddr),
+				Paddr:  uint32(p.Paddr),
+				Filesz: uint32(p.Filesz),
+				Memsz:  uint32(p.Memsz),
+				Flags:  uint32(p.Flags),
+				Align:  uint32(p.Align),
+			})
+		}
+	case ELFCLASS64:
+		binary.Write(cw, bo, &Header64{
+			Ident:     ident,
+			Type:      uint16(w.Type),
+			Machine:   uint16(w.Machine),
+			Version:   uint32(version),
+			Entry:     w.Entry,
+			Phoff:     phoff,
+			Shoff:     shoff,
+			Flags:     w.Flags,
+			Ehsize:    uint16(ehsize),
+			Phentsize: uint16(phentsize),
+			Phnum:     uint16(len(w.Progs)),
+			Shentsize: uint16(shentsize),
+			Shnum:     uint16(len(w.Sections)),
+			Shstrndx:  uint16(shstrndx),
+		})
+		for _, p := range w.Progs {
+			binary.Write(cw, bo, &Prog64{
+				Type:   uint32(p.Type),
+				Flags:  uint32(p.Flags),
+				Off:    p.Off,
+				Vaddr:  p.Vaddr,
+				Paddr:  p.Paddr,
+				Filesz: p.Filesz,
+				Memsz:  p.Memsz,
+				Align:  p.Align,
+			})
+		}
+	}
+
+	// Write the section contents in file order.
+	sections := make([]*WriterSection, 0, len(w.Sections))
+	for _, s := range w.Sections[1:] {
+		if s.fileSize() > 0 {
+			sections = append(sections, s)
+		}
+	}
+	sort.Stable(sectionsByOffset(sections))
+	for _, s := range sections {
+		cw.pad(s.Offset)
+		cw.Write(s.Data)
+	}
+	cw.pad(shoff)
+
+	for i, s := range w.Sections {
+		size := s.Size
+		if s.Type != SHT_NOBITS {
+			size = uint64(len(s.Data))
+		}
+		switch w.Class {
+		case ELFCLASS32:
+			binary.Write(cw, bo, &Section32{
+				Name:      names[i],
+				Type:      uint32(s.Type),
+				Flags:     uint32(s.Flags),
+				Addr:      uint32(s.Addr),
+				Off:       uint32(s.Offset),
+				Size:      uint32(size),
+				Link:      s.Link,
+				Info:      s.Info,
+				Addralign: uint32(s.Addralign),
+				Entsize:   uint32(s.Entsize),
+			})
+		case ELFCLASS64:
+			binary.Write(cw, bo, &Section64{
+				Name:      names[i],
+				Type:      uint32(s.Type),
+				Flags:     uint64(s.Flags),
+				Addr:      s.Addr,
+				Off:       s.Offset,
+				Size:      size,
+				Link:      s.Link,
+				Info:      s.Info,
+				Addralign: s.Addralign,
+				Entsize:   s.Entsize,
+			})
+		}
+	}
+	return cw.n, cw.err
+}
+
+type sectionsByOffset []*WriterSection
+
+func (s sectionsByOffset) Len() int           { return len(s) }
+func (s sectionsByOffset) Less(i, j int) bool { return s[i].Offset < s[j].Offset }
+func (s sectionsByOffset) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// A countWriter counts the bytes written to w
+// and remembers the first error.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+// pad writes zeros up to the file offset off.
+func (cw *countWriter) pad(off uint64) {
+	var zero [512]byte
+	for cw.err == nil && uint64(cw.n) < off {
+		n := off - uint64(cw.n)
+		if n > uint64(len(zero)) {
+			n = uint64(len(zero))
+		}
+		cw.Write(zero[:n])
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package elf
+
+import (
+	"bytes"
+	"debug/dwarf"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// rewrite writes w and reads the result back.
+func rewrite(t *testing.T, w *Writer) *File {
+	var buf bytes.Buffer
+	n, err := w.WriteTo(&buf)
+	if err != nil {
+		t.Fatal("WriteTo:", err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo returned %d, wrote %d bytes", n, buf.Len())
+	}
+	f, err := NewFile(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal("NewFile:", err)
+	}
+	return f
+}
+
+func sectionData(t *testing.T, s *Section) []byte {
+	if s.Type == SHT_NOBITS {
+		return nil
+	}
+	b, err := s.Data()
+	if err != nil {
+		t.Fatalf("section %s: %v", s.Name, err)
+	}
+	return b
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	for _, tt := range fileTests {
+		if !strings.HasSuffix(tt.file, "-exec") {
+			continue
+		}
+		f, err := Open(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		w, err := NewWriter(f)
+		if err != nil {
+			t.Fatal("NewWriter:", err)
+		}
+		g := rewrite(t, w)
+
+		if !reflect.DeepEqual(g.FileHeader, f.FileHeader) {
+			t.Errorf("%s: file header %#v, want %#v", tt.file, g.FileHeader, f.FileHeader)
+		}
+		if len(g.Progs) != len(f.Progs) {
+			t.Fatalf("%s: %d program headers, want %d", tt.file, len(g.Progs), len(f.Progs))
+		}
+		for i, p := range g.Progs {
+			if p.ProgHeader != f.Progs[i].ProgHeader {
+				t.Errorf("%s: program %d: %#v, want %#v", tt.file, i, p.ProgHeader, f.Progs[i].ProgHeader)
+			}
+		}
+		if len(g.Sections) != len(f.Sections) {
+			t.Fatalf("%s: %d sections, want %d", tt.file, len(g.Sections), len(f.Sections))
+		}
+		for i, s := range g.Sections {
+			want := f.Sections[i].SectionHeader
+			if s.Name == ".shstrtab" {
+				// The section names are laid out anew.
+				continue
+			}
+			if s.Flags&SHF_ALLOC == 0 {
+				// Unallocated sections may move.
+				want.Offset = s.Offset
+			}
+			if s.SectionHeader != want {
+				t.Errorf("%s: section %d: %#v, want %#v", tt.file, i, s.SectionHeader, want)
+			}
+			if !bytes.Equal(sectionData(t, s), sectionData(t, f.Sections[i])) {
+				t.Errorf("%s: section %s: contents differ", tt.file, s.Name)
+			}
+		}
+		syms, err := g.Symbols()
+		if err != nil {
+			t.Errorf("%s: Symbols: %v", tt.file, err)
+		}
+		want, _ := f.Symbols()
+		if !reflect.DeepEqual(syms, want) {
+			t.Errorf("%s: symbols differ", tt.file)
+		}
+	}
+}
+
+func TestWriterStripDWARF(t *testing.T) {
+	f, err := Open("testdata/gcc-amd64-linux-exec")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w, err := NewWriter(f)
+	if err != nil {
+		t.Fatal("NewWriter:", err)
+	}
+	w.StripDWARF()
+	id := []byte{0xde, 0xad, 0xbe, 0xef}
+	w.AddNote(".note.gnu.build-id", "GNU", 3, id)
+	g := rewrite(t, w)
+
+	for _, s := range g.Sections {
+		if strings.HasPrefix(s.Name, ".debug_") {
+			t.Errorf("section %s not removed", s.Name)
+		}
+	}
+	if _, err := g.DWARF(); err == nil {
+		t.Errorf("DWARF succeeded after StripDWARF")
+	}
+	for i, p := range g.Progs {
+		if p.ProgHeader != f.Progs[i].ProgHeader {
+			t.Errorf("program %d: %#v, want %#v", i, p.ProgHeader, f.Progs[i].ProgHeader)
+		}
+	}
+	for _, s := range f.Sections {
+		if s.Flags&SHF_ALLOC == 0 {
+			continue
+		}
+		gs := g.Section(s.Name)
+		if gs == nil || gs.SectionHeader != s.SectionHeader {
+			t.Errorf("section %s changed", s.Name)
+		}
+	}
+	syms, err := g.Symbols()
+	if err != nil {
+		t.Errorf("Symbols: %v", err)
+	}
+	want, _ := f.Symbols()
+	if !reflect.DeepEqual(syms, want) {
+		t.Errorf("symbols differ")
+	}
+
+	note := g.Section(".note.gnu.build-id")
+	if note == nil {
+		t.Fatal("no build ID note")
+	}
+	wantNote := []byte{4, 0, 0, 0, 4, 0, 0, 0, 3, 0, 0, 0, 'G', 'N', 'U', 0, 0xde, 0xad, 0xbe, 0xef}
+	if got := sectionData(t, note); note.Type != SHT_NOTE || !bytes.Equal(got, wantNote) {
+		t.Errorf("build ID note: type %v, contents %x; want SHT_NOTE, %x", note.Type, got, wantNote)
+	}
+}
+
+// A test object file with a compilation unit whose low PC is
+// relocated against the symbol f, at 0x20 in .text.
+var (
+	relocTestAbbrev = []byte{
+		1, 0x11, 0, // abbrev 1: DW_TAG_compile_unit, no children
+		0x03, 0x08, // DW_AT_name, DW_FORM_string
+		0x11, 0x01, // DW_AT_low_pc, DW_FORM_addr
+		0, 0, 0,
+	}
+	relocTestInfo = []byte{
+		16, 0, 0, 0, // unit length
+		2, 0, // version
+		0, 0, 0, 0, // abbrev offset
+		4,                // address size
+		1,                // abbrev
+		't', '.', 'c', 0, // name
+		0x00, 0x10, 0, 0, // low PC: addend 0x1000
+	}
+	relocTestOff = len(relocTestInfo) - 4
+)
+
+func TestWriterRelocations(t *testing.T) {
+	tests := []struct {
+		machine Machine
+		reloc   uint32
+	}{
+		{EM_386, uint32(R_386_32)},
+		{EM_ARM, uint32(R_ARM_ABS32)},
+	}
+	for _, tt := range tests {
+		w := &Writer{
+			FileHeader: FileHeader{
+				Class:     ELFCLASS32,
+				Data:      ELFDATA2LSB,
+				Version:   EV_CURRENT,
+				ByteOrder: binary.LittleEndian,
+				Type:      ET_REL,
+				Machine:   tt.machine,
+			},
+		}
+		w.AddSection(SectionHeader{Name: ".debug_abbrev", Type: SHT_PROGBITS, Addralign: 1}, relocTestAbbrev)
+		info := w.AddSection(SectionHeader{Name: ".debug_info", Type: SHT_PROGBITS, Addralign: 1}, relocTestInfo)
+		var rel bytes.Buffer
+		binary.Write(&rel, binary.LittleEndian, &Rel32{Off: uint32(relocTestOff), Info: R_INFO32(1, tt.reloc)})
+		relsec := w.AddSection(SectionHeader{Name: ".rel.debug_info", Type: SHT_REL, Flags: SHF_INFO_LINK, Info: 2, Addralign: 4, Entsize: 8}, rel.Bytes())
+		w.AddSection(SectionHeader{Name: ".text", Type: SHT_PROGBITS, Flags: SHF_ALLOC | SHF_EXECINSTR, Addralign: 4}, make([]byte, 0x40))
+		symtab, err := w.AddSymbols([]Symbol{
+			{Name: "f", Info: ST_INFO(STB_GLOBAL, STT_FUNC), Section: 4, Value: 0x20, Size: 0x20},
+		})
+		if err != nil {
+			t.Fatal("AddSymbols:", err)
+		}
+		relsec.Link = uint32(len(w.Sections) - 2)
+		if symtab != w.Sections[relsec.Link] {
+			t.Fatalf("%v: symbol table is not at index %d", tt.machine, relsec.Link)
+		}
+
+		f := rewrite(t, w)
+		d, err := f.DWARF()
+		if err != nil {
+			t.Errorf("%v: DWARF: %v", tt.machine, err)
+			continue
+		}
+		e, err := d.Reader().Next()
+		if err != nil {
+			t.Errorf("%v: reading compilation unit: %v", tt.machine, err)
+			continue
+		}
+		if pc, _ := e.Val(dwarf.AttrLowpc).(uint64); pc != 0x1020 {
+			t.Errorf("%v: low PC %#x, want 0x1020", tt.machine, pc)
+		}
+		// The relocation was applied to the data read by DWARF only.
+		if !bytes.Equal(info.Data, relocTestInfo) {
+			t.Errorf("%v: section contents modified", tt.machine)
+		}
+
+		// Removing the DWARF sections renumbers the text section.
+		w.StripDWARF()
+		if len(w.Sections) != 5 {
+			t.Fatalf("%v: %d sections after StripDWARF, want 5", tt.machine, len(w.Sections))
+		}
+		f = rewrite(t, w)
+		syms, err := f.Symbols()
+		if err != nil {
+			t.Fatalf("%v: Symbols: %v", tt.machine, err)
+		}
+		if len(syms) != 1 || syms[0].Name != "f" || syms[0].Section != 1 || f.Sections[1].Name != ".text" {
+			t.Errorf("%v: symbols %+v after StripDWARF, want f in section 1 (.text)", tt.machine, syms)
+		}
+	}
+}
+
+func TestWriterLayout(t *testing.T) {
+	// An executable with a loaded text segment.
+	w := &Writer{
+		FileHeader: FileHeader{
+			Class:   ELFCLASS64,
+			Data:    ELFDATA2LSB,
+			Type:    ET_EXEC,
+			Machine: EM_X86_64,
+			Entry:   0x401000,
+		},
+		Progs: []*ProgHeader{
+			{Type: PT_LOAD, Flags: PF_R | PF_X, Vaddr: 0x400000, Paddr: 0x400000, Memsz: 0x1010, Align: 0x1000},
+		},
+	}
+	text := w.AddSection(SectionHeader{Name: ".text", Type: SHT_PROGBITS, Flags: SHF_ALLOC | SHF_EXECINSTR, Addr: 0x401000, Addralign: 16}, make([]byte, 0x10))
+	w.AddSection(SectionHeader{Name: ".comment", Type: SHT_PROGBITS, Addralign: 1}, []byte("test\x00"))
+	f := rewrite(t, w)
+
+	if text.Offset != 0x1000 {
+		t.Errorf(".text at offset %#x, want 0x1000", text.Offset)
+	}
+	want := ProgHeader{Type: PT_LOAD, Flags: PF_R | PF_X, Off: 0, Vaddr: 0x400000, Paddr: 0x400000, Filesz: 0x1010, Memsz: 0x1010, Align: 0x1000}
+	if len(f.Progs) != 1 || f.Progs[0].ProgHeader != want {
+		t.Errorf("program headers %#v, want %#v", f.Progs, want)
+	}
+	var names []string
+	for _, s := range f.Sections {
+		names = append(names, s.Name)
+	}
+	if want := []string{"", ".text", ".comment", ".shstrtab"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("sections %q, want %q", names, want)
+	}
+	if c := f.Section(".comment"); c.Offset != 0x1010 {
+		t.Errorf(".comment at offset %#x, want 0x1010", c.Offset)
+	}
+
+	// Sections may not overlap.
+	w.AddSection(SectionHeader{Name: ".bad", Type: SHT_PROGBITS, Offset: 0x1008}, make([]byte, 8))
+	if _, err := w.WriteTo(new(bytes.Buffer)); err == nil {
+		t.Errorf("WriteTo succeeded with overlapping sections")
+	}
+}
@@ -267,9 +267,9 @@ func (f *File) Section(name string) *Section {
 
 func (f *File) DWARF() (*dwarf.Data, error) {
 	// There are many other DWARF sections, but these
-	// are the required ones, and the debug/dwarf package
-	// does not use the others, so don't bother loading them.
-	var names = [...]string{"abbrev", "info", "str"}
+	// are the ones the debug/dwarf package uses.
+	// Don't bother loading others.
+	var names = [...]string{"abbrev", "frame", "info", "line", "ranges", "str"}
 	var dat [len(names)][]byte
 	for i, name := range names {
 		name = ".debug_" + name
@@ -281,11 +281,16 @@ func (f *File) DWARF() (*dwarf.Data, error) {
 		if err != nil && uint32(len(b)) < s.Size {
 			return nil, err
 		}
+		// The section data is padded to the file alignment;
+		// VirtualSize gives the size of the DWARF data.
+		if 0 < s.VirtualSize && s.VirtualSize < s.Size {
+			b = b[:s.VirtualSize]
+		}
 		dat[i] = b
 	}
 
-	abbrev, info, str := dat[0], dat[1], dat[2]
-	return dwarf.New(abbrev, nil, nil, info, nil, nil, nil, str)
+	abbrev, frame, info, line, ranges, str := dat[0], dat[1], dat[2], dat[3], dat[4], dat[5]
+	return dwarf.New(abbrev, nil, frame, info, line, nil, ranges, str)
 }
 
 // ImportedSymbols returns the names of all symbols
@@ -5,6 +5,7 @@
 package pe
 
 import (
+	"debug/dwarf"
 	"reflect"
 	"testing"
 )
@@ -125,3 +126,29 @@ func TestOpenFailure(t *testing.T) {
 		t.Errorf("open %s: succeeded unexpectedly", filename)
 	}
 }
+
+func TestDWARF(t *testing.T) {
+	f, err := Open("testdata/gcc-386-mingw-exec")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	d, err := f.DWARF()
+	if err != nil {
+		t.Fatal("DWARF:", err)
+	}
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			t.Fatal("reading DWARF:", err)
+		}
+		if e == nil {
+			break
+		}
+		if name, _ := e.Val(dwarf.AttrName).(string); e.Tag == dwarf.TagSubprogram && name == "main" {
+			return
+		}
+	}
+	t.Error("function main not found in DWARF")
+}
@@ -0,0 +1,290 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package symbolize
+
+import (
+	"debug/dwarf"
+	"io"
+	"sort"
+)
+
+// A unit holds the line table and functions of a DWARF compilation
+// unit, indexed for lookup by PC.  It is loaded on first use.
+type unit struct {
+	entry  *dwarf.Entry
+	loaded bool
+	err    error
+	lines  []lineRow   // line table rows, sorted by PC
+	funcs  []funcRange // ranges of the unit's functions, sorted by PC; they do not overlap
+}
+
+// A lineRow is a row of a line table.  It applies to the PCs from
+// its address up to the address of the next row.
+type lineRow struct {
+	addr uint64
+	file string
+	line int
+	end  bool // first PC after a sequence of rows; file and line are unset
+}
+
+// A function is a subprogram or inlined subroutine.
+type function struct {
+	name     string
+	entry    uint64
+	ranges   [][2]uint64
+	callFile string      // for inlined subroutines, file of the call site
+	callLine int         // for inlined subroutines, line of the call site
+	inlined  []*function // calls inlined into the function
+}
+
+// A funcRange is a PC range covered by a subprogram.
+type funcRange struct {
+	low, high uint64
+	fn        *function
+}
+
+type funcRangesByPC []funcRange
+
+func (x funcRangesByPC) Len() int           { return len(x) }
+func (x funcRangesByPC) Less(i, j int) bool { return x[i].low < x[j].low }
+func (x funcRangesByPC) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// frames returns the frames for pc, which is covered by u.
+func (u *unit) frames(d *dwarf.Data, pc uint64) ([]Frame, error) {
+	if err := u.load(d); err != nil {
+		return nil, err
+	}
+
+	file, line := u.lineForPC(pc)
+	fn := u.funcForPC(pc)
+	if fn == nil {
+		if file == "" {
+			return nil, nil
+		}
+		return []Frame{{File: file, Line: line}}, nil
+	}
+
+	// Walk down the tree of inlined calls covering pc.  Each
+	// call site is the location in the calling function's frame.
+	var frames []Frame
+	for {
+		inner := fn.inlinedForPC(pc)
+		if inner == nil {
+			break
+		}
+		frames = append(frames, Frame{Func: fn.name, Entry: fn.entry, File: inner.callFile, Line: inner.callLine})
+		fn = inner
+	}
+	frames = append(frames, Frame{Func: fn.name, Entry: fn.entry, File: file, Line: line})
+
+	// Reverse to put the innermost frame first.
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+	for i := range frames[:len(frames)-1] {
+		frames[i].Inline = true
+		frames[i].Entry = 0
+	}
+	return frames, nil
+}
+
+// lineForPC returns the source position of pc in u's line table.
+func (u *unit) lineForPC(pc uint64) (file string, line int) {
+	i := sort.Search(len(u.lines), func(i int) bool { return u.lines[i].addr > pc }) - 1
+	if i < 0 || u.lines[i].end {
+		return "", 0
+	}
+	return u.lines[i].file, u.lines[i].line
+}
+
+// funcForPC returns the subprogram in u covering pc, or nil.
+func (u *unit) funcForPC(pc uint64) *function {
+	i := sort.Search(len(u.funcs), func(i int) bool { return u.funcs[i].low > pc }) - 1
+	if i < 0 || pc >= u.funcs[i].high {
+		return nil
+	}
+	return u.funcs[i].fn
+}
+
+// inlinedForPC returns the call inlined into fn covering pc, or nil.
+func (fn *function) inlinedForPC(pc uint64) *function {
+	for _, in := range fn.inlined {
+		for _, r := range in.ranges {
+			if r[0] <= pc && pc < r[1] {
+				return in
+			}
+		}
+	}
+	return nil
+}
+
+// lineRanges returns the PC ranges covered by u's line table.
+func (u *unit) lineRanges() [][2]uint64 {
+	var ranges [][2]uint64
+	start := -1
+	for i, row := range u.lines {
+		switch {
+		case start < 0 && !row.end:
+			start = i
+		case start >= 0 && row.end:
+			ranges = append(ranges, [2]uint64{u.lines[start].addr, row.addr})
+			start = -1
+		}
+	}
+	return ranges
+}
+
+// load reads u's line table and functions.
+func (u *unit) load(d *dwarf.Data) error {
+	if !u.loaded {
+		u.loaded = true
+		u.err = u.read(d)
+	}
+	return u.err
+}
+
+func (u *unit) read(d *dwarf.Data) error {
+	var files []*dwarf.LineFile
+	lr, err := d.LineReader(u.entry)
+	if err != nil {
+		return err
+	}
+	if lr != nil {
+		// Sequences of rows need not be in PC order.
+		// Collect them and sort them by their first PC.
+		var seqs [][]lineRow
+		var seq []lineRow
+		var e dwarf.LineEntry
+		for {
+			if err := lr.Next(&e); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return err
+			}
+			row := lineRow{addr: e.Address, line: e.Line, end: e.EndSequence}
+			if e.File != nil && !e.EndSequence {
+				row.file = e.File.Name
+			}
+			seq = append(seq, row)
+			if e.EndSequence {
+				seqs = append(seqs, seq)
+				seq = nil
+			}
+		}
+		sort.Stable(sequencesByPC(seqs))
+		for _, seq := range seqs {
+			u.lines = append(u.lines, seq...)
+		}
+		files = lr.Files()
+	}
+
+	if !u.entry.Children {
+		return nil
+	}
+	names := make(map[dwarf.Offset]string)
+	r := d.Reader()
+	r.Seek(u.entry.Offset)
+	if _, err := r.Next(); err != nil {
+		return err
+	}
+	// stack holds the innermost function enclosing the entries
+	// at each nesting level, starting with the unit's children.
+	stack := []*function{nil}
+	for len(stack) > 0 {
+		e, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		parent := stack[len(stack)-1]
+		fn := parent
+		switch e.Tag {
+		case dwarf.TagSubprogram, dwarf.TagInlinedSubroutine:
+			ranges, err := d.Ranges(e)
+			if err != nil {
+				return err
+			}
+			if len(ranges) == 0 {
+				// A declaration or an abstract instance.
+				break
+			}
+			name, err := entryName(d, e, names)
+			if err != nil {
+				return err
+			}
+			fn = &function{name: name, entry: ranges[0][0], ranges: ranges}
+			if low, ok := e.Val(dwarf.AttrLowpc).(uint64); ok {
+				fn.entry = low
+			}
+			if e.Tag == dwarf.TagInlinedSubroutine && parent != nil {
+				if i, ok := e.Val(dwarf.AttrCallFile).(int64); ok && 0 < i && i < int64(len(files)) && files[i] != nil {
+					fn.callFile = files[i].Name
+				}
+				if l, ok := e.Val(dwarf.AttrCallLine).(int64); ok {
+					fn.callLine = int(l)
+				}
+				parent.inlined = append(parent.inlined, fn)
+				break
+			}
+			for _, r := range ranges {
+				u.funcs = append(u.funcs, funcRange{r[0], r[1], fn})
+			}
+		}
+		if e.Children {
+			stack = append(stack, fn)
+		}
+	}
+	sort.Stable(funcRangesByPC(u.funcs))
+	return nil
+}
+
+type sequencesByPC [][]lineRow
+
+func (x sequencesByPC) Len() int           { return len(x) }
+func (x sequencesByPC) Less(i, j int) bool { return x[i][0].addr < x[j][0].addr }
+func (x sequencesByPC) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// entryName returns the name of the function described by e,
+// following references to the abstract instance or declaration
+// of an inlined or out-of-line function.
+func entryName(d *dwarf.Data, e *dwarf.Entry, cache map[dwarf.Offset]string) (string, error) {
+	for depth := 0; depth < 8; depth++ {
+		if name, ok := e.Val(dwarf.AttrName).(string); ok {
+			return name, nil
+		}
+		ref, ok := e.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset)
+		if !ok {
+			ref, ok = e.Val(dwarf.AttrSpecification).(dwarf.Offset)
+		}
+		if !ok {
+			break
+		}
+		if name, ok := cache[ref]; ok {
+			return name, nil
+		}
+		r := d.Reader()
+		r.Seek(ref)
+		next, err := r.Next()
+		if err != nil {
+			return "", err
+		}
+		if next == nil {
+			break
+		}
+		if name, ok := next.Val(dwarf.AttrName).(string); ok {
+			cache[ref] = name
+			return name, nil
+		}
+		e = next
+	}
+	return "?", nil
+}
@@ -0,0 +1,295 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package symbolize maps program counters in an executable to
+// functions and source lines.
+//
+// A Symbolizer reads the Go symbol and line tables (see package
+// debug/gosym) of an ELF, Mach-O or PE executable.  Program counters
+// not covered by those tables, such as those in C code linked using
+// cgo, are looked up in the executable's DWARF debugging information
+// instead, which also describes the calls the C compiler inlined.
+//
+// A Symbolizer indexes the tables it reads on first use, so that
+// looking up large batches of program counters is fast.
+package symbolize
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"debug/gosym"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// A Frame describes the source location of a program counter.
+// When the compiler inlined a function call, a program counter has
+// one Frame for the inlined function and one for each caller it was
+// inlined into.
+type Frame struct {
+	Func   string // function name
+	Entry  uint64 // entry PC of the function, or 0 for inlined functions
+	File   string // source file name, or "" if unknown
+	Line   int    // source line number, or 0 if unknown
+	Inline bool   // whether the function was inlined into the next frame's function
+}
+
+// A Symbolizer maps program counters in an executable to frames.
+// It is safe for concurrent use by multiple goroutines.
+type Symbolizer struct {
+	closer io.Closer
+
+	gotab *gosym.Table // Go symbol table, or nil
+
+	mu       sync.Mutex
+	dwarf    *dwarf.Data // debugging information, or nil
+	units    []unitRange // compilation units by PC range, sorted
+	indexed  bool
+	indexErr error
+}
+
+// ErrNoSymbols is returned when an executable has neither Go symbol
+// tables nor DWARF line tables.
+var ErrNoSymbols = errors.New("symbolize: no symbol or line tables")
+
+// Open opens the named executable and returns a Symbolizer for it.
+func Open(name string) (*Symbolizer, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	s, err := New(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	s.closer = f
+	return s, nil
+}
+
+// Close closes the Symbolizer.
+// If the Symbolizer was created using New directly instead of Open,
+// Close has no effect.
+func (s *Symbolizer) Close() error {
+	var err error
+	if s.closer != nil {
+		err = s.closer.Close()
+		s.closer = nil
+	}
+	return err
+}
+
+// New returns a Symbolizer for the executable read from r.
+// The executable may be in ELF, Mach-O or PE format.
+func New(r io.ReaderAt) (*Symbolizer, error) {
+	textStart, symtab, pclntab, dwarfData, err := loadTables(r)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Symbolizer)
+	if pclntab != nil {
+		tab, err := gosym.NewTable(symtab, gosym.NewLineTable(pclntab, textStart))
+		if err != nil {
+			return nil, err
+		}
+		s.gotab = tab
+	}
+	// Executables without debugging information are fine
+	// as long as they have Go tables.
+	if d, err := dwarfData(); err == nil {
+		s.dwarf = d
+	}
+	if s.gotab == nil && s.dwarf == nil {
+		return nil, ErrNoSymbols
+	}
+	return s, nil
+}
+
+// loadTables returns the start of the text segment, the contents of
+// the Go symbol and line table sections, if any, and a function
+// returning the DWARF debugging information of the executable in r.
+func loadTables(r io.ReaderAt) (textStart uint64, symtab, pclntab []byte, dwarfData func() (*dwarf.Data, error), err error) {
+	if obj, err := elf.NewFile(r); err == nil {
+		if sect := obj.Section(".text"); sect != nil {
+			textStart = sect.Addr
+		}
+		if sect := obj.Section(".gosymtab"); sect != nil {
+			if symtab, err = sect.Data(); err != nil {
+				return 0, nil, nil, nil, err
+			}
+		}
+		if sect := obj.Section(".gopclntab"); sect != nil {
+			if pclntab, err = sect.Data(); err != nil {
+				return 0, nil, nil, nil, err
+			}
+		}
+		return textStart, symtab, pclntab, obj.DWARF, nil
+	}
+
+	if obj, err := macho.NewFile(r); err == nil {
+		if sect := obj.Section("__text"); sect != nil {
+			textStart = sect.Addr
+		}
+		if sect := obj.Section("__gosymtab"); sect != nil {
+			if symtab, err = sect.Data(); err != nil {
+				return 0, nil, nil, nil, err
+			}
+		}
+		if sect := obj.Section("__gopclntab"); sect != nil {
+			if pclntab, err = sect.Data(); err != nil {
+				return 0, nil, nil, nil, err
+			}
+		}
+		return textStart, symtab, pclntab, obj.DWARF, nil
+	}
+
+	if obj, err := pe.NewFile(r); err == nil {
+		if sect := obj.Section(".text"); sect != nil {
+			textStart = uint64(sect.VirtualAddress)
+		}
+		if sect := obj.Section(".gosymtab"); sect != nil {
+			if symtab, err = sect.Data(); err != nil {
+				return 0, nil, nil, nil, err
+			}
+		}
+		if sect := obj.Section(".gopclntab"); sect != nil {
+			if pclntab, err = sect.Data(); err != nil {
+				return 0, nil, nil, nil, err
+			}
+		}
+		return textStart, symtab, pclntab, obj.DWARF, nil
+	}
+
+	return 0, nil, nil, nil, errors.New("symbolize: unrecognized executable format")
+}
+
+// Frames returns the frames for pc, innermost first.
+// Only the last frame is not inlined.
+// If nothing is known about pc, Frames returns nil, nil.
+func (s *Symbolizer) Frames(pc uint64) ([]Frame, error) {
+	if s.gotab != nil {
+		if fn := s.gotab.PCToFunc(pc); fn != nil {
+			file, line, _ := s.gotab.PCToLine(pc)
+			return []Frame{{Func: fn.Name, Entry: fn.Entry, File: file, Line: line}}, nil
+		}
+	}
+	if s.dwarf == nil {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, err := s.unitForPC(pc)
+	if u == nil || err != nil {
+		return nil, err
+	}
+	return u.frames(s.dwarf, pc)
+}
+
+// Symbolize returns the frames for each of the program counters
+// in pcs, as returned by Frames.  It is more efficient than calling
+// Frames for each program counter, especially when pcs holds many
+// nearby or repeated program counters.
+func (s *Symbolizer) Symbolize(pcs []uint64) ([][]Frame, error) {
+	// Look up each distinct PC once, in increasing order,
+	// so that lookups in the same function are close together.
+	sorted := make(pcList, len(pcs))
+	copy(sorted, pcs)
+	sort.Sort(sorted)
+	frames := make(map[uint64][]Frame)
+	for i, pc := range sorted {
+		if i > 0 && sorted[i-1] == pc {
+			continue
+		}
+		f, err := s.Frames(pc)
+		if err != nil {
+			return nil, err
+		}
+		frames[pc] = f
+	}
+	out := make([][]Frame, len(pcs))
+	for i, pc := range pcs {
+		out[i] = frames[pc]
+	}
+	return out, nil
+}
+
+type pcList []uint64
+
+func (x pcList) Len() int           { return len(x) }
+func (x pcList) Less(i, j int) bool { return x[i] < x[j] }
+func (x pcList) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// A unitRange is a PC range covered by a compilation unit.
+type unitRange struct {
+	low, high uint64
+	u         *unit
+}
+
+type unitRangesByPC []unitRange
+
+func (x unitRangesByPC) Len() int           { return len(x) }
+func (x unitRangesByPC) Less(i, j int) bool { return x[i].low < x[j].low }
+func (x unitRangesByPC) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// unitForPC returns the compilation unit covering pc, or nil.
+// s.mu must be held.
+func (s *Symbolizer) unitForPC(pc uint64) (*unit, error) {
+	if !s.indexed {
+		s.indexed = true
+		s.indexErr = s.indexUnits()
+	}
+	if s.indexErr != nil {
+		return nil, s.indexErr
+	}
+	i := sort.Search(len(s.units), func(i int) bool { return s.units[i].low > pc }) - 1
+	if i < 0 || pc >= s.units[i].high {
+		return nil, nil
+	}
+	return s.units[i].u, nil
+}
+
+// indexUnits records the PC ranges of the compilation units in s.dwarf.
+func (s *Symbolizer) indexUnits() error {
+	r := s.dwarf.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		r.SkipChildren()
+		u := &unit{entry: e}
+		ranges, err := s.dwarf.Ranges(e)
+		if err != nil {
+			return err
+		}
+		if len(ranges) == 0 {
+			// Some compilers do not record the ranges of a
+			// compilation unit.  Use those of its line table.
+			if err := u.load(s.dwarf); err != nil {
+				return err
+			}
+			ranges = u.lineRanges()
+		}
+		for _, rg := range ranges {
+			if rg[0] < rg[1] {
+				s.units = append(s.units, unitRange{rg[0], rg[1], u})
+			}
+		}
+	}
+	sort.Sort(unitRangesByPC(s.units))
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package symbolize
+
+import (
+	"os"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+const inlineExec = "testdata/inline-gcc-amd64-linux-exec"
+
+var inlineFile = "/tmp/sym/inline.c"
+
+var inlineTests = []struct {
+	pc     uint64
+	frames []Frame
+}{
+	{0x1140, []Frame{
+		{Func: "leaf", File: inlineFile, Line: 8, Inline: true},
+		{Func: "middle", File: inlineFile, Line: 14, Inline: true},
+		{Func: "outer", Entry: 0x1140, File: inlineFile, Line: 21},
+	}},
+	{0x1144, []Frame{
+		{Func: "leaf", File: inlineFile, Line: 8, Inline: true},
+		{Func: "middle", File: inlineFile, Line: 14, Inline: true},
+		{Func: "outer", Entry: 0x1140, File: inlineFile, Line: 21},
+	}},
+	{0x114a, []Frame{
+		{Func: "middle", File: inlineFile, Line: 15, Inline: true},
+		{Func: "outer", Entry: 0x1140, File: inlineFile, Line: 21},
+	}},
+	{0x1159, []Frame{
+		{Func: "outer", Entry: 0x1140, File: inlineFile, Line: 22},
+	}},
+	{0x1045, []Frame{
+		{Func: "main", Entry: 0x1040, File: inlineFile, Line: 28},
+	}},
+	{0x10, nil},
+}
+
+func TestDWARFInline(t *testing.T) {
+	s, err := Open(inlineExec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	for _, tt := range inlineTests {
+		frames, err := s.Frames(tt.pc)
+		if err != nil {
+			t.Errorf("Frames(%#x): %v", tt.pc, err)
+			continue
+		}
+		if !reflect.DeepEqual(frames, tt.frames) {
+			t.Errorf("Frames(%#x) = %+v, want %+v", tt.pc, frames, tt.frames)
+		}
+	}
+}
+
+func TestSymbolize(t *testing.T) {
+	s, err := Open(inlineExec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	var pcs []uint64
+	var want [][]Frame
+	for i := 0; i < 3; i++ {
+		for j := len(inlineTests) - 1; j >= 0; j-- {
+			pcs = append(pcs, inlineTests[j].pc)
+			want = append(want, inlineTests[j].frames)
+		}
+	}
+	got, err := s.Symbolize(pcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Symbolize(%#x) = %+v, want %+v", pcs, got, want)
+	}
+}
+
+var formatTests = []struct {
+	file  string
+	pc    uint64
+	frame Frame
+}{
+	{
+		"../macho/testdata/gcc-amd64-darwin-exec-debug",
+		0x100000f6a,
+		Frame{Func: "main", Entry: 0x100000f6a, File: "/home/rsc/go/src/pkg/debug/macho/testdata/hello.c", Line: 3},
+	},
+	{
+		"../pe/testdata/gcc-386-mingw-exec",
+		0x401344,
+		Frame{Func: "main", Entry: 0x401344, File: `g:\opensource\go\src\pkg\debug\pe\testdata/hello.c`, Line: 5},
+	},
+}
+
+func TestFormats(t *testing.T) {
+	for _, tt := range formatTests {
+		s, err := Open(tt.file)
+		if err != nil {
+			t.Errorf("%s: %v", tt.file, err)
+			continue
+		}
+		frames, err := s.Frames(tt.pc)
+		s.Close()
+		if err != nil {
+			t.Errorf("%s: Frames(%#x): %v", tt.file, tt.pc, err)
+			continue
+		}
+		if want := []Frame{tt.frame}; !reflect.DeepEqual(frames, want) {
+			t.Errorf("%s: Frames(%#x) = %+v, want %+v", tt.file, tt.pc, frames, want)
+		}
+	}
+}
+
+func TestNoSymbols(t *testing.T) {
+	if _, err := Open("../macho/testdata/gcc-amd64-darwin-exec"); err != ErrNoSymbols {
+		t.Errorf("Open of executable without symbols: err = %v, want ErrNoSymbols", err)
+	}
+	if _, err := Open("symbolize_test.go"); err == nil {
+		t.Errorf("Open of non-executable succeeded")
+	}
+}
+
+func goFunc() {}
+
+func TestGo(t *testing.T) {
+	switch runtime.GOOS {
+	case "nacl", "plan9":
+		t.Skipf("cannot read own executable on %s", runtime.GOOS)
+	}
+	s, err := Open(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	pc := reflect.ValueOf(goFunc).Pointer()
+	frames, err := s.Frames(uint64(pc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := runtime.FuncForPC(pc)
+	file, line := fn.FileLine(pc)
+	want := []Frame{{Func: fn.Name(), Entry: uint64(fn.Entry()), File: file, Line: line}}
+	if !reflect.DeepEqual(frames, want) {
+		t.Errorf("Frames(%#x) = %+v, want %+v", pc, frames, want)
+	}
+}
@@ -0,0 +1,30 @@
+// gcc -O2 -gdwarf-4 -fno-asynchronous-unwind-tables -o inline-gcc-amd64-linux-exec inline.c
+
+volatile int sink;
+
+static inline __attribute__((always_inline)) void
+leaf(int x)
+{
+	sink = x * 3;
+}
+
+static inline __attribute__((always_inline)) void
+middle(int x)
+{
+	leaf(x + 1);
+	sink += 2;
+}
+
+__attribute__((noinline)) void
+outer(int x)
+{
+	middle(x);
+	sink -= 1;
+}
+
+int
+main(void)
+{
+	outer(4);
+	return 0;
+}
@@ -15,9 +15,11 @@ import (
 // RemoveAll removes all exported variables.
 // This is for tests only.
 func RemoveAll() {
-	mutex.Lock()
-	defer mutex.Unlock()
-	vars = make(map[string]Var)
+	varKeysMu.Lock()
+	defer varKeysMu.Unlock()
+	for _, k := range varKeys {
+		vars.Delete(k)
+	}
 	varKeys = nil
 }
 
@@ -98,13 +100,13 @@ func TestMapCounter(t *testing.T) {
 	colors.Add("red", 2)
 	colors.Add("blue", 4)
 	colors.AddFloat("green", 4.125)
-	if x := colors.m["red"].(*Int).i; x != 3 {
+	if x := colors.Get("red").(*Int).i; x != 3 {
 		t.Errorf("colors.m[\"red\"] = %v, want 3", x)
 	}
-	if x := colors.m["blue"].(*Int).i; x != 4 {
+	if x := colors.Get("blue").(*Int).i; x != 4 {
 		t.Errorf("colors.m[\"blue\"] = %v, want 4", x)
 	}
-	if x := colors.m["green"].(*Float).f; x != 4.125 {
+	if x := colors.Get("green").(*Float).f; x != 4.125 {
 		t.Errorf("colors.m[\"green\"] = %v, want 3.14", x)
 	}
 
@@ -130,6 +132,43 @@ func TestMapCounter(t *testing.T) {
 	}
 }
 
+func TestMapInit(t *testing.T) {
+	RemoveAll()
+	colors := NewMap("bike-shed-colors")
+	colors.Add("red", 1)
+	colors.Add("blue", 1)
+	colors.Init()
+	if s := colors.String(); s != "{}" {
+		t.Errorf("after Init, colors.String() = %q, want {}", s)
+	}
+	if v := colors.Get("red"); v != nil {
+		t.Errorf("after Init, colors.Get(\"red\") = %v, want nil", v)
+	}
+	colors.Add("green", 2)
+	if s := colors.String(); s != `{"green": 2}` {
+		t.Errorf("colors.String() = %q, want {\"green\": 2}", s)
+	}
+}
+
+func BenchmarkMapAddSame(b *testing.B) {
+	m := new(Map).Init()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.Add("red", 1)
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	RemoveAll()
+	NewInt("requests")
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			Get("requests")
+		}
+	})
+}
+
 func TestFunc(t *testing.T) {
 	RemoveAll()
 	var x interface{} = []string{"a", "b"}
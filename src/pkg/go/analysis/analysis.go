@@ -0,0 +1,187 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package analysis defines the interface between a modular static
+// analysis and an analysis driver program.
+//
+// An analysis is described by an Analyzer: its name, documentation,
+// flags, the function that performs it, and its relationships to other
+// analyzers. An Analyzer is applied to one package at a time; its Run
+// function is given a Pass providing the package's syntax trees and
+// type information and the means to report diagnostics.
+//
+// Analyzers may depend on each other in two ways. An analyzer A that
+// lists B in its Requires field may use the result of B on the same
+// package, available in Pass.ResultOf[B]. An analyzer that declares
+// FactTypes may attach facts to the objects and packages it analyzes
+// and retrieve the facts attached to them while analyzing the
+// packages that import them; the driver applies such analyzers to the
+// dependencies of the packages it was asked to analyze, too.
+//
+// Drivers are provided by the packages go/analysis/multichecker, for
+// programs such as cmd/vet that run a fixed set of analyzers, and
+// go/analysis/analysistest, for testing an analyzer against packages
+// annotated with the expected diagnostics.
+//
+package analysis
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+)
+
+// An Analyzer describes an analysis function and its options.
+type Analyzer struct {
+	// Name of the analyzer; it must be a valid Go identifier
+	// and is used in command-line flags and diagnostics.
+	Name string
+
+	// Doc is the documentation for the analyzer.
+	// The first line is a one-line summary.
+	Doc string
+
+	// Flags defines any flags accepted by the analyzer.
+	// Drivers make them available with the analyzer's name
+	// as a prefix, as in -printf.funcs.
+	Flags flag.FlagSet
+
+	// Run applies the analyzer to a package.
+	// It returns an error if the analyzer failed.
+	//
+	// On success, the result of Run, which must be of type
+	// ResultType, is made available to the analyzers that
+	// require this one.
+	Run func(*Pass) (interface{}, error)
+
+	// RunDespiteErrors allows the driver to invoke Run
+	// even on packages that failed to parse or type-check;
+	// the Pass then holds partial information.
+	RunDespiteErrors bool
+
+	// Requires lists the analyzers whose results this one
+	// depends on. The driver runs them first, on the same
+	// package.
+	Requires []*Analyzer
+
+	// ResultType is the type of the result of Run, if any.
+	ResultType reflect.Type
+
+	// FactTypes lists the types of facts the analyzer may
+	// import and export. Each must be a pointer type.
+	FactTypes []Fact
+}
+
+func (a *Analyzer) String() string { return a.Name }
+
+// A Pass provides information to the Run function that applies
+// a specific analyzer to a single Go package.
+//
+// The Run function must not retain a Pass or any of its fields
+// after it returns.
+type Pass struct {
+	Analyzer *Analyzer // the identity of the current analyzer
+
+	// syntax and type information
+	Fset       *token.FileSet // file position information
+	Files      []*ast.File    // the abstract syntax tree of each file
+	OtherFiles []string       // names of non-Go files of this package
+	Pkg        *types.Package // type information about the package
+	TypesInfo  *types.Info    // type information about the syntax trees
+	TypesSizes types.Sizes    // function for computing sizes of types
+
+	// Report reports a Diagnostic, a finding about a specific
+	// location in the analyzed source code.
+	Report func(Diagnostic)
+
+	// ResultOf provides the results of the analyzers required
+	// by this one, keyed by analyzer.
+	ResultOf map[*Analyzer]interface{}
+
+	// ImportObjectFact retrieves the fact of type fact's type
+	// associated with obj, which may belong to this package or
+	// one of its dependencies. If a fact is found, it is copied
+	// into fact and ImportObjectFact returns true.
+	ImportObjectFact func(obj types.Object, fact Fact) bool
+
+	// ImportPackageFact retrieves the fact of type fact's type
+	// associated with pkg, like ImportObjectFact.
+	ImportPackageFact func(pkg *types.Package, fact Fact) bool
+
+	// ExportObjectFact associates fact with obj, which must
+	// belong to the package being analyzed, replacing any
+	// previous fact of the same type.
+	ExportObjectFact func(obj types.Object, fact Fact)
+
+	// ExportPackageFact associates fact with the package
+	// being analyzed.
+	ExportPackageFact func(fact Fact)
+}
+
+// Reportf is a helper function that reports a Diagnostic
+// using the specified position and formatted message.
+func (pass *Pass) Reportf(pos token.Pos, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	pass.Report(Diagnostic{Pos: pos, Message: msg})
+}
+
+// ReportRangef is like Reportf but reports a diagnostic
+// covering the extent of the node rng.
+func (pass *Pass) ReportRangef(rng ast.Node, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	pass.Report(Diagnostic{Pos: rng.Pos(), End: rng.End(), Message: msg})
+}
+
+func (pass *Pass) String() string {
+	return fmt.Sprintf("%s@%s", pass.Analyzer.Name, pass.Pkg.Path())
+}
+
+// A Fact is an intermediate fact produced during analysis.
+//
+// Each fact is associated with a named declaration (a types.Object)
+// or with a package as a whole. A single object or package may have
+// multiple associated facts, but only one of any particular fact type.
+//
+// A Fact type must be a pointer. Facts are shared by the analysis of
+// different packages, so they must not be modified after export.
+type Fact interface {
+	AFact() // dummy method to avoid type errors
+}
+
+// A Diagnostic is a message associated with a source location or range.
+//
+// An Analyzer may return a variety of diagnostics; the optional Category,
+// which should be a constant, may be used to classify them.
+type Diagnostic struct {
+	Pos      token.Pos
+	End      token.Pos // optional
+	Category string    // optional
+	Message  string
+
+	// SuggestedFixes contains suggested fixes for the diagnostic,
+	// which a driver may apply at the user's request.
+	SuggestedFixes []SuggestedFix
+}
+
+// A SuggestedFix is a code change associated with a Diagnostic that a
+// user can choose to apply to their code. Usually the SuggestedFix is
+// meant to fix the issue flagged by the diagnostic.
+//
+// The TextEdits of a fix must not overlap.
+type SuggestedFix struct {
+	// A description of the fix, such as "Remove redundant newline".
+	Message   string
+	TextEdits []TextEdit
+}
+
+// A TextEdit represents the replacement of the code between Pos
+// and End with the new text.
+type TextEdit struct {
+	Pos     token.Pos
+	End     token.Pos
+	NewText []byte
+}
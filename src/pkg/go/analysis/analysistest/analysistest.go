@@ -0,0 +1,255 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package analysistest provides utilities for testing analyzers.
+//
+// Run applies an analyzer to packages in a test GOPATH and checks the
+// diagnostics and facts it produces against expectations written in
+// comments of the form
+//
+//	// want "regexp" ...
+//
+// on the line of each expected diagnostic. Each string literal, quoted
+// or raw, must match the message of one diagnostic reported on that
+// line. An expectation of the form name:"regexp" instead refers to a
+// fact exported for the object called name declared on that line, as
+// printed by fmt.Sprint; the name package denotes the facts of the
+// package as a whole, expected on the package clause of its first file.
+//
+package analysistest
+
+import (
+	"fmt"
+	"go/analysis"
+	"go/analysis/internal/checker"
+	"go/build"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/scanner"
+)
+
+// Testing is an abstraction of a *testing.T.
+type Testing interface {
+	Errorf(format string, args ...interface{})
+}
+
+// TestData returns the effective filename of
+// the program's "testdata" directory.
+// This function may be overridden by projects using
+// an alternative build system (such as Blaze) that
+// does not run a test in its package directory.
+var TestData = func() string {
+	testdata, err := filepath.Abs("testdata")
+	if err != nil {
+		panic(err)
+	}
+	return testdata
+}
+
+// A Result holds the result of applying an analyzer to a package.
+type Result struct {
+	Pass        *analysis.Pass // only the Pkg, Fset, and Files fields are set
+	Diagnostics []analysis.Diagnostic
+	Facts       map[types.Object][]analysis.Fact
+	Result      interface{}
+	Err         error
+}
+
+// Run applies an analyzer to the packages denoted by the import paths,
+// which are resolved in the workspace dir, used as GOPATH. It reports
+// an error through t for each mismatch between the diagnostics and
+// facts produced and the expectations in the packages' comments.
+// It returns the results of the analysis, one per package.
+func Run(t Testing, dir string, a *analysis.Analyzer, paths ...string) []*Result {
+	if err := analysis.Validate([]*analysis.Analyzer{a}); err != nil {
+		t.Errorf("invalid analyzer: %v", err)
+		return nil
+	}
+
+	ctxt := build.Default
+	ctxt.GOPATH = dir
+	ctxt.CgoEnabled = false // load cgo-using dependencies without a C toolchain
+	loader := checker.NewLoader(&ctxt)
+	pkgs, err := loader.Load(paths)
+	if err != nil {
+		t.Errorf("loading %s: %v", paths, err)
+		return nil
+	}
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			t.Errorf("%v", err)
+		}
+	}
+
+	var results []*Result
+	for _, act := range checker.Analyze(pkgs, []*analysis.Analyzer{a}) {
+		if act.Err != nil {
+			t.Errorf("error analyzing %s: %v", act, act.Err)
+		} else {
+			check(t, act)
+		}
+		results = append(results, &Result{
+			Pass: &analysis.Pass{
+				Analyzer: a,
+				Fset:     act.Pkg.Fset,
+				Files:    act.Pkg.Files,
+				Pkg:      act.Pkg.Types,
+			},
+			Diagnostics: act.Diagnostics,
+			Facts:       act.ObjectFacts(),
+			Result:      act.Result,
+			Err:         act.Err,
+		})
+	}
+	return results
+}
+
+// An expectation is a regular expression expected to match a
+// diagnostic message (if name is empty) or the fact about the
+// named object.
+type expectation struct {
+	name string
+	rx   *regexp.Regexp
+}
+
+// A lineKey identifies a line of a file.
+type lineKey struct {
+	file string
+	line int
+}
+
+// check reports mismatches between the diagnostics and facts produced
+// by act and the expectations in its package's comments.
+func check(t Testing, act *checker.Action) {
+	fset := act.Pkg.Fset
+	want := make(map[lineKey][]expectation)
+	for _, f := range act.Pkg.Files {
+		for _, cgroup := range f.Comments {
+			for _, c := range cgroup.List {
+				text := strings.TrimPrefix(c.Text, "//")
+				if text == c.Text {
+					continue // not a //-comment
+				}
+				text = strings.TrimSpace(text)
+				if !strings.HasPrefix(text, "want ") && text != "want" {
+					continue
+				}
+				posn := fset.Position(c.Pos())
+				expects, err := parseExpectations(strings.TrimPrefix(text, "want"))
+				if err != nil {
+					t.Errorf("%s: in 'want' comment: %s", posn, err)
+					continue
+				}
+				k := lineKey{posn.Filename, posn.Line}
+				want[k] = append(want[k], expects...)
+			}
+		}
+	}
+
+	// checkMessage matches the message against the expectations of
+	// the line at posn, consuming the first match.
+	checkMessage := func(posn token.Position, kind, name, message string) {
+		k := lineKey{posn.Filename, posn.Line}
+		expects := want[k]
+		for i, exp := range expects {
+			if exp.name == name && exp.rx.MatchString(message) {
+				want[k] = append(expects[:i:i], expects[i+1:]...)
+				return
+			}
+		}
+		t.Errorf("%s: unexpected %s: %s", posn, kind, message)
+	}
+
+	for _, d := range act.Diagnostics {
+		checkMessage(fset.Position(d.Pos), "diagnostic", "", d.Message)
+	}
+	for obj, facts := range act.ObjectFacts() {
+		for _, fact := range facts {
+			checkMessage(fset.Position(obj.Pos()), "fact", obj.Name(), fmt.Sprint(fact))
+		}
+	}
+	for _, fact := range act.PackageFacts() {
+		// Package facts are expected on the package clause of the first file.
+		checkMessage(fset.Position(act.Pkg.Files[0].Name.Pos()), "package fact", "package", fmt.Sprint(fact))
+	}
+
+	// Report unmatched expectations, in order.
+	var keys []lineKey
+	for k, expects := range want {
+		if len(expects) > 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Sort(byLine(keys))
+	for _, k := range keys {
+		for _, exp := range want[k] {
+			if exp.name != "" {
+				t.Errorf("%s:%d: no fact was produced for %s matching %q", k.file, k.line, exp.name, exp.rx)
+			} else {
+				t.Errorf("%s:%d: no diagnostic was reported matching %q", k.file, k.line, exp.rx)
+			}
+		}
+	}
+}
+
+type byLine []lineKey
+
+func (s byLine) Len() int      { return len(s) }
+func (s byLine) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byLine) Less(i, j int) bool {
+	if s[i].file != s[j].file {
+		return s[i].file < s[j].file
+	}
+	return s[i].line < s[j].line
+}
+
+// parseExpectations parses the text following "want" in a comment:
+// a list of string literals, each optionally preceded by a name and
+// a colon.
+func parseExpectations(text string) ([]expectation, error) {
+	var s scanner.Scanner
+	s.Init(strings.NewReader(text))
+	s.Mode = scanner.ScanIdents | scanner.ScanStrings | scanner.ScanRawStrings
+	var scanErr string
+	s.Error = func(_ *scanner.Scanner, msg string) { scanErr = msg }
+
+	var expects []expectation
+	for {
+		tok := s.Scan()
+		if scanErr != "" {
+			return nil, fmt.Errorf("%s", scanErr)
+		}
+		var name string
+		switch tok {
+		case scanner.EOF:
+			if expects == nil {
+				return nil, fmt.Errorf("no expectations")
+			}
+			return expects, nil
+		case scanner.Ident:
+			name = s.TokenText()
+			if s.Scan() != ':' {
+				return nil, fmt.Errorf("unexpected %s after %s", scanner.TokenString(tok), name)
+			}
+			tok = s.Scan()
+		}
+		if tok != scanner.String && tok != scanner.RawString {
+			return nil, fmt.Errorf("got %s, want string literal", scanner.TokenString(tok))
+		}
+		lit, err := strconv.Unquote(s.TokenText())
+		if err != nil {
+			return nil, err
+		}
+		rx, err := regexp.Compile(lit)
+		if err != nil {
+			return nil, err
+		}
+		expects = append(expects, expectation{name, rx})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cfg
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+const src = `package main
+
+import "log"
+
+func f1() {
+	live()
+	return
+	dead()
+}
+
+func f2() {
+	for {
+		live()
+	}
+	dead()
+}
+
+func f3() {
+	for {
+		live()
+		break
+	}
+	live()
+}
+
+func f4(x int) {
+	switch x {
+	case 1:
+		live()
+		fallthrough
+	case 2:
+		live()
+		log.Fatal()
+	default:
+		panic("oops")
+	}
+	dead()
+}
+
+func f5(ch chan int) {
+	select {
+	case <-ch:
+		live()
+		return
+	}
+	dead()
+}
+
+func f6(unknown bool) {
+outer:
+	for {
+		for {
+			break outer
+			dead()
+		}
+		dead()
+	}
+	live()
+}
+
+func f7() {
+	goto L
+	dead()
+L:
+	live()
+}
+
+func f8(ints []int) {
+	for _ = range ints {
+		live()
+		continue
+		dead()
+	}
+	live()
+}
+
+func f9(x interface{}) {
+	switch x.(type) {
+	default:
+		return
+	}
+	dead()
+}
+`
+
+func TestDeadCode(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "dummy.go", src, parser.Mode(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range f.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok {
+			g := New(decl.Body, mayReturn)
+
+			// Print statements in unreachable blocks
+			// (in order determined by builder).
+			var buf []string
+			for _, b := range g.Blocks {
+				if !b.Live {
+					for _, n := range b.Nodes {
+						buf = append(buf, formatNode(fset, n))
+					}
+				}
+			}
+
+			// Check that each dead call is in an unreachable block,
+			// and each live call is not.
+			ast.Inspect(decl, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok {
+					if id, ok := call.Fun.(*ast.Ident); ok {
+						dead := false
+						for _, s := range buf {
+							if s == id.Name+"()" {
+								dead = true
+							}
+						}
+						if want := id.Name == "dead"; dead != want {
+							t.Errorf("%s: %s() in live block: %t, want %t", fset.Position(call.Pos()), id.Name, !dead, !want)
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+}
+
+// A trivial mayReturn predicate that looks only at syntax, not types.
+func mayReturn(call *ast.CallExpr) bool {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name != "panic"
+	case *ast.SelectorExpr:
+		return fun.Sel.Name != "Fatal"
+	}
+	return true
+}
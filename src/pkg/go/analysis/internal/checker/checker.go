@@ -0,0 +1,258 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package checker implements the analysis driver shared by
+// go/analysis/multichecker and go/analysis/analysistest:
+// it loads packages from source, applies analyzers to them and to
+// the dependencies from which they need facts, and reports the
+// resulting diagnostics.
+package checker
+
+import (
+	"fmt"
+	"go/analysis"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// An Action represents one unit of analysis work: the application
+// of one analyzer to one package.
+type Action struct {
+	Analyzer    *analysis.Analyzer
+	Pkg         *Package
+	Deps        []*Action
+	Result      interface{}
+	Err         error
+	Diagnostics []analysis.Diagnostic
+
+	isRoot       bool // whether Pkg was requested by the user
+	done         bool
+	objectFacts  map[objectFactKey]analysis.Fact
+	packageFacts map[packageFactKey]analysis.Fact
+}
+
+type objectFactKey struct {
+	obj types.Object
+	typ reflect.Type
+}
+
+type packageFactKey struct {
+	pkg *types.Package
+	typ reflect.Type
+}
+
+func (act *Action) String() string {
+	return fmt.Sprintf("%s@%s", act.Analyzer, act.Pkg)
+}
+
+// Analyze applies the analyzers to the packages, returning the root
+// actions: one per analyzer and package, in that order.
+func Analyze(pkgs []*Package, analyzers []*analysis.Analyzer) []*Action {
+	type key struct {
+		a   *analysis.Analyzer
+		pkg *Package
+	}
+	actions := make(map[key]*Action)
+
+	var mkAction func(a *analysis.Analyzer, pkg *Package) *Action
+	mkAction = func(a *analysis.Analyzer, pkg *Package) *Action {
+		k := key{a, pkg}
+		act, ok := actions[k]
+		if !ok {
+			act = &Action{Analyzer: a, Pkg: pkg}
+
+			// Add a dependency on each required analyzer.
+			for _, req := range a.Requires {
+				act.Deps = append(act.Deps, mkAction(req, pkg))
+			}
+
+			// An analysis that consumes or produces facts
+			// must run on the package's dependencies too.
+			if len(a.FactTypes) > 0 {
+				for _, imp := range pkg.Imports {
+					act.Deps = append(act.Deps, mkAction(a, imp))
+				}
+			}
+
+			actions[k] = act
+		}
+		return act
+	}
+
+	var roots []*Action
+	for _, a := range analyzers {
+		for _, pkg := range pkgs {
+			root := mkAction(a, pkg)
+			root.isRoot = true
+			roots = append(roots, root)
+		}
+	}
+
+	for _, root := range roots {
+		root.exec()
+	}
+	return roots
+}
+
+// exec runs the action after the actions it depends on.
+func (act *Action) exec() {
+	if act.done {
+		return
+	}
+	act.done = true
+	for _, dep := range act.Deps {
+		dep.exec()
+	}
+
+	// Analyze dependencies and gather their results and facts.
+	var failed []string
+	inputs := make(map[*analysis.Analyzer]interface{})
+	act.objectFacts = make(map[objectFactKey]analysis.Fact)
+	act.packageFacts = make(map[packageFactKey]analysis.Fact)
+	for _, dep := range act.Deps {
+		if dep.Pkg == act.Pkg {
+			// Same package, different analysis (horizontal edge):
+			// in-memory outputs of prerequisite analyzers
+			// become inputs to this analysis pass.
+			if dep.Err != nil {
+				failed = append(failed, dep.String())
+				continue
+			}
+			inputs[dep.Analyzer] = dep.Result
+		} else if dep.Analyzer == act.Analyzer {
+			// Same analysis, different package (vertical edge):
+			// facts from the dependency are inherited. A failed
+			// dependency, such as a package that cannot be
+			// type-checked from source, merely contributes
+			// no facts of its own.
+			for k, v := range dep.objectFacts {
+				act.objectFacts[k] = v
+			}
+			for k, v := range dep.packageFacts {
+				act.packageFacts[k] = v
+			}
+		}
+	}
+	if failed != nil {
+		sort.Strings(failed)
+		act.Err = fmt.Errorf("failed prerequisites: %s", strings.Join(failed, ", "))
+		return
+	}
+	if len(act.Pkg.Errors) > 0 && !act.Analyzer.RunDespiteErrors {
+		act.Err = fmt.Errorf("analysis skipped due to errors in package")
+		return
+	}
+
+	pass := &analysis.Pass{
+		Analyzer:          act.Analyzer,
+		Fset:              act.Pkg.Fset,
+		Files:             act.Pkg.Files,
+		OtherFiles:        act.Pkg.OtherFiles,
+		Pkg:               act.Pkg.Types,
+		TypesInfo:         act.Pkg.TypesInfo,
+		TypesSizes:        sizes,
+		ResultOf:          inputs,
+		Report:            func(d analysis.Diagnostic) { act.Diagnostics = append(act.Diagnostics, d) },
+		ImportObjectFact:  act.importObjectFact,
+		ExportObjectFact:  act.exportObjectFact,
+		ImportPackageFact: act.importPackageFact,
+		ExportPackageFact: act.exportPackageFact,
+	}
+	act.Result, act.Err = act.Analyzer.Run(pass)
+	if act.Err == nil && act.Analyzer.ResultType != nil {
+		if got, want := reflect.TypeOf(act.Result), act.Analyzer.ResultType; got != want {
+			act.Err = fmt.Errorf("internal error: on package %s, analyzer %s returned a result of type %v, but declared ResultType %v",
+				pass.Pkg.Path(), pass.Analyzer, got, want)
+		}
+	}
+	if !act.isRoot {
+		// Diagnostics are only reported for the
+		// packages requested by the user.
+		act.Diagnostics = nil
+	}
+}
+
+// sizes are the sizes of types on the default 64-bit platform.
+var sizes = &types.StdSizes{WordSize: 8, MaxAlign: 8}
+
+// checkFactType panics if fact is not a declared fact type of the
+// action's analyzer.
+func (act *Action) checkFactType(fact analysis.Fact) {
+	t := reflect.TypeOf(fact)
+	for _, ft := range act.Analyzer.FactTypes {
+		if reflect.TypeOf(ft) == t {
+			return
+		}
+	}
+	panic(fmt.Sprintf("invalid Fact type: got %T, want one of %v", fact, act.Analyzer.FactTypes))
+}
+
+// importObjectFact implements Pass.ImportObjectFact.
+func (act *Action) importObjectFact(obj types.Object, ptr analysis.Fact) bool {
+	if obj == nil {
+		panic("nil object")
+	}
+	act.checkFactType(ptr)
+	key := objectFactKey{obj, reflect.TypeOf(ptr)}
+	if v, ok := act.objectFacts[key]; ok {
+		reflect.ValueOf(ptr).Elem().Set(reflect.ValueOf(v).Elem())
+		return true
+	}
+	return false
+}
+
+// exportObjectFact implements Pass.ExportObjectFact.
+func (act *Action) exportObjectFact(obj types.Object, fact analysis.Fact) {
+	if obj.Pkg() != act.Pkg.Types {
+		panic(fmt.Sprintf("internal error: in analysis %s of package %s: Fact.Set(%s, %T): can't set facts on objects belonging another package",
+			act.Analyzer, act.Pkg, obj, fact))
+	}
+	act.checkFactType(fact)
+	act.objectFacts[objectFactKey{obj, reflect.TypeOf(fact)}] = fact
+}
+
+// importPackageFact implements Pass.ImportPackageFact.
+func (act *Action) importPackageFact(pkg *types.Package, ptr analysis.Fact) bool {
+	if pkg == nil {
+		panic("nil package")
+	}
+	act.checkFactType(ptr)
+	key := packageFactKey{pkg, reflect.TypeOf(ptr)}
+	if v, ok := act.packageFacts[key]; ok {
+		reflect.ValueOf(ptr).Elem().Set(reflect.ValueOf(v).Elem())
+		return true
+	}
+	return false
+}
+
+// exportPackageFact implements Pass.ExportPackageFact.
+func (act *Action) exportPackageFact(fact analysis.Fact) {
+	act.checkFactType(fact)
+	act.packageFacts[packageFactKey{act.Pkg.Types, reflect.TypeOf(fact)}] = fact
+}
+
+// ObjectFacts returns the facts about the objects of the action's
+// package exported by the action.
+func (act *Action) ObjectFacts() map[types.Object][]analysis.Fact {
+	facts := make(map[types.Object][]analysis.Fact)
+	for k, fact := range act.objectFacts {
+		if k.obj.Pkg() == act.Pkg.Types {
+			facts[k.obj] = append(facts[k.obj], fact)
+		}
+	}
+	return facts
+}
+
+// PackageFacts returns the facts about the action's package.
+func (act *Action) PackageFacts() []analysis.Fact {
+	var facts []analysis.Fact
+	for k, fact := range act.packageFacts {
+		if k.pkg == act.Pkg.Types {
+			facts = append(facts, fact)
+		}
+	}
+	return facts
+}
@@ -0,0 +1,271 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// A Package is a package loaded from source and type-checked.
+type Package struct {
+	ImportPath string
+	Dir        string
+	Fset       *token.FileSet
+	Files      []*ast.File
+	OtherFiles []string // absolute names of non-Go source files
+	Types      *types.Package
+	TypesInfo  *types.Info
+	Errors     []error    // parse and type errors
+	Imports    []*Package // directly imported packages, except unsafe and C
+}
+
+func (p *Package) String() string { return p.ImportPath }
+
+// A Loader loads packages and their dependencies from source.
+// Each dependency is loaded once, so that all packages loaded
+// by the same Loader share the objects of the packages they
+// import.
+type Loader struct {
+	Context *build.Context
+	Fset    *token.FileSet
+
+	deps map[string]*Package // dependencies, by import path; nil while loading
+}
+
+// NewLoader returns a loader for the given build context.
+func NewLoader(ctxt *build.Context) *Loader {
+	return &Loader{
+		Context: ctxt,
+		Fset:    token.NewFileSet(),
+		deps:    make(map[string]*Package),
+	}
+}
+
+// Load loads the packages denoted by args, each of which is an import
+// path or a directory; alternatively, all arguments are the names of
+// Go source files in a single directory, possibly with the assembly
+// files of the package. The test files of a directory are included in
+// the package or, for external tests, form a package of their own,
+// whose import path has the suffix "_test". As when the go command
+// builds it, the external test package imports the package under test
+// including its test files.
+func (l *Loader) Load(args []string) ([]*Package, error) {
+	if len(args) > 0 && strings.HasSuffix(args[0], ".go") {
+		return l.loadFiles(args)
+	}
+	var pkgs []*Package
+	for _, arg := range args {
+		var bp *build.Package
+		var err error
+		if fi, serr := os.Stat(arg); serr == nil && fi.IsDir() {
+			bp, err = l.Context.ImportDir(arg, 0)
+		} else {
+			bp, err = l.Context.Import(arg, ".", 0)
+		}
+		if err != nil {
+			return nil, err
+		}
+		names := stringList(bp.GoFiles, bp.CgoFiles, bp.TestGoFiles)
+		other := stringList(bp.CFiles, bp.HFiles, bp.SFiles, bp.SwigFiles, bp.SwigCXXFiles, bp.SysoFiles)
+		pkg := l.check(bp.ImportPath, bp.Dir, names, other, true)
+		pkgs = append(pkgs, pkg)
+		if len(bp.XTestGoFiles) > 0 {
+			pkgs = append(pkgs, l.xtest(pkg).check(bp.ImportPath+"_test", bp.Dir, bp.XTestGoFiles, nil, true))
+		}
+	}
+	return pkgs, nil
+}
+
+// loadFiles loads the package formed by the Go source files named by
+// args, and the external test package formed by some of them, if any.
+// Assembly files among args are recorded as other files of the package.
+func (l *Loader) loadFiles(args []string) ([]*Package, error) {
+	dir := filepath.Dir(args[0])
+	var names, xtest, other []string
+	for _, arg := range args {
+		if filepath.Dir(arg) != dir {
+			return nil, fmt.Errorf("named files must all be in one directory; have %s and %s", dir, filepath.Dir(arg))
+		}
+		if strings.HasSuffix(arg, ".s") {
+			other = append(other, filepath.Base(arg))
+			continue
+		}
+		if !strings.HasSuffix(arg, ".go") {
+			return nil, fmt.Errorf("named files must be .go or .s files: %s", arg)
+		}
+		f, err := parser.ParseFile(l.Fset, arg, nil, parser.PackageClauseOnly)
+		if err == nil && strings.HasSuffix(arg, "_test.go") && strings.HasSuffix(f.Name.Name, "_test") {
+			xtest = append(xtest, filepath.Base(arg))
+			continue
+		}
+		names = append(names, filepath.Base(arg))
+	}
+
+	// The go command names files relative to the current directory;
+	// the import path is found from the absolute directory name.
+	path := "command-line-arguments"
+	if abs, err := filepath.Abs(dir); err == nil {
+		if bp, err := l.Context.ImportDir(abs, build.FindOnly); err == nil && bp.ImportPath != "." {
+			path = bp.ImportPath
+		}
+	}
+	var pkgs []*Package
+	xl := l
+	if len(names) > 0 {
+		pkg := l.check(path, dir, names, other, true)
+		pkgs = append(pkgs, pkg)
+		xl = l.xtest(pkg)
+	}
+	if len(xtest) > 0 {
+		pkgs = append(pkgs, xl.check(path+"_test", dir, xtest, nil, true))
+	}
+	return pkgs, nil
+}
+
+// check parses the named files in dir and type-checks them as the
+// package with the given import path, loading its dependencies.
+func (l *Loader) check(path, dir string, names, other []string, withComments bool) *Package {
+	pkg := &Package{
+		ImportPath: path,
+		Dir:        dir,
+		Fset:       l.Fset,
+		TypesInfo: &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Defs:       make(map[*ast.Ident]types.Object),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Implicits:  make(map[ast.Node]types.Object),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+			Scopes:     make(map[ast.Node]*types.Scope),
+		},
+	}
+	mode := parser.Mode(0)
+	if withComments {
+		mode = parser.ParseComments
+	}
+	for _, name := range names {
+		f, err := parser.ParseFile(l.Fset, filepath.Join(dir, name), nil, mode)
+		if err != nil {
+			pkg.Errors = append(pkg.Errors, err)
+		}
+		if f != nil {
+			pkg.Files = append(pkg.Files, f)
+		}
+	}
+	for _, name := range other {
+		pkg.OtherFiles = append(pkg.OtherFiles, filepath.Join(dir, name))
+	}
+
+	imports := make(map[*Package]bool)
+	conf := types.Config{
+		FakeImportC: true,
+		Error: func(err error) {
+			pkg.Errors = append(pkg.Errors, err)
+		},
+		Importer: importerFunc(func(ipath string) (*types.Package, error) {
+			dep, err := l.importPkg(ipath, dir)
+			if err != nil {
+				return nil, err
+			}
+			if dep.Types != types.Unsafe {
+				imports[dep] = true
+			}
+			return dep.Types, nil
+		}),
+	}
+	pkg.Types, _ = conf.Check(path, l.Fset, pkg.Files, pkg.TypesInfo)
+
+	for dep := range imports {
+		pkg.Imports = append(pkg.Imports, dep)
+	}
+	sort.Sort(byPath(pkg.Imports))
+	return pkg
+}
+
+// importPkg loads the package imported by path from a file in srcDir.
+func (l *Loader) importPkg(path, srcDir string) (*Package, error) {
+	if path == "unsafe" {
+		return &Package{ImportPath: path, Types: types.Unsafe}, nil
+	}
+	bp, err := l.Context.Import(path, srcDir, 0)
+	if err != nil {
+		return nil, err
+	}
+	if dep, ok := l.deps[bp.ImportPath]; ok {
+		if dep == nil {
+			return nil, fmt.Errorf("import cycle through package %q", bp.ImportPath)
+		}
+		return dep, nil
+	}
+	l.deps[bp.ImportPath] = nil
+	dep := l.check(bp.ImportPath, bp.Dir, stringList(bp.GoFiles, bp.CgoFiles), nil, false)
+	l.deps[bp.ImportPath] = dep
+	return dep, nil
+}
+
+// xtest returns a loader for the external test package of pkg, which
+// imports pkg in place of the package with the same import path.
+// It shares the dependencies loaded by l, except for those that import
+// the package under test, directly or indirectly; these are loaded
+// again to import pkg too.
+func (l *Loader) xtest(pkg *Package) *Loader {
+	xl := &Loader{
+		Context: l.Context,
+		Fset:    l.Fset,
+		deps:    map[string]*Package{pkg.ImportPath: pkg},
+	}
+	imports := make(map[*Package]bool)
+	for path, dep := range l.deps {
+		if dep != nil && path != pkg.ImportPath && !importsPath(dep, pkg.ImportPath, imports) {
+			xl.deps[path] = dep
+		}
+	}
+	return xl
+}
+
+// importsPath reports whether pkg imports the package with the given
+// path, directly or indirectly. The results for the packages visited
+// are recorded in memo.
+func importsPath(pkg *Package, path string, memo map[*Package]bool) bool {
+	if imports, ok := memo[pkg]; ok {
+		return imports
+	}
+	memo[pkg] = false // break cycles, which the type checker reports
+	for _, dep := range pkg.Imports {
+		if dep.ImportPath == path || importsPath(dep, path, memo) {
+			memo[pkg] = true
+			return true
+		}
+	}
+	return false
+}
+
+// importerFunc adapts a function to the types.Importer interface.
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+type byPath []*Package
+
+func (s byPath) Len() int           { return len(s) }
+func (s byPath) Less(i, j int) bool { return s[i].ImportPath < s[j].ImportPath }
+func (s byPath) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// stringList returns the concatenation of the lists.
+func stringList(lists ...[]string) []string {
+	var x []string
+	for _, list := range lists {
+		x = append(x, list...)
+	}
+	return x
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package checker
+
+import (
+	"go/build"
+	"path/filepath"
+	"testing"
+)
+
+func testLoader(t *testing.T) *Loader {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctxt := build.Default
+	ctxt.GOPATH = gopath
+	ctxt.CgoEnabled = false
+	return NewLoader(&ctxt)
+}
+
+// checkLoad checks that pkgs are the package named path and its
+// external test package, type-checked without errors.
+func checkLoad(t *testing.T, pkgs []*Package, path string) {
+	want := []string{path, path + "_test"}
+	if len(pkgs) != len(want) {
+		t.Fatalf("loaded %v, want %v", pkgs, want)
+	}
+	for i, pkg := range pkgs {
+		if pkg.ImportPath != want[i] {
+			t.Errorf("loaded %v, want %v", pkgs, want)
+		}
+		for _, err := range pkg.Errors {
+			t.Errorf("%s: %v", pkg, err)
+		}
+	}
+	for _, dep := range pkgs[1].Imports {
+		if dep == pkgs[0] {
+			return
+		}
+	}
+	t.Errorf("%s does not import the package under test", pkgs[1])
+}
+
+// The external test package must see the names declared by
+// the test files of the package under test.
+func TestLoadXTest(t *testing.T) {
+	pkgs, err := testLoader(t).Load([]string{"xtest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkLoad(t, pkgs, "xtest")
+	if other := pkgs[0].OtherFiles; len(other) != 1 || filepath.Base(other[0]) != "x.s" {
+		t.Errorf("other files of xtest = %v, want [x.s]", other)
+	}
+}
+
+// Same, for the files named on the command line, as by go vet.
+func TestLoadXTestFiles(t *testing.T) {
+	dir := filepath.Join("testdata", "src", "xtest")
+	var args []string
+	for _, name := range []string{"export_test.go", "x.go", "x_test.go", "x.s"} {
+		args = append(args, filepath.Join(dir, name))
+	}
+	pkgs, err := testLoader(t).Load(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkLoad(t, pkgs, "xtest")
+	if other := pkgs[0].OtherFiles; len(other) != 1 || filepath.Base(other[0]) != "x.s" {
+		t.Errorf("other files of xtest = %v, want [x.s]", other)
+	}
+}
+
+// Same, for a standard package with an export_test.go file.
+func TestLoadXTestStd(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	pkgs, err := testLoader(t).Load([]string{"sync"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkLoad(t, pkgs, "sync")
+}
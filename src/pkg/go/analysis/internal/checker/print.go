@@ -0,0 +1,235 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package checker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/analysis"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// PrintText prints the diagnostics of the actions to w, one per line,
+// sorted by position, followed by the suggested fixes if verbose is set.
+// It returns the number of diagnostics printed.
+func PrintText(w io.Writer, roots []*Action, verbose bool) int {
+	n := 0
+	for _, act := range roots {
+		if act.Err != nil {
+			continue
+		}
+		fset := act.Pkg.Fset
+		diags := sortDiagnostics(fset, act.Diagnostics)
+		for _, d := range diags {
+			fmt.Fprintf(w, "%s: %s\n", fset.Position(d.Pos), d.Message)
+			if verbose {
+				for _, fix := range d.SuggestedFixes {
+					fmt.Fprintf(w, "\tfix: %s\n", fix.Message)
+				}
+			}
+			n++
+		}
+	}
+	return n
+}
+
+// sortDiagnostics returns a copy of the diagnostics sorted by position,
+// with duplicates removed.
+func sortDiagnostics(fset *token.FileSet, list []analysis.Diagnostic) []analysis.Diagnostic {
+	diags := make([]analysis.Diagnostic, len(list))
+	copy(diags, list)
+	sort.Sort(byPosition{fset, diags})
+	var out []analysis.Diagnostic
+	for i, d := range diags {
+		if i > 0 && d.Pos == diags[i-1].Pos && d.Message == diags[i-1].Message {
+			continue
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+type byPosition struct {
+	fset  *token.FileSet
+	diags []analysis.Diagnostic
+}
+
+func (s byPosition) Len() int      { return len(s.diags) }
+func (s byPosition) Swap(i, j int) { s.diags[i], s.diags[j] = s.diags[j], s.diags[i] }
+func (s byPosition) Less(i, j int) bool {
+	p, q := s.fset.Position(s.diags[i].Pos), s.fset.Position(s.diags[j].Pos)
+	if p.Filename != q.Filename {
+		return p.Filename < q.Filename
+	}
+	if p.Offset != q.Offset {
+		return p.Offset < q.Offset
+	}
+	return s.diags[i].Message < s.diags[j].Message
+}
+
+// JSON output types.
+type (
+	jsonTextEdit struct {
+		Filename string `json:"filename"`
+		Start    int    `json:"start"`
+		End      int    `json:"end"`
+		New      string `json:"new"`
+	}
+
+	jsonSuggestedFix struct {
+		Message string         `json:"message"`
+		Edits   []jsonTextEdit `json:"edits"`
+	}
+
+	jsonDiagnostic struct {
+		Category       string             `json:"category,omitempty"`
+		Posn           string             `json:"posn"`
+		Message        string             `json:"message"`
+		SuggestedFixes []jsonSuggestedFix `json:"suggested_fixes,omitempty"`
+	}
+
+	jsonError struct {
+		Err string `json:"error"`
+	}
+)
+
+// PrintJSON prints the diagnostics of the actions to w as a JSON
+// object mapping each package's import path to an object mapping
+// analyzer names to lists of diagnostics, or to an error.
+// Offsets in text edits are byte offsets in the files.
+func PrintJSON(w io.Writer, roots []*Action) error {
+	tree := make(map[string]map[string]interface{})
+	for _, act := range roots {
+		m, ok := tree[act.Pkg.ImportPath]
+		if !ok {
+			m = make(map[string]interface{})
+			tree[act.Pkg.ImportPath] = m
+		}
+		if act.Err != nil {
+			m[act.Analyzer.Name] = jsonError{act.Err.Error()}
+			continue
+		}
+		fset := act.Pkg.Fset
+		var diags []jsonDiagnostic
+		for _, d := range sortDiagnostics(fset, act.Diagnostics) {
+			var fixes []jsonSuggestedFix
+			for _, fix := range d.SuggestedFixes {
+				var edits []jsonTextEdit
+				for _, edit := range fix.TextEdits {
+					start := fset.Position(edit.Pos)
+					edits = append(edits, jsonTextEdit{
+						Filename: start.Filename,
+						Start:    start.Offset,
+						End:      fset.Position(edit.End).Offset,
+						New:      string(edit.NewText),
+					})
+				}
+				fixes = append(fixes, jsonSuggestedFix{Message: fix.Message, Edits: edits})
+			}
+			diags = append(diags, jsonDiagnostic{
+				Category:       d.Category,
+				Posn:           fset.Position(d.Pos).String(),
+				Message:        d.Message,
+				SuggestedFixes: fixes,
+			})
+		}
+		if diags != nil {
+			m[act.Analyzer.Name] = diags
+		}
+	}
+
+	data, err := json.MarshalIndent(tree, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// An edit is a TextEdit in terms of file offsets.
+type edit struct {
+	start, end int
+	text       string
+}
+
+type byStart []edit
+
+func (s byStart) Len() int      { return len(s) }
+func (s byStart) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byStart) Less(i, j int) bool {
+	if s[i].start != s[j].start {
+		return s[i].start < s[j].start
+	}
+	return s[i].end < s[j].end
+}
+
+// ApplyFixes applies the suggested fixes of the diagnostics of the
+// actions to the files they refer to. Identical edits are applied
+// once; a fix that overlaps with another one is an error, and no
+// changes are made to the file concerned.
+func ApplyFixes(roots []*Action) error {
+	edits := make(map[string][]edit) // by file name
+	for _, act := range roots {
+		fset := act.Pkg.Fset
+		for _, d := range act.Diagnostics {
+			for _, fix := range d.SuggestedFixes {
+				for _, e := range fix.TextEdits {
+					start, end := fset.Position(e.Pos), fset.Position(e.End)
+					if !e.End.IsValid() {
+						end = start
+					}
+					edits[start.Filename] = append(edits[start.Filename], edit{start.Offset, end.Offset, string(e.NewText)})
+				}
+			}
+		}
+	}
+
+	var firstErr error
+	for filename, list := range edits {
+		sort.Sort(byStart(list))
+		var unique []edit
+		for i, e := range list {
+			if i > 0 && e == list[i-1] {
+				continue
+			}
+			unique = append(unique, e)
+		}
+
+		src, err := ioutil.ReadFile(filename)
+		if err == nil {
+			src, err = applyEdits(filename, src, unique)
+		}
+		if err == nil {
+			err = ioutil.WriteFile(filename, src, 0644)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// applyEdits applies the sorted, non-overlapping edits to src.
+func applyEdits(filename string, src []byte, edits []edit) ([]byte, error) {
+	var buf bytes.Buffer
+	last := 0
+	for _, e := range edits {
+		if e.start < last {
+			return nil, fmt.Errorf("%s: conflicting fixes at offset %d", filename, e.start)
+		}
+		if e.end > len(src) || e.start > e.end {
+			return nil, fmt.Errorf("%s: invalid fix at offset %d", filename, e.start)
+		}
+		buf.Write(src[last:e.start])
+		buf.WriteString(e.text)
+		last = e.end
+	}
+	buf.Write(src[last:])
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,3 @@
+package xtest
+
+var Add = add
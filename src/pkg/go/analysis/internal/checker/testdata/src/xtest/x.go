@@ -0,0 +1,5 @@
+// Package xtest has an external test that uses a name
+// declared by the test files of the package itself.
+package xtest
+
+func add(x, y int) int { return x + y }
@@ -0,0 +1 @@
+// Assembly files are passed to vet along with the Go files.
@@ -0,0 +1,12 @@
+package xtest_test
+
+import (
+	"testing"
+	"xtest"
+)
+
+func TestAdd(t *testing.T) {
+	if xtest.Add(1, 2) != 3 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multichecker defines the main function for an analysis driver
+// with several analyzers. It is the basis of cmd/vet, and makes it
+// easy to build a custom checker from a set of analyzers:
+//
+//	package main
+//
+//	import (
+//		"go/analysis/multichecker"
+//		"go/analysis/passes/printf"
+//		"example.com/lint/mycheck"
+//	)
+//
+//	func main() { multichecker.Main(printf.Analyzer, mycheck.Analyzer) }
+//
+// The resulting program accepts import paths, directories, or the
+// names of Go source files in a single directory, and analyzes the
+// packages they denote, including their tests. Packages are loaded
+// from source using the default build context.
+//
+// By default all analyzers are run. Each analyzer's name is a boolean
+// flag: enabling some analyzers, as in -printf, runs only those;
+// disabling some, as in -printf=false, runs all the others. The flags
+// of an analyzer are prefixed by its name, as in -printf.funcs.
+//
+// Diagnostics are printed to standard error, one per line, and the
+// exit status is 1 if there were any. With the -json flag, they are
+// printed to standard output as a JSON object instead, including the
+// suggested fixes, and the exit status is 0. The -fix flag applies
+// the suggested fixes to the source files.
+//
+package multichecker
+
+import (
+	"flag"
+	"fmt"
+	"go/analysis"
+	"go/analysis/internal/checker"
+	"go/build"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	jsonFlag = flag.Bool("json", false, "emit JSON output")
+	fixFlag  = flag.Bool("fix", false, "apply all suggested fixes")
+	verbose  = flag.Bool("v", false, "verbose: print suggested fixes and analysis errors")
+)
+
+// triState is a boolean flag that records whether it was set.
+type triState int
+
+const (
+	unset triState = iota
+	setTrue
+	setFalse
+)
+
+func (ts *triState) Get() interface{} { return *ts == setTrue }
+
+func (ts *triState) Set(value string) error {
+	switch value {
+	case "true":
+		*ts = setTrue
+	case "false":
+		*ts = setFalse
+	default:
+		return fmt.Errorf("want true or false")
+	}
+	return nil
+}
+
+func (ts *triState) String() string {
+	if *ts == setFalse {
+		return "false"
+	}
+	return "true"
+}
+
+func (ts triState) IsBoolFlag() bool { return true }
+
+// Main is the main function of a checker command for the analyzers.
+// It parses the command line, runs the enabled analyzers and exits.
+func Main(analyzers ...*analysis.Analyzer) {
+	progname := filepath.Base(os.Args[0])
+
+	if err := analysis.Validate(analyzers); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", progname, err)
+		os.Exit(1)
+	}
+
+	enabled := registerFlags(analyzers)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [flags] [packages | files]\n\n", progname)
+		fmt.Fprintf(os.Stderr, "%s reports suspicious constructs in Go packages.\nRegistered analyzers:\n\n", progname)
+		names := make([]string, len(analyzers))
+		byName := make(map[string]*analysis.Analyzer)
+		for i, a := range analyzers {
+			names[i] = a.Name
+			byName[a.Name] = a
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			title := strings.SplitN(byName[name].Doc, "\n", 2)[0]
+			fmt.Fprintf(os.Stderr, "\t%-12s %s\n", name, title)
+		}
+		fmt.Fprintf(os.Stderr, "\nFlags:\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+	}
+
+	analyzers = filter(analyzers, enabled)
+	os.Exit(run(progname, flag.Args(), analyzers))
+}
+
+// registerFlags defines the flags of the analyzers and returns the
+// flags that enable them, by analyzer.
+func registerFlags(analyzers []*analysis.Analyzer) map[*analysis.Analyzer]*triState {
+	enabled := make(map[*analysis.Analyzer]*triState)
+	for _, a := range analyzers {
+		title := strings.SplitN(a.Doc, "\n", 2)[0]
+		enable := new(triState)
+		flag.Var(enable, a.Name, "enable "+a.Name+" analysis: "+title)
+		enabled[a] = enable
+
+		prefix := a.Name + "."
+		a.Flags.VisitAll(func(f *flag.Flag) {
+			flag.Var(f.Value, prefix+f.Name, f.Usage)
+		})
+	}
+	return enabled
+}
+
+// filter returns the analyzers selected by the enabling flags: those
+// explicitly enabled if there are any, otherwise all those that were
+// not explicitly disabled.
+func filter(analyzers []*analysis.Analyzer, enabled map[*analysis.Analyzer]*triState) []*analysis.Analyzer {
+	var on, notOff []*analysis.Analyzer
+	for _, a := range analyzers {
+		switch *enabled[a] {
+		case setTrue:
+			on = append(on, a)
+		case unset:
+			notOff = append(notOff, a)
+		}
+	}
+	if on != nil {
+		return on
+	}
+	return notOff
+}
+
+func run(progname string, args []string, analyzers []*analysis.Analyzer) (exitcode int) {
+	loader := checker.NewLoader(&build.Default)
+	pkgs, err := loader.Load(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", progname, err)
+		return 1
+	}
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", progname, err)
+			exitcode = 1
+		}
+	}
+
+	roots := checker.Analyze(pkgs, analyzers)
+
+	if *fixFlag {
+		if err := checker.ApplyFixes(roots); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", progname, err)
+			exitcode = 1
+		}
+	}
+
+	if *jsonFlag {
+		if err := checker.PrintJSON(os.Stdout, roots); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", progname, err)
+			return 1
+		}
+		return exitcode
+	}
+
+	if *verbose {
+		for _, act := range roots {
+			if act.Err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s: %v\n", progname, act, act.Err)
+			}
+		}
+	}
+	if checker.PrintText(os.Stderr, roots, *verbose) > 0 {
+		exitcode = 1
+	}
+	return exitcode
+}
@@ -0,0 +1,16 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package copylock_test
+
+import (
+	"go/analysis/analysistest"
+	"go/analysis/passes/copylock"
+	"testing"
+)
+
+func TestAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, copylock.Analyzer, "a")
+}
@@ -0,0 +1,113 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the copylocks checker.
+
+package a
+
+import "sync"
+
+type Tlock struct {
+	once sync.Once
+	mu   sync.Mutex
+}
+
+type embedded struct {
+	sync.Mutex
+}
+
+type iface struct {
+	sync.Locker // an interface, safe to copy
+}
+
+func OkFunc() {
+	var x *sync.Mutex
+	p := x
+	var y sync.Mutex
+	p = &y
+
+	var z = sync.Mutex{}
+	w := sync.Mutex{}
+	w = sync.Mutex{}
+	q := struct{ L sync.Mutex }{
+		L: sync.Mutex{},
+	}
+
+	yy := []Tlock{
+		Tlock{},
+		Tlock{
+			once: sync.Once{},
+		},
+	}
+
+	var i iface
+	j := i
+	_, _, _, _, _, _ = p, &z, &w, &q, yy, j
+}
+
+func BadFunc() {
+	var x *sync.Mutex
+	p := x
+	var y sync.Mutex
+	p = &y
+	*p = *x // want `assignment copies lock value to \*p: sync.Mutex`
+
+	var t Tlock
+	var tp *Tlock
+	tp = &t
+	*tp = t // want `assignment copies lock value to \*tp: a.Tlock contains sync.Once contains sync.Mutex`
+	t = *tp // want "assignment copies lock value to t: a.Tlock contains sync.Once contains sync.Mutex"
+
+	yy := *x  // want "assignment copies lock value to yy: sync.Mutex"
+	var z = t // want "variable declaration copies lock value to z: a.Tlock contains sync.Once contains sync.Mutex"
+
+	w := struct{ L sync.Mutex }{
+		L: *x, // want `literal copies lock value from \*x: sync.Mutex`
+	}
+	var q = map[int]Tlock{
+		1: t,   // want "literal copies lock value from t: a.Tlock contains sync.Once contains sync.Mutex"
+		2: *tp, // want `literal copies lock value from \*tp: a.Tlock contains sync.Once contains sync.Mutex`
+	}
+	var e embedded
+	f := e // want "assignment copies lock value to f: a.embedded"
+	_, _, _, _, _ = &yy, &z, &w, q, &f
+}
+
+func ByValue(mu sync.Mutex) {} // want "ByValue passes lock by value: sync.Mutex"
+
+func (t Tlock) Method() {} // want "Method passes lock by value: a.Tlock contains sync.Once contains sync.Mutex"
+
+func ByPointer(mu *sync.Mutex) {}
+
+func ReturnLock(t *Tlock) Tlock {
+	return *t // want `return copies lock value: a.Tlock contains sync.Once contains sync.Mutex`
+}
+
+func ZeroLock() Tlock {
+	return Tlock{}
+}
+
+func Calls(mu *sync.Mutex, wg *sync.WaitGroup) {
+	ByPointer(mu)
+	ByValue(*mu) // want `call of ByValue copies lock value: sync.Mutex`
+	_ = new(sync.Mutex)
+	consume(*wg)                // want `call of consume copies lock value: sync.WaitGroup contains sync.Mutex`
+	fn := func(m sync.Mutex) {} // want "func passes lock by value: sync.Mutex"
+	_ = fn
+}
+
+func consume(interface{}) {}
+
+func Range(locks []sync.Mutex, arr *[2]Tlock) {
+	for _, m := range locks { // want "range var m copies lock: sync.Mutex"
+		_ = &m
+	}
+	for i := range locks {
+		_ = i
+	}
+	var t Tlock
+	for _, t = range arr { // want "range var t copies lock: a.Tlock contains sync.Once contains sync.Mutex"
+	}
+	_ = &t
+}
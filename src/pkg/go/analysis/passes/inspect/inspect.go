@@ -0,0 +1,166 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package inspect defines an Analyzer that provides an Inspector
+// for the syntax trees of a package. It is only a building block
+// for other analyzers, which traverse the trees through the
+// Inspector rather than each walking them on their own:
+//
+//	var Analyzer = &analysis.Analyzer{
+//		...
+//		Requires: []*analysis.Analyzer{inspect.Analyzer},
+//	}
+//
+//	func run(pass *analysis.Pass) (interface{}, error) {
+//		inspect := pass.ResultOf[inspect.Analyzer].(*inspect.Inspector)
+//		inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+//			call := n.(*ast.CallExpr)
+//			...
+//		})
+//		...
+//	}
+//
+package inspect
+
+import (
+	"go/analysis"
+	"go/ast"
+	"reflect"
+)
+
+// Analyzer provides an Inspector for the package's syntax trees.
+var Analyzer = &analysis.Analyzer{
+	Name:             "inspect",
+	Doc:              "optimize AST traversal for later passes",
+	Run:              run,
+	RunDespiteErrors: true,
+	ResultType:       reflect.TypeOf(new(Inspector)),
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	return New(pass.Files), nil
+}
+
+// An Inspector provides traversals over the syntax trees of a package.
+// The trees are walked once, when the Inspector is created; each
+// traversal then visits the recorded nodes without reflection or
+// recursion.
+type Inspector struct {
+	events []event
+}
+
+// An event records the entry to (index > i) or exit from
+// (index < 0) a node during the traversal.
+type event struct {
+	node  ast.Node
+	typ   reflect.Type
+	index int // index of the matching push or pop event
+}
+
+// New returns an Inspector for the specified syntax trees.
+func New(files []*ast.File) *Inspector {
+	var events []event
+	var stack []int // indexes of the push events of the current path
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if n != nil {
+				stack = append(stack, len(events))
+				events = append(events, event{node: n, typ: reflect.TypeOf(n)})
+				return true
+			}
+			// pop
+			push := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			events[push].index = len(events)
+			events = append(events, event{node: events[push].node, index: -1 - push})
+			return true
+		})
+	}
+	return &Inspector{events}
+}
+
+// typeSet returns the set of the dynamic types of the nodes in types,
+// or nil, which matches all types, if types is empty.
+func typeSet(types []ast.Node) map[reflect.Type]bool {
+	if len(types) == 0 {
+		return nil
+	}
+	set := make(map[reflect.Type]bool)
+	for _, n := range types {
+		set[reflect.TypeOf(n)] = true
+	}
+	return set
+}
+
+// Preorder visits all the nodes of the files supplied to New in
+// depth-first order. It calls f(n) for each node n before it visits
+// n's children. If types is non-empty, only nodes whose type matches
+// an element of types are passed to f; the elements are typically
+// nil pointers of the desired node types.
+func (in *Inspector) Preorder(types []ast.Node, f func(ast.Node)) {
+	set := typeSet(types)
+	for _, ev := range in.events {
+		if ev.index > 0 && (set == nil || set[ev.typ]) {
+			f(ev.node)
+		}
+	}
+}
+
+// Nodes visits the nodes of the files supplied to New in depth-first
+// order. It calls f(n, true) for each node n before it visits n's
+// children. If f returns true, Nodes invokes f recursively for each
+// of the non-nil children of the node, followed by a call of
+// f(n, false). The types argument filters the nodes passed to f as
+// in Preorder; the children of nodes not passed to f are visited.
+func (in *Inspector) Nodes(types []ast.Node, f func(n ast.Node, push bool) (proceed bool)) {
+	set := typeSet(types)
+	for i := 0; i < len(in.events); i++ {
+		ev := in.events[i]
+		if ev.index > 0 {
+			// push
+			if set == nil || set[ev.typ] {
+				if !f(ev.node, true) {
+					i = ev.index // jump to corresponding pop + 1
+					continue
+				}
+			}
+		} else {
+			// pop
+			push := in.events[-1-ev.index]
+			if set == nil || set[push.typ] {
+				f(ev.node, false)
+			}
+		}
+	}
+}
+
+// WithStack visits nodes in a similar manner to Nodes, but it
+// supplies each call to f an additional argument, the current
+// traversal stack. The stack's first element is the outermost node,
+// an *ast.File; its last is the innermost, n.
+func (in *Inspector) WithStack(types []ast.Node, f func(n ast.Node, push bool, stack []ast.Node) (proceed bool)) {
+	set := typeSet(types)
+	var stack []ast.Node
+	for i := 0; i < len(in.events); i++ {
+		ev := in.events[i]
+		if ev.index > 0 {
+			// push
+			stack = append(stack, ev.node)
+			if set == nil || set[ev.typ] {
+				if !f(ev.node, true, stack) {
+					i = ev.index
+					stack = stack[:len(stack)-1]
+					continue
+				}
+			}
+		} else {
+			// pop
+			push := in.events[-1-ev.index]
+			if set == nil || set[push.typ] {
+				f(ev.node, false, stack)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
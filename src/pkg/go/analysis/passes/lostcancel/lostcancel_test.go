@@ -0,0 +1,16 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lostcancel_test
+
+import (
+	"go/analysis/analysistest"
+	"go/analysis/passes/lostcancel"
+	"testing"
+)
+
+func TestAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, lostcancel.Analyzer, "a")
+}
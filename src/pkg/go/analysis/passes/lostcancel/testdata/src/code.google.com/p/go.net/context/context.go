@@ -0,0 +1,24 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package context is a stub of the context package for the tests.
+package context
+
+import "time"
+
+type Context interface {
+	Done() <-chan struct{}
+}
+
+type CancelFunc func()
+
+func Background() Context { return nil }
+
+func WithCancel(parent Context) (Context, CancelFunc) { return nil, nil }
+
+func WithDeadline(parent Context, d time.Time) (Context, CancelFunc) { return nil, nil }
+
+func WithTimeout(parent Context, d time.Duration) (Context, CancelFunc) { return nil, nil }
+
+func WithValue(parent Context, key, val interface{}) Context { return nil }
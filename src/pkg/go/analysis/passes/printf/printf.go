@@ -0,0 +1,866 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package printf defines an Analyzer that checks consistency
+// of Printf format strings and arguments.
+package printf
+
+import (
+	"bytes"
+	"fmt"
+	"go/analysis"
+	"go/analysis/passes/inspect"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const Doc = `check consistency of Printf format strings and arguments
+
+The check applies to calls of the formatting functions such as
+fmt.Printf and fmt.Sprintf, as well as any detected wrappers of
+those functions. In this example, the %d format operator requires
+an integer operand:
+
+	fmt.Printf("%d", "hello") // Printf format %d has arg "hello" of wrong type string
+
+Calls of the print functions such as fmt.Println are checked for
+possible formatting directives and redundant newlines.
+
+A function that passes its final ...interface{} parameter, and a
+preceding format string parameter if any, to a formatting or print
+function is itself a wrapper of that kind, whose calls are checked
+in turn; wrappers are detected across packages. The -funcs flag
+names further functions to check, as in -funcs=Warn,log.Warnf: names
+ending in f are formatting functions, others print functions.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "printf",
+	Doc:       Doc,
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(isWrapper)},
+}
+
+func init() {
+	Analyzer.Flags.Var(isPrint, "funcs", "comma-separated list of print function names to check")
+}
+
+// Kind is the kind of a print function.
+type Kind int
+
+const (
+	KindNone   Kind = iota // not a print wrapper
+	KindPrintf             // function behaves like fmt.Printf
+	KindPrint              // function behaves like fmt.Print
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindPrintf:
+		return "printfWrapper"
+	case KindPrint:
+		return "printWrapper"
+	}
+	return "none"
+}
+
+// isWrapper is a fact indicating that a function is a print or
+// printf wrapper.
+type isWrapper struct{ Kind Kind }
+
+func (f *isWrapper) AFact() {}
+
+func (f *isWrapper) String() string { return f.Kind.String() }
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	findPrintfLike(pass)
+	checkCalls(pass)
+	return nil, nil
+}
+
+// A wrapper is a function that may forward its final ...interface{}
+// parameter args, and its preceding string parameter format, if any,
+// to a print or printf function.
+type wrapper struct {
+	obj    *types.Func
+	fdecl  *ast.FuncDecl
+	format *types.Var // optional "format string" parameter
+	args   *types.Var // "args ...interface{}" parameter
+}
+
+// maybePrintfWrapper returns the wrapper described by decl,
+// or nil if it doesn't have the signature of one.
+func maybePrintfWrapper(info *types.Info, decl *ast.FuncDecl) *wrapper {
+	if decl.Body == nil {
+		return nil
+	}
+	fn, ok := info.Defs[decl.Name].(*types.Func)
+	if !ok {
+		return nil
+	}
+	sig := fn.Type().(*types.Signature)
+	if !sig.Variadic() {
+		return nil
+	}
+	params := sig.Params()
+	nparams := params.Len()
+	args := params.At(nparams - 1)
+	iface, ok := args.Type().(*types.Slice).Elem().(*types.Interface)
+	if !ok || !iface.Empty() {
+		return nil // final parameter is not ...interface{}
+	}
+	var format *types.Var
+	if nparams >= 2 {
+		if p := params.At(nparams - 2); p.Type() == types.Typ[types.String] {
+			format = p
+		}
+	}
+	return &wrapper{obj: fn, fdecl: decl, format: format, args: args}
+}
+
+// findPrintfLike scans the package for functions that are
+// wrappers of print functions and exports facts for them.
+func findPrintfLike(pass *analysis.Pass) {
+	var wrappers []*wrapper
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fdecl, ok := decl.(*ast.FuncDecl); ok {
+				if w := maybePrintfWrapper(pass.TypesInfo, fdecl); w != nil {
+					wrappers = append(wrappers, w)
+				}
+			}
+		}
+	}
+
+	// Wrappers may call each other in any order;
+	// iterate until no more wrappers are found.
+	found := make(map[*wrapper]Kind)
+	for changed := true; changed; {
+		changed = false
+		for _, w := range wrappers {
+			if found[w] != KindNone {
+				continue
+			}
+			if kind := w.forwards(pass); kind != KindNone {
+				found[w] = kind
+				pass.ExportObjectFact(w.obj, &isWrapper{Kind: kind})
+				changed = true
+			}
+		}
+	}
+}
+
+// forwards returns the kind of print function to which w forwards
+// its arguments, or KindNone.  A function that assigns to its
+// arguments, for example to format some of them itself, forwards
+// something else and is not a wrapper.
+func (w *wrapper) forwards(pass *analysis.Pass) Kind {
+	if assigns(pass.TypesInfo, w.fdecl.Body, w.args) {
+		return KindNone
+	}
+	kind := KindNone
+	ast.Inspect(w.fdecl.Body, func(n ast.Node) bool {
+		if kind != KindNone {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false // a closure may not be called at all
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 || !call.Ellipsis.IsValid() || !match(pass.TypesInfo, call.Args[len(call.Args)-1], w.args) {
+			return true
+		}
+		fn := callee(pass.TypesInfo, call)
+		if fn == nil {
+			return true
+		}
+		switch printfFuncKind(pass, fn) {
+		case KindPrintf:
+			// The format must be forwarded too.
+			if w.format != nil && len(call.Args) >= 2 && match(pass.TypesInfo, call.Args[len(call.Args)-2], w.format) {
+				kind = KindPrintf
+			}
+		case KindPrint:
+			kind = KindPrint
+		}
+		return true
+	})
+	return kind
+}
+
+// assigns reports whether body assigns to the parameter param
+// or to one of its elements.
+func assigns(info *types.Info, body *ast.BlockStmt, param *types.Var) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || found {
+			return !found
+		}
+		for _, lhs := range assign.Lhs {
+			if index, ok := unparen(lhs).(*ast.IndexExpr); ok {
+				lhs = index.X
+			}
+			if match(info, unparen(lhs), param) {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// match reports whether arg refers to the parameter param.
+func match(info *types.Info, arg ast.Expr, param *types.Var) bool {
+	id, ok := arg.(*ast.Ident)
+	return ok && info.ObjectOf(id) == param
+}
+
+// callee returns the function or method statically called by call, or nil.
+func callee(info *types.Info, call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil
+	}
+	fn, _ := info.Uses[id].(*types.Func)
+	return fn
+}
+
+func unparen(x ast.Expr) ast.Expr {
+	for {
+		p, ok := x.(*ast.ParenExpr)
+		if !ok {
+			return x
+		}
+		x = p.X
+	}
+}
+
+// isPrint records the print functions, by full name.
+// If a key ends in 'f' then it is assumed to be a formatted print.
+var isPrint = stringSet{
+	"fmt.Errorf":   true,
+	"fmt.Fprint":   true,
+	"fmt.Fprintf":  true,
+	"fmt.Fprintln": true,
+	"fmt.Print":    true,
+	"fmt.Printf":   true,
+	"fmt.Println":  true,
+	"fmt.Sprint":   true,
+	"fmt.Sprintf":  true,
+	"fmt.Sprintln": true,
+
+	"log.Fatal":   true,
+	"log.Fatalf":  true,
+	"log.Fatalln": true,
+	"log.Panic":   true,
+	"log.Panicf":  true,
+	"log.Panicln": true,
+	"log.Print":   true,
+	"log.Printf":  true,
+	"log.Println": true,
+
+	"(*log.Logger).Fatal":   true,
+	"(*log.Logger).Fatalf":  true,
+	"(*log.Logger).Fatalln": true,
+	"(*log.Logger).Panic":   true,
+	"(*log.Logger).Panicf":  true,
+	"(*log.Logger).Panicln": true,
+	"(*log.Logger).Print":   true,
+	"(*log.Logger).Printf":  true,
+	"(*log.Logger).Println": true,
+
+	"(*testing.common).Error":  true,
+	"(*testing.common).Errorf": true,
+	"(*testing.common).Fatal":  true,
+	"(*testing.common).Fatalf": true,
+	"(*testing.common).Log":    true,
+	"(*testing.common).Logf":   true,
+	"(*testing.common).Skip":   true,
+	"(*testing.common).Skipf":  true,
+}
+
+// printfFuncKind returns the kind of print function fn is, if any.
+func printfFuncKind(pass *analysis.Pass, fn *types.Func) Kind {
+	var fact isWrapper
+	if pass.ImportObjectFact(fn, &fact) {
+		return fact.Kind
+	}
+	name := fn.FullName()
+	if !isPrint[name] {
+		// The -funcs flag may name functions by
+		// their name alone, case-insensitively.
+		name = strings.ToLower(fn.Name())
+		if !isPrint[name] {
+			return KindNone
+		}
+	}
+	if strings.HasSuffix(name, "f") {
+		return KindPrintf
+	}
+	return KindPrint
+}
+
+// stringSet is a set of names from a command-line flag.
+// The values of the map are unused.
+type stringSet map[string]bool
+
+func (ss stringSet) String() string {
+	var list []string
+	for name := range ss {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
+
+func (ss stringSet) Set(flag string) error {
+	for _, name := range strings.Split(flag, ",") {
+		if len(name) == 0 {
+			return fmt.Errorf("empty string")
+		}
+		if !strings.Contains(name, ".") {
+			name = strings.ToLower(name)
+		}
+		ss[name] = true
+	}
+	return nil
+}
+
+// checkCalls checks all the calls of print functions in the package.
+func checkCalls(pass *analysis.Pass) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspect.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.CallExpr)(nil),
+	}
+	var fdecl *ast.FuncDecl // innermost enclosing function declaration
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			fdecl = n
+		case *ast.CallExpr:
+			fn := callee(pass.TypesInfo, n)
+			if fn == nil {
+				return
+			}
+			switch printfFuncKind(pass, fn) {
+			case KindPrintf:
+				checkPrintf(pass, n, fn, fdecl)
+			case KindPrint:
+				checkPrint(pass, n, fn)
+			}
+		}
+	})
+}
+
+// formatState holds the parsed representation of a printf directive such as "%3.*[4]d".
+// It is constructed by parsePrintfVerb.
+type formatState struct {
+	verb     rune   // the format verb: 'd' for "%d"
+	format   string // the full format directive from % through verb, "%.3d".
+	name     string // Printf, Sprintf etc.
+	flags    []byte // the list of # + etc.
+	argNums  []int  // the successive argument numbers that are consumed, adjusted to refer to actual arg in call
+	firstArg int    // Index of first argument after the format in the Printf call.
+	// Used only during parse.
+	pass         *analysis.Pass
+	call         *ast.CallExpr
+	argNum       int  // Which argument we're expecting to format now.
+	hasIndex     bool // Whether the argument is indexed.
+	indexPending bool // Whether we have an indexed argument that has not resolved.
+	nbytes       int  // number of bytes of the format string consumed.
+}
+
+// checkPrintf checks a call to a formatted print routine such as Printf.
+func checkPrintf(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func, fdecl *ast.FuncDecl) {
+	idx := fn.Type().(*types.Signature).Params().Len() - 2
+	if idx < 0 || idx >= len(call.Args) {
+		return
+	}
+	tv, ok := pass.TypesInfo.Types[call.Args[idx]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return // format string not constant
+	}
+	format := constant.StringVal(tv.Value)
+	firstArg := idx + 1 // Arguments are immediately after format string.
+	if !strings.Contains(format, "%") {
+		if len(call.Args) > firstArg {
+			pass.Reportf(call.Lparen, "%s call has arguments but no formatting directives", fn.Name())
+		}
+		return
+	}
+	// Hard part: check formats against args.
+	argNum := firstArg
+	maxArgNum := firstArg
+	anyIndex := false
+	for i, w := 0, 0; i < len(format); i += w {
+		w = 1
+		if format[i] != '%' {
+			continue
+		}
+		state := parsePrintfVerb(pass, call, fn.Name(), format[i:], firstArg, argNum)
+		if state == nil {
+			return
+		}
+		w = len(state.format)
+		if !okPrintfArg(pass, call, state, fdecl) { // One error per format is enough.
+			return
+		}
+		if state.hasIndex {
+			anyIndex = true
+		}
+		if len(state.argNums) > 0 {
+			// Continue with the next sequential argument.
+			argNum = state.argNums[len(state.argNums)-1] + 1
+		}
+		for _, n := range state.argNums {
+			if n >= maxArgNum {
+				maxArgNum = n + 1
+			}
+		}
+	}
+	// Dotdotdot is hard.
+	if call.Ellipsis.IsValid() && maxArgNum >= len(call.Args)-1 {
+		return
+	}
+	// If any formats are indexed, extra arguments are ignored.
+	if anyIndex {
+		return
+	}
+	// There should be no leftover arguments.
+	if maxArgNum != len(call.Args) {
+		expect := maxArgNum - firstArg
+		numArgs := len(call.Args) - firstArg
+		pass.Reportf(call.Pos(), "%s call needs %v but has %v", fn.Name(), count(expect, "arg"), count(numArgs, "arg"))
+	}
+}
+
+// parseFlags accepts any printf flags.
+func (s *formatState) parseFlags() {
+	for s.nbytes < len(s.format) {
+		switch c := s.format[s.nbytes]; c {
+		case '#', '0', '+', '-', ' ':
+			s.flags = append(s.flags, c)
+			s.nbytes++
+		default:
+			return
+		}
+	}
+}
+
+// scanNum advances through a decimal number if present.
+func (s *formatState) scanNum() {
+	for ; s.nbytes < len(s.format); s.nbytes++ {
+		c := s.format[s.nbytes]
+		if c < '0' || '9' < c {
+			return
+		}
+	}
+}
+
+// parseIndex scans an index expression. It returns false if there is a syntax error.
+func (s *formatState) parseIndex() bool {
+	if s.nbytes == len(s.format) || s.format[s.nbytes] != '[' {
+		return true
+	}
+	// Argument index present.
+	s.nbytes++ // skip '['
+	start := s.nbytes
+	s.scanNum()
+	ok := true
+	if s.nbytes == len(s.format) || s.nbytes == start || s.format[s.nbytes] != ']' {
+		ok = false // syntax error is either missing "]" or invalid index.
+		s.nbytes = strings.Index(s.format[start:], "]")
+		if s.nbytes < 0 {
+			s.pass.Reportf(s.call.Pos(), "%s format %s is missing closing ]", s.name, s.format)
+			return false
+		}
+		s.nbytes = s.nbytes + start
+	}
+	arg32, err := strconv.ParseInt(s.format[start:s.nbytes], 10, 32)
+	if err != nil || !ok || arg32 <= 0 || arg32 > int64(len(s.call.Args)-s.firstArg) {
+		s.pass.Reportf(s.call.Pos(), "%s format has invalid argument index [%s]", s.name, s.format[start:s.nbytes])
+		return false
+	}
+	s.nbytes++ // skip ']'
+	arg := int(arg32)
+	arg += s.firstArg - 1 // We want to zero-index the actual arguments.
+	s.argNum = arg
+	s.hasIndex = true
+	s.indexPending = true
+	return true
+}
+
+// parseNum scans a width or precision (or *).
+func (s *formatState) parseNum() {
+	if s.nbytes < len(s.format) && s.format[s.nbytes] == '*' {
+		if s.indexPending { // Absorb it.
+			s.indexPending = false
+		}
+		s.nbytes++
+		s.argNums = append(s.argNums, s.argNum)
+		s.argNum++
+	} else {
+		s.scanNum()
+	}
+}
+
+// parsePrecision scans for a precision. It returns false if there's a bad index expression.
+func (s *formatState) parsePrecision() bool {
+	// If there's a period, there may be a precision.
+	if s.nbytes < len(s.format) && s.format[s.nbytes] == '.' {
+		s.flags = append(s.flags, '.') // Treat precision as a flag.
+		s.nbytes++
+		if !s.parseIndex() {
+			return false
+		}
+		s.parseNum()
+	}
+	return true
+}
+
+// parsePrintfVerb looks the formatting directive that begins the format string
+// and returns a formatState that encodes what the directive wants, without looking
+// at the actual arguments present in the call. The result is nil if there is an error.
+func parsePrintfVerb(pass *analysis.Pass, call *ast.CallExpr, name, format string, firstArg, argNum int) *formatState {
+	state := &formatState{
+		format:   format,
+		name:     name,
+		flags:    make([]byte, 0, 5),
+		argNum:   argNum,
+		argNums:  make([]int, 0, 1),
+		nbytes:   1, // There's guaranteed to be a percent sign.
+		firstArg: firstArg,
+		pass:     pass,
+		call:     call,
+	}
+	// There may be flags.
+	state.parseFlags()
+	// There may be an index.
+	if !state.parseIndex() {
+		return nil
+	}
+	// There may be a width.
+	state.parseNum()
+	// There may be a precision.
+	if !state.parsePrecision() {
+		return nil
+	}
+	// Now a verb, possibly prefixed by an index (which we may already have).
+	if !state.indexPending && !state.parseIndex() {
+		return nil
+	}
+	if state.nbytes == len(state.format) {
+		pass.Reportf(call.Pos(), "%s format %s is missing verb at end of string", name, state.format)
+		return nil
+	}
+	verb, w := utf8.DecodeRuneInString(state.format[state.nbytes:])
+	state.verb = verb
+	state.nbytes += w
+	if verb != '%' {
+		state.argNums = append(state.argNums, state.argNum)
+	}
+	state.format = state.format[:state.nbytes]
+	return state
+}
+
+// printfArgType encodes the types of expressions a printf verb accepts. It is a bitmask.
+type printfArgType int
+
+const (
+	argBool printfArgType = 1 << iota
+	argInt
+	argRune
+	argString
+	argFloat
+	argComplex
+	argPointer
+	anyType printfArgType = ^0
+)
+
+type printVerb struct {
+	verb  rune   // User may provide verb through Formatter; could be a rune.
+	flags string // known flags are all ASCII
+	typ   printfArgType
+}
+
+// Common flag sets for printf verbs.
+const (
+	noFlag       = ""
+	numFlag      = " -+.0"
+	sharpNumFlag = " -+.0#"
+	allFlags     = " -+.0#"
+)
+
+// printVerbs identifies which flags are known to printf for each verb.
+var printVerbs = []printVerb{
+	// '-' is a width modifier, always valid.
+	// '.' is a precision for float, max width for strings.
+	// '+' is required sign for numbers, Go format for %v.
+	// '#' is alternate format for several verbs.
+	// ' ' is spacer for numbers
+	{'%', noFlag, 0},
+	{'b', numFlag, argInt | argFloat | argComplex},
+	{'c', "-", argRune | argInt},
+	{'d', numFlag, argInt | argPointer},
+	{'e', sharpNumFlag, argFloat | argComplex},
+	{'E', sharpNumFlag, argFloat | argComplex},
+	{'f', sharpNumFlag, argFloat | argComplex},
+	{'F', sharpNumFlag, argFloat | argComplex},
+	{'g', sharpNumFlag, argFloat | argComplex},
+	{'G', sharpNumFlag, argFloat | argComplex},
+	{'o', sharpNumFlag, argInt | argPointer},
+	{'p', "-#", argPointer},
+	{'q', " -+.0#", argRune | argInt | argString},
+	{'s', " -+.0", argString},
+	{'t', "-", argBool},
+	{'T', "-", anyType},
+	{'U', "-#", argRune | argInt},
+	{'v', allFlags, anyType},
+	{'x', sharpNumFlag, argRune | argInt | argString | argPointer},
+	{'X', sharpNumFlag, argRune | argInt | argString | argPointer},
+}
+
+// okPrintfArg compares the formatState to the arguments actually present,
+// reporting any discrepancies it can discern. If the final argument is ellipsissed,
+// there's little it can do for that.
+func okPrintfArg(pass *analysis.Pass, call *ast.CallExpr, state *formatState, fdecl *ast.FuncDecl) (ok bool) {
+	var v printVerb
+	found := false
+	// Linear scan is fast enough for a small list.
+	for _, v = range printVerbs {
+		if v.verb == state.verb {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		pass.Reportf(call.Pos(), "%s format %s has unknown verb %c", state.name, state.format, state.verb)
+		return false
+	}
+	for _, flag := range state.flags {
+		if !strings.ContainsRune(v.flags, rune(flag)) {
+			pass.Reportf(call.Pos(), "%s format %s has unrecognized flag %c", state.name, state.format, flag)
+			return false
+		}
+	}
+	// Verb is good. If len(state.argNums)>trueArgs, we have something like %.*s and all
+	// but the final arg must be an integer.
+	trueArgs := 1
+	if state.verb == '%' {
+		trueArgs = 0
+	}
+	nargs := len(state.argNums)
+	for i := 0; i < nargs-trueArgs; i++ {
+		argNum := state.argNums[i]
+		if !argCanBeChecked(pass, call, i, state) {
+			return
+		}
+		arg := call.Args[argNum]
+		if !matchArgType(pass, argInt, nil, arg) {
+			pass.Reportf(call.Pos(), "%s format %s uses non-int %s as argument of *", state.name, state.format, gofmt(pass, arg))
+			return false
+		}
+	}
+
+	if state.verb == '%' || nargs == 0 {
+		return true
+	}
+	// Now check verb's type.
+	argNum := state.argNums[len(state.argNums)-1]
+	if !argCanBeChecked(pass, call, len(state.argNums)-1, state) {
+		return false
+	}
+	arg := call.Args[argNum]
+	if isFunctionValue(pass, arg) && state.verb != 'p' && state.verb != 'T' {
+		pass.Reportf(call.Pos(), "%s format %s arg %s is a func value, not called", state.name, state.format, gofmt(pass, arg))
+		return false
+	}
+	if !matchArgType(pass, v.typ, nil, arg) {
+		typeString := ""
+		if typ := pass.TypesInfo.Types[arg].Type; typ != nil {
+			typeString = typ.String()
+		}
+		pass.Reportf(call.Pos(), "%s format %s has arg %s of wrong type %s", state.name, state.format, gofmt(pass, arg), typeString)
+		return false
+	}
+	if v.typ&argString != 0 && v.verb != 'T' && !bytes.Contains(state.flags, []byte{'#'}) && recursiveStringer(pass, arg, fdecl) {
+		pass.Reportf(call.Pos(), "%s format %s with arg %s causes recursive String method call", state.name, state.format, gofmt(pass, arg))
+		return false
+	}
+	return true
+}
+
+// recursiveStringer reports whether the argument e is the receiver
+// of the String method enclosing the call, which would make the call
+// recursive.
+func recursiveStringer(pass *analysis.Pass, e ast.Expr, fdecl *ast.FuncDecl) bool {
+	if fdecl == nil || fdecl.Recv == nil || fdecl.Name.Name != "String" || len(fdecl.Recv.List[0].Names) == 0 {
+		return false
+	}
+	id, ok := unparen(e).(*ast.Ident)
+	if !ok {
+		if u, ok := unparen(e).(*ast.UnaryExpr); ok && u.Op == token.AND {
+			id, _ = unparen(u.X).(*ast.Ident)
+		}
+	}
+	if id == nil {
+		return false
+	}
+	recv := pass.TypesInfo.Defs[fdecl.Recv.List[0].Names[0]]
+	return recv != nil && pass.TypesInfo.Uses[id] == recv
+}
+
+// isFunctionValue reports whether the expression is a function as opposed to a function call.
+// It is almost always a mistake to print a function value.
+func isFunctionValue(pass *analysis.Pass, e ast.Expr) bool {
+	if typ := pass.TypesInfo.Types[e].Type; typ != nil {
+		_, ok := typ.(*types.Signature)
+		return ok
+	}
+	return false
+}
+
+// argCanBeChecked reports whether the specified argument is statically present;
+// it may be beyond the list of arguments or in a terminal slice... argument, which
+// means we can't see it.
+func argCanBeChecked(pass *analysis.Pass, call *ast.CallExpr, formatArg int, state *formatState) bool {
+	argNum := state.argNums[formatArg]
+	if argNum <= 0 {
+		// Shouldn't happen, so catch it with prejudice.
+		panic("negative arg num")
+	}
+	if argNum < len(call.Args)-1 {
+		return true // Always OK.
+	}
+	if call.Ellipsis.IsValid() {
+		return false // We just can't tell; there could be many more arguments.
+	}
+	if argNum < len(call.Args) {
+		return true
+	}
+	// There are bad indexes in the format or there are fewer arguments than the format needs.
+	// This is the argument number relative to the format: Printf("%s", "hi") will give 1 for the "hi".
+	arg := argNum - state.firstArg + 1 // People think of arguments as 1-indexed.
+	pass.Reportf(call.Pos(), "%s format %s reads arg #%d, but call has %v", state.name, state.format, arg, count(len(call.Args)-state.firstArg, "arg"))
+	return false
+}
+
+// printFormatRE is the regexp we match and report as a possible format string
+// in the first argument to unformatted prints like fmt.Print.
+// We exclude the space flag, so that printing a string like "x % y" is not flagged.
+const (
+	flagsRE    = `[+\-#]*`
+	indexOptRE = `(\[[0-9]+\])?`
+	numOptRE   = `([0-9]+|` + indexOptRE + `\*)?`
+	verbRE     = `[bcdefgopqstvxEFGTUX]`
+)
+
+var printFormatRE = regexp.MustCompile(`%` + flagsRE + numOptRE + `\.?` + numOptRE + indexOptRE + verbRE)
+
+// checkPrint checks a call to an unformatted print routine such as Println.
+func checkPrint(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) {
+	firstArg := 0
+	typ := pass.TypesInfo.Types[call.Fun].Type
+	if typ == nil {
+		// Skip checking functions with unknown type.
+		return
+	}
+	if sig, ok := typ.(*types.Signature); ok {
+		if !sig.Variadic() {
+			// Skip checking non-variadic functions.
+			return
+		}
+		params := sig.Params()
+		firstArg = params.Len() - 1
+
+		typ := params.At(firstArg).Type()
+		typ = typ.(*types.Slice).Elem()
+		it, ok := typ.(*types.Interface)
+		if !ok || !it.Empty() {
+			// Skip variadic functions accepting non-interface{} args.
+			return
+		}
+	}
+	args := call.Args
+	if len(args) <= firstArg {
+		// Skip calls without variadic args.
+		return
+	}
+	args = args[firstArg:]
+
+	if firstArg == 0 {
+		if sel, ok := call.Args[0].(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				if x.Name == "os" && strings.HasPrefix(sel.Sel.Name, "Std") {
+					pass.Reportf(call.Pos(), "%s does not take io.Writer but has first arg %s", fn.Name(), gofmt(pass, call.Args[0]))
+				}
+			}
+		}
+	}
+
+	arg := args[0]
+	if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		// Ignore trailing % character in lit.Value.
+		// The % in "abc 0.0%" couldn't be a formatting directive.
+		s := strings.TrimSuffix(lit.Value, `%"`)
+		if strings.Contains(s, "%") {
+			m := printFormatRE.FindStringSubmatch(s)
+			if m != nil {
+				pass.Reportf(call.Pos(), "%s call has possible formatting directive %s", fn.Name(), m[0])
+			}
+		}
+	}
+	if strings.HasSuffix(fn.Name(), "ln") {
+		// The last item, if a string, should not have a newline.
+		arg = args[len(args)-1]
+		if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			str, _ := strconv.Unquote(lit.Value)
+			if strings.HasSuffix(str, "\n") {
+				d := analysis.Diagnostic{
+					Pos:     call.Pos(),
+					End:     call.End(),
+					Message: fmt.Sprintf("%s arg list ends with redundant newline", fn.Name()),
+				}
+				if strings.HasSuffix(lit.Value, `\n"`) {
+					// Remove the escape sequence before the closing quote.
+					end := lit.End() - 1
+					d.SuggestedFixes = []analysis.SuggestedFix{{
+						Message:   "Remove redundant newline",
+						TextEdits: []analysis.TextEdit{{Pos: end - 2, End: end}},
+					}}
+				}
+				pass.Report(d)
+			}
+		}
+	}
+	for _, arg := range args {
+		if isFunctionValue(pass, arg) {
+			pass.Reportf(call.Pos(), "%s arg %s is a func value, not called", fn.Name(), gofmt(pass, arg))
+		}
+	}
+}
+
+// count(n, what) returns "1 what" or "N whats"
+// (assuming the plural of what is whats).
+func count(n int, what string) string {
+	if n == 1 {
+		return "1 " + what
+	}
+	return fmt.Sprintf("%d %ss", n, what)
+}
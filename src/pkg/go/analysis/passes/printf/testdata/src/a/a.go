@@ -0,0 +1,126 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the printf checker.
+
+package a
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+)
+
+func PrintfTests() {
+	var b bool
+	var i int
+	var r rune
+	var s string
+	var x float64
+	var p *int
+	var imap map[int]int
+	var fn func()
+	var e error = fmt.Errorf("err")
+
+	fmt.Printf("%d", i)
+	fmt.Printf("%5.2f %v %T %q %x", x, imap, s, r, s)
+	fmt.Printf("%t %p %s %%", b, p, e)
+	fmt.Printf("%*d", 2, i)
+	fmt.Printf("%[2]d %[1]s", s, i)
+	fmt.Printf("%s", []byte("bytes"))
+	fmt.Printf("%d", imap)
+
+	fmt.Printf("%d", "hello")     // want `Printf format %d has arg "hello" of wrong type string`
+	fmt.Printf("%s", i)           // want "Printf format %s has arg i of wrong type int"
+	fmt.Printf("%t", s)           // want "Printf format %t has arg s of wrong type string"
+	fmt.Printf("%z", i)           // want "Printf format %z has unknown verb z"
+	fmt.Printf("%#s", s)          // want "Printf format %#s has unrecognized flag #"
+	fmt.Printf("%d %d", i)        // want "Printf format %d reads arg #2, but call has 1 arg"
+	fmt.Printf("%d", i, i)        // want "Printf call needs 1 arg but has 2 args"
+	fmt.Printf("no verbs", i)     // want "Printf call has arguments but no formatting directives"
+	fmt.Printf("%*d", s, i)       // want "Printf format %\\*d uses non-int s as argument of \\*"
+	fmt.Printf("%[3]d", i, i)     // want `Printf format has invalid argument index \[3\]`
+	fmt.Printf("%[1d", i)         // want `Printf format %\[1d is missing closing \]`
+	fmt.Printf("%d %", i)         // want "Printf format % is missing verb at end of string"
+	fmt.Printf("%v", fn)          // want "Printf format %v arg fn is a func value, not called"
+	fmt.Sprintf("%x", x)          // want "Sprintf format %x has arg x of wrong type float64"
+	log.Printf("%d", "str")       // want "Printf format %d has arg \"str\" of wrong type string"
+	fmt.Printf("%s", notString{}) // want "Printf format %s has arg notString{} of wrong type a.notString"
+
+	fmt.Println("%d", i)  // want "Println call has possible formatting directive %d"
+	fmt.Println("done\n") // want "Println arg list ends with redundant newline"
+	fmt.Print("percent 50%")
+	fmt.Println(fn)                // want "Println arg fn is a func value, not called"
+	fmt.Println(os.Stderr, "oops") // want "Println does not take io.Writer but has first arg os.Stderr"
+
+	var l *log.Logger
+	l.Printf("%d", s) // want "Printf format %d has arg s of wrong type string"
+	l.Println(s)
+
+	var t *testing.T
+	t.Errorf("%s", i) // want "Errorf format %s has arg i of wrong type int"
+	t.Log("%s", s)    // want "Log call has possible formatting directive %s"
+
+	// Arguments passed with ... cannot be checked.
+	args := []interface{}{i}
+	fmt.Printf("%s %d", args...)
+}
+
+type notString struct{ n int }
+
+type stringer int
+
+func (s stringer) String() string {
+	if s < 0 {
+		return fmt.Sprintf("%s", s) // want "Sprintf format %s with arg s causes recursive String method call"
+	}
+	return fmt.Sprintf("%d", s)
+}
+
+type formatter int
+
+func (formatter) Format(fmt.State, rune) {}
+
+func FormatterTest(f formatter) {
+	fmt.Printf("%s %d", stringer(1), f)
+}
+
+// Errorf is a printf wrapper.
+func Errorf(format string, args ...interface{}) { // want Errorf:"printfWrapper"
+	fmt.Fprintf(os.Stderr, format, args...)
+}
+
+// Warn is a print wrapper.
+func Warn(args ...interface{}) { // want Warn:"printWrapper"
+	fmt.Fprintln(os.Stderr, args...)
+}
+
+// Warnf forwards to Errorf and is a printf wrapper in turn.
+func Warnf(format string, args ...interface{}) { // want Warnf:"printfWrapper"
+	Errorf(format, args...)
+}
+
+// Fail does not forward its format.
+func Fail(format string, args ...interface{}) {
+	fmt.Printf("fail: %v", args...)
+}
+
+// Sprintf formats some of its arguments itself before forwarding them.
+func Sprintf(format string, args ...interface{}) string {
+	for i, arg := range args {
+		if n, ok := arg.(int); ok {
+			args[i] = fmt.Sprintf("#%d", n)
+		}
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+func WrapperTests() {
+	Errorf("%d", "x") // want "Errorf format %d has arg \"x\" of wrong type string"
+	Warnf("%s", 1)    // want "Warnf format %s has arg 1 of wrong type int"
+	Warn("%d", 1)     // want "Warn call has possible formatting directive %d"
+	Fail("%d", "x")
+	Sprintf("%s", 1)
+}
@@ -0,0 +1,18 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests of printf wrappers declared in another package.
+
+package b
+
+import "a"
+
+func Logf(format string, args ...interface{}) { // want Logf:"printfWrapper"
+	a.Warnf(format, args...)
+}
+
+func F() {
+	a.Errorf("%s", 2) // want "Errorf format %s has arg 2 of wrong type int"
+	Logf("%d", "s")   // want "Logf format %d has arg \"s\" of wrong type string"
+}
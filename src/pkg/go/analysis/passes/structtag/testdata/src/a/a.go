@@ -0,0 +1,74 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the struct tag checker.
+
+package a
+
+import "encoding/xml"
+
+type StructTagTest struct {
+	A   int "hello"            // want "`hello` not compatible with reflect.StructTag.Get: bad syntax for struct tag pair"
+	B   int "\tx:\"y\""        // want "not compatible with reflect.StructTag.Get: bad syntax for struct tag key"
+	C   int "x:\"y\"\tx:\"y\"" // want "not compatible with reflect.StructTag.Get"
+	D   int "x:`y`"            // want "not compatible with reflect.StructTag.Get: bad syntax for struct tag value"
+	E   int "ct\brl:\"char\""  // want "not compatible with reflect.StructTag.Get: bad syntax for struct tag pair"
+	F   int `:"emptykey"`      // want "not compatible with reflect.StructTag.Get: bad syntax for struct tag key"
+	G   int `x:"noEndQuote`    // want "not compatible with reflect.StructTag.Get: bad syntax for struct tag value"
+	H   int `x:"trunc\x0"`     // want "not compatible with reflect.StructTag.Get: bad syntax for struct tag value"
+	I   int `x:"foo",y:"bar"`  // want "not compatible with reflect.StructTag.Get: key:.value. pairs not separated by spaces"
+	J   int `x:"foo"y:"bar"`   // want "not compatible with reflect.StructTag.Get: key:.value. pairs not separated by spaces"
+	OK0 int `x:"y" u:"v" w:""`
+	OK1 int `x:"y:z"`
+	OK2 int `k0:"values contain spaces"`
+	OK3 int `json:"x,omitempty"`
+}
+
+type UnexportedEncodingTagTest struct {
+	x int `json:"xx"` // want "struct field x has json tag but is not exported"
+	y int `xml:"yy"`  // want "struct field y has xml tag but is not exported"
+	z int
+	A int `json:"aa" xml:"bb"`
+}
+
+type unexp struct{}
+
+type JSONEmbeddedField struct {
+	UnexportedEncodingTagTest `is:"embedded"`
+	unexp                     `is:"embedded,notexported" json:"unexp"` // OK: embedded fields may be unexported
+}
+
+type DuplicateJSONFields struct {
+	JSON              int `json:"a"`
+	DuplicateJSON     int `json:"a"` // want "struct field DuplicateJSON repeats json tag \"a\" also at a.go:43"
+	IgnoredJSON       int `json:"-"`
+	OtherIgnoredJSON  int `json:"-"`
+	OmitJSON          int `json:",omitempty"`
+	OtherOmitJSON     int `json:",omitempty"`
+	DuplicateOmitJSON int `json:"a,omitempty"` // want "struct field DuplicateOmitJSON repeats json tag \"a\" also at a.go:43"
+	NonJSON           int `foo:"a"`
+	DuplicateNonJSON  int `foo:"a"`
+	Embedded          struct {
+		DuplicateJSON int `json:"a"` // OK because it's not in the same struct type
+	}
+
+	XML              int `xml:"a"`
+	DuplicateXML     int `xml:"a"` // want "struct field DuplicateXML repeats xml tag \"a\" also at a.go:56"
+	IgnoredXML       int `xml:"-"`
+	OtherIgnoredXML  int `xml:"-"`
+	OmitXML          int `xml:",omitempty"`
+	OtherOmitXML     int `xml:",omitempty"`
+	DuplicateOmitXML int `xml:"a,omitempty"` // want "struct field DuplicateOmitXML repeats xml tag \"a\" also at a.go:56"
+	AttributeXML     int `xml:"b,attr"`
+	ElementXML       int `xml:"b"`      // OK: attributes and elements are distinct
+	DuplicateAttrXML int `xml:"b,attr"` // want "struct field DuplicateAttrXML repeats xml attribute tag \"b\" also at a.go:63"
+
+	XMLName xml.Name `xml:"a"`
+}
+
+type SpaceInTag struct {
+	A int `json:"a "`     // want "suspicious space in struct tag value"
+	B int `xml:"b,attr "` // want "suspicious space in struct tag value"
+	C int `foo:"c d"`
+}
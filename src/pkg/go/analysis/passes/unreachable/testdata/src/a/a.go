@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the unreachable code checker.
+
+package a
+
+import "fmt"
+
+func _() {
+	print(1)
+	return
+	println() // want "unreachable code"
+	return
+}
+
+func _() {
+L:
+	print(1)
+	goto L
+	println() // want "unreachable code"
+}
+
+func _() {
+	print(1)
+	panic(2)
+	println() // want "unreachable code"
+}
+
+func _() {
+	{
+		print(1)
+		return
+		println() // want "unreachable code"
+	}
+	println() // ok: only the first unreachable statement is reported
+}
+
+func _() {
+	for {
+	}
+	println() // want "unreachable code"
+}
+
+func _() {
+	for {
+		break
+	}
+	println() // ok
+	return
+}
+
+func _() {
+L:
+	for {
+		for {
+			break L
+		}
+	}
+	println() // ok
+	return
+}
+
+func _() {
+	for x := 0; x < 10; x++ {
+		continue
+		println() // want "unreachable code"
+	}
+	return
+}
+
+func _(x int) {
+	if x > 0 {
+		return
+	} else {
+		return
+	}
+	println() // want "unreachable code"
+}
+
+func _(x int) {
+	if x > 0 {
+		return
+	}
+	println() // ok
+	return
+}
+
+func _(x int) {
+	switch x {
+	case 1:
+		return
+	default:
+		panic(x)
+	}
+	println() // want "unreachable code"
+}
+
+func _(x int) {
+	switch x {
+	case 1:
+		return
+	}
+	println() // ok: no default
+	return
+}
+
+func _(x interface{}) {
+	switch x.(type) {
+	default:
+		return
+	}
+	println() // want "unreachable code"
+}
+
+func _(c chan int) {
+	select {
+	case <-c:
+		return
+	}
+	println() // want "unreachable code"
+}
+
+func _(c chan int) {
+	select {}
+	println() // want "unreachable code"
+}
+
+func _() {
+	f := func() {
+		return
+		fmt.Println() // want "unreachable code"
+	}
+	f()
+}
+
+func _() {
+	panic := func(int) {}
+	panic(1)
+	println() // ok: not the builtin panic
+}
@@ -0,0 +1,62 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+type testFact struct{}
+
+func (*testFact) AFact() {}
+
+type valueFact struct{}
+
+func (valueFact) AFact() {}
+
+func run(*Pass) (interface{}, error) { return nil, nil }
+
+func TestValidate(t *testing.T) {
+	var (
+		dep  = &Analyzer{Name: "dep", Doc: "dep", Run: run}
+		a    = &Analyzer{Name: "a", Doc: "a", Run: run, Requires: []*Analyzer{dep}}
+		b    = &Analyzer{Name: "b", Doc: "b", Run: run, Requires: []*Analyzer{dep}}
+		cyc1 = &Analyzer{Name: "cyc1", Doc: "cyc1", Run: run}
+		cyc2 = &Analyzer{Name: "cyc2", Doc: "cyc2", Run: run, Requires: []*Analyzer{cyc1}}
+	)
+	cyc1.Requires = []*Analyzer{cyc2}
+
+	var tests = []struct {
+		analyzers []*Analyzer
+		err       string // substring of the error, or "" if valid
+	}{
+		{[]*Analyzer{a, b}, ""},
+		{[]*Analyzer{a, dep}, ""},
+		{[]*Analyzer{a, a}, "duplicate analyzer"},
+		{[]*Analyzer{cyc1}, "cycle detected"},
+		{[]*Analyzer{{Name: "bad name", Doc: "x", Run: run}}, "invalid analyzer name"},
+		{[]*Analyzer{{Name: "nodoc", Run: run}}, "undocumented"},
+		{[]*Analyzer{{Name: "norun", Doc: "x"}}, "no Run function"},
+		{[]*Analyzer{{Name: "dep", Doc: "x", Run: run}, dep}, "two analyzers named dep"},
+		{[]*Analyzer{{Name: "val", Doc: "x", Run: run, FactTypes: []Fact{valueFact{}}}}, "not a pointer"},
+		{[]*Analyzer{
+			{Name: "f1", Doc: "x", Run: run, FactTypes: []Fact{new(testFact)}},
+			{Name: "f2", Doc: "x", Run: run, FactTypes: []Fact{new(testFact)}},
+		}, "registered by two analyzers"},
+	}
+	for _, test := range tests {
+		err := Validate(test.analyzers)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("Validate(%v) = %v; want nil", test.analyzers, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Validate(%v) = %v; want error containing %q", test.analyzers, err, test.err)
+		}
+	}
+}
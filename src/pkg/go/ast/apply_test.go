@@ -0,0 +1,257 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ast_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+var applyTests = []struct {
+	name      string
+	orig      string
+	want      string
+	pre, post ast.ApplyFunc
+}{
+	{
+		name: "replace",
+		orig: `package p
+
+var x int
+`,
+		want: `package p
+
+var t T
+`,
+		post: func(c *ast.Cursor) bool {
+			if _, ok := c.Node().(*ast.ValueSpec); ok {
+				c.Replace(valueSpec("t", "T"))
+			}
+			return true
+		},
+	},
+	{
+		name: "delete",
+		orig: `package p
+
+var x int
+var y int
+var z int
+`,
+		want: `package p
+
+var y int
+`,
+		pre: func(c *ast.Cursor) bool {
+			n := c.Node()
+			if d, ok := n.(*ast.GenDecl); ok && d.Specs[0].(*ast.ValueSpec).Names[0].Name != "y" {
+				c.Delete()
+			}
+			return true
+		},
+	},
+	{
+		name: "insertafter",
+		orig: `package p
+
+var x int
+var y int
+`,
+		want: `package p
+
+var x int
+var x1 int
+var y int
+var y1 int
+`,
+		pre: func(c *ast.Cursor) bool {
+			if d, ok := c.Node().(*ast.GenDecl); ok {
+				name := d.Specs[0].(*ast.ValueSpec).Names[0].Name
+				c.InsertAfter(varDecl(name+"1", "int"))
+			}
+			return true
+		},
+	},
+	{
+		name: "insertbefore",
+		orig: `package p
+
+var x int
+var y int
+`,
+		want: `package p
+
+var x0 int
+
+var x int
+var y0 int
+var y int
+`,
+		pre: func(c *ast.Cursor) bool {
+			if d, ok := c.Node().(*ast.GenDecl); ok {
+				name := d.Specs[0].(*ast.ValueSpec).Names[0].Name
+				c.InsertBefore(varDecl(name+"0", "int"))
+			}
+			return true
+		},
+	},
+	{
+		name: "statements",
+		orig: `package p
+
+func f() {
+	a()
+	b()
+	c()
+}
+`,
+		want: `package p
+
+func f() {
+	a()
+	log()
+
+	c()
+	done()
+}
+`,
+		post: func(c *ast.Cursor) bool {
+			s, ok := c.Node().(*ast.ExprStmt)
+			if !ok {
+				return true
+			}
+			switch s.X.(*ast.CallExpr).Fun.(*ast.Ident).Name {
+			case "b":
+				c.Replace(callStmt("log"))
+			case "c":
+				c.InsertAfter(callStmt("done"))
+			}
+			return true
+		},
+	},
+	{
+		name: "expression",
+		orig: `package p
+
+var _ = f(a, b)
+`,
+		want: `package p
+
+var _ = f(b, a)
+`,
+		post: func(c *ast.Cursor) bool {
+			if id, ok := c.Node().(*ast.Ident); ok && c.Name() == "Args" {
+				if id.Name == "a" {
+					c.Replace(ast.NewIdent("b"))
+				} else {
+					c.Replace(ast.NewIdent("a"))
+				}
+			}
+			return true
+		},
+	},
+	{
+		name: "skip",
+		orig: `package p
+
+func f() { a() }
+
+var _ = a
+`,
+		want: `package p
+
+func f() { a() }
+
+var _ = z
+`,
+		pre: func(c *ast.Cursor) bool {
+			if _, ok := c.Node().(*ast.FuncDecl); ok {
+				return false // don't rename in function bodies
+			}
+			if id, ok := c.Node().(*ast.Ident); ok && id.Name == "a" {
+				c.Replace(ast.NewIdent("z"))
+			}
+			return true
+		},
+	},
+}
+
+func valueSpec(name, typ string) *ast.ValueSpec {
+	return &ast.ValueSpec{
+		Names: []*ast.Ident{ast.NewIdent(name)},
+		Type:  ast.NewIdent(typ),
+	}
+}
+
+func varDecl(name, typ string) *ast.GenDecl {
+	return &ast.GenDecl{
+		Tok:   token.VAR,
+		Specs: []ast.Spec{valueSpec(name, typ)},
+	}
+}
+
+func callStmt(name string) *ast.ExprStmt {
+	return &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent(name)}}
+}
+
+func TestApply(t *testing.T) {
+	for _, test := range applyTests {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, test.name, test.orig, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := ast.Apply(f, test.pre, test.post)
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, n); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("%s: got:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestApplyCursor(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", "package p; func f() { a(); b(); c() }", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check the cursor's view of each node and stop at b.
+	var visited []string
+	ast.Apply(f, nil, func(c *ast.Cursor) bool {
+		s, ok := c.Node().(*ast.ExprStmt)
+		if !ok {
+			return true
+		}
+		name := s.X.(*ast.CallExpr).Fun.(*ast.Ident).Name
+		visited = append(visited, name)
+		if _, ok := c.Parent().(*ast.BlockStmt); !ok || c.Name() != "List" || c.Index() != len(visited)-1 {
+			t.Errorf("%s: got parent %T, name %q, index %d", name, c.Parent(), c.Name(), c.Index())
+		}
+		return name != "b"
+	})
+	if len(visited) != 2 {
+		t.Errorf("visited %v; want traversal to stop after b", visited)
+	}
+
+	// Replacing the root is reflected in the result.
+	root := ast.Apply(f, func(c *ast.Cursor) bool {
+		if c.Index() >= 0 || c.Parent() == nil || c.Name() != "Node" {
+			t.Errorf("root: got parent %T, index %d", c.Parent(), c.Index())
+		}
+		c.Replace(ast.NewIdent("x"))
+		return false
+	}, nil)
+	if id, ok := root.(*ast.Ident); !ok || id.Name != "x" {
+		t.Errorf("got root %v; want x", root)
+	}
+}
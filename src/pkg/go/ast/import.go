@@ -5,9 +5,11 @@
 package ast
 
 import (
+	"fmt"
 	"go/token"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 // SortImports sorts runs of consecutive import lines in import blocks in f.
@@ -194,3 +196,347 @@ type byCommentPos []*CommentGroup
 func (x byCommentPos) Len() int           { return len(x) }
 func (x byCommentPos) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
 func (x byCommentPos) Less(i, j int) bool { return x[i].Pos() < x[j].Pos() }
+
+// AddImport adds the import path to the file f, if absent.
+// It reports whether the import was added.
+func AddImport(fset *token.FileSet, f *File, path string) (added bool) {
+	return AddNamedImport(fset, f, "", path)
+}
+
+// AddNamedImport adds the import path to the file f, if absent.
+// If name is not empty, it is used to rename the import.
+// It reports whether the import was added.
+//
+// For example, calling
+//	AddNamedImport(fset, f, "pathpkg", "path")
+// adds
+//	import pathpkg "path"
+//
+func AddNamedImport(fset *token.FileSet, f *File, name, path string) (added bool) {
+	if imports(f, name, path) {
+		return false
+	}
+
+	newImport := &ImportSpec{
+		Path: &BasicLit{
+			Kind:  token.STRING,
+			Value: strconv.Quote(path),
+		},
+	}
+	if name != "" {
+		newImport.Name = &Ident{Name: name}
+	}
+
+	// Find an import decl to add to.
+	// The goal is to find an existing import
+	// whose import path has the longest shared
+	// prefix with path.
+	var (
+		bestMatch  = -1     // length of longest shared prefix
+		lastImport = -1     // index in f.Decls of the file's final import decl
+		impDecl    *GenDecl // import decl containing the best match
+		impIndex   = -1     // spec index in impDecl containing the best match
+	)
+	for i, decl := range f.Decls {
+		gen, ok := decl.(*GenDecl)
+		if ok && gen.Tok == token.IMPORT {
+			lastImport = i
+			// Do not add to import "C", to avoid disrupting the
+			// association with its doc comment, breaking cgo.
+			if declImports(gen, "C") {
+				continue
+			}
+
+			// Match an empty import decl if that's all that is available.
+			if len(gen.Specs) == 0 && bestMatch == -1 {
+				impDecl = gen
+			}
+
+			// Compute longest shared prefix with imports in this group.
+			for j, spec := range gen.Specs {
+				n := matchLen(importPath(spec), path)
+				if n > bestMatch {
+					bestMatch = n
+					impDecl = gen
+					impIndex = j
+				}
+			}
+		}
+	}
+
+	// If no import decl found, add one after the last import,
+	// or after the package clause if there are no imports.
+	if impDecl == nil {
+		impDecl = &GenDecl{
+			Tok: token.IMPORT,
+		}
+		if lastImport >= 0 {
+			impDecl.TokPos = f.Decls[lastImport].End()
+		} else {
+			// There are no existing imports.
+			// Our new import goes after the package declaration and
+			// after the comment, if any, that starts on the same line
+			// as the package declaration.
+			impDecl.TokPos = f.Package
+
+			file := fset.File(f.Package)
+			pkgLine := file.Line(f.Package)
+			for _, c := range f.Comments {
+				if file.Line(c.Pos()) > pkgLine {
+					break
+				}
+				impDecl.TokPos = c.End()
+			}
+		}
+		f.Decls = append(f.Decls, nil)
+		copy(f.Decls[lastImport+2:], f.Decls[lastImport+1:])
+		f.Decls[lastImport+1] = impDecl
+	}
+
+	// Insert new import at insertAt.
+	insertAt := 0
+	if impIndex >= 0 {
+		// insert after the found import
+		insertAt = impIndex + 1
+	}
+	impDecl.Specs = append(impDecl.Specs, nil)
+	copy(impDecl.Specs[insertAt+1:], impDecl.Specs[insertAt:])
+	impDecl.Specs[insertAt] = newImport
+	pos := impDecl.Pos()
+	if insertAt > 0 {
+		// If there is a comment after an existing import, preserve the
+		// comment position by placing the new import after it.
+		if c := impDecl.Specs[insertAt-1].(*ImportSpec).Comment; c != nil {
+			pos = c.End()
+		} else {
+			// Assign same position as the previous import,
+			// so that the sorter sees it as being in the same block.
+			pos = impDecl.Specs[insertAt-1].Pos()
+		}
+	}
+	if newImport.Name != nil {
+		newImport.Name.NamePos = pos
+	}
+	newImport.Path.ValuePos = pos
+	newImport.EndPos = pos
+
+	// Clean up parens. impDecl contains at least one spec.
+	if len(impDecl.Specs) == 1 {
+		// Remove unneeded parens.
+		impDecl.Lparen = token.NoPos
+	} else if !impDecl.Lparen.IsValid() {
+		// impDecl needs parens added.
+		impDecl.Lparen = impDecl.Specs[0].Pos()
+	}
+
+	f.Imports = append(f.Imports, newImport)
+	return true
+}
+
+// DeleteImport deletes the import path from the file f, if present.
+// It reports whether an import was deleted. Comments attached to the
+// deleted import are removed from f.Comments as well.
+func DeleteImport(fset *token.FileSet, f *File, path string) (deleted bool) {
+	return DeleteNamedImport(fset, f, "", path)
+}
+
+// DeleteNamedImport deletes the import with the given name and path
+// from the file f, if present. An empty name matches only imports
+// that are not renamed. It reports whether an import was deleted.
+func DeleteNamedImport(fset *token.FileSet, f *File, name, path string) (deleted bool) {
+	var delspecs []*ImportSpec
+	var delcomments []*CommentGroup
+
+	// Find the import nodes that import path, if any.
+	for i := 0; i < len(f.Decls); i++ {
+		gen, ok := f.Decls[i].(*GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		for j := 0; j < len(gen.Specs); j++ {
+			impspec := gen.Specs[j].(*ImportSpec)
+			if importName(impspec) != name || importPath(impspec) != path {
+				continue
+			}
+
+			// We found an import spec that imports path.
+			// Delete it.
+			delspecs = append(delspecs, impspec)
+			deleted = true
+			copy(gen.Specs[j:], gen.Specs[j+1:])
+			gen.Specs = gen.Specs[:len(gen.Specs)-1]
+
+			// The comments of the deleted import go with it;
+			// the printer would attach them to the next node
+			// otherwise.
+			if impspec.Doc != nil {
+				delcomments = append(delcomments, impspec.Doc)
+			}
+			if impspec.Comment != nil {
+				delcomments = append(delcomments, impspec.Comment)
+			}
+
+			// If this was the last import spec in this decl,
+			// delete the decl, too.
+			if len(gen.Specs) == 0 {
+				copy(f.Decls[i:], f.Decls[i+1:])
+				f.Decls = f.Decls[:len(f.Decls)-1]
+				if gen.Doc != nil {
+					delcomments = append(delcomments, gen.Doc)
+				}
+				i--
+				break
+			} else if len(gen.Specs) == 1 && gen.Specs[0].(*ImportSpec).Doc == nil {
+				gen.Lparen = token.NoPos // drop parens
+			}
+			if j > 0 {
+				// We deleted an entry but now there may be a hole
+				// where the import and its comments were. Unless it
+				// was preceded by a blank line, which the printer
+				// keeps separating the neighboring groups, close
+				// the hole.
+				prev := gen.Specs[j-1].(*ImportSpec)
+				prevLine := fset.Position(prev.End()).Line
+				if prev.Comment != nil {
+					prevLine = fset.Position(prev.Comment.End()).Line
+				}
+				first := fset.Position(impspec.Pos()).Line
+				if impspec.Doc != nil {
+					first = fset.Position(impspec.Doc.Pos()).Line
+				}
+				last := fset.Position(impspec.End()).Line
+				if impspec.Comment != nil {
+					last = fset.Position(impspec.Comment.End()).Line
+				}
+				if file := fset.File(impspec.Pos()); first-prevLine <= 1 {
+					for n := last - first + 1; n > 0 && first < file.LineCount(); n-- {
+						file.MergeLine(first)
+					}
+				}
+			}
+			j--
+		}
+	}
+
+	// Delete imports from f.Imports.
+	for i := 0; i < len(f.Imports); i++ {
+		imp := f.Imports[i]
+		for j, del := range delspecs {
+			if imp == del {
+				copy(f.Imports[i:], f.Imports[i+1:])
+				f.Imports = f.Imports[:len(f.Imports)-1]
+				copy(delspecs[j:], delspecs[j+1:])
+				delspecs = delspecs[:len(delspecs)-1]
+				i--
+				break
+			}
+		}
+	}
+
+	// Delete comments from f.Comments.
+	for i := 0; i < len(f.Comments); i++ {
+		cg := f.Comments[i]
+		for j, del := range delcomments {
+			if cg == del {
+				copy(f.Comments[i:], f.Comments[i+1:])
+				f.Comments = f.Comments[:len(f.Comments)-1]
+				copy(delcomments[j:], delcomments[j+1:])
+				delcomments = delcomments[:len(delcomments)-1]
+				i--
+				break
+			}
+		}
+	}
+
+	if len(delspecs) > 0 {
+		panic(fmt.Sprintf("deleted specs from Decls but not from Imports: %v", delspecs))
+	}
+
+	return
+}
+
+// UsesImport reports whether a given import is used.
+// The package name used by the file is taken from the import's
+// local name, or guessed from the last element of its path.
+// Blank and dot imports are always considered used.
+func UsesImport(f *File, path string) (used bool) {
+	spec := importSpec(f, path)
+	if spec == nil {
+		return
+	}
+
+	name := spec.Name.String()
+	switch name {
+	case "<nil>":
+		// If the package name is not explicitly specified,
+		// make an educated guess. This is not guaranteed to be correct.
+		lastSlash := strings.LastIndex(path, "/")
+		if lastSlash == -1 {
+			name = path
+		} else {
+			name = path[lastSlash+1:]
+		}
+	case "_", ".":
+		// Not sure if this import is used - err on the safe side.
+		return true
+	}
+
+	Inspect(f, func(n Node) bool {
+		if sel, ok := n.(*SelectorExpr); ok && isTopName(sel.X, name) {
+			used = true
+		}
+		return !used
+	})
+	return
+}
+
+// imports reports whether f imports path with the given name.
+func imports(f *File, name, path string) bool {
+	for _, s := range f.Imports {
+		if importName(s) == name && importPath(s) == path {
+			return true
+		}
+	}
+	return false
+}
+
+// importSpec returns the import spec if f imports path,
+// or nil otherwise.
+func importSpec(f *File, path string) *ImportSpec {
+	for _, s := range f.Imports {
+		if importPath(s) == path {
+			return s
+		}
+	}
+	return nil
+}
+
+// declImports reports whether gen contains an import of path.
+func declImports(gen *GenDecl, path string) bool {
+	if gen.Tok != token.IMPORT {
+		return false
+	}
+	for _, spec := range gen.Specs {
+		if importPath(spec) == path {
+			return true
+		}
+	}
+	return false
+}
+
+// matchLen returns the length of the longest path segment prefix shared by x and y.
+func matchLen(x, y string) int {
+	n := 0
+	for i := 0; i < len(x) && i < len(y) && x[i] == y[i]; i++ {
+		if x[i] == '/' {
+			n++
+		}
+	}
+	return n
+}
+
+// isTopName reports whether n is a top-level unresolved identifier with the given name.
+func isTopName(n Expr, name string) bool {
+	id, ok := n.(*Ident)
+	return ok && id.Name == name && id.Obj == nil
+}
@@ -0,0 +1,311 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ast_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func parseImports(t *testing.T, name, src string) (*token.FileSet, *ast.File) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return fset, f
+}
+
+func formatImports(t *testing.T, name string, fset *token.FileSet, f *ast.File) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return buf.String()
+}
+
+var addTests = []struct {
+	name       string
+	pkg, path  string
+	orig, want string
+}{
+	{
+		name: "no imports",
+		path: "os",
+		orig: `package main
+`,
+		want: `package main
+
+import "os"
+`,
+	},
+	{
+		name: "package comment",
+		path: "os",
+		orig: `package main // comment
+
+// Doc for T.
+type T int
+`,
+		want: `package main // comment
+import "os"
+
+// Doc for T.
+type T int
+`,
+	},
+	{
+		name: "single import",
+		path: "os",
+		orig: `package main
+
+import "C"
+import "bytes"
+`,
+		want: `package main
+
+import "C"
+import (
+	"bytes"
+	"os"
+)
+`,
+	},
+	{
+		name: "longest prefix",
+		path: "go/format",
+		orig: `package main
+
+import (
+	"fmt"
+
+	"go/ast"
+	"go/token" // positions
+)
+`,
+		want: `package main
+
+import (
+	"fmt"
+
+	"go/ast"
+	"go/format"
+	"go/token" // positions
+)
+`,
+	},
+	{
+		name: "after comment",
+		path: "os/exec",
+		orig: `package main
+
+import (
+	"os" // for Exit
+)
+`,
+		want: `package main
+
+import (
+	"os" // for Exit
+	"os/exec"
+)
+`,
+	},
+	{
+		name: "named",
+		pkg:  "pathpkg",
+		path: "path",
+		orig: `package main
+
+import "path"
+`,
+		want: `package main
+
+import (
+	"path"
+	pathpkg "path"
+)
+`,
+	},
+	{
+		name: "present",
+		path: "fmt",
+		orig: `package main
+
+import "fmt"
+`,
+		want: `package main
+
+import "fmt"
+`,
+	},
+}
+
+func TestAddImport(t *testing.T) {
+	for _, test := range addTests {
+		fset, f := parseImports(t, test.name, test.orig)
+		added := ast.AddNamedImport(fset, f, test.pkg, test.path)
+		if want := test.orig != test.want; added != want {
+			t.Errorf("%s: AddNamedImport = %v; want %v", test.name, added, want)
+		}
+		if got := formatImports(t, test.name, fset, f); got != test.want {
+			t.Errorf("%s: got:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+var deleteTests = []struct {
+	name       string
+	path       string
+	orig, want string
+}{
+	{
+		name: "only import",
+		path: "os",
+		orig: `package main
+
+// Doc for the imports.
+import "os"
+
+// Doc for T.
+type T int
+`,
+		want: `package main
+
+// Doc for T.
+type T int
+`,
+	},
+	{
+		name: "middle",
+		path: "io",
+		orig: `package main
+
+import (
+	"fmt"
+	"io" // for Writer
+	"os"
+)
+`,
+		want: `package main
+
+import (
+	"fmt"
+	"os"
+)
+`,
+	},
+	{
+		name: "last",
+		path: "os",
+		orig: `package main
+
+import (
+	"fmt"
+	"io"
+	// Doc for os.
+	"os"
+)
+
+var x int
+`,
+		want: `package main
+
+import (
+	"fmt"
+	"io"
+)
+
+var x int
+`,
+	},
+	{
+		name: "drop parens",
+		path: "io",
+		orig: `package main
+
+import (
+	"fmt" // for Println
+	"io"
+)
+`,
+		want: `package main
+
+import "fmt" // for Println
+`,
+	},
+	{
+		name: "all copies",
+		path: "fmt",
+		orig: `package main
+
+import "fmt"
+import "os"
+import "fmt"
+`,
+		want: `package main
+
+import "os"
+`,
+	},
+	{
+		name: "absent",
+		path: "io",
+		orig: `package main
+
+import "fmt"
+`,
+		want: `package main
+
+import "fmt"
+`,
+	},
+}
+
+func TestDeleteImport(t *testing.T) {
+	for _, test := range deleteTests {
+		fset, f := parseImports(t, test.name, test.orig)
+		deleted := ast.DeleteImport(fset, f, test.path)
+		if want := test.orig != test.want; deleted != want {
+			t.Errorf("%s: DeleteImport = %v; want %v", test.name, deleted, want)
+		}
+		if got := formatImports(t, test.name, fset, f); got != test.want {
+			t.Errorf("%s: got:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+		for _, imp := range f.Imports {
+			if imp.Path.Value == `"`+test.path+`"` {
+				t.Errorf("%s: %s still in f.Imports", test.name, test.path)
+			}
+		}
+	}
+}
+
+var usesTests = []struct {
+	path string
+	src  string
+	used bool
+}{
+	{"io", `package p; import "io"; var _ io.Writer`, true},
+	{"io", `package p; import "io"; var io int; var _ = io`, false},
+	{"go/ast", `package p; import "go/ast"; var _ ast.Node`, true},
+	{"fmt", `package p; import f "fmt"; var _ = f.Println`, true},
+	{"fmt", `package p; import f "fmt"; func g(fmt int) {}`, false},
+	{"os", `package p; import _ "os"`, true},
+	{"os", `package p; import . "os"`, true},
+	{"os", `package p; import "fmt"; var _ = fmt.Println`, false},
+}
+
+func TestUsesImport(t *testing.T) {
+	for _, test := range usesTests {
+		_, f := parseImports(t, test.src, test.src)
+		if used := ast.UsesImport(f, test.path); used != test.used {
+			t.Errorf("%s: UsesImport(%q) = %v; want %v", test.src, test.path, used, test.used)
+		}
+	}
+}
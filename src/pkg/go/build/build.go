@@ -333,6 +333,19 @@ const (
 	// If AllowBinary is set, Import can be satisfied by a compiled
 	// package object without corresponding sources.
 	AllowBinary
+
+	// By default, Import searches vendor directories
+	// that apply in the given source directory before searching
+	// the GOROOT and GOPATH roots.
+	// If an Import finds and returns a package using a vendor
+	// directory, the resulting ImportPath is the complete path
+	// to the package, including the path elements leading up
+	// to and including "vendor".
+	// For example, if Import("y", "x/subdir", 0) finds
+	// "x/vendor/y", the returned package's ImportPath is "x/vendor/y",
+	// not plain "y".
+	// If IgnoreVendor is set, vendor directories are not searched.
+	IgnoreVendor
 )
 
 // A Package describes the Go package found in a directory.
@@ -441,20 +454,23 @@ func (ctxt *Context) Import(path string, srcDir string, mode ImportMode) (*Packa
 
 	var pkga string
 	var pkgerr error
-	switch ctxt.Compiler {
-	case "gccgo":
-		dir, elem := pathpkg.Split(p.ImportPath)
-		pkga = "pkg/gccgo_" + ctxt.GOOS + "_" + ctxt.GOARCH + "/" + dir + "lib" + elem + ".a"
-	case "gc":
-		suffix := ""
-		if ctxt.InstallSuffix != "" {
-			suffix = "_" + ctxt.InstallSuffix
+	setPkga := func() {
+		switch ctxt.Compiler {
+		case "gccgo":
+			dir, elem := pathpkg.Split(p.ImportPath)
+			pkga = "pkg/gccgo_" + ctxt.GOOS + "_" + ctxt.GOARCH + "/" + dir + "lib" + elem + ".a"
+		case "gc":
+			suffix := ""
+			if ctxt.InstallSuffix != "" {
+				suffix = "_" + ctxt.InstallSuffix
+			}
+			pkga = "pkg/" + ctxt.GOOS + "_" + ctxt.GOARCH + suffix + "/" + p.ImportPath + ".a"
+		default:
+			// Save error for end of function.
+			pkgerr = fmt.Errorf("import %q: unknown compiler %q", path, ctxt.Compiler)
 		}
-		pkga = "pkg/" + ctxt.GOOS + "_" + ctxt.GOARCH + suffix + "/" + p.ImportPath + ".a"
-	default:
-		// Save error for end of function.
-		pkgerr = fmt.Errorf("import %q: unknown compiler %q", path, ctxt.Compiler)
 	}
+	setPkga()
 
 	binaryOnly := false
 	if IsLocalImport(path) {
@@ -511,10 +527,51 @@ func (ctxt *Context) Import(path string, srcDir string, mode ImportMode) (*Packa
 
 		// tried records the location of unsuccessful package lookups
 		var tried struct {
+			vendor []string
 			goroot string
 			gopath []string
 		}
 
+		// Vendor directories get first chance to satisfy import.
+		if mode&IgnoreVendor == 0 && srcDir != "" {
+			searchVendor := func(root, src string, isGoroot bool) bool {
+				sub, ok := ctxt.hasSubdir(src, srcDir)
+				if !ok || strings.Contains("/"+sub+"/", "/testdata/") {
+					return false
+				}
+				for {
+					vendor := ctxt.joinPath(src, sub, "vendor")
+					if ctxt.isDir(vendor) {
+						dir := ctxt.joinPath(vendor, path)
+						if ctxt.isDir(dir) {
+							p.Dir = dir
+							p.ImportPath = pathpkg.Join(sub, "vendor", path)
+							p.Goroot = isGoroot
+							p.Root = root
+							setPkga() // p.ImportPath changed
+							return true
+						}
+						tried.vendor = append(tried.vendor, dir)
+					}
+					if sub == "" {
+						return false
+					}
+					sub = pathpkg.Dir(sub)
+					if sub == "." {
+						sub = ""
+					}
+				}
+			}
+			if ctxt.GOROOT != "" && searchVendor(ctxt.GOROOT, ctxt.joinPath(ctxt.GOROOT, "src", "pkg"), true) {
+				goto Found
+			}
+			for _, root := range ctxt.gopath() {
+				if searchVendor(root, ctxt.joinPath(root, "src"), false) {
+					goto Found
+				}
+			}
+		}
+
 		// Determine directory from import path.
 		if ctxt.GOROOT != "" {
 			dir := ctxt.joinPath(ctxt.GOROOT, "src", "pkg", path)
@@ -542,13 +599,18 @@ func (ctxt *Context) Import(path string, srcDir string, mode ImportMode) (*Packa
 
 		// package was not found
 		var paths []string
+		format := "\t%s (vendor tree)"
+		for _, dir := range tried.vendor {
+			paths = append(paths, fmt.Sprintf(format, dir))
+			format = "\t%s"
+		}
 		if tried.goroot != "" {
 			paths = append(paths, fmt.Sprintf("\t%s (from $GOROOT)", tried.goroot))
 		} else {
 			paths = append(paths, "\t($GOROOT not set)")
 		}
 		var i int
-		var format = "\t%s (from $GOPATH)"
+		format = "\t%s (from $GOPATH)"
 		for ; i < len(tried.gopath); i++ {
 			if i > 0 {
 				format = "\t%s"
@@ -100,6 +100,46 @@ func TestLocalDirectory(t *testing.T) {
 	}
 }
 
+func TestImportVendor(t *testing.T) {
+	gopath, err := filepath.Abs("testdata/withvendor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctxt := Default
+	ctxt.GOPATH = gopath
+	src := filepath.Join(gopath, "src")
+
+	tests := []struct {
+		srcDir string
+		path   string
+	}{
+		{"a/b/c", "a/b/vendor/c/d"},
+		{"a/b", "a/b/vendor/c/d"},
+		{"a", "a/vendor/c/d"},
+		{"a/vendor/c/d", "a/vendor/c/d"},
+	}
+	for _, tt := range tests {
+		p, err := ctxt.Import("c/d", filepath.Join(src, filepath.FromSlash(tt.srcDir)), 0)
+		if err != nil {
+			t.Errorf("Import(c/d) from %s: %v", tt.srcDir, err)
+			continue
+		}
+		if p.ImportPath != tt.path {
+			t.Errorf("Import(c/d) from %s: ImportPath=%q, want %q", tt.srcDir, p.ImportPath, tt.path)
+		}
+		if want := filepath.Join(src, filepath.FromSlash(tt.path)); p.Dir != want {
+			t.Errorf("Import(c/d) from %s: Dir=%q, want %q", tt.srcDir, p.Dir, want)
+		}
+	}
+
+	if _, err := ctxt.Import("c/d", filepath.Join(src, "x"), 0); err == nil {
+		t.Errorf("Import(c/d) from x succeeded, want error")
+	}
+	if _, err := ctxt.Import("c/d", filepath.Join(src, "a", "b"), IgnoreVendor); err == nil {
+		t.Errorf("Import(c/d, IgnoreVendor) succeeded, want error")
+	}
+}
+
 func TestShouldBuild(t *testing.T) {
 	const file1 = "// +build tag1\n\n" +
 		"package main\n"
@@ -43,6 +43,11 @@ var pkgDeps = map[string][]string{
 		"unsafe",
 	},
 
+	// Higher-level synchronization built on sync.
+	"sync/errgroup":  {"sync"},
+	"sync/semaphore": {"L0"},
+	"sync/lockorder": {"L0", "strconv"},
+
 	// L1 adds simple functions and strings processing,
 	// but not Unicode tables.
 	"math":          {"unsafe"},
@@ -144,13 +149,15 @@ var pkgDeps = map[string][]string{
 	"log": {"L1", "os", "fmt", "time"},
 
 	// Packages used by testing must be low-level (L2+fmt).
-	"regexp":         {"L2", "regexp/syntax"},
-	"regexp/syntax":  {"L2"},
-	"runtime/debug":  {"L2", "fmt", "io/ioutil", "os", "time"},
-	"runtime/pprof":  {"L2", "fmt", "text/tabwriter"},
-	"text/tabwriter": {"L2"},
-
-	"testing":        {"L2", "flag", "fmt", "os", "runtime/pprof", "time"},
+	"regexp":          {"L2", "regexp/syntax"},
+	"regexp/syntax":   {"L2"},
+	"runtime/debug":   {"L2", "fmt", "io/ioutil", "os", "time"},
+	"runtime/metrics": {"L0", "math"},
+	"runtime/pprof":   {"L2", "fmt", "os", "text/tabwriter", "time"},
+	"runtime/trace":   {"L2", "fmt"},
+	"text/tabwriter":  {"L2"},
+
+	"testing":        {"L2", "flag", "fmt", "os", "runtime/pprof", "runtime/trace", "time"},
 	"testing/iotest": {"L2", "log"},
 	"testing/quick":  {"L2", "flag", "fmt", "reflect"},
 
@@ -180,6 +187,28 @@ var pkgDeps = map[string][]string{
 		"go/token",
 	},
 
+	// Go type checker.
+	"go/constant":             {"L4", "go/token", "math/big"},
+	"go/importer":             {"L4", "go/build", "go/internal/gcimporter", "go/internal/srcimporter", "go/token", "go/types"},
+	"go/internal/gcimporter":  {"L4", "OS", "go/build", "go/constant", "go/token", "go/types", "text/scanner"},
+	"go/internal/srcimporter": {"L4", "OS", "go/ast", "go/build", "go/parser", "go/token", "go/types"},
+	"go/rewrite":              {"L4", "GOPARSER", "go/types"},
+	"go/types":                {"L4", "GOPARSER", "container/heap", "go/constant"},
+
+	// Go static analysis.
+	"go/analysis":                    {"L4", "flag", "go/ast", "go/token", "go/types"},
+	"go/analysis/analysistest":       {"L4", "OS", "go/analysis", "go/analysis/internal/checker", "go/build", "go/token", "go/types", "regexp", "text/scanner"},
+	"go/analysis/internal/cfg":       {"L4", "GOPARSER"},
+	"go/analysis/internal/checker":   {"L4", "OS", "GOPARSER", "encoding/json", "go/analysis", "go/build", "go/types"},
+	"go/analysis/multichecker":       {"L4", "OS", "flag", "go/analysis", "go/analysis/internal/checker", "go/build"},
+	"go/analysis/passes/copylock":    {"L4", "GOPARSER", "go/analysis", "go/analysis/passes/inspect", "go/types"},
+	"go/analysis/passes/inspect":     {"L4", "go/analysis", "go/ast"},
+	"go/analysis/passes/lostcancel":  {"L4", "go/analysis", "go/analysis/internal/cfg", "go/analysis/passes/inspect", "go/ast", "go/types"},
+	"go/analysis/passes/printf":      {"L4", "GOPARSER", "go/analysis", "go/analysis/passes/inspect", "go/constant", "go/types", "regexp"},
+	"go/analysis/passes/shadow":      {"L4", "go/analysis", "go/analysis/passes/inspect", "go/ast", "go/token", "go/types"},
+	"go/analysis/passes/structtag":   {"L4", "OS", "go/analysis", "go/analysis/passes/inspect", "go/ast", "go/token", "go/types"},
+	"go/analysis/passes/unreachable": {"L4", "go/analysis", "go/analysis/passes/inspect", "go/ast", "go/token"},
+
 	// One of a kind.
 	"archive/tar":         {"L4", "OS", "syscall"},
 	"archive/zip":         {"L4", "OS", "compress/flate"},
@@ -190,11 +219,16 @@ var pkgDeps = map[string][]string{
 	"compress/zlib":       {"L4", "compress/flate"},
 	"database/sql":        {"L4", "container/list", "database/sql/driver"},
 	"database/sql/driver": {"L4", "time"},
+	"debug/armasm":        {"L4"},
+	"debug/buildinfo":     {"L4", "OS", "debug/elf", "debug/macho", "debug/pe", "debug/plan9obj"},
 	"debug/dwarf":         {"L4"},
-	"debug/elf":           {"L4", "OS", "debug/dwarf"},
+	"debug/elf":           {"L4", "OS", "debug/dwarf", "compress/zlib"},
 	"debug/gosym":         {"L4"},
 	"debug/macho":         {"L4", "OS", "debug/dwarf"},
 	"debug/pe":            {"L4", "OS", "debug/dwarf"},
+	"debug/plan9obj":      {"L4", "OS"},
+	"debug/symbolize":     {"L4", "OS", "debug/dwarf", "debug/elf", "debug/gosym", "debug/macho", "debug/pe"},
+	"debug/x86asm":        {"L4"},
 	"encoding":            {"L4"},
 	"encoding/ascii85":    {"L4"},
 	"encoding/asn1":       {"L4", "math/big"},
@@ -240,7 +274,8 @@ var pkgDeps = map[string][]string{
 	// Basic networking.
 	// Because net must be used by any package that wants to
 	// do networking portably, it must have a small dependency set: just L1+basic os.
-	"net": {"L1", "CGO", "os", "syscall", "time"},
+	"net":            {"L1", "CGO", "net/dnsmessage", "os", "syscall", "time"},
+	"net/dnsmessage": {"L1"},
 
 	// NET enables use of basic network-related packages.
 	"NET": {
@@ -321,12 +356,12 @@ var pkgDeps = map[string][]string{
 	},
 
 	// HTTP-using packages.
-	"expvar":            {"L4", "OS", "encoding/json", "net/http"},
+	"expvar":            {"L4", "OS", "encoding/json", "net/http", "runtime/metrics"},
 	"net/http/cgi":      {"L4", "NET", "OS", "crypto/tls", "net/http", "regexp"},
 	"net/http/fcgi":     {"L4", "NET", "OS", "net/http", "net/http/cgi"},
 	"net/http/httptest": {"L4", "NET", "OS", "crypto/tls", "flag", "net/http"},
 	"net/http/httputil": {"L4", "NET", "OS", "net/http"},
-	"net/http/pprof":    {"L4", "OS", "html/template", "net/http", "runtime/pprof"},
+	"net/http/pprof":    {"L4", "OS", "html/template", "net/http", "runtime/pprof", "runtime/trace"},
 	"net/rpc":           {"L4", "NET", "encoding/gob", "net/http", "text/template"},
 	"net/rpc/jsonrpc":   {"L4", "NET", "encoding/json", "net/rpc"},
 }
@@ -55,6 +55,18 @@
 //	            foo/
 //	                bar.a          (installed package object)
 //
+// Vendor Directories
+//
+// Code below a directory named "vendor" is importable only by code
+// in the directory tree rooted at the parent of "vendor", and only
+// using an import path that omits the prefix up to and including the
+// vendor element.  When resolving an import from code in DIR/src/foo/bar,
+// Import first searches DIR/src/foo/bar/vendor, DIR/src/foo/vendor and
+// DIR/src/vendor, in that order, before the Go root and the Go path.
+// A package found this way has an import path that includes the vendor
+// element, as in "foo/vendor/quux".  The IgnoreVendor mode disables
+// the search.
+//
 // Build Constraints
 //
 // A build constraint, also known as a build tag, is a line comment that begins
@@ -0,0 +1,5 @@
+package c
+
+import "c/d"
+
+var _ = d.D
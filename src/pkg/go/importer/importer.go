@@ -0,0 +1,104 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package importer provides access to export data importers.
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"go/build"
+	"go/internal/gcimporter"
+	"go/internal/srcimporter"
+	"go/token"
+	"go/types"
+	"io"
+	"runtime"
+)
+
+// A Lookup function returns a reader to access package data for
+// a given import path, or an error if no matching package is found.
+type Lookup func(path string) (io.ReadCloser, error)
+
+// For returns an Importer for importing from installed packages
+// for the compilers "gc" and "source". The result is nil for
+// any other compiler name.
+//
+// If lookup is nil, the default package lookup mechanism for the
+// given compiler is used, and the resulting importer attempts
+// to resolve relative and absolute import paths to canonical
+// import path IDs before finding the imported file.
+//
+// If lookup is non-nil, then the returned importer calls lookup
+// each time it needs to resolve an import path. In this mode
+// the importer can only be invoked with canonical import paths
+// (not relative or absolute ones); it is assumed that the translation
+// to canonical import paths is being done by the client of the
+// importer. Lookup is only supported by the "gc" importer.
+//
+// The "source" importer type-checks packages from their source
+// files found via go/build.Default.
+func For(compiler string, lookup Lookup) types.Importer {
+	switch compiler {
+	case "gc":
+		return &gcimports{
+			packages: make(map[string]*types.Package),
+			lookup:   lookup,
+		}
+
+	case "source":
+		if lookup != nil {
+			panic("source importer for custom import path lookup not supported")
+		}
+		return srcimporter.New(&build.Default, token.NewFileSet(), make(map[string]*types.Package))
+	}
+
+	// compiler not supported
+	return nil
+}
+
+// Default returns an Importer for the compiler that built the running binary.
+// If available, the result implements types.ImporterFrom.
+func Default() types.Importer {
+	return For(runtime.Compiler, nil)
+}
+
+// gc importer
+
+type gcimports struct {
+	packages map[string]*types.Package
+	lookup   Lookup
+}
+
+func (m *gcimports) Import(path string) (*types.Package, error) {
+	return m.ImportFrom(path, "" /* no vendoring */, 0)
+}
+
+func (m *gcimports) ImportFrom(path, srcDir string, mode types.ImportMode) (*types.Package, error) {
+	if mode != 0 {
+		panic("mode must be 0")
+	}
+	if m.lookup == nil {
+		return gcimporter.Import(m.packages, path, srcDir)
+	}
+
+	// custom lookup: the path is a canonical import path
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	if pkg := m.packages[path]; pkg != nil && pkg.Complete() {
+		return pkg, nil
+	}
+	rc, err := m.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	buf := bufio.NewReader(rc)
+	if err := gcimporter.FindExportData(buf); err != nil {
+		return nil, fmt.Errorf("reading export data for %q: %v", path, err)
+	}
+	return gcimporter.ImportData(m.packages, path, path, buf)
+}
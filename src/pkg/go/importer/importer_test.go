@@ -0,0 +1,101 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"fmt"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// exports maps import paths to gc object files
+// consisting of just a header and export data.
+var exports = map[string]string{
+	"p": `go object linux amd64 go1.3 X:precisestack
+
+$$
+package p
+	import q "q"
+	type @"".T struct { @"".Q @"q".Q }
+	func @"".New () (? *@"".T)
+
+$$
+`,
+	"q": `go object linux amd64 go1.3 X:precisestack
+
+$$
+package q
+	type @"".Q int
+	const @"".Max @"".Q = 100
+
+$$
+`,
+}
+
+func lookup(path string) (io.ReadCloser, error) {
+	if src, ok := exports[path]; ok {
+		return ioutil.NopCloser(strings.NewReader(src)), nil
+	}
+	return nil, fmt.Errorf("no export data for %q", path)
+}
+
+func TestForLookup(t *testing.T) {
+	imp := For("gc", lookup)
+	if imp == nil {
+		t.Fatal("no gc importer")
+	}
+
+	p, err := imp.Import("p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	T := p.Scope().Lookup("T")
+	if T == nil {
+		t.Fatal("p.T not found")
+	}
+	if got, want := T.Type().Underlying().String(), "struct{Q q.Q}"; got != want {
+		t.Errorf("p.T: got %s; want %s", got, want)
+	}
+
+	// q was imported as a dependency of p and is completed
+	// by importing it explicitly; the type objects are shared
+	q, err := imp.Import("q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	field := T.Type().Underlying().(*types.Struct).Field(0)
+	if field.Type() != q.Scope().Lookup("Q").Type() {
+		t.Errorf("p.T.Q and q.Q are different types")
+	}
+	if q.Scope().Lookup("Max") == nil {
+		t.Errorf("q.Max not found")
+	}
+
+	if _, err := imp.Import("r"); err == nil {
+		t.Errorf("import of r succeeded; want error")
+	}
+	if pkg, err := imp.Import("unsafe"); err != nil || pkg != types.Unsafe {
+		t.Errorf("import of unsafe: got %v, %v", pkg, err)
+	}
+}
+
+func TestFor(t *testing.T) {
+	for _, compiler := range []string{"gc", "source"} {
+		imp := For(compiler, nil)
+		if imp == nil {
+			t.Errorf("no importer for %s", compiler)
+			continue
+		}
+		if _, ok := imp.(types.ImporterFrom); !ok {
+			t.Errorf("%s importer does not implement types.ImporterFrom", compiler)
+		}
+	}
+	if imp := For("unknown", nil); imp != nil {
+		t.Errorf("got importer for unknown compiler")
+	}
+}
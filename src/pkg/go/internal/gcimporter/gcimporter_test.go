@@ -0,0 +1,173 @@
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gcimporter
+
+import (
+	"bufio"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// export is the export data section of an object file for a
+// hypothetical package p, as written by gc.
+const export = `go object linux amd64 go1.3 X:precisestack
+
+$$
+package p
+	import io "io"
+	type @"".T struct { @"".X int; @"".r @"io".Reader "json:\"r\"" }
+	func (@"".t·1 *@"".T) Read (@"".b·2 []byte) (@"".n·3 int, @"".err·4 error) { return len(@"".b·2), nil }
+	func (@"".t·1 @"".T) @"".x () (? int)
+	type @"io".Reader interface { Read(@"io".p []byte) (@"io".n int, @"io".err error) }
+	type @"".Stringer interface { String() (? string) }
+	type @"".Ch <-chan []map[string]*@"".T
+	const @"".B bool = true
+	const @"".I = 42
+	const @"".N = -7
+	const @"".F = 3p-1
+	const @"".G float64 = 1p+10
+	const @"".R = ('a' + 97)
+	const @"".S = "hello"
+	var @"".V @"".T
+	func @"".Printf (@"".format·1 string, @"".args·2 ...interface {}) (@"".n·3 int)
+	func @"".Use (? @"unsafe".Pointer)
+
+$$
+`
+
+func importExport(t *testing.T) *types.Package {
+	buf := bufio.NewReader(strings.NewReader(export))
+	if err := FindExportData(buf); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := ImportData(make(map[string]*types.Package), "p.6", "p", buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+func TestImportData(t *testing.T) {
+	pkg := importExport(t)
+	if pkg.Name() != "p" || pkg.Path() != "p" {
+		t.Errorf("got package %s %q; want p %q", pkg.Name(), pkg.Path(), "p")
+	}
+	if !pkg.Complete() {
+		t.Errorf("package not marked complete")
+	}
+	if imps := pkg.Imports(); len(imps) != 1 || imps[0].Path() != "io" {
+		t.Errorf("got imports %v; want [io]", imps)
+	}
+
+	for _, test := range []struct {
+		name, want string
+	}{
+		{"T", "type p.T struct{X int; r io.Reader \"json:\\\"r\\\"\"}"},
+		{"Stringer", "type p.Stringer interface{String() string}"},
+		{"Ch", "type p.Ch <-chan []map[string]*p.T"},
+		{"B", "const p.B bool"},
+		{"I", "const p.I untyped int"},
+		{"F", "const p.F untyped float"},
+		{"G", "const p.G float64"},
+		{"R", "const p.R untyped rune"},
+		{"S", "const p.S untyped string"},
+		{"V", "var p.V p.T"},
+		{"Printf", "func p.Printf(format string, args ...interface{}) (n int)"},
+		{"Use", "func p.Use(unsafe.Pointer)"},
+	} {
+		obj := pkg.Scope().Lookup(test.name)
+		if obj == nil {
+			t.Errorf("%s not found", test.name)
+			continue
+		}
+		if got := obj.String(); got != test.want {
+			t.Errorf("%s: got %s; want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestImportConsts(t *testing.T) {
+	pkg := importExport(t)
+	for _, test := range []struct {
+		name, want string
+	}{
+		{"B", "true"},
+		{"I", "42"},
+		{"N", "-7"},
+		{"F", "1.5"},
+		{"G", "1024"},
+		{"R", "97"},
+		{"S", `"hello"`},
+	} {
+		obj := pkg.Scope().Lookup(test.name).(*types.Const)
+		if got := obj.Val().String(); got != test.want {
+			t.Errorf("%s: got %s; want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestImportMethods(t *testing.T) {
+	pkg := importExport(t)
+	T := pkg.Scope().Lookup("T").Type().(*types.Named)
+	if n := T.NumMethods(); n != 2 {
+		t.Fatalf("got %d methods for T; want 2", n)
+	}
+
+	// *T implements io.Reader, T does not
+	var reader *types.Interface
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == "io" {
+			reader = imp.Scope().Lookup("Reader").Type().Underlying().(*types.Interface)
+		}
+	}
+	if reader == nil {
+		t.Fatal("io.Reader not imported")
+	}
+	if types.Implements(T, reader) {
+		t.Errorf("T implements io.Reader")
+	}
+	if !types.Implements(types.NewPointer(T), reader) {
+		t.Errorf("*T does not implement io.Reader")
+	}
+}
+
+func TestImportSharedPackages(t *testing.T) {
+	// importing the same data twice into one packages map
+	// must reuse the existing type objects
+	packages := make(map[string]*types.Package)
+	var pkgs [2]*types.Package
+	for i := range pkgs {
+		buf := bufio.NewReader(strings.NewReader(export))
+		if err := FindExportData(buf); err != nil {
+			t.Fatal(err)
+		}
+		pkg, err := ImportData(packages, "p.6", "p", buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkgs[i] = pkg
+	}
+	if pkgs[0] != pkgs[1] {
+		t.Errorf("got different packages for repeated import")
+	}
+	T := pkgs[0].Scope().Lookup("T").Type().(*types.Named)
+	if n := T.NumMethods(); n != 2 {
+		t.Errorf("got %d methods for T after reimport; want 2", n)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	for _, src := range []string{
+		"package p\n\ttype @\"\".T\n$$\n",
+		"package p\n\tconst @\"\".C = zork\n$$\n",
+		"package p\n\tfunc @\"\".F (? int, ...? int, ...? int)\n$$\n",
+	} {
+		_, err := ImportData(make(map[string]*types.Package), "p.6", "p", strings.NewReader(src))
+		if err == nil {
+			t.Errorf("%q: expected error", src)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package srcimporter
+
+import (
+	"go/build"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// files maps import paths in the test GOPATH to package sources.
+var files = map[string]string{
+	"a/a.go": `package a
+
+type T struct{ X int }
+
+func (t *T) Get() int { return t.X }
+
+const C = 42
+`,
+	"b/b.go": `package b
+
+import "a"
+
+var V = a.T{X: a.C}
+
+func F() int { return V.Get() }
+`,
+	"b/vendor/v/v.go": `package v
+
+const Name = "vendored"
+`,
+	"b/w/w.go": `package w
+
+import "v"
+
+const Name = v.Name
+`,
+	"c/c.go": `package c
+
+import "d"
+
+var _ = d.X
+`,
+	"d/d.go": `package d
+
+import "c"
+
+var X = 1
+`,
+	"e/e.go": `package e
+
+var _ int = "not an int"
+`,
+}
+
+func setup(t *testing.T) (ctxt *build.Context, root string) {
+	root, err := ioutil.TempDir("", "srcimporter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		filename := filepath.Join(root, "src", filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c := build.Default
+	c.GOPATH = root
+	return &c, root
+}
+
+func TestImport(t *testing.T) {
+	ctxt, root := setup(t)
+	defer os.RemoveAll(root)
+
+	packages := make(map[string]*types.Package)
+	imp := New(ctxt, token.NewFileSet(), packages)
+
+	pkg, err := imp.ImportFrom("b", ".", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pkg.Complete() {
+		t.Errorf("package b not complete")
+	}
+	if obj := pkg.Scope().Lookup("V"); obj == nil || obj.Type().String() != "a.T" {
+		t.Errorf("b.V: got %v; want var of type a.T", obj)
+	}
+	if a := packages["a"]; a == nil || a.Scope().Lookup("C") == nil {
+		t.Errorf("package a not imported")
+	}
+
+	// importing again returns the same package
+	pkg2, err := imp.Import("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg2 != pkg {
+		t.Errorf("repeated import returned a different package")
+	}
+
+	// unsafe is known to the type checker
+	if pkg, err := imp.Import("unsafe"); err != nil || pkg != types.Unsafe {
+		t.Errorf("import of unsafe: got %v, %v", pkg, err)
+	}
+}
+
+func TestImportVendor(t *testing.T) {
+	ctxt, root := setup(t)
+	defer os.RemoveAll(root)
+
+	imp := New(ctxt, token.NewFileSet(), make(map[string]*types.Package))
+	pkg, err := imp.ImportFrom("b/w", ".", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, _ := pkg.Scope().Lookup("Name").(*types.Const)
+	if obj == nil || obj.Val().String() != `"vendored"` {
+		t.Errorf("b/w.Name: got %v; want vendored constant", obj)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	ctxt, root := setup(t)
+	defer os.RemoveAll(root)
+
+	imp := New(ctxt, token.NewFileSet(), make(map[string]*types.Package))
+	for _, test := range []struct {
+		path, err string
+	}{
+		{"c", "import cycle"},
+		{"e", "type-checking package \"e\" failed"},
+		{"nonexistent", "nonexistent"},
+	} {
+		_, err := imp.Import(test.path)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("import %s: got error %v; want %q", test.path, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rewrite
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+)
+
+// Values/types for special cases.
+var (
+	objectPtrNil = reflect.ValueOf((*ast.Object)(nil))
+	scopePtrNil  = reflect.ValueOf((*ast.Scope)(nil))
+
+	identType     = reflect.TypeOf((*ast.Ident)(nil))
+	objectPtrType = reflect.TypeOf((*ast.Object)(nil))
+	positionType  = reflect.TypeOf(token.NoPos)
+	callExprType  = reflect.TypeOf((*ast.CallExpr)(nil))
+	scopePtrType  = reflect.TypeOf((*ast.Scope)(nil))
+)
+
+// exprOf returns the expression held by val, or nil.
+func exprOf(val reflect.Value) ast.Expr {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if val.IsNil() {
+			return nil
+		}
+		x, _ := val.Interface().(ast.Expr)
+		return x
+	}
+	return nil
+}
+
+// A matcher matches a pattern against code type-checked with info.
+type matcher struct {
+	tmpl  *Template // nil when comparing two pieces of rewritten code
+	pinfo *types.Info
+	info  *types.Info
+	env   map[*types.Var]ast.Expr
+}
+
+// match returns the match of t's pattern with x, or nil.
+func (t *Template) match(info *types.Info, x ast.Expr) *match {
+	m := &matcher{tmpl: t, pinfo: t.info, info: info, env: make(map[*types.Var]ast.Expr)}
+	if !m.match(reflect.ValueOf(t.before), reflect.ValueOf(x)) {
+		return nil
+	}
+	return &match{tmpl: t, node: x, pos: x.Pos(), env: m.env}
+}
+
+// equal reports whether the expressions x and y, both type-checked
+// with info, are the same.
+func equal(info *types.Info, x, y ast.Expr) bool {
+	m := &matcher{pinfo: info, info: info}
+	return m.match(reflect.ValueOf(x), reflect.ValueOf(y))
+}
+
+// object returns the object denoted by the (possibly qualified)
+// identifier x, or nil.
+func (m *matcher) object(x ast.Expr) types.Object {
+	switch x := x.(type) {
+	case *ast.Ident:
+		return m.info.Uses[x]
+	case *ast.SelectorExpr:
+		if id, ok := x.X.(*ast.Ident); ok {
+			if _, ok := m.info.Uses[id].(*types.PkgName); ok {
+				return m.info.Uses[x.Sel]
+			}
+		}
+	}
+	return nil
+}
+
+// typeOK reports whether the expression x may be bound
+// to a wildcard of type T.
+func (m *matcher) typeOK(x ast.Expr, T types.Type) bool {
+	tv, ok := m.info.Types[x]
+	if !ok || !tv.IsValue() {
+		return false
+	}
+	V := tv.Type
+	if types.Identical(V, T) {
+		return true
+	}
+	if b, ok := V.(*types.Basic); ok && b.Info()&types.IsUntyped != 0 || types.IsInterface(T) {
+		return types.AssignableTo(V, T)
+	}
+	return false
+}
+
+// match reports whether pattern matches val,
+// recording wildcard bindings in m.env.
+func (m *matcher) match(pattern, val reflect.Value) bool {
+	// A wildcard matches any expression of suitable type. If it
+	// appears multiple times in the pattern, it must match the
+	// same expression each time. A qualified identifier matches
+	// any reference to the same object.
+	if m.tmpl != nil && pattern.IsValid() && pattern.Kind() == reflect.Ptr {
+		if p := exprOf(pattern); p != nil {
+			if w := m.tmpl.wildcard(p); w != nil {
+				x := exprOf(val)
+				if x == nil || !m.typeOK(x, w.Type()) {
+					return false
+				}
+				if old, ok := m.env[w]; ok {
+					return equal(m.info, old, x)
+				}
+				m.env[w] = x
+				return true
+			}
+			if m.tmpl.qualifier(p) != nil {
+				obj := m.pinfo.Uses[p.(*ast.SelectorExpr).Sel]
+				return obj != nil && obj == m.object(exprOf(val))
+			}
+		}
+	}
+
+	// Otherwise, pattern and val must match recursively.
+	if !pattern.IsValid() || !val.IsValid() {
+		return !pattern.IsValid() && !val.IsValid()
+	}
+	if pattern.Type() != val.Type() {
+		return false
+	}
+
+	// Special cases.
+	switch pattern.Type() {
+	case identType:
+		// Identifiers match if they denote the same object
+		// or, if that is unknown, have the same name.
+		p := pattern.Interface().(*ast.Ident)
+		v := val.Interface().(*ast.Ident)
+		if p == nil || v == nil {
+			return p == nil && v == nil
+		}
+		if pobj, vobj := m.pinfo.Uses[p], m.info.Uses[v]; pobj != nil && vobj != nil {
+			return pobj == vobj
+		}
+		return p.Name == v.Name
+	case objectPtrType, scopePtrType, positionType:
+		// object and scope pointers and token positions always match
+		return true
+	case callExprType:
+		// For calls, the Ellipsis fields (token.Position) must
+		// match since that is how f(x) and f(x...) are different.
+		// Check them here but fall through for the remaining fields.
+		p := pattern.Interface().(*ast.CallExpr)
+		v := val.Interface().(*ast.CallExpr)
+		if p != nil && v != nil && p.Ellipsis.IsValid() != v.Ellipsis.IsValid() {
+			return false
+		}
+	}
+
+	p := reflect.Indirect(pattern)
+	v := reflect.Indirect(val)
+	if !p.IsValid() || !v.IsValid() {
+		return !p.IsValid() && !v.IsValid()
+	}
+
+	switch p.Kind() {
+	case reflect.Slice:
+		if p.Len() != v.Len() {
+			return false
+		}
+		for i := 0; i < p.Len(); i++ {
+			if !m.match(p.Index(i), v.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Struct:
+		for i := 0; i < p.NumField(); i++ {
+			if !m.match(p.Field(i), v.Field(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Interface:
+		return m.match(p.Elem(), v.Elem())
+	}
+
+	// Handle token integers, etc.
+	return p.Interface() == v.Interface()
+}
+
+// A rewriter replaces the matches in a file.
+type rewriter struct {
+	info     *types.Info
+	file     *ast.File
+	matches  map[ast.Expr]*match
+	replaced map[ast.Expr]ast.Expr // matched expressions and their replacements
+	used     map[string]bool       // import paths referred to by replacements
+	added    map[string]bool       // import paths to add
+}
+
+// rewriteVal rewrites the matches in val, innermost first.
+func (rw *rewriter) rewriteVal(val reflect.Value) reflect.Value {
+	if !val.IsValid() {
+		return reflect.Value{}
+	}
+	x := exprOf(val)
+	if r, ok := rw.replaced[x]; ok && x != nil {
+		// already rewritten (the node is reachable twice)
+		return reflect.ValueOf(r)
+	}
+	val = apply(rw.rewriteVal, val)
+	if m := rw.matches[x]; m != nil && x != nil {
+		// The position of x may have changed if its children
+		// were rewritten; use the one of the original tree.
+		r := rw.subst(m, reflect.ValueOf(m.tmpl.after), reflect.ValueOf(m.pos)).Interface().(ast.Expr)
+		rw.replaced[x] = r
+		return reflect.ValueOf(r)
+	}
+	return val
+}
+
+// subst returns a copy of the replacement pattern with the bindings of m
+// substituted for wildcards and pos used as the position of tokens from
+// the pattern. If m == nil, subst returns a copy of pattern and doesn't
+// change the position information.
+func (rw *rewriter) subst(m *match, pattern, pos reflect.Value) reflect.Value {
+	if !pattern.IsValid() {
+		return reflect.Value{}
+	}
+
+	// *ast.Objects and scopes are likely incorrect after
+	// a rewrite; replace them with nil.
+	switch pattern.Type() {
+	case objectPtrType:
+		return objectPtrNil
+	case scopePtrType:
+		return scopePtrNil
+	}
+
+	if m != nil && pattern.Kind() == reflect.Ptr {
+		if x := exprOf(pattern); x != nil {
+			// Wildcard gets replaced with its (rewritten) binding.
+			if w := m.tmpl.wildcard(x); w != nil {
+				b := m.env[w]
+				if r, ok := rw.replaced[b]; ok {
+					b = r
+				}
+				return rw.subst(nil, reflect.ValueOf(b), reflect.Value{})
+			}
+			// Qualified identifier gets the file's name for the package.
+			if pkg := m.tmpl.qualifier(x); pkg != nil {
+				return reflect.ValueOf(rw.qualify(pkg.Imported(), x.(*ast.SelectorExpr).Sel.Name, pos.Interface().(token.Pos)))
+			}
+		}
+	}
+
+	if pos.IsValid() && pattern.Type() == positionType {
+		// use new position only if old position was valid in the first place
+		if old := pattern.Interface().(token.Pos); !old.IsValid() {
+			return pattern
+		}
+		return pos
+	}
+
+	// Otherwise copy.
+	switch p := pattern; p.Kind() {
+	case reflect.Slice:
+		v := reflect.MakeSlice(p.Type(), p.Len(), p.Len())
+		for i := 0; i < p.Len(); i++ {
+			v.Index(i).Set(rw.subst(m, p.Index(i), pos))
+		}
+		return v
+
+	case reflect.Struct:
+		v := reflect.New(p.Type()).Elem()
+		for i := 0; i < p.NumField(); i++ {
+			v.Field(i).Set(rw.subst(m, p.Field(i), pos))
+		}
+		return v
+
+	case reflect.Ptr:
+		v := reflect.New(p.Type()).Elem()
+		if elem := p.Elem(); elem.IsValid() {
+			v.Set(rw.subst(m, elem, pos).Addr())
+		}
+		return v
+
+	case reflect.Interface:
+		v := reflect.New(p.Type()).Elem()
+		if elem := p.Elem(); elem.IsValid() {
+			v.Set(rw.subst(m, elem, pos))
+		}
+		return v
+	}
+
+	return pattern
+}
+
+// qualify returns an expression referring to the object pkg.name
+// from the rewritten file, importing pkg if necessary.
+func (rw *rewriter) qualify(pkg *types.Package, name string, pos token.Pos) ast.Expr {
+	path := pkg.Path()
+	rw.used[path] = true
+	local := ""
+	for _, spec := range rw.file.Imports {
+		if importPath(spec) != path {
+			continue
+		}
+		if spec.Name == nil {
+			local = pkg.Name()
+			break
+		}
+		if spec.Name.Name != "_" {
+			local = spec.Name.Name
+			break
+		}
+	}
+	switch local {
+	case "":
+		local = pkg.Name()
+		rw.added[path] = true
+	case ".":
+		return &ast.Ident{NamePos: pos, Name: name}
+	}
+	return &ast.SelectorExpr{
+		X:   &ast.Ident{NamePos: pos, Name: local},
+		Sel: &ast.Ident{NamePos: pos, Name: name},
+	}
+}
+
+// set is a wrapper for x.Set(y); it protects the caller from panics if x cannot be changed to y.
+func set(x, y reflect.Value) {
+	// don't bother if x cannot be set or y is invalid
+	if !x.CanSet() || !y.IsValid() {
+		return
+	}
+	defer func() {
+		if x := recover(); x != nil {
+			if s, ok := x.(string); ok &&
+				(strings.Contains(s, "type mismatch") || strings.Contains(s, "not assignable")) {
+				// x cannot be set to y - ignore this rewrite
+				return
+			}
+			panic(x)
+		}
+	}()
+	x.Set(y)
+}
+
+// apply replaces each AST field x in val with f(x), returning val.
+// To avoid extra conversions, f operates on the reflect.Value form.
+func apply(f func(reflect.Value) reflect.Value, val reflect.Value) reflect.Value {
+	if !val.IsValid() {
+		return reflect.Value{}
+	}
+
+	// *ast.Objects introduce cycles and are likely incorrect after
+	// rewrite; don't follow them but replace with nil instead
+	if val.Type() == objectPtrType {
+		return objectPtrNil
+	}
+
+	// similarly for scopes: they are likely incorrect after a rewrite;
+	// replace them with nil
+	if val.Type() == scopePtrType {
+		return scopePtrNil
+	}
+
+	switch v := reflect.Indirect(val); v.Kind() {
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i)
+			set(e, f(e))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			e := v.Field(i)
+			set(e, f(e))
+		}
+	case reflect.Interface:
+		e := v.Elem()
+		set(v, f(e))
+	}
+	return val
+}
@@ -0,0 +1,337 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rewrite implements type-aware rewriting of Go syntax trees
+// using rules given by example.
+//
+// A rule is described by a template, a Go source file declaring two
+// functions named before and after with identical signatures:
+//
+//	package template
+//
+//	import "os"
+//
+//	func before(f *os.File) error { return f.Close() }
+//	func after(f *os.File) error  { return f.Sync() }
+//
+// The body of each function must consist of a single return statement
+// with one result, or of a single expression statement. The parameters
+// of the functions are wildcards: the pattern f.Close() matches a call of
+// the Close method on any expression whose type is *os.File, and nothing
+// else. A wildcard of non-interface type matches expressions of identical
+// type (or untyped constants assignable to it); a wildcard of interface
+// type matches any expression assignable to it. All other identifiers in
+// the pattern must denote the same objects in the rewritten code, however
+// they are spelled there.
+//
+// Apply rewrites each match of a template's pattern with the corresponding
+// replacement, adding imports needed by the replacement and deleting
+// imports that are no longer used.
+//
+package rewrite
+
+import (
+	"fmt"
+	"go/ast"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// A Template is a type-checked rewrite rule.
+type Template struct {
+	name   string
+	info   *types.Info
+	params map[*types.Var]bool // wildcards
+	before ast.Expr            // pattern
+	after  ast.Expr            // replacement
+}
+
+// NewTemplate type-checks the template file and returns the rewrite rule
+// it describes. Packages imported by the template are loaded using imp;
+// the code rewritten with the template must be type-checked with the
+// same importer so that the packages' objects are shared.
+//
+func NewTemplate(fset *token.FileSet, file *ast.File, imp types.Importer) (*Template, error) {
+	t := &Template{
+		name: fset.Position(file.Package).Filename,
+		info: &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Defs:  make(map[*ast.Ident]types.Object),
+			Uses:  make(map[*ast.Ident]types.Object),
+		},
+		params: make(map[*types.Var]bool),
+	}
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check(file.Name.Name, fset, []*ast.File{file}, t.info)
+	if err != nil {
+		return nil, err
+	}
+
+	var before, after *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if d, ok := decl.(*ast.FuncDecl); ok && d.Recv == nil {
+			switch d.Name.Name {
+			case "before":
+				before = d
+			case "after":
+				after = d
+			}
+		}
+	}
+	if before == nil || after == nil {
+		return nil, fmt.Errorf("%s: template must declare functions before and after", t.name)
+	}
+	bsig := t.info.Defs[before.Name].Type()
+	asig := t.info.Defs[after.Name].Type()
+	if !types.Identical(bsig, asig) {
+		return nil, fmt.Errorf("%s: before%s and after%s have different signatures",
+			fset.Position(after.Pos()), bsig.(*types.Signature), asig.(*types.Signature))
+	}
+
+	if t.before, err = body(fset, before); err != nil {
+		return nil, err
+	}
+	if t.after, err = body(fset, after); err != nil {
+		return nil, err
+	}
+
+	// The parameters of before are the wildcards; those of after
+	// are distinct objects, so map them onto the ones of before.
+	sig := bsig.(*types.Signature)
+	for i := 0; i < sig.Params().Len(); i++ {
+		t.params[sig.Params().At(i)] = true
+	}
+	aparams := asig.(*types.Signature).Params()
+	for id, obj := range t.info.Uses {
+		for i := 0; i < aparams.Len(); i++ {
+			if obj == aparams.At(i) {
+				t.info.Uses[id] = sig.Params().At(i)
+			}
+		}
+	}
+
+	if id, ok := t.before.(*ast.Ident); ok && t.isWildcard(id) {
+		return nil, fmt.Errorf("%s: pattern must not be a wildcard", fset.Position(id.Pos()))
+	}
+
+	// The replacement is inserted into other packages, so it
+	// must not refer to package-level objects of the template.
+	ast.Inspect(t.after, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && err == nil {
+			if obj := t.info.Uses[id]; obj != nil && obj.Pkg() == pkg && obj.Parent() == pkg.Scope() {
+				err = fmt.Errorf("%s: replacement refers to template-local %s", fset.Position(id.Pos()), obj.Name())
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// body returns the expression forming the body of the template function fn.
+func body(fset *token.FileSet, fn *ast.FuncDecl) (ast.Expr, error) {
+	if fn.Body != nil && len(fn.Body.List) == 1 {
+		switch s := fn.Body.List[0].(type) {
+		case *ast.ReturnStmt:
+			if len(s.Results) == 1 {
+				return s.Results[0], nil
+			}
+		case *ast.ExprStmt:
+			return s.X, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: body of %s must be a single return or expression statement",
+		fset.Position(fn.Pos()), fn.Name.Name)
+}
+
+// String returns the name of the file the template was read from.
+func (t *Template) String() string { return t.name }
+
+// isWildcard reports whether the template identifier id denotes a wildcard.
+func (t *Template) isWildcard(id *ast.Ident) bool {
+	v, ok := t.info.Uses[id].(*types.Var)
+	return ok && t.params[v]
+}
+
+// wildcard returns the wildcard denoted by x, or nil.
+func (t *Template) wildcard(x ast.Expr) *types.Var {
+	if id, ok := x.(*ast.Ident); ok && t.isWildcard(id) {
+		return t.info.Uses[id].(*types.Var)
+	}
+	return nil
+}
+
+// qualifier returns the package name qualifying the template node n if n is
+// a qualified identifier pkg.Name, or nil.
+func (t *Template) qualifier(n ast.Node) *types.PkgName {
+	if sel, ok := n.(*ast.SelectorExpr); ok {
+		if id, ok := sel.X.(*ast.Ident); ok {
+			pkg, _ := t.info.Uses[id].(*types.PkgName)
+			return pkg
+		}
+	}
+	return nil
+}
+
+// A match records a match of a template in the rewritten code.
+type match struct {
+	tmpl *Template
+	node ast.Expr
+	pos  token.Pos               // position of node
+	env  map[*types.Var]ast.Expr // wildcard bindings
+	bad  bool                    // match conflicts with another one
+}
+
+// inBinding reports whether the node n is part of an expression
+// bound to a wildcard of m.
+func (m *match) inBinding(n ast.Node) bool {
+	for _, x := range m.env {
+		if x.Pos() <= n.Pos() && n.End() <= x.End() {
+			return true
+		}
+	}
+	return false
+}
+
+type byPos []*match
+
+func (s byPos) Len() int      { return len(s) }
+func (s byPos) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byPos) Less(i, j int) bool {
+	// Outer matches sort before the matches they contain.
+	if p, q := s[i].node.Pos(), s[j].node.Pos(); p != q {
+		return p < q
+	}
+	return s[i].node.End() > s[j].node.End()
+}
+
+// Apply rewrites every match of the templates in file, which must have
+// been type-checked with the Types and Uses maps of info
+// populated. It returns the number of rewrites made.
+//
+// Two matches conflict if they are of the same expression, or if one is
+// contained in the other without being part of an expression bound to a
+// wildcard. Conflicting matches are not rewritten; they are reported
+// in the returned error, which is a scanner.ErrorList.
+//
+func Apply(fset *token.FileSet, file *ast.File, info *types.Info, templates ...*Template) (int, error) {
+	// Find all matches in the unmodified tree, where type
+	// information is available for every expression.
+	var matches []*match
+	ast.Inspect(file, func(n ast.Node) bool {
+		if x, ok := n.(ast.Expr); ok {
+			for _, t := range templates {
+				if m := t.match(info, x); m != nil {
+					matches = append(matches, m)
+				}
+			}
+		}
+		return true
+	})
+	if len(matches) == 0 {
+		return 0, nil
+	}
+
+	var errors scanner.ErrorList
+	sort.Sort(byPos(matches))
+	for i, outer := range matches {
+		for _, inner := range matches[i+1:] {
+			if inner.node.Pos() >= outer.node.End() {
+				break
+			}
+			if inner.node != outer.node && outer.inBinding(inner.node) {
+				continue
+			}
+			errors.Add(fset.Position(inner.node.Pos()),
+				fmt.Sprintf("conflicting rewrites by %s and %s", outer.tmpl, inner.tmpl))
+			outer.bad = true
+			inner.bad = true
+		}
+	}
+
+	// Track the uses of each imported package so that imports
+	// left unused by the rewrites can be deleted.
+	uses := make(map[*types.PkgName]int)
+	count := func(n ast.Node, delta int) {
+		ast.Inspect(n, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok {
+				if pkg, ok := info.Uses[id].(*types.PkgName); ok {
+					uses[pkg] += delta
+				}
+			}
+			return true
+		})
+	}
+	count(file, +1)
+
+	rw := &rewriter{
+		info:     info,
+		file:     file,
+		matches:  make(map[ast.Expr]*match),
+		replaced: make(map[ast.Expr]ast.Expr),
+		used:     make(map[string]bool),
+		added:    make(map[string]bool),
+	}
+	for _, m := range matches {
+		if m.bad {
+			continue
+		}
+		rw.matches[m.node] = m
+		count(m.node, -1)
+		ast.Inspect(m.tmpl.after, func(n ast.Node) bool {
+			if x, ok := n.(ast.Expr); ok {
+				if v := m.tmpl.wildcard(x); v != nil {
+					count(m.env[v], +1)
+				}
+			}
+			return true
+		})
+	}
+
+	cmap := ast.NewCommentMap(fset, file, file.Comments)
+	apply(rw.rewriteVal, reflect.ValueOf(file))
+	file.Comments = cmap.Filter(file).Comments()
+
+	for pkg, n := range uses {
+		if path := pkg.Imported().Path(); n <= 0 && !rw.used[path] {
+			for _, spec := range file.Imports {
+				if importPath(spec) == path {
+					ast.DeleteNamedImport(fset, file, importName(spec), path)
+					break
+				}
+			}
+		}
+	}
+	for path := range rw.added {
+		ast.AddImport(fset, file, path)
+	}
+
+	errors.Sort()
+	return len(rw.replaced), errors.Err()
+}
+
+// importPath returns the unquoted import path of s,
+// or "" if the path is not properly quoted.
+func importPath(s *ast.ImportSpec) string {
+	t, err := strconv.Unquote(s.Path.Value)
+	if err == nil {
+		return t
+	}
+	return ""
+}
+
+// importName returns the local name of the import s, or "".
+func importName(s *ast.ImportSpec) string {
+	if s.Name == nil {
+		return ""
+	}
+	return s.Name.Name
+}
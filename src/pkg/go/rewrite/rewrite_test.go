@@ -0,0 +1,314 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rewrite_test
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/rewrite"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// packages are the sources of the packages imported by the tests.
+var packages = map[string]string{
+	"os": `package os
+type File struct{}
+func (f *File) Close() error
+func (f *File) Sync() error
+func Open(name string) (*File, error)`,
+
+	"net": `package net
+type Conn interface {
+	Close() error
+}`,
+
+	"old": `package old
+func F(x int) int
+func G(x int) int`,
+
+	"example.com/new": `package new
+func F(x int) int`,
+}
+
+// importer imports the packages above, type-checking each at most once
+// so that the objects of a package are shared by all importers.
+type importer struct {
+	fset *token.FileSet
+	pkgs map[string]*types.Package
+}
+
+func newImporter() *importer {
+	return &importer{token.NewFileSet(), make(map[string]*types.Package)}
+}
+
+func (imp *importer) Import(path string) (*types.Package, error) {
+	if pkg := imp.pkgs[path]; pkg != nil {
+		return pkg, nil
+	}
+	src, ok := packages[path]
+	if !ok {
+		return nil, fmt.Errorf("can't find import: %s", path)
+	}
+	f, err := parser.ParseFile(imp.fset, path+".go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check(path, imp.fset, []*ast.File{f}, nil)
+	if err != nil {
+		return nil, err
+	}
+	imp.pkgs[path] = pkg
+	return pkg, nil
+}
+
+// rewriteSource rewrites src with the given templates and returns
+// the formatted result.
+func rewriteSource(t *testing.T, src string, templates ...string) (string, error) {
+	fset := token.NewFileSet()
+	imp := newImporter()
+
+	var list []*rewrite.Template
+	for i, tsrc := range templates {
+		f, err := parser.ParseFile(fset, fmt.Sprintf("template%d.go", i), tsrc, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmpl, err := rewrite.NewTemplate(fset, f, imp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		list = append(list, tmpl)
+	}
+
+	f, err := parser.ParseFile(fset, "p.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{Importer: imp}
+	if _, err := conf.Check("p", fset, []*ast.File{f}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	_, rerr := rewrite.Apply(fset, f, info, list...)
+
+	ast.SortImports(fset, f)
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String(), rerr
+}
+
+const closeTemplate = `package template
+
+import "os"
+
+func before(f *os.File) error { return f.Close() }
+func after(f *os.File) error  { return f.Sync() }
+`
+
+func TestTypedWildcard(t *testing.T) {
+	const src = `package p
+
+import (
+	"net"
+	"os"
+)
+
+func _(f *os.File, c net.Conn, g func() *os.File) {
+	f.Close()
+	c.Close()
+	defer g().Close() // comment
+}
+`
+	const want = `package p
+
+import (
+	"net"
+	"os"
+)
+
+func _(f *os.File, c net.Conn, g func() *os.File) {
+	f.Sync()
+	c.Close()
+	defer g().Sync() // comment
+}
+`
+	got, err := rewriteSource(t, src, closeTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestImports(t *testing.T) {
+	const tmpl = `package template
+
+import "old"
+import "example.com/new"
+
+func before(x int) int { return old.F(x) }
+func after(x int) int  { return new.F(x) }
+`
+	var tests = []struct {
+		src, want string
+	}{
+		// The import of old is replaced.
+		{`package p
+
+import "old"
+
+var _ = old.F(old.F(1))
+`, `package p
+
+import "example.com/new"
+
+var _ = new.F(new.F(1))
+`},
+
+		// The import of old is still needed.
+		{`package p
+
+import "old"
+
+var _ = old.F(old.G(1))
+`, `package p
+
+import (
+	"example.com/new"
+	"old"
+)
+
+var _ = new.F(old.G(1))
+`},
+
+		// Renamed imports are recognized and kept.
+		{`package p
+
+import (
+	o "old"
+	n "example.com/new"
+)
+
+var _ = o.F(n.F(1))
+`, `package p
+
+import n "example.com/new"
+
+var _ = n.F(n.F(1))
+`},
+
+		// Objects are matched, not names.
+		{`package p
+
+import "old"
+
+type T struct{}
+
+func (T) F(x int) int { return x }
+
+var old_ = T{}
+var _ = old_.F(1) + old.G(2)
+`, `package p
+
+import "old"
+
+type T struct{}
+
+func (T) F(x int) int { return x }
+
+var old_ = T{}
+var _ = old_.F(1) + old.G(2)
+`},
+	}
+
+	for _, test := range tests {
+		got, err := rewriteSource(t, test.src, tmpl)
+		if err != nil {
+			t.Errorf("%s: %s", test.src, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+		}
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	const (
+		double = `package template
+
+func before(x int) int { return x * 2 }
+func after(x int) int  { return x + x }
+`
+		incr = `package template
+
+func before(x int) int { return x*2 + 1 }
+func after(x int) int  { return x<<1 | 1 }
+`
+	)
+	const src = `package p
+
+func _(a, b int) {
+	_ = a*2 + 1
+	_ = (b * 2) * 2
+}
+`
+	const want = `package p
+
+func _(a, b int) {
+	_ = a*2 + 1
+	_ = (b + b) + (b + b)
+}
+`
+	got, err := rewriteSource(t, src, double, incr)
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	list, ok := err.(scanner.ErrorList)
+	if !ok || len(list) != 1 || !strings.Contains(list[0].Msg, "conflicting rewrites") {
+		t.Errorf("got error %v; want one conflict", err)
+	} else if pos := list[0].Pos; pos.Line != 4 {
+		t.Errorf("conflict reported at %s; want line 4", pos)
+	}
+}
+
+func TestNewTemplateErrors(t *testing.T) {
+	var tests = []struct {
+		src, err string
+	}{
+		{`package t; func before(x int) int { return x }`, "must declare functions before and after"},
+		{`package t; func before(x int) int { return -x }; func after(x int8) int { return int(x) }`, "different signatures"},
+		{`package t; func before(x int) int { return x }; func after(x int) int { return -x }`, "must not be a wildcard"},
+		{`package t; func before(x int) int { y := x; return y }; func after(x int) int { return x }`, "single return or expression statement"},
+		{`package t; var v int; func before(x int) int { return x + 1 }; func after(x int) int { return x + v }`, "template-local v"},
+	}
+
+	for _, test := range tests {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "t.go", test.src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = rewrite.NewTemplate(fset, f, newImporter())
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v; want %q", test.src, err, test.err)
+		}
+	}
+}
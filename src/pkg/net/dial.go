@@ -6,6 +6,7 @@ package net
 
 import (
 	"errors"
+	"syscall"
 	"time"
 )
 
@@ -38,18 +39,41 @@ type Dialer struct {
 	// If nil, a local address is automatically chosen.
 	LocalAddr Addr
 
-	// DualStack allows a single dial to attempt to establish
-	// multiple IPv4 and IPv6 connections and to return the first
-	// established connection when the network is "tcp" and the
-	// destination is a host name that has multiple address family
-	// DNS records.
+	// DualStack enables RFC 6555-compliant "Happy Eyeballs"
+	// dialing when the network is "tcp" and the destination is a
+	// host name with both IPv4 and IPv6 addresses.  The addresses
+	// of the preferred family are tried first; if none has
+	// connected after FallbackDelay, the others are tried at the
+	// same time, and the first established connection is used.
 	DualStack bool
 
+	// FallbackDelay specifies the length of time to wait before
+	// trying the other address family when DualStack is enabled.
+	// If zero, a default delay of 300ms is used.
+	FallbackDelay time.Duration
+
 	// KeepAlive specifies the keep-alive period for an active
 	// network connection.
 	// If zero, keep-alives are not enabled. Network protocols
 	// that do not support keep-alives ignore this field.
 	KeepAlive time.Duration
+
+	// Resolver optionally specifies an alternate resolver to use
+	// for looking up host names.  Lookups give up at the dial's
+	// deadline, or earlier if the Resolver has its own Timeout.
+	// If nil, DefaultResolver is used.
+	Resolver *Resolver
+
+	// If Control is not nil, it is called after creating the
+	// network connection but before actually dialing, so that
+	// socket options can be set.
+	//
+	// The network and address passed to Control are not
+	// necessarily the ones passed to Dial.  For example, dialing
+	// "tcp" calls Control with "tcp4" or "tcp6", and with one of
+	// the addresses the host name resolves to.  On Plan 9,
+	// Control is not called.
+	Control func(network, address string, c syscall.RawConn) error
 }
 
 // Return either now+Timeout or Deadline, whichever comes first.
@@ -66,6 +90,37 @@ func (d *Dialer) deadline() time.Time {
 	}
 }
 
+func (d *Dialer) fallbackDelay() time.Duration {
+	if d.FallbackDelay > 0 {
+		return d.FallbackDelay
+	}
+	return 300 * time.Millisecond
+}
+
+// partialDeadline returns the deadline to use for a single address,
+// when multiple addresses are pending.
+func partialDeadline(now, deadline time.Time, addrsRemaining int) (time.Time, error) {
+	if deadline.IsZero() {
+		return deadline, nil
+	}
+	timeRemaining := deadline.Sub(now)
+	if timeRemaining <= 0 {
+		return time.Time{}, errTimeout
+	}
+	// Tentatively allocate equal time to each remaining address.
+	timeout := timeRemaining / time.Duration(addrsRemaining)
+	// If the time per address is too short, steal from the end of the list.
+	const saneMinimum = 2 * time.Second
+	if timeout < saneMinimum {
+		if timeRemaining < saneMinimum {
+			timeout = timeRemaining
+		} else {
+			timeout = saneMinimum
+		}
+	}
+	return now.Add(timeout), nil
+}
+
 func parseNetwork(net string) (afnet string, proto int, err error) {
 	i := last(net, ':')
 	if i < 0 { // no colon
@@ -95,7 +150,7 @@ func parseNetwork(net string) (afnet string, proto int, err error) {
 	return "", 0, UnknownNetworkError(net)
 }
 
-func resolveAddr(op, net, addr string, deadline time.Time) (netaddr, error) {
+func (r *Resolver) resolveAddr(op, net, addr string, deadline time.Time) (netaddr, error) {
 	afnet, _, err := parseNetwork(net)
 	if err != nil {
 		return nil, err
@@ -107,7 +162,7 @@ func resolveAddr(op, net, addr string, deadline time.Time) (netaddr, error) {
 	case "unix", "unixgram", "unixpacket":
 		return ResolveUnixAddr(afnet, addr)
 	}
-	return resolveInternetAddr(afnet, addr, deadline)
+	return r.resolveInternetAddr(afnet, addr, deadline)
 }
 
 // Dial connects to the address on the named network.
@@ -123,6 +178,8 @@ func resolveAddr(op, net, addr string, deadline time.Time) (netaddr, error) {
 // "[ipv6-host%zone]:80".
 // The functions JoinHostPort and SplitHostPort manipulate addresses
 // in this form.
+// If the host is a name with several IP addresses, Dial tries each
+// in turn until one succeeds.
 //
 // Examples:
 //	Dial("tcp", "12.34.56.78:80")
@@ -146,6 +203,8 @@ func Dial(network, address string) (Conn, error) {
 
 // DialTimeout acts like Dial but takes a timeout.
 // The timeout includes name resolution, if required.
+// When dialing a host name with several IP addresses, the timeout
+// is shared among them.
 func DialTimeout(network, address string, timeout time.Duration) (Conn, error) {
 	d := Dialer{Timeout: timeout}
 	return d.Dial(network, address)
@@ -156,19 +215,35 @@ func DialTimeout(network, address string, timeout time.Duration) (Conn, error) {
 // See func Dial for a description of the network and address
 // parameters.
 func (d *Dialer) Dial(network, address string) (Conn, error) {
-	ra, err := resolveAddr("dial", network, address, d.deadline())
+	r := d.Resolver
+	if r == nil {
+		r = DefaultResolver
+	}
+	finalDeadline := d.deadline()
+	ra, err := r.resolveAddr("dial", network, address, finalDeadline)
 	if err != nil {
 		return nil, &OpError{Op: "dial", Net: network, Addr: nil, Err: err}
 	}
 	dialer := func(deadline time.Time) (Conn, error) {
-		return dialSingle(network, address, d.LocalAddr, ra.toAddr(), deadline)
+		return dialSingle(network, address, d.LocalAddr, ra.toAddr(), deadline, d.Control)
 	}
-	if ras, ok := ra.(addrList); ok && d.DualStack && network == "tcp" {
+	if ras, ok := ra.(addrList); ok {
+		primaries, fallbacks := ras, addrList(nil)
+		if d.DualStack && network == "tcp" {
+			primaries, fallbacks = ras.partition()
+		}
 		dialer = func(deadline time.Time) (Conn, error) {
-			return dialMulti(network, address, d.LocalAddr, ras, deadline)
+			if len(fallbacks) > 0 {
+				return dialParallel(network, address, d.LocalAddr, primaries, fallbacks, d.fallbackDelay(), deadline, d.Control)
+			}
+			c, errs := dialSerial(network, address, d.LocalAddr, primaries, deadline, nil, d.Control)
+			if c == nil {
+				return nil, dialError(network, errs)
+			}
+			return c, nil
 		}
 	}
-	c, err := dial(network, ra.toAddr(), dialer, d.deadline())
+	c, err := dial(network, ra.toAddr(), dialer, finalDeadline)
 	if d.KeepAlive > 0 && err == nil {
 		if tc, ok := c.(*TCPConn); ok {
 			tc.SetKeepAlive(true)
@@ -181,70 +256,158 @@ func (d *Dialer) Dial(network, address string) (Conn, error) {
 
 var testHookSetKeepAlive = func() {} // changed by dial_test.go
 
-// dialMulti attempts to establish connections to each destination of
-// the list of addresses. It will return the first established
-// connection and close the other connections. Otherwise it returns
-// error on the last attempt.
-func dialMulti(net, addr string, la Addr, ras addrList, deadline time.Time) (Conn, error) {
-	type racer struct {
+// dialParallel races two copies of dialSerial, giving the first a
+// head start of delay.  It returns the first established connection
+// and closes the others.  Otherwise it returns the errors of all the
+// attempts, the primaries' first.
+func dialParallel(net, addr string, la Addr, primaries, fallbacks addrList, delay time.Duration, deadline time.Time, ctrlFn func(string, string, syscall.RawConn) error) (Conn, error) {
+	type dialResult struct {
 		Conn
-		error
+		errs    []error
+		primary bool
 	}
-	// Sig controls the flow of dial results on lane. It passes a
-	// token to the next racer and also indicates the end of flow
-	// by using closed channel.
-	sig := make(chan bool, 1)
-	lane := make(chan racer, 1)
-	for _, ra := range ras {
-		go func(ra Addr) {
-			c, err := dialSingle(net, addr, la, ra, deadline)
-			if _, ok := <-sig; ok {
-				lane <- racer{c, err}
-			} else if err == nil {
-				// We have to return the resources
-				// that belong to the other
-				// connections here for avoiding
-				// unnecessary resource starvation.
-				c.Close()
+	results := make(chan dialResult) // unbuffered
+	done := make(chan struct{})
+	defer close(done)
+	startRacer := func(ras addrList, primary bool) {
+		go func() {
+			c, errs := dialSerial(net, addr, la, ras, deadline, done, ctrlFn)
+			select {
+			case results <- dialResult{c, errs, primary}:
+			case <-done:
+				// The race is over; return the resources
+				// held by a connection that lost it.
+				if c != nil {
+					c.Close()
+				}
 			}
-		}(ra.toAddr())
+		}()
 	}
-	defer close(sig)
-	lastErr := errTimeout
-	nracers := len(ras)
-	for nracers > 0 {
-		sig <- true
+	startRacer(primaries, true)
+	fallbackTimer := time.NewTimer(delay)
+	defer fallbackTimer.Stop()
+
+	var primaryErrs, fallbackErrs []error
+	racers, fallbackStarted := 1, false
+	for {
 		select {
-		case racer := <-lane:
-			if racer.error == nil {
-				return racer.Conn, nil
+		case <-fallbackTimer.C:
+			if !fallbackStarted {
+				startRacer(fallbacks, false)
+				racers++
+				fallbackStarted = true
+			}
+		case res := <-results:
+			racers--
+			if res.Conn != nil {
+				return res.Conn, nil
+			}
+			if res.primary {
+				primaryErrs = res.errs
+			} else {
+				fallbackErrs = res.errs
+			}
+			if !fallbackStarted {
+				// The primaries have failed; don't
+				// wait any longer for the fallbacks.
+				startRacer(fallbacks, false)
+				racers++
+				fallbackStarted = true
+			}
+			if racers == 0 {
+				return nil, dialError(net, append(primaryErrs, fallbackErrs...))
 			}
-			lastErr = racer.error
-			nracers--
 		}
 	}
-	return nil, lastErr
 }
 
+// dialSerial connects to a list of addresses in sequence, returning
+// the first established connection, or the errors of all the
+// attempts.  Each address gets a share of the time remaining before
+// deadline, so that one that does not respond cannot use it all.
+// Dialing stops early, between attempts, if done is closed.
+func dialSerial(net, addr string, la Addr, ras addrList, deadline time.Time, done <-chan struct{}, ctrlFn func(string, string, syscall.RawConn) error) (Conn, []error) {
+	var errs []error
+	for i, ra := range ras {
+		select {
+		case <-done:
+			return nil, errs
+		default:
+		}
+		partial, err := partialDeadline(time.Now(), deadline, len(ras)-i)
+		if err != nil {
+			// Ran out of time.
+			return nil, append(errs, &OpError{Op: "dial", Net: net, Addr: ra.toAddr(), Err: err})
+		}
+		c, err := dialSingle(net, addr, la, ra.toAddr(), partial, ctrlFn)
+		if err == nil {
+			return c, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errs
+}
+
+// dialError returns the error of a dial whose attempts failed with
+// errs.  A single error is returned as is.
+func dialError(net string, errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return &OpError{Op: "dial", Net: net, Err: dialErrors(errs)}
+}
+
+// dialErrors holds the errors of a dial that tried several addresses,
+// in the order the addresses were tried.  It is a timeout, or
+// temporary, if the last attempt's error is.
+type dialErrors []error
+
+func (e dialErrors) Error() string {
+	s := ""
+	for i, err := range e {
+		if i > 0 {
+			s += "; "
+		}
+		if oe, ok := err.(*OpError); ok && oe.Addr != nil {
+			s += oe.Addr.String() + ": " + oe.Err.Error()
+		} else {
+			s += err.Error()
+		}
+	}
+	return s
+}
+
+func (e dialErrors) Timeout() bool {
+	t, ok := e[len(e)-1].(timeout)
+	return ok && t.Timeout()
+}
+
+func (e dialErrors) Temporary() bool {
+	t, ok := e[len(e)-1].(temporary)
+	return ok && t.Temporary()
+}
+
+var testHookDialTCP = dialTCP // changed by dial_test.go
+
 // dialSingle attempts to establish and returns a single connection to
 // the destination address.
-func dialSingle(net, addr string, la, ra Addr, deadline time.Time) (c Conn, err error) {
+func dialSingle(net, addr string, la, ra Addr, deadline time.Time, ctrlFn func(string, string, syscall.RawConn) error) (c Conn, err error) {
 	if la != nil && la.Network() != ra.Network() {
 		return nil, &OpError{Op: "dial", Net: net, Addr: ra, Err: errors.New("mismatched local address type " + la.Network())}
 	}
 	switch ra := ra.(type) {
 	case *TCPAddr:
 		la, _ := la.(*TCPAddr)
-		c, err = dialTCP(net, la, ra, deadline)
+		c, err = testHookDialTCP(net, la, ra, deadline, ctrlFn)
 	case *UDPAddr:
 		la, _ := la.(*UDPAddr)
-		c, err = dialUDP(net, la, ra, deadline)
+		c, err = dialUDP(net, la, ra, deadline, ctrlFn)
 	case *IPAddr:
 		la, _ := la.(*IPAddr)
-		c, err = dialIP(net, la, ra, deadline)
+		c, err = dialIP(net, la, ra, deadline, ctrlFn)
 	case *UnixAddr:
 		la, _ := la.(*UnixAddr)
-		c, err = dialUnix(net, la, ra, deadline)
+		c, err = dialUnix(net, la, ra, deadline, ctrlFn)
 	default:
 		return nil, &OpError{Op: "dial", Net: net, Addr: ra, Err: &AddrError{Err: "unexpected address type", Addr: addr}}
 	}
@@ -259,16 +422,46 @@ func dialSingle(net, addr string, la, ra Addr, deadline time.Time) (c Conn, err
 // "tcp6", "unix" or "unixpacket".
 // See Dial for the syntax of laddr.
 func Listen(net, laddr string) (Listener, error) {
-	la, err := resolveAddr("listen", net, laddr, noDeadline)
+	var lc ListenConfig
+	return lc.Listen(net, laddr)
+}
+
+// ListenPacket announces on the local network address laddr.
+// The network net must be a packet-oriented network: "udp", "udp4",
+// "udp6", "ip", "ip4", "ip6" or "unixgram".
+// See Dial for the syntax of laddr.
+func ListenPacket(net, laddr string) (PacketConn, error) {
+	var lc ListenConfig
+	return lc.ListenPacket(net, laddr)
+}
+
+// ListenConfig contains options for listening to an address.
+type ListenConfig struct {
+	// If Control is not nil, it is called after creating the
+	// network connection but before binding it to the operating
+	// system, so that socket options such as SO_REUSEPORT can be
+	// set.
+	//
+	// The network and address passed to Control are not
+	// necessarily the ones passed to Listen.  For example,
+	// listening on "tcp" calls Control with "tcp4" or "tcp6".
+	// On Plan 9, Control is not called.
+	Control func(network, address string, c syscall.RawConn) error
+}
+
+// Listen announces on the local network address laddr, as the
+// Listen function does.
+func (lc *ListenConfig) Listen(net, laddr string) (Listener, error) {
+	la, err := DefaultResolver.resolveAddr("listen", net, laddr, noDeadline)
 	if err != nil {
 		return nil, &OpError{Op: "listen", Net: net, Addr: nil, Err: err}
 	}
 	var l Listener
 	switch la := la.toAddr().(type) {
 	case *TCPAddr:
-		l, err = ListenTCP(net, la)
+		l, err = listenTCP(net, la, lc.Control)
 	case *UnixAddr:
-		l, err = ListenUnix(net, la)
+		l, err = listenUnix(net, la, lc.Control)
 	default:
 		return nil, &OpError{Op: "listen", Net: net, Addr: la, Err: &AddrError{Err: "unexpected address type", Addr: laddr}}
 	}
@@ -278,23 +471,21 @@ func Listen(net, laddr string) (Listener, error) {
 	return l, nil
 }
 
-// ListenPacket announces on the local network address laddr.
-// The network net must be a packet-oriented network: "udp", "udp4",
-// "udp6", "ip", "ip4", "ip6" or "unixgram".
-// See Dial for the syntax of laddr.
-func ListenPacket(net, laddr string) (PacketConn, error) {
-	la, err := resolveAddr("listen", net, laddr, noDeadline)
+// ListenPacket announces on the local network address laddr, as
+// the ListenPacket function does.
+func (lc *ListenConfig) ListenPacket(net, laddr string) (PacketConn, error) {
+	la, err := DefaultResolver.resolveAddr("listen", net, laddr, noDeadline)
 	if err != nil {
 		return nil, &OpError{Op: "listen", Net: net, Addr: nil, Err: err}
 	}
 	var l PacketConn
 	switch la := la.toAddr().(type) {
 	case *UDPAddr:
-		l, err = ListenUDP(net, la)
+		l, err = listenUDP(net, la, lc.Control)
 	case *IPAddr:
-		l, err = ListenIP(net, la)
+		l, err = listenIP(net, la, lc.Control)
 	case *UnixAddr:
-		l, err = ListenUnixgram(net, la)
+		l, err = listenUnixgram(net, la, lc.Control)
 	default:
 		return nil, &OpError{Op: "listen", Net: net, Addr: la, Err: &AddrError{Err: "unexpected address type", Addr: laddr}}
 	}
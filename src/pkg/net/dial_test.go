@@ -6,6 +6,7 @@ package net
 
 import (
 	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -16,6 +17,7 @@ import (
 	"runtime"
 	"strconv"
 	"sync"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -369,17 +371,17 @@ func TestDialMultiFDLeak(t *testing.T) {
 
 	var wg sync.WaitGroup
 	portnum, _, _ := dtoi(dss.port, 0)
-	ras := addrList{
-		// Losers that will fail to connect, see RFC 6890.
+	primaries := addrList{
+		// Loser that will fail to connect, see RFC 6890.
 		&TCPAddr{IP: IPv4(198, 18, 0, 254), Port: portnum},
-		&TCPAddr{IP: ParseIP("2001:2::254"), Port: portnum},
-
-		// Winner candidates of this race.
+		// Winner candidate of this race.
 		&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: portnum},
-		&TCPAddr{IP: IPv6loopback, Port: portnum},
-
-		// Losers that will have established connections.
+		// Loser that will have established connections.
 		&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: portnum},
+	}
+	fallbacks := addrList{
+		&TCPAddr{IP: ParseIP("2001:2::254"), Port: portnum},
+		&TCPAddr{IP: IPv6loopback, Port: portnum},
 		&TCPAddr{IP: IPv6loopback, Port: portnum},
 	}
 	const T1 = 10 * time.Millisecond
@@ -389,7 +391,7 @@ func TestDialMultiFDLeak(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if c, err := dialMulti("tcp", "fast failover test", nil, ras, time.Now().Add(T1)); err == nil {
+			if c, err := dialParallel("tcp", "fast failover test", nil, primaries, fallbacks, 0, time.Now().Add(T1), nil); err == nil {
 				c.Close()
 			}
 		}()
@@ -588,3 +590,184 @@ func TestDialerKeepAlive(t *testing.T) {
 		}
 	}
 }
+
+var partialDeadlineTests = []struct {
+	now            time.Time
+	deadline       time.Time
+	addrs          int
+	expectDeadline time.Time
+	expectErr      error
+}{
+	// Regular division.
+	{time.Unix(0, 0), time.Unix(10, 0), 1, time.Unix(10, 0), nil},
+	{time.Unix(0, 0), time.Unix(10, 0), 2, time.Unix(5, 0), nil},
+	{time.Unix(0, 0), time.Unix(10, 0), 3, time.Unix(3, 333333333), nil},
+	// Bump against the 2-second sane minimum.
+	{time.Unix(0, 0), time.Unix(10, 0), 6, time.Unix(2, 0), nil},
+	// Total available is now below the sane minimum.
+	{time.Unix(0, 0), time.Unix(1, 0), 3, time.Unix(1, 0), nil},
+	// No timeout.
+	{time.Unix(0, 0), time.Time{}, 1, time.Time{}, nil},
+	// Step the clock forward and cross the deadline.
+	{time.Unix(1, 0), time.Unix(2, 0), 1, time.Unix(2, 0), nil},
+	{time.Unix(2, 0), time.Unix(2, 0), 1, time.Time{}, errTimeout},
+	{time.Unix(3, 0), time.Unix(2, 0), 1, time.Time{}, errTimeout},
+}
+
+func TestPartialDeadline(t *testing.T) {
+	for i, tt := range partialDeadlineTests {
+		deadline, err := partialDeadline(tt.now, tt.deadline, tt.addrs)
+		if err != tt.expectErr {
+			t.Errorf("#%d: got err %v; want %v", i, err, tt.expectErr)
+		}
+		if !deadline.Equal(tt.expectDeadline) {
+			t.Errorf("#%d: got deadline %v; want %v", i, deadline, tt.expectDeadline)
+		}
+	}
+}
+
+func init() { testHookDialTCP = fakeDialTCP }
+
+// fakeDialTCP fakes dials to the documentation addresses in
+// 192.0.2.0/24 and 2001:db8::/32, according to their last byte:
+//
+//	1: the address does not respond until the deadline
+//	2: the address refuses connections
+//	3: the connection is made to the same port on 127.0.0.1
+//	4: the deadline is recorded in fakeDialDeadlines and the dial
+//	   times out at once
+//
+// Dials to other addresses are real.
+func fakeDialTCP(net string, laddr, raddr *TCPAddr, deadline time.Time, ctrlFn func(string, string, syscall.RawConn) error) (*TCPConn, error) {
+	if !isFakeDialAddr(raddr.IP) {
+		return dialTCP(net, laddr, raddr, deadline, ctrlFn)
+	}
+	switch raddr.IP[len(raddr.IP)-1] {
+	case 1:
+		if deadline.IsZero() {
+			deadline = time.Now().Add(5 * time.Second)
+		}
+		time.Sleep(deadline.Sub(time.Now()))
+		return nil, &OpError{Op: "dial", Net: net, Addr: raddr, Err: errTimeout}
+	case 3:
+		return dialTCP("tcp", laddr, &TCPAddr{IP: IPv4(127, 0, 0, 1), Port: raddr.Port}, deadline, ctrlFn)
+	case 4:
+		fakeDialDeadlines.Lock()
+		fakeDialDeadlines.d = append(fakeDialDeadlines.d, deadline)
+		fakeDialDeadlines.Unlock()
+		return nil, &OpError{Op: "dial", Net: net, Addr: raddr, Err: errTimeout}
+	}
+	return nil, &OpError{Op: "dial", Net: net, Addr: raddr, Err: errors.New("connection refused")}
+}
+
+var fakeDialDeadlines struct {
+	sync.Mutex
+	d []time.Time
+}
+
+func isFakeDialAddr(ip IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4[0] == 192 && ip4[1] == 0 && ip4[2] == 2
+	}
+	return len(ip) == IPv6len && ip[0] == 0x20 && ip[1] == 0x01 && ip[2] == 0x0d && ip[3] == 0xb8
+}
+
+func TestDialParallel(t *testing.T) {
+	ln, err := Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Close()
+		}
+	}()
+	port := ln.Addr().(*TCPAddr).Port
+
+	var (
+		slow4    = &TCPAddr{IP: IPv4(192, 0, 2, 1), Port: port}
+		refused4 = &TCPAddr{IP: IPv4(192, 0, 2, 2), Port: port}
+		good4    = &TCPAddr{IP: IPv4(192, 0, 2, 3), Port: port}
+		slow6    = &TCPAddr{IP: ParseIP("2001:db8::1"), Port: port}
+		refused6 = &TCPAddr{IP: ParseIP("2001:db8::2"), Port: port}
+		good6    = &TCPAddr{IP: ParseIP("2001:db8::3"), Port: port}
+	)
+	tests := []struct {
+		primaries, fallbacks addrList
+		delay                time.Duration
+		minTime, maxTime     time.Duration // bounds on the dial's duration
+		ok                   bool
+	}{
+		// The primary connects.
+		{addrList{good4}, addrList{slow6}, 50 * time.Millisecond, 0, time.Second, true},
+		// The primary does not respond; the fallback starts after the delay.
+		{addrList{slow4}, addrList{good6}, 50 * time.Millisecond, 50 * time.Millisecond, time.Second, true},
+		// The primary refuses; the fallback starts at once.
+		{addrList{refused4}, addrList{good6}, 5 * time.Second, 0, time.Second, true},
+		// A primary that does not respond does not use all the time.
+		{addrList{slow4, good4}, addrList{refused6}, 5 * time.Second, 2 * time.Second, 3 * time.Second, true},
+		// Everything fails.
+		{addrList{refused4}, addrList{refused6}, 5 * time.Second, 0, time.Second, false},
+	}
+	for i, tt := range tests {
+		start := time.Now()
+		c, err := dialParallel("tcp", "happy eyeballs test", nil, tt.primaries, tt.fallbacks, tt.delay, start.Add(4*time.Second), nil)
+		elapsed := time.Since(start)
+		if tt.ok != (err == nil) {
+			t.Errorf("#%d: got err %v; want success %v", i, err, tt.ok)
+		}
+		if c != nil {
+			c.Close()
+		}
+		if elapsed < tt.minTime || elapsed > tt.maxTime {
+			t.Errorf("#%d: dial took %v; want between %v and %v", i, elapsed, tt.minTime, tt.maxTime)
+		}
+	}
+}
+
+func TestDialSerialErrors(t *testing.T) {
+	fakeDialDeadlines.Lock()
+	fakeDialDeadlines.d = nil
+	fakeDialDeadlines.Unlock()
+	ras := addrList{
+		&TCPAddr{IP: IPv4(192, 0, 2, 4), Port: 80},
+		&TCPAddr{IP: ParseIP("2001:db8::4"), Port: 80},
+		&TCPAddr{IP: IPv4(192, 0, 2, 4), Port: 81},
+	}
+	deadline := time.Now().Add(9 * time.Second)
+	c, errs := dialSerial("tcp", "serial test", nil, ras, deadline, nil, nil)
+	if c != nil {
+		c.Close()
+		t.Fatalf("dialSerial succeeded")
+	}
+	if len(errs) != len(ras) {
+		t.Fatalf("got %d errors; want %d", len(errs), len(ras))
+	}
+	fakeDialDeadlines.Lock()
+	deadlines := fakeDialDeadlines.d
+	fakeDialDeadlines.Unlock()
+	// The time is shared among the addresses.
+	if d := deadline.Sub(deadlines[0]); d < 5*time.Second {
+		t.Errorf("first address was given until %v before the deadline; want about 6s", d)
+	}
+	if !deadlines[2].Equal(deadline) {
+		t.Errorf("last address was given until %v; want %v", deadlines[2], deadline)
+	}
+
+	err := dialError("tcp", errs)
+	if e, ok := err.(Error); !ok || !e.Timeout() {
+		t.Errorf("dialError(%v) is not a timeout", err)
+	}
+	const want = "dial tcp: 192.0.2.4:80: i/o timeout; [2001:db8::4]:80: i/o timeout; 192.0.2.4:81: i/o timeout"
+	if s := err.Error(); s != want {
+		t.Errorf("got error %q; want %q", s, want)
+	}
+	if err := dialError("tcp", errs[:1]); err != errs[0] {
+		t.Errorf("dialError of one error = %v; want %v", err, errs[0])
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"net/dnsmessage"
+	"sync"
+	"time"
+)
+
+// A dnsCache holds the answers to DNS queries made by a Resolver
+// for as long as their time to live allows.  Answers that a name
+// does not exist are kept for the time given by the zone's SOA
+// record, as described in RFC 2308.
+type dnsCache struct {
+	mu sync.Mutex
+	m  map[dnsCacheKey]*dnsCacheEntry
+}
+
+type dnsCacheKey struct {
+	name  string
+	qtype dnsmessage.Type
+}
+
+type dnsCacheEntry struct {
+	cname  string
+	addrs  []dnsmessage.Resource // nil if the name does not exist
+	server string
+	expire time.Time
+}
+
+// get returns the unexpired entry for name and qtype, if any.
+func (c *dnsCache) get(name string, qtype dnsmessage.Type) *dnsCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := dnsCacheKey{name, qtype}
+	e := c.m[key]
+	if e == nil {
+		return nil
+	}
+	if !time.Now().Before(e.expire) {
+		delete(c.m, key)
+		return nil
+	}
+	return e
+}
+
+// put records the answer to a query for name and qtype, which
+// came from server in msg, keeping at most size entries.  An
+// answer without addrs says that the name does not exist.
+func (c *dnsCache) put(size int, name string, qtype dnsmessage.Type, server, cname string, addrs []dnsmessage.Resource, msg *dnsmessage.Message) {
+	var ttl uint32
+	if addrs != nil {
+		ttl = minTTL(addrs)
+	} else {
+		// Negative answers may be kept for the lesser of
+		// the SOA record's TTL and its minimum field.
+		soa := false
+		for _, rr := range msg.Authorities {
+			if b, ok := rr.Body.(*dnsmessage.SOAResource); ok {
+				ttl, soa = rr.Header.TTL, true
+				if b.MinTTL < ttl {
+					ttl = b.MinTTL
+				}
+				break
+			}
+		}
+		if !soa {
+			return
+		}
+	}
+	if ttl == 0 {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.m == nil {
+		c.m = make(map[dnsCacheKey]*dnsCacheEntry)
+	}
+	key := dnsCacheKey{name, qtype}
+	if _, ok := c.m[key]; !ok && len(c.m) >= size {
+		c.evict(now, len(c.m)-size+1)
+	}
+	c.m[key] = &dnsCacheEntry{
+		cname:  cname,
+		addrs:  addrs,
+		server: server,
+		expire: now.Add(time.Duration(ttl) * time.Second),
+	}
+}
+
+// evict removes at least n entries, preferring those that
+// have expired.  The caller must hold c.mu.
+func (c *dnsCache) evict(now time.Time, n int) {
+	for key, e := range c.m {
+		if !now.Before(e.expire) {
+			delete(c.m, key)
+			n--
+		}
+	}
+	for key := range c.m {
+		if n <= 0 {
+			break
+		}
+		delete(c.m, key)
+		n--
+	}
+}
+
+func minTTL(rrs []dnsmessage.Resource) uint32 {
+	ttl := rrs[0].Header.TTL
+	for _, rr := range rrs[1:] {
+		if rr.Header.TTL < ttl {
+			ttl = rr.Header.TTL
+		}
+	}
+	return ttl
+}
@@ -6,6 +6,7 @@ package net
 
 import (
 	"math/rand"
+	"net/dnsmessage"
 	"sort"
 )
 
@@ -63,13 +64,13 @@ func reverseaddr(addr string) (arpa string, err error) {
 
 // Find answer for name in dns message.
 // On return, if err == nil, addrs != nil.
-func answer(name, server string, dns *dnsMsg, qtype uint16) (cname string, addrs []dnsRR, err error) {
-	addrs = make([]dnsRR, 0, len(dns.answer))
+func answer(name, server string, dns *dnsmessage.Message, qtype dnsmessage.Type) (cname string, addrs []dnsmessage.Resource, err error) {
+	addrs = make([]dnsmessage.Resource, 0, len(dns.Answers))
 
-	if dns.rcode == dnsRcodeNameError && dns.recursion_available {
+	if dns.RCode == dnsmessage.RCodeNameError && dns.RecursionAvailable {
 		return "", nil, &DNSError{Err: noSuchHost, Name: name}
 	}
-	if dns.rcode != dnsRcodeSuccess {
+	if dns.RCode != dnsmessage.RCodeSuccess {
 		// None of the error codes make sense
 		// for the query we sent.  If we didn't get
 		// a name error and we didn't get success,
@@ -85,22 +86,22 @@ func answer(name, server string, dns *dnsMsg, qtype uint16) (cname string, addrs
 Cname:
 	for cnameloop := 0; cnameloop < 10; cnameloop++ {
 		addrs = addrs[0:0]
-		for _, rr := range dns.answer {
-			if _, justHeader := rr.(*dnsRR_Header); justHeader {
+		for _, rr := range dns.Answers {
+			if rr.Body == nil {
 				// Corrupt record: we only have a
 				// header. That header might say it's
 				// of type qtype, but we don't
 				// actually have it. Skip.
 				continue
 			}
-			h := rr.Header()
-			if h.Class == dnsClassINET && h.Name == name {
-				switch h.Rrtype {
+			h := &rr.Header
+			if h.Class == dnsmessage.ClassINET && h.Name == name {
+				switch h.Type {
 				case qtype:
 					addrs = append(addrs, rr)
-				case dnsTypeCNAME:
+				case dnsmessage.TypeCNAME:
 					// redirect to cname
-					name = rr.(*dnsRR_CNAME).Cname
+					name = rr.Body.(*dnsmessage.CNAMEResource).CNAME
 					continue Cname
 				}
 			}
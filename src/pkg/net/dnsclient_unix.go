@@ -8,209 +8,278 @@
 // Has to be linked into package net for Dial.
 
 // TODO(rsc):
-//	Check periodically whether /etc/resolv.conf has changed.
 //	Could potentially handle many outstanding lookups faster.
-//	Could have a small cache.
 //	Random UDP source port (net.Dial should do that for us).
-//	Random request IDs.
 
 package net
 
 import (
 	"io"
 	"math/rand"
-	"sync"
+	"net/dnsmessage"
 	"time"
 )
 
-// Send a request on the connection and hope for a reply.
-// Up to cfg.attempts attempts.
-func exchange(cfg *dnsConfig, c Conn, name string, qtype uint16) (*dnsMsg, error) {
-	_, useTCP := c.(*TCPConn)
-	if len(name) >= 256 {
-		return nil, &DNSError{Err: "name too long", Name: name}
+// maxDNSPacketSize is the size of the largest DNS message over UDP
+// that the resolver accepts, which it advertises to servers using
+// EDNS0 (RFC 6891).  It is small enough to avoid IP fragmentation
+// on most paths; larger answers come back truncated and are asked
+// for again over TCP.
+const maxDNSPacketSize = 1232
+
+// newQuery returns a recursive query for name and qtype, which
+// advertises maxDNSPacketSize if edns0 is set.
+func newQuery(name string, qtype dnsmessage.Type, edns0 bool) *dnsmessage.Message {
+	q := &dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               uint16(rand.Int()) ^ uint16(time.Now().UnixNano()),
+			RecursionDesired: true,
+		},
+		Questions: []dnsmessage.Question{
+			{Name: name, Type: qtype, Class: dnsmessage.ClassINET},
+		},
 	}
-	out := new(dnsMsg)
-	out.id = uint16(rand.Int()) ^ uint16(time.Now().UnixNano())
-	out.question = []dnsQuestion{
-		{name, qtype, dnsClassINET},
+	if edns0 {
+		var opt dnsmessage.Resource
+		opt.Header.SetEDNS0(maxDNSPacketSize, dnsmessage.RCodeSuccess, false)
+		opt.Body = &dnsmessage.OPTResource{}
+		q.Additionals = []dnsmessage.Resource{opt}
 	}
-	out.recursion_desired = true
-	msg, ok := out.Pack()
-	if !ok {
-		return nil, &DNSError{Err: "internal error - cannot pack message", Name: name}
+	return q
+}
+
+// exchange sends the query q on the connection and waits for the
+// reply until deadline.  Messages on connections that are not
+// packet-oriented are prefixed by their length, as in DNS over TCP.
+// Packets that do not answer q are ignored.
+func exchange(c Conn, q *dnsmessage.Message, deadline time.Time) (*dnsmessage.Message, error) {
+	_, isPacket := c.(PacketConn)
+	var prefix []byte
+	if !isPacket {
+		prefix = []byte{0, 0}
 	}
-	if useTCP {
-		mlen := uint16(len(msg))
-		msg = append([]byte{byte(mlen >> 8), byte(mlen)}, msg...)
+	msg, err := q.AppendPack(prefix)
+	if err != nil {
+		return nil, &DNSError{Err: "internal error - cannot pack message", Name: q.Questions[0].Name}
 	}
-	for attempt := 0; attempt < cfg.attempts; attempt++ {
-		n, err := c.Write(msg)
-		if err != nil {
-			return nil, err
-		}
-
-		if cfg.timeout == 0 {
-			c.SetReadDeadline(noDeadline)
+	if !isPacket {
+		mlen := len(msg) - 2
+		msg[0], msg[1] = byte(mlen>>8), byte(mlen)
+	}
+	c.SetDeadline(deadline)
+	if _, err := c.Write(msg); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, maxDNSPacketSize)
+	for {
+		var n int
+		if isPacket {
+			n, err = c.Read(buf)
 		} else {
-			c.SetReadDeadline(time.Now().Add(time.Duration(cfg.timeout) * time.Second))
-		}
-		buf := make([]byte, 2000)
-		if useTCP {
-			n, err = io.ReadFull(c, buf[:2])
-			if err != nil {
-				if e, ok := err.(Error); ok && e.Timeout() {
-					continue
-				}
+			if _, err = io.ReadFull(c, buf[:2]); err != nil {
+				return nil, err
 			}
-			mlen := int(buf[0])<<8 | int(buf[1])
-			if mlen > len(buf) {
-				buf = make([]byte, mlen)
+			n = int(buf[0])<<8 | int(buf[1])
+			if n > len(buf) {
+				buf = make([]byte, n)
 			}
-			n, err = io.ReadFull(c, buf[:mlen])
-		} else {
-			n, err = c.Read(buf)
+			n, err = io.ReadFull(c, buf[:n])
 		}
 		if err != nil {
-			if e, ok := err.(Error); ok && e.Timeout() {
-				continue
-			}
 			return nil, err
 		}
-		buf = buf[:n]
-		in := new(dnsMsg)
-		if !in.Unpack(buf) || in.id != out.id {
+		in := new(dnsmessage.Message)
+		if in.Unpack(buf[:n]) != nil || !in.Response || in.ID != q.ID ||
+			len(in.Questions) != 1 || !equalASCIILabel(in.Questions[0].Name, q.Questions[0].Name) ||
+			in.Questions[0].Type != q.Questions[0].Type {
+			if !isPacket {
+				return nil, &DNSError{Err: "invalid response", Name: q.Questions[0].Name}
+			}
 			continue
 		}
 		return in, nil
 	}
-	var server string
-	if a := c.RemoteAddr(); a != nil {
-		server = a.String()
+}
+
+// equalASCIILabel reports whether the domain names x and y are
+// equal, ignoring the case of ASCII letters.
+func equalASCIILabel(x, y string) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := 0; i < len(x); i++ {
+		a, b := x[i], y[i]
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		if a != b {
+			return false
+		}
+	}
+	return true
+}
+
+// dial connects to the DNS server address on network,
+// using r.Dial if it is set.
+func (r *Resolver) dial(network, address string, deadline time.Time) (Conn, error) {
+	if r != nil && r.Dial != nil {
+		return r.Dial(network, address)
+	}
+	// Calling Dial here is scary -- we have to be sure
+	// not to dial a name that will require a DNS lookup,
+	// or Dial will call back here to translate it.
+	// The DNS config parser has already checked that
+	// all the cfg.servers[i] are IP addresses, which
+	// Dial will use without a DNS lookup.
+	d := Dialer{Deadline: deadline}
+	return d.Dial(network, address)
+}
+
+// query asks server about name and qtype, giving up at deadline.
+// It retries a truncated answer over TCP (see RFC 5966), and a
+// query with EDNS0 to which the server objects without it.
+func (r *Resolver) query(cfg *dnsConfig, server, name string, qtype dnsmessage.Type, deadline time.Time) (*dnsmessage.Message, error) {
+	network := "udp"
+	if cfg.useTCP {
+		network = "tcp"
+	}
+	edns0 := true
+	for {
+		c, err := r.dial(network, server, deadline)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := exchange(c, newQuery(name, qtype, edns0), deadline)
+		c.Close()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case msg.Truncated && network == "udp":
+			network = "tcp"
+		case msg.RCode == dnsmessage.RCodeFormatError && edns0:
+			// Some old servers do not understand EDNS0.
+			edns0 = false
+		default:
+			return msg, nil
+		}
 	}
-	return nil, &DNSError{Err: "no answer from server", Name: name, Server: server, IsTimeout: true}
 }
 
 // Do a lookup for a single name, which must be rooted
 // (otherwise answer will not find the answers).
-func tryOneName(cfg *dnsConfig, name string, qtype uint16) (cname string, addrs []dnsRR, err error) {
+// Up to cfg.attempts rounds over the servers, waiting
+// up to cfg.timeout seconds for each and giving up at deadline.
+func (r *Resolver) tryOneName(cfg *dnsConfig, name string, qtype dnsmessage.Type, deadline time.Time) (cname string, addrs []dnsmessage.Resource, err error) {
 	if len(cfg.servers) == 0 {
 		return "", nil, &DNSError{Err: "no DNS servers", Name: name}
 	}
-	for i := 0; i < len(cfg.servers); i++ {
-		// Calling Dial here is scary -- we have to be sure
-		// not to dial a name that will require a DNS lookup,
-		// or Dial will call back here to translate it.
-		// The DNS config parser has already checked that
-		// all the cfg.servers[i] are IP addresses, which
-		// Dial will use without a DNS lookup.
-		server := cfg.servers[i] + ":53"
-		c, cerr := Dial("udp", server)
-		if cerr != nil {
-			err = cerr
-			continue
-		}
-		msg, merr := exchange(cfg, c, name, qtype)
-		c.Close()
-		if merr != nil {
-			err = merr
-			continue
+	if len(name) >= 256 {
+		return "", nil, &DNSError{Err: "name too long", Name: name}
+	}
+	useCache := r != nil && r.CacheSize > 0
+	if useCache {
+		if e := r.cache.get(name, qtype); e != nil {
+			if e.addrs == nil {
+				return "", nil, &DNSError{Err: noSuchHost, Name: name, Server: e.server}
+			}
+			return e.cname, e.addrs, nil
 		}
-		if msg.truncated { // see RFC 5966
-			c, cerr = Dial("tcp", server)
-			if cerr != nil {
-				err = cerr
-				continue
+	}
+	servers := cfg.serverList()
+	for attempt := 0; attempt < cfg.attempts; attempt++ {
+		for _, server := range servers {
+			server += ":53"
+			now := time.Now()
+			if !deadline.IsZero() && !now.Before(deadline) {
+				return "", nil, &DNSError{Err: "no answer from server", Name: name, Server: server, IsTimeout: true}
+			}
+			d := now.Add(time.Duration(cfg.timeout) * time.Second)
+			if !deadline.IsZero() && deadline.Before(d) {
+				d = deadline
 			}
-			msg, merr = exchange(cfg, c, name, qtype)
-			c.Close()
+			msg, merr := r.query(cfg, server, name, qtype, d)
 			if merr != nil {
+				if e, ok := merr.(Error); ok && e.Timeout() {
+					merr = &DNSError{Err: "no answer from server", Name: name, Server: server, IsTimeout: true}
+				}
 				err = merr
 				continue
 			}
-		}
-		cname, addrs, err = answer(name, server, msg, qtype)
-		if err == nil || err.(*DNSError).Err == noSuchHost {
-			break
+			cname, addrs, err = answer(name, server, msg, qtype)
+			if err == nil || err.(*DNSError).Err == noSuchHost {
+				if useCache {
+					r.cache.put(r.CacheSize, name, qtype, server, cname, addrs, msg)
+				}
+				return
+			}
 		}
 	}
 	return
 }
 
-func convertRR_A(records []dnsRR) []IP {
+func convertRR_A(records []dnsmessage.Resource) []IP {
 	addrs := make([]IP, len(records))
 	for i, rr := range records {
-		a := rr.(*dnsRR_A).A
-		addrs[i] = IPv4(byte(a>>24), byte(a>>16), byte(a>>8), byte(a))
+		a := rr.Body.(*dnsmessage.AResource).A
+		addrs[i] = IPv4(a[0], a[1], a[2], a[3])
 	}
 	return addrs
 }
 
-func convertRR_AAAA(records []dnsRR) []IP {
+func convertRR_AAAA(records []dnsmessage.Resource) []IP {
 	addrs := make([]IP, len(records))
 	for i, rr := range records {
 		a := make(IP, IPv6len)
-		copy(a, rr.(*dnsRR_AAAA).AAAA[:])
+		copy(a, rr.Body.(*dnsmessage.AAAAResource).AAAA[:])
 		addrs[i] = a
 	}
 	return addrs
 }
 
-var cfg *dnsConfig
-var dnserr error
-
-// Assume dns config file is /etc/resolv.conf here
-func loadConfig() { cfg, dnserr = dnsReadConfig("/etc/resolv.conf") }
-
-var onceLoadConfig sync.Once
-
-func lookup(name string, qtype uint16) (cname string, addrs []dnsRR, err error) {
-	if !isDomainName(name) {
-		return name, nil, &DNSError{Err: "invalid domain name", Name: name}
+// nameList returns the rooted names to try, in order, when
+// looking up name: name itself if it is rooted or has at least
+// cfg.ndots dots, then name with each search domain appended,
+// then name itself if it has fewer dots.
+func (cfg *dnsConfig) nameList(name string) []string {
+	if name[len(name)-1] == '.' {
+		return []string{name}
 	}
-	onceLoadConfig.Do(loadConfig)
-	if dnserr != nil || cfg == nil {
-		err = dnserr
-		return
+	var names []string
+	hasNdots := count(name, '.') >= cfg.ndots
+	if hasNdots {
+		names = append(names, name+".")
 	}
-	// If name is rooted (trailing dot) or has enough dots,
-	// try it by itself first.
-	rooted := len(name) > 0 && name[len(name)-1] == '.'
-	if rooted || count(name, '.') >= cfg.ndots {
-		rname := name
-		if !rooted {
+	for _, suffix := range cfg.search {
+		rname := name + "." + suffix
+		if rname[len(rname)-1] != '.' {
 			rname += "."
 		}
-		// Can try as ordinary name.
-		cname, addrs, err = tryOneName(cfg, rname, qtype)
-		if err == nil {
-			return
-		}
+		names = append(names, rname)
 	}
-	if rooted {
-		return
+	if !hasNdots {
+		names = append(names, name+".")
 	}
+	return names
+}
 
-	// Otherwise, try suffixes.
-	for i := 0; i < len(cfg.search); i++ {
-		rname := name + "." + cfg.search[i]
-		if rname[len(rname)-1] != '.' {
-			rname += "."
-		}
-		cname, addrs, err = tryOneName(cfg, rname, qtype)
+func (r *Resolver) lookup(name string, qtype dnsmessage.Type, deadline time.Time) (cname string, addrs []dnsmessage.Resource, err error) {
+	if !isDomainName(name) {
+		return name, nil, &DNSError{Err: "invalid domain name", Name: name}
+	}
+	cfg := getDNSConfig()
+	for _, rname := range cfg.nameList(name) {
+		cname, addrs, err = r.tryOneName(cfg, rname, qtype, deadline)
 		if err == nil {
 			return
 		}
-	}
-
-	// Last ditch effort: try unsuffixed.
-	rname := name
-	if !rooted {
-		rname += "."
-	}
-	cname, addrs, err = tryOneName(cfg, rname, qtype)
-	if err == nil {
-		return
+		if e, ok := err.(*DNSError); ok && e.IsTimeout && !deadline.IsZero() && !time.Now().Before(deadline) {
+			// No time to try other names.
+			break
+		}
 	}
 	if e, ok := err.(*DNSError); ok {
 		// Show original name passed to lookup, not suffixed one.
@@ -222,23 +291,18 @@ func lookup(name string, qtype uint16) (cname string, addrs []dnsRR, err error)
 }
 
 // goLookupHost is the native Go implementation of LookupHost.
-// Used only if cgoLookupHost refuses to handle the request
-// (that is, only if cgoLookupHost is the stub in cgo_stub.go).
+// Used only if r prefers it or cgoLookupHost refuses to handle
+// the request (that is, if cgoLookupHost is the stub in cgo_stub.go).
 // Normally we let cgo use the C library resolver instead of
 // depending on our lookup code, so that Go and C get the same
 // answers.
-func goLookupHost(name string) (addrs []string, err error) {
+func (r *Resolver) goLookupHost(name string, deadline time.Time) (addrs []string, err error) {
 	// Use entries from /etc/hosts if they match.
 	addrs = lookupStaticHost(name)
 	if len(addrs) > 0 {
 		return
 	}
-	onceLoadConfig.Do(loadConfig)
-	if dnserr != nil || cfg == nil {
-		err = dnserr
-		return
-	}
-	ips, err := goLookupIP(name)
+	ips, err := r.goLookupIP(name, deadline)
 	if err != nil {
 		return
 	}
@@ -250,12 +314,12 @@ func goLookupHost(name string) (addrs []string, err error) {
 }
 
 // goLookupIP is the native Go implementation of LookupIP.
-// Used only if cgoLookupIP refuses to handle the request
-// (that is, only if cgoLookupIP is the stub in cgo_stub.go).
+// Used only if r prefers it or cgoLookupIP refuses to handle
+// the request (that is, if cgoLookupIP is the stub in cgo_stub.go).
 // Normally we let cgo use the C library resolver instead of
 // depending on our lookup code, so that Go and C get the same
 // answers.
-func goLookupIP(name string) (addrs []IP, err error) {
+func (r *Resolver) goLookupIP(name string, deadline time.Time) (addrs []IP, err error) {
 	// Use entries from /etc/hosts if possible.
 	haddrs := lookupStaticHost(name)
 	if len(haddrs) > 0 {
@@ -268,20 +332,30 @@ func goLookupIP(name string) (addrs []IP, err error) {
 			return
 		}
 	}
-	onceLoadConfig.Do(loadConfig)
-	if dnserr != nil || cfg == nil {
-		err = dnserr
-		return
+	// Look up IPv4 and IPv6 addresses at the same time,
+	// unless the single-request option asks for one at a time.
+	type result struct {
+		records []dnsmessage.Resource
+		err     error
+	}
+	var resc chan result
+	lookupAAAA := func() result {
+		_, records, err := r.lookup(name, dnsmessage.TypeAAAA, deadline)
+		return result{records, err}
+	}
+	if !getDNSConfig().singleRequest {
+		resc = make(chan result, 1)
+		go func() { resc <- lookupAAAA() }()
 	}
-	var records []dnsRR
-	var cname string
-	var err4, err6 error
-	cname, records, err4 = lookup(name, dnsTypeA)
+	_, records, err4 := r.lookup(name, dnsmessage.TypeA, deadline)
 	addrs = convertRR_A(records)
-	if cname != "" {
-		name = cname
+	var aaaa result
+	if resc != nil {
+		aaaa = <-resc
+	} else {
+		aaaa = lookupAAAA()
 	}
-	_, records, err6 = lookup(name, dnsTypeAAAA)
+	records, err6 := aaaa.records, aaaa.err
 	if err4 != nil && err6 == nil {
 		// Ignore A error because AAAA lookup succeeded.
 		err4 = nil
@@ -302,21 +376,16 @@ func goLookupIP(name string) (addrs []IP, err error) {
 }
 
 // goLookupCNAME is the native Go implementation of LookupCNAME.
-// Used only if cgoLookupCNAME refuses to handle the request
-// (that is, only if cgoLookupCNAME is the stub in cgo_stub.go).
+// Used only if r prefers it or cgoLookupCNAME refuses to handle
+// the request (that is, if cgoLookupCNAME is the stub in cgo_stub.go).
 // Normally we let cgo use the C library resolver instead of
 // depending on our lookup code, so that Go and C get the same
 // answers.
-func goLookupCNAME(name string) (cname string, err error) {
-	onceLoadConfig.Do(loadConfig)
-	if dnserr != nil || cfg == nil {
-		err = dnserr
-		return
-	}
-	_, rr, err := lookup(name, dnsTypeCNAME)
+func (r *Resolver) goLookupCNAME(name string, deadline time.Time) (cname string, err error) {
+	_, rr, err := r.lookup(name, dnsmessage.TypeCNAME, deadline)
 	if err != nil {
 		return
 	}
-	cname = rr[0].(*dnsRR_CNAME).Cname
+	cname = rr[0].Body.(*dnsmessage.CNAMEResource).CNAME
 	return
 }
@@ -7,7 +7,11 @@
 package net
 
 import (
+	"io"
+	"net/dnsmessage"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestTCPLookup(t *testing.T) {
@@ -19,9 +23,447 @@ func TestTCPLookup(t *testing.T) {
 		t.Fatalf("Dial failed: %v", err)
 	}
 	defer c.Close()
-	cfg := &dnsConfig{timeout: 10, attempts: 3}
-	_, err = exchange(cfg, c, "com.", dnsTypeALL)
+	_, err = exchange(c, newQuery("com.", dnsmessage.TypeALL, true), time.Now().Add(10*time.Second))
 	if err != nil {
 		t.Fatalf("exchange failed: %v", err)
 	}
 }
+
+// A fakeDNSServer answers queries sent to it over UDP and TCP.
+// Resolvers reach it using its dial method.
+type fakeDNSServer struct {
+	pc PacketConn
+	ln Listener
+
+	// answer returns the answers to q, or false to drop the query.
+	answer func(q dnsmessage.Question) ([]dnsmessage.Resource, bool)
+
+	// truncate causes answers over UDP to be cut short,
+	// so that they have to be asked for again over TCP.
+	truncate bool
+
+	mu      sync.Mutex
+	queries []fakeDNSQuery
+}
+
+type fakeDNSQuery struct {
+	network string
+	msg     *dnsmessage.Message
+}
+
+func newFakeDNSServer(answer func(q dnsmessage.Question) ([]dnsmessage.Resource, bool)) (*fakeDNSServer, error) {
+	pc, err := ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	ln, err := Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		pc.Close()
+		return nil, err
+	}
+	s := &fakeDNSServer{pc: pc, ln: ln, answer: answer}
+	go s.serveUDP()
+	go s.serveTCP()
+	return s, nil
+}
+
+// reply returns the reply to the query in b, or nil if there is none.
+func (s *fakeDNSServer) reply(network string, b []byte) []byte {
+	q := new(dnsmessage.Message)
+	if q.Unpack(b) != nil || len(q.Questions) != 1 {
+		return nil
+	}
+	s.mu.Lock()
+	s.queries = append(s.queries, fakeDNSQuery{network, q})
+	truncate := s.truncate && network == "udp"
+	s.mu.Unlock()
+	answers, ok := s.answer(q.Questions[0])
+	if !ok {
+		return nil
+	}
+	m := &dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 q.ID,
+			Response:           true,
+			RecursionDesired:   q.RecursionDesired,
+			RecursionAvailable: true,
+		},
+		Questions: q.Questions,
+		Answers:   answers,
+	}
+	if len(answers) == 0 {
+		m.RCode = dnsmessage.RCodeNameError
+	}
+	if truncate {
+		m.Truncated = true
+		m.Answers = nil
+	}
+	b, err := m.Pack()
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func (s *fakeDNSServer) serveUDP() {
+	b := make([]byte, maxDNSPacketSize)
+	for {
+		n, addr, err := s.pc.ReadFrom(b)
+		if err != nil {
+			return
+		}
+		if r := s.reply("udp", b[:n]); r != nil {
+			s.pc.WriteTo(r, addr)
+		}
+	}
+}
+
+func (s *fakeDNSServer) serveTCP() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer c.Close()
+			for {
+				var l [2]byte
+				if _, err := io.ReadFull(c, l[:]); err != nil {
+					return
+				}
+				b := make([]byte, int(l[0])<<8|int(l[1]))
+				if _, err := io.ReadFull(c, b); err != nil {
+					return
+				}
+				r := s.reply("tcp", b)
+				if r == nil {
+					continue
+				}
+				c.Write(append([]byte{byte(len(r) >> 8), byte(len(r))}, r...))
+			}
+		}()
+	}
+}
+
+func (s *fakeDNSServer) dial(network, address string) (Conn, error) {
+	if network == "tcp" {
+		return Dial("tcp", s.ln.Addr().String())
+	}
+	return Dial("udp", s.pc.LocalAddr().String())
+}
+
+// received returns the queries the server has received.
+func (s *fakeDNSServer) received() []fakeDNSQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeDNSQuery(nil), s.queries...)
+}
+
+func (s *fakeDNSServer) Close() error {
+	s.ln.Close()
+	return s.pc.Close()
+}
+
+// setTestDNSConfig makes the Go resolver use c until
+// the returned function is called.
+func setTestDNSConfig(c *dnsConfig) (restore func()) {
+	resolvConf.Lock()
+	defer resolvConf.Unlock()
+	saved := resolvConf.conf
+	resolvConf.conf = c
+	resolvConf.path = resolvConfPath
+	resolvConf.lastChecked = time.Now().Add(time.Hour)
+	return func() {
+		resolvConf.Lock()
+		defer resolvConf.Unlock()
+		resolvConf.conf = saved
+		resolvConf.lastChecked = time.Time{}
+	}
+}
+
+func sidecarAnswer(q dnsmessage.Question) ([]dnsmessage.Resource, bool) {
+	if q.Name != "sidecar.test." && q.Name != "_app._tcp.sidecar.test." {
+		return nil, true
+	}
+	h := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: 60}
+	var body dnsmessage.ResourceBody
+	switch q.Type {
+	case dnsmessage.TypeA:
+		body = &dnsmessage.AResource{A: [4]byte{127, 0, 0, 1}}
+	case dnsmessage.TypeSRV:
+		body = &dnsmessage.SRVResource{Priority: 1, Weight: 1, Port: 8080, Target: "sidecar.test."}
+	case dnsmessage.TypeTXT:
+		body = &dnsmessage.TXTResource{TXT: []string{"v=1 ", "ok"}}
+	default:
+		return nil, true
+	}
+	h.Type = q.Type
+	return []dnsmessage.Resource{{Header: h, Body: body}}, true
+}
+
+func TestResolverDial(t *testing.T) {
+	s, err := newFakeDNSServer(sidecarAnswer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	var dials []string
+	r := &Resolver{
+		Dial: func(network, address string) (Conn, error) {
+			dials = append(dials, network+" "+address)
+			return s.dial(network, address)
+		},
+	}
+	ips, err := r.LookupIP("sidecar.test.")
+	if err != nil {
+		t.Fatalf("LookupIP: %v", err)
+	}
+	if len(ips) != 1 || !ips[0].Equal(IPv4(127, 0, 0, 1)) {
+		t.Errorf("LookupIP = %v, want [127.0.0.1]", ips)
+	}
+	if len(dials) == 0 || dials[0] != "udp 192.0.2.53:53" {
+		t.Errorf("Dial calls = %q, want udp 192.0.2.53:53 first", dials)
+	}
+
+	_, srvs, err := r.LookupSRV("app", "tcp", "sidecar.test.")
+	if err != nil {
+		t.Fatalf("LookupSRV: %v", err)
+	}
+	if len(srvs) != 1 || *srvs[0] != (SRV{"sidecar.test.", 8080, 1, 1}) {
+		t.Errorf("LookupSRV = %v, want [{sidecar.test. 8080 1 1}]", srvs)
+	}
+
+	txt, err := r.LookupTXT("sidecar.test.")
+	if err != nil {
+		t.Fatalf("LookupTXT: %v", err)
+	}
+	if len(txt) != 1 || txt[0] != "v=1 ok" {
+		t.Errorf("LookupTXT = %q, want [\"v=1 ok\"]", txt)
+	}
+
+	if _, err := r.LookupHost("missing.test."); err == nil {
+		t.Errorf("LookupHost of missing name succeeded")
+	}
+}
+
+func TestResolverTimeout(t *testing.T) {
+	s, err := newFakeDNSServer(func(dnsmessage.Question) ([]dnsmessage.Resource, bool) {
+		return nil, false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	r := &Resolver{Dial: s.dial, Timeout: 100 * time.Millisecond}
+	start := time.Now()
+	_, err = r.LookupIP("sidecar.test.")
+	if err == nil {
+		t.Fatalf("LookupIP succeeded")
+	}
+	if e, ok := err.(Error); !ok || !e.Timeout() {
+		t.Errorf("LookupIP: err = %v, want timeout", err)
+	}
+	if d := time.Since(start); d > 3*time.Second {
+		t.Errorf("LookupIP took %v, want about 100ms", d)
+	}
+}
+
+func TestResolverDeadline(t *testing.T) {
+	s, err := newFakeDNSServer(func(q dnsmessage.Question) ([]dnsmessage.Resource, bool) {
+		if q.Name == "slow.test." {
+			return nil, false
+		}
+		return sidecarAnswer(q)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	r := &Resolver{Dial: s.dial}
+	addrs, err := r.LookupHostDeadline("sidecar.test.", time.Now().Add(5*time.Second))
+	if err != nil {
+		t.Fatalf("LookupHostDeadline: %v", err)
+	}
+	if len(addrs) != 1 || addrs[0] != "127.0.0.1" {
+		t.Errorf("LookupHostDeadline = %q, want [127.0.0.1]", addrs)
+	}
+
+	start := time.Now()
+	_, err = r.LookupTXTDeadline("slow.test.", time.Now().Add(100*time.Millisecond))
+	if e, ok := err.(Error); !ok || !e.Timeout() {
+		t.Errorf("LookupTXTDeadline: err = %v, want timeout", err)
+	}
+	if d := time.Since(start); d > 3*time.Second {
+		t.Errorf("LookupTXTDeadline took %v, want about 100ms", d)
+	}
+
+	// A deadline in the past fails without asking the servers.
+	n := len(s.received())
+	if _, err := r.LookupIPDeadline("sidecar.test.", time.Now().Add(-time.Second)); err == nil {
+		t.Errorf("LookupIPDeadline with past deadline succeeded")
+	}
+	if q := s.received(); len(q) != n {
+		t.Errorf("LookupIPDeadline with past deadline sent %d queries", len(q)-n)
+	}
+}
+
+func TestDialerResolver(t *testing.T) {
+	s, err := newFakeDNSServer(sidecarAnswer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	ln, err := Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	_, port, err := SplitHostPort(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Dialer{Timeout: 5 * time.Second, Resolver: &Resolver{Dial: s.dial}}
+	c, err := d.Dial("tcp", JoinHostPort("sidecar.test.", port))
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer c.Close()
+	if a := c.RemoteAddr().String(); a != ln.Addr().String() {
+		t.Errorf("RemoteAddr = %s, want %s", a, ln.Addr())
+	}
+}
+
+// manySRVAnswer answers SRV queries for _big._tcp.sidecar.test.
+// with more records than fit in a UDP answer.
+func manySRVAnswer(q dnsmessage.Question) ([]dnsmessage.Resource, bool) {
+	if q.Name != "_big._tcp.sidecar.test." || q.Type != dnsmessage.TypeSRV {
+		return nil, true
+	}
+	var rrs []dnsmessage.Resource
+	for i := 0; i < 100; i++ {
+		rrs = append(rrs, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: q.Name, Type: dnsmessage.TypeSRV, Class: dnsmessage.ClassINET, TTL: 60},
+			Body:   &dnsmessage.SRVResource{Priority: 1, Weight: 1, Port: uint16(8000 + i), Target: "node" + itoa(i) + ".sidecar.test."},
+		})
+	}
+	return rrs, true
+}
+
+func TestResolverTCPFallback(t *testing.T) {
+	s, err := newFakeDNSServer(manySRVAnswer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	s.mu.Lock()
+	s.truncate = true
+	s.mu.Unlock()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	r := &Resolver{Dial: s.dial}
+	_, srvs, err := r.LookupSRV("big", "tcp", "sidecar.test.")
+	if err != nil {
+		t.Fatalf("LookupSRV: %v", err)
+	}
+	if len(srvs) != 100 {
+		t.Errorf("LookupSRV returned %d records; want 100", len(srvs))
+	}
+	var networks []string
+	for _, q := range s.received() {
+		networks = append(networks, q.network)
+	}
+	if len(networks) != 2 || networks[0] != "udp" || networks[1] != "tcp" {
+		t.Errorf("queries sent over %q; want [udp tcp]", networks)
+	}
+}
+
+func TestResolverEDNS0(t *testing.T) {
+	s, err := newFakeDNSServer(sidecarAnswer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	r := &Resolver{Dial: s.dial}
+	if _, err := r.LookupTXT("sidecar.test."); err != nil {
+		t.Fatalf("LookupTXT: %v", err)
+	}
+	qs := s.received()
+	if len(qs) != 1 {
+		t.Fatalf("server received %d queries; want 1", len(qs))
+	}
+	add := qs[0].msg.Additionals
+	if len(add) != 1 || add[0].Header.Type != dnsmessage.TypeOPT || int(add[0].Header.Class) != maxDNSPacketSize {
+		t.Errorf("query additional records = %v; want OPT record with payload size %d", add, maxDNSPacketSize)
+	}
+}
+
+func TestResolverCache(t *testing.T) {
+	s, err := newFakeDNSServer(sidecarAnswer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer setTestDNSConfig(&dnsConfig{servers: []string{"192.0.2.53"}, ndots: 1, timeout: 5, attempts: 2})()
+
+	r := &Resolver{Dial: s.dial, CacheSize: 10}
+	for i := 0; i < 3; i++ {
+		_, srvs, err := r.LookupSRV("app", "tcp", "sidecar.test.")
+		if err != nil {
+			t.Fatalf("LookupSRV: %v", err)
+		}
+		if len(srvs) != 1 {
+			t.Errorf("LookupSRV returned %d records; want 1", len(srvs))
+		}
+	}
+	if n := len(s.received()); n != 1 {
+		t.Errorf("server received %d queries; want 1", n)
+	}
+	// Without an SOA record, that a name does not exist is not kept.
+	for i := 0; i < 2; i++ {
+		if _, err := r.LookupTXT("missing.test."); err == nil {
+			t.Errorf("LookupTXT of missing name succeeded")
+		}
+	}
+	if n := len(s.received()); n != 3 {
+		t.Errorf("server received %d queries; want 3", n)
+	}
+}
+
+func TestDNSCacheEviction(t *testing.T) {
+	var c dnsCache
+	msg := new(dnsmessage.Message)
+	rr := []dnsmessage.Resource{{Header: dnsmessage.ResourceHeader{TTL: 60}}}
+	for i := 0; i < 5; i++ {
+		c.put(3, "host"+itoa(i)+".", dnsmessage.TypeA, "server", "", rr, msg)
+	}
+	if len(c.m) != 3 {
+		t.Errorf("cache has %d entries; want 3", len(c.m))
+	}
+	if c.get("host4.", dnsmessage.TypeA) == nil {
+		t.Errorf("most recent entry missing from cache")
+	}
+
+	// Negative answers are kept for the SOA's minimum TTL.
+	msg.Authorities = []dnsmessage.Resource{{
+		Header: dnsmessage.ResourceHeader{TTL: 3600},
+		Body:   &dnsmessage.SOAResource{MinTTL: 30},
+	}}
+	c.put(3, "missing.", dnsmessage.TypeA, "server", "", nil, msg)
+	e := c.get("missing.", dnsmessage.TypeA)
+	if e == nil || e.addrs != nil {
+		t.Fatalf("negative answer not cached")
+	}
+	if d := e.expire.Sub(time.Now()); d > 30*time.Second {
+		t.Errorf("negative answer kept for %v; want at most 30s", d)
+	}
+}
@@ -8,98 +8,217 @@
 
 package net
 
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultNS are the servers used when resolv.conf lists none:
+// as with the C library, the name server on the local machine.
+var defaultNS = []string{"[127.0.0.1]", "[::1]"}
+
 type dnsConfig struct {
-	servers  []string // servers to use
-	search   []string // suffixes to append to local name
-	ndots    int      // number of dots in name to trigger absolute lookup
-	timeout  int      // seconds before giving up on packet
-	attempts int      // lost packets before giving up on server
-	rotate   bool     // round robin among servers
+	servers       []string // servers to use
+	search        []string // suffixes to append to local name
+	ndots         int      // number of dots in name to trigger absolute lookup
+	timeout       int      // seconds before giving up on packet
+	attempts      int      // lost packets before giving up on server
+	rotate        bool     // round robin among servers
+	singleRequest bool     // look up IPv4 and IPv6 addresses one after the other
+	useTCP        bool     // query servers using TCP rather than UDP
+	err           error    // any error reading the file; the defaults are used
+	mtime         time.Time
+
+	// serverOffset counts lookups for round robin among servers.
+	mu           sync.Mutex
+	serverOffset int
 }
 
+// Limits on option values, as in the C library's resolver.
+const (
+	maxNdots    = 15
+	maxTimeout  = 30
+	maxAttempts = 5
+)
+
 // See resolv.conf(5) on a Linux machine.
-// TODO(rsc): Supposed to call uname() and chop the beginning
-// of the host name to get the default search domain.
-func dnsReadConfig(filename string) (*dnsConfig, error) {
+func dnsReadConfig(filename string) *dnsConfig {
+	conf := &dnsConfig{
+		ndots:    1,
+		timeout:  5,
+		attempts: 2,
+	}
 	file, err := open(filename)
 	if err != nil {
-		return nil, &DNSConfigError{err}
+		conf.servers = defaultNS
+		conf.search = dnsDefaultSearch()
+		conf.err = &DNSConfigError{err}
+		return conf
 	}
-	conf := new(dnsConfig)
-	conf.servers = make([]string, 0, 3) // small, but the standard limit
-	conf.search = make([]string, 0)
-	conf.ndots = 1
-	conf.timeout = 5
-	conf.attempts = 2
-	conf.rotate = false
+	defer file.close()
+	if fi, err := file.file.Stat(); err == nil {
+		conf.mtime = fi.ModTime()
+	}
+	haveSearch := false
 	for line, ok := file.readLine(); ok; line, ok = file.readLine() {
+		if len(line) > 0 && (line[0] == ';' || line[0] == '#') {
+			// comment.
+			continue
+		}
 		f := getFields(line)
 		if len(f) < 1 {
 			continue
 		}
 		switch f[0] {
 		case "nameserver": // add one name server
-			a := conf.servers
-			n := len(a)
-			if len(f) > 1 && n < cap(a) {
+			// The C library uses the first three servers.
+			if len(f) > 1 && len(conf.servers) < 3 {
 				// One more check: make sure server name is
 				// just an IP address.  Otherwise we need DNS
 				// to look it up.
-				name := f[1]
-				switch len(ParseIP(name)) {
-				case 16:
-					name = "[" + name + "]"
-					fallthrough
-				case 4:
-					a = a[0 : n+1]
-					a[n] = name
-					conf.servers = a
+				if ParseIP(f[1]) != nil {
+					conf.servers = append(conf.servers, "["+f[1]+"]")
 				}
 			}
 
 		case "domain": // set search path to just this domain
 			if len(f) > 1 {
-				conf.search = make([]string, 1)
-				conf.search[0] = f[1]
+				conf.search = []string{f[1]}
 			} else {
-				conf.search = make([]string, 0)
+				conf.search = nil
 			}
+			haveSearch = true
 
 		case "search": // set search path to given servers
-			conf.search = make([]string, len(f)-1)
-			for i := 0; i < len(conf.search); i++ {
-				conf.search[i] = f[i+1]
-			}
+			conf.search = append([]string(nil), f[1:]...)
+			haveSearch = true
 
 		case "options": // magic options
-			for i := 1; i < len(f); i++ {
-				s := f[i]
-				switch {
-				case len(s) >= 6 && s[0:6] == "ndots:":
-					n, _, _ := dtoi(s, 6)
-					if n < 1 {
-						n = 1
-					}
-					conf.ndots = n
-				case len(s) >= 8 && s[0:8] == "timeout:":
-					n, _, _ := dtoi(s, 8)
-					if n < 1 {
-						n = 1
-					}
-					conf.timeout = n
-				case len(s) >= 8 && s[0:9] == "attempts:":
-					n, _, _ := dtoi(s, 9)
-					if n < 1 {
-						n = 1
-					}
-					conf.attempts = n
-				case s == "rotate":
-					conf.rotate = true
-				}
+			conf.setOptions(f[1:])
+		}
+	}
+	if len(conf.servers) == 0 {
+		conf.servers = defaultNS
+	}
+	if !haveSearch {
+		conf.search = dnsDefaultSearch()
+	}
+	return conf
+}
+
+// setOptions applies the resolv.conf options in opts to conf.
+// Unknown options are ignored.
+func (conf *dnsConfig) setOptions(opts []string) {
+	for _, s := range opts {
+		switch {
+		case hasPrefix(s, "ndots:"):
+			n, _, _ := dtoi(s, len("ndots:"))
+			if n < 0 {
+				n = 0
+			} else if n > maxNdots {
+				n = maxNdots
+			}
+			conf.ndots = n
+		case hasPrefix(s, "timeout:"):
+			n, _, _ := dtoi(s, len("timeout:"))
+			if n < 1 {
+				n = 1
+			} else if n > maxTimeout {
+				n = maxTimeout
+			}
+			conf.timeout = n
+		case hasPrefix(s, "attempts:"):
+			n, _, _ := dtoi(s, len("attempts:"))
+			if n < 1 {
+				n = 1
+			} else if n > maxAttempts {
+				n = maxAttempts
 			}
+			conf.attempts = n
+		case s == "rotate":
+			conf.rotate = true
+		case s == "single-request", s == "single-request-reopen":
+			// Every query uses its own socket, so
+			// single-request-reopen is single-request.
+			conf.singleRequest = true
+		case s == "use-vc", s == "usevc", s == "tcp":
+			conf.useTCP = true
 		}
 	}
-	file.close()
+}
+
+// serverList returns the servers to try, in order.
+// With the rotate option, each call starts with the
+// server following the one the previous call started with.
+func (conf *dnsConfig) serverList() []string {
+	if !conf.rotate || len(conf.servers) < 2 {
+		return conf.servers
+	}
+	conf.mu.Lock()
+	off := conf.serverOffset % len(conf.servers)
+	conf.serverOffset++
+	conf.mu.Unlock()
+	servers := make([]string, 0, len(conf.servers))
+	servers = append(servers, conf.servers[off:]...)
+	return append(servers, conf.servers[:off]...)
+}
+
+// dnsDefaultSearch returns the search list used when resolv.conf
+// has none: the domain of the local host name, if it has one.
+func dnsDefaultSearch() []string {
+	hn, err := os.Hostname()
+	if err != nil {
+		return nil
+	}
+	if i := byteIndex(hn, '.'); i >= 0 && i < len(hn)-1 {
+		return []string{hn[i+1:]}
+	}
+	return nil
+}
 
-	return conf, nil
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
+
+// resolvConfPath is the file the Go resolver reads its
+// configuration from.  Tests may change it.
+var resolvConfPath = "/etc/resolv.conf"
+
+// How often to check whether resolv.conf has changed.
+const resolvConfCheckInterval = 5 * time.Second
+
+var resolvConf struct {
+	sync.Mutex
+	conf        *dnsConfig
+	path        string
+	lastChecked time.Time
+}
+
+// getDNSConfig returns the current configuration, rereading
+// resolv.conf if it has changed since it was last read.  To
+// keep lookups cheap, the file is checked at most every
+// resolvConfCheckInterval.
+func getDNSConfig() *dnsConfig {
+	resolvConf.Lock()
+	defer resolvConf.Unlock()
+	now := time.Now()
+	conf := resolvConf.conf
+	if conf != nil && resolvConf.path == resolvConfPath && now.Sub(resolvConf.lastChecked) < resolvConfCheckInterval {
+		return conf
+	}
+	resolvConf.lastChecked = now
+	if conf != nil && resolvConf.path == resolvConfPath {
+		fi, err := os.Stat(resolvConfPath)
+		switch {
+		case err != nil && conf.err != nil:
+			// Still missing.
+			return conf
+		case err == nil && fi.ModTime().Equal(conf.mtime):
+			return conf
+		}
+	}
+	resolvConf.conf = dnsReadConfig(resolvConfPath)
+	resolvConf.path = resolvConfPath
+	return resolvConf.conf
 }
@@ -6,12 +6,18 @@
 
 package net
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
 
 func TestDNSReadConfig(t *testing.T) {
-	dnsConfig, err := dnsReadConfig("testdata/resolv.conf")
-	if err != nil {
-		t.Fatal(err)
+	dnsConfig := dnsReadConfig("testdata/resolv.conf")
+	if dnsConfig.err != nil {
+		t.Fatal(dnsConfig.err)
 	}
 
 	if len(dnsConfig.servers) != 1 {
@@ -44,3 +50,111 @@ func TestDNSReadConfig(t *testing.T) {
 		t.Errorf("dnsConfig.rotate = %t; want %t", dnsConfig.rotate, true)
 	}
 }
+
+func TestDNSReadConfigOptions(t *testing.T) {
+	conf := dnsReadConfig("testdata/resolv-options.conf")
+	if conf.err != nil {
+		t.Fatal(conf.err)
+	}
+	// Only IP addresses are used, and only the first three.
+	if want := []string{"[192.168.1.1]", "[2001:db8::1]", "[192.168.1.2]"}; !reflect.DeepEqual(conf.servers, want) {
+		t.Errorf("servers = %q; want %q", conf.servers, want)
+	}
+	if want := []string{"a.example.com", "b.example.com"}; !reflect.DeepEqual(conf.search, want) {
+		t.Errorf("search = %q; want %q", conf.search, want)
+	}
+	if conf.ndots != maxNdots || conf.timeout != 1 || conf.attempts != maxAttempts {
+		t.Errorf("ndots, timeout, attempts = %d, %d, %d; want %d, %d, %d",
+			conf.ndots, conf.timeout, conf.attempts, maxNdots, 1, maxAttempts)
+	}
+	if !conf.singleRequest || !conf.useTCP || conf.rotate {
+		t.Errorf("singleRequest, useTCP, rotate = %t, %t, %t; want true, true, false",
+			conf.singleRequest, conf.useTCP, conf.rotate)
+	}
+}
+
+func TestDNSReadMissingConfig(t *testing.T) {
+	conf := dnsReadConfig("testdata/does-not-exist")
+	if conf.err == nil {
+		t.Errorf("missing file: no error")
+	}
+	if !reflect.DeepEqual(conf.servers, defaultNS) {
+		t.Errorf("servers = %q; want %q", conf.servers, defaultNS)
+	}
+	if conf.ndots != 1 || conf.timeout != 5 || conf.attempts != 2 {
+		t.Errorf("ndots, timeout, attempts = %d, %d, %d; want 1, 5, 2",
+			conf.ndots, conf.timeout, conf.attempts)
+	}
+}
+
+func TestDNSConfigServerList(t *testing.T) {
+	conf := &dnsConfig{servers: []string{"a", "b", "c"}}
+	for i := 0; i < 2; i++ {
+		if got := conf.serverList(); !reflect.DeepEqual(got, conf.servers) {
+			t.Errorf("serverList() = %q; want %q", got, conf.servers)
+		}
+	}
+	conf.rotate = true
+	for _, want := range [][]string{{"a", "b", "c"}, {"b", "c", "a"}, {"c", "a", "b"}, {"a", "b", "c"}} {
+		if got := conf.serverList(); !reflect.DeepEqual(got, want) {
+			t.Errorf("serverList() = %q; want %q", got, want)
+		}
+	}
+}
+
+var nameListTests = []struct {
+	name  string
+	ndots int
+	want  []string
+}{
+	{"host.", 1, []string{"host."}},
+	{"host", 1, []string{"host.a.", "host.b.", "host."}},
+	{"host.corp", 1, []string{"host.corp.", "host.corp.a.", "host.corp.b."}},
+	{"host.corp", 2, []string{"host.corp.a.", "host.corp.b.", "host.corp."}},
+}
+
+func TestDNSConfigNameList(t *testing.T) {
+	for _, tt := range nameListTests {
+		conf := &dnsConfig{search: []string{"a", "b."}, ndots: tt.ndots}
+		if got := conf.nameList(tt.name); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("nameList(%q) with ndots:%d = %q; want %q", tt.name, tt.ndots, got, tt.want)
+		}
+	}
+}
+
+func TestDNSConfigReload(t *testing.T) {
+	f, err := ioutil.TempFile("", "resolv.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := f.Name()
+	defer os.Remove(path)
+	f.WriteString("nameserver 192.0.2.1\n")
+	f.Close()
+
+	saved := resolvConfPath
+	resolvConfPath = path
+	defer func() { resolvConfPath = saved }()
+
+	if conf := getDNSConfig(); len(conf.servers) != 1 || conf.servers[0] != "[192.0.2.1]" {
+		t.Fatalf("servers = %q; want [\"[192.0.2.1]\"]", conf.servers)
+	}
+	if err := ioutil.WriteFile(path, []byte("nameserver 192.0.2.2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Make sure the change is seen, however coarse the
+	// file system's timestamps.
+	mtime := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if conf := getDNSConfig(); conf.servers[0] != "[192.0.2.1]" {
+		t.Errorf("configuration reread within resolvConfCheckInterval")
+	}
+	resolvConf.Lock()
+	resolvConf.lastChecked = time.Time{}
+	resolvConf.Unlock()
+	if conf := getDNSConfig(); len(conf.servers) != 1 || conf.servers[0] != "[192.0.2.2]" {
+		t.Errorf("after change, servers = %q; want [\"[192.0.2.2]\"]", conf.servers)
+	}
+}
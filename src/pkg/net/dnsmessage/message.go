@@ -0,0 +1,596 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dnsmessage implements packing and unpacking of DNS messages,
+// as described in RFC 1035.
+//
+// The package understands the resource record types in common use,
+// including the EDNS0 OPT pseudo-record of RFC 6891, and keeps the
+// data of other types as uninterpreted bytes.
+//
+// Domain names are absolute and are written with a trailing dot,
+// as in "golang.org.".  Pack compresses names as RFC 1035 allows.
+package dnsmessage
+
+import (
+	"errors"
+	"strconv"
+)
+
+// A Type is the type of a resource record or of a question.
+type Type uint16
+
+const (
+	TypeA     Type = 1
+	TypeNS    Type = 2
+	TypeCNAME Type = 5
+	TypeSOA   Type = 6
+	TypePTR   Type = 12
+	TypeMX    Type = 15
+	TypeTXT   Type = 16
+	TypeAAAA  Type = 28
+	TypeSRV   Type = 33
+	TypeOPT   Type = 41
+
+	// Question types only.
+	TypeAXFR Type = 252
+	TypeALL  Type = 255
+)
+
+var typeNames = map[Type]string{
+	TypeA:     "A",
+	TypeNS:    "NS",
+	TypeCNAME: "CNAME",
+	TypeSOA:   "SOA",
+	TypePTR:   "PTR",
+	TypeMX:    "MX",
+	TypeTXT:   "TXT",
+	TypeAAAA:  "AAAA",
+	TypeSRV:   "SRV",
+	TypeOPT:   "OPT",
+	TypeAXFR:  "AXFR",
+	TypeALL:   "ALL",
+}
+
+func (t Type) String() string {
+	if s, ok := typeNames[t]; ok {
+		return s
+	}
+	return "Type" + strconv.Itoa(int(t))
+}
+
+// A Class is the class of a resource record or of a question.
+type Class uint16
+
+const (
+	ClassINET   Class = 1
+	ClassCSNET  Class = 2
+	ClassCHAOS  Class = 3
+	ClassHESIOD Class = 4
+
+	// Question classes only.
+	ClassANY Class = 255
+)
+
+var classNames = map[Class]string{
+	ClassINET:   "INET",
+	ClassCSNET:  "CSNET",
+	ClassCHAOS:  "CHAOS",
+	ClassHESIOD: "HESIOD",
+	ClassANY:    "ANY",
+}
+
+func (c Class) String() string {
+	if s, ok := classNames[c]; ok {
+		return s
+	}
+	return "Class" + strconv.Itoa(int(c))
+}
+
+// An Opcode is the kind of query in a message.
+type Opcode uint16
+
+// An RCode is the response code of a message.
+type RCode uint16
+
+const (
+	RCodeSuccess        RCode = 0
+	RCodeFormatError    RCode = 1
+	RCodeServerFailure  RCode = 2
+	RCodeNameError      RCode = 3
+	RCodeNotImplemented RCode = 4
+	RCodeRefused        RCode = 5
+)
+
+var rcodeNames = map[RCode]string{
+	RCodeSuccess:        "Success",
+	RCodeFormatError:    "FormatError",
+	RCodeServerFailure:  "ServerFailure",
+	RCodeNameError:      "NameError",
+	RCodeNotImplemented: "NotImplemented",
+	RCodeRefused:        "Refused",
+}
+
+func (r RCode) String() string {
+	if s, ok := rcodeNames[r]; ok {
+		return s
+	}
+	return "RCode" + strconv.Itoa(int(r))
+}
+
+var (
+	errShortMessage   = errors.New("dnsmessage: message too short")
+	errInvalidName    = errors.New("dnsmessage: invalid domain name")
+	errNameTooLong    = errors.New("dnsmessage: domain name too long")
+	errLabelTooLong   = errors.New("dnsmessage: domain name label too long")
+	errTooManyPtrs    = errors.New("dnsmessage: too many compression pointers")
+	errStringTooLong  = errors.New("dnsmessage: character string too long")
+	errDataTooLong    = errors.New("dnsmessage: resource data too long")
+	errNilBody        = errors.New("dnsmessage: nil resource body")
+	errTooManyRecords = errors.New("dnsmessage: too many questions or resources")
+)
+
+// A Header is the header of a DNS message.
+type Header struct {
+	ID                 uint16
+	Response           bool
+	Opcode             Opcode
+	Authoritative      bool
+	Truncated          bool
+	RecursionDesired   bool
+	RecursionAvailable bool
+	RCode              RCode // low 4 bits only; see ResourceHeader.ExtendedRCode
+}
+
+// Bits of the second 16-bit word of the wire header.
+const (
+	headerBitQR = 1 << 15 // query/response (response=1)
+	headerBitAA = 1 << 10 // authoritative
+	headerBitTC = 1 << 9  // truncated
+	headerBitRD = 1 << 8  // recursion desired
+	headerBitRA = 1 << 7  // recursion available
+)
+
+func (h *Header) bits() uint16 {
+	bits := uint16(h.Opcode&0xF)<<11 | uint16(h.RCode&0xF)
+	if h.Response {
+		bits |= headerBitQR
+	}
+	if h.Authoritative {
+		bits |= headerBitAA
+	}
+	if h.Truncated {
+		bits |= headerBitTC
+	}
+	if h.RecursionDesired {
+		bits |= headerBitRD
+	}
+	if h.RecursionAvailable {
+		bits |= headerBitRA
+	}
+	return bits
+}
+
+func (h *Header) setBits(bits uint16) {
+	h.Response = bits&headerBitQR != 0
+	h.Opcode = Opcode(bits>>11) & 0xF
+	h.Authoritative = bits&headerBitAA != 0
+	h.Truncated = bits&headerBitTC != 0
+	h.RecursionDesired = bits&headerBitRD != 0
+	h.RecursionAvailable = bits&headerBitRA != 0
+	h.RCode = RCode(bits & 0xF)
+}
+
+func (h *Header) String() string {
+	return "{ID=" + strconv.Itoa(int(h.ID)) +
+		", Response=" + strconv.FormatBool(h.Response) +
+		", Opcode=" + strconv.Itoa(int(h.Opcode)) +
+		", Authoritative=" + strconv.FormatBool(h.Authoritative) +
+		", Truncated=" + strconv.FormatBool(h.Truncated) +
+		", RecursionDesired=" + strconv.FormatBool(h.RecursionDesired) +
+		", RecursionAvailable=" + strconv.FormatBool(h.RecursionAvailable) +
+		", RCode=" + h.RCode.String() + "}"
+}
+
+// A Question is a DNS query.
+type Question struct {
+	Name  string
+	Type  Type
+	Class Class
+}
+
+func (q *Question) String() string {
+	return "{Name=" + q.Name + ", Type=" + q.Type.String() + ", Class=" + q.Class.String() + "}"
+}
+
+// A Message is a DNS message.
+type Message struct {
+	Header
+	Questions   []Question
+	Answers     []Resource
+	Authorities []Resource
+	Additionals []Resource
+}
+
+// Pack returns the wire format of m.
+func (m *Message) Pack() ([]byte, error) {
+	return m.AppendPack(make([]byte, 0, 512))
+}
+
+// AppendPack is like Pack but appends the wire format of m to b
+// and returns the extended buffer.  Compressed names refer to
+// offsets relative to the start of the appended message.
+func (m *Message) AppendPack(b []byte) ([]byte, error) {
+	for _, n := range []int{len(m.Questions), len(m.Answers), len(m.Authorities), len(m.Additionals)} {
+		if n > 0xFFFF {
+			return nil, errTooManyRecords
+		}
+	}
+	p := &packer{msg: b, start: len(b), names: make(map[string]int)}
+	p.uint16(m.ID)
+	p.uint16(m.bits())
+	p.uint16(uint16(len(m.Questions)))
+	p.uint16(uint16(len(m.Answers)))
+	p.uint16(uint16(len(m.Authorities)))
+	p.uint16(uint16(len(m.Additionals)))
+	for i := range m.Questions {
+		q := &m.Questions[i]
+		if err := p.name(q.Name, true); err != nil {
+			return nil, err
+		}
+		p.uint16(uint16(q.Type))
+		p.uint16(uint16(q.Class))
+	}
+	for _, section := range [][]Resource{m.Answers, m.Authorities, m.Additionals} {
+		for i := range section {
+			if err := p.resource(&section[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return p.msg, nil
+}
+
+// Unpack parses the wire format msg into m, replacing its contents.
+//
+// A resource record whose data does not match its type is kept with
+// a nil Body, so that a single malformed record does not hide the
+// rest of the message.
+func (m *Message) Unpack(msg []byte) error {
+	*m = Message{}
+	u := &unpacker{msg: msg}
+	id, err := u.uint16()
+	if err != nil {
+		return err
+	}
+	bits, err := u.uint16()
+	if err != nil {
+		return err
+	}
+	m.ID = id
+	m.setBits(bits)
+	var counts [4]uint16
+	for i := range counts {
+		if counts[i], err = u.uint16(); err != nil {
+			return err
+		}
+	}
+	// Each question or resource takes at least a few bytes, so
+	// a count larger than the message is left to fail below
+	// rather than allocated up front.
+	m.Questions = make([]Question, 0, min(int(counts[0]), len(msg)))
+	for i := 0; i < int(counts[0]); i++ {
+		var q Question
+		if q.Name, err = u.name(); err != nil {
+			return err
+		}
+		t, err := u.uint16()
+		if err != nil {
+			return err
+		}
+		c, err := u.uint16()
+		if err != nil {
+			return err
+		}
+		q.Type, q.Class = Type(t), Class(c)
+		m.Questions = append(m.Questions, q)
+	}
+	sections := []*[]Resource{&m.Answers, &m.Authorities, &m.Additionals}
+	for i, section := range sections {
+		n := int(counts[i+1])
+		*section = make([]Resource, 0, min(n, len(msg)))
+		for j := 0; j < n; j++ {
+			r, err := u.resource()
+			if err != nil {
+				return err
+			}
+			*section = append(*section, r)
+		}
+	}
+	return nil
+}
+
+func min(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+func (m *Message) String() string {
+	s := "DNS: " + m.Header.String() + "\n"
+	if len(m.Questions) > 0 {
+		s += "-- Questions\n"
+		for i := range m.Questions {
+			s += m.Questions[i].String() + "\n"
+		}
+	}
+	sections := []struct {
+		name string
+		rs   []Resource
+	}{
+		{"Answers", m.Answers},
+		{"Authorities", m.Authorities},
+		{"Additionals", m.Additionals},
+	}
+	for _, sect := range sections {
+		if len(sect.rs) == 0 {
+			continue
+		}
+		s += "-- " + sect.name + "\n"
+		for i := range sect.rs {
+			s += sect.rs[i].String() + "\n"
+		}
+	}
+	return s
+}
+
+// A packer accumulates the wire format of a message.
+type packer struct {
+	msg   []byte
+	start int            // offset of the message in msg
+	names map[string]int // message offsets of names packed so far
+}
+
+func (p *packer) uint16(v uint16) {
+	p.msg = append(p.msg, byte(v>>8), byte(v))
+}
+
+func (p *packer) uint32(v uint32) {
+	p.msg = append(p.msg, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// name packs a domain name.  Domain names are a sequence of counted
+// labels ending with a zero-length label.  If compress is set, a
+// suffix of the name that has been packed already is replaced by a
+// pointer to it.  Names without a trailing dot are treated as absolute.
+func (p *packer) name(name string, compress bool) error {
+	if name == "" || name[len(name)-1] != '.' {
+		name += "."
+	}
+	if len(name) > 254 {
+		return errNameTooLong
+	}
+	if name == "." {
+		p.msg = append(p.msg, 0)
+		return nil
+	}
+	for i := 0; i < len(name); {
+		suffix := name[i:]
+		if compress {
+			if off, ok := p.names[suffix]; ok {
+				p.uint16(0xC000 | uint16(off))
+				return nil
+			}
+		}
+		// Pointers have 14 bits of offset.
+		if off := len(p.msg) - p.start; off <= 0x3FFF {
+			if _, ok := p.names[suffix]; !ok {
+				p.names[suffix] = off
+			}
+		}
+		j := i
+		for name[j] != '.' {
+			j++
+		}
+		switch {
+		case j == i:
+			return errInvalidName
+		case j-i > 63:
+			return errLabelTooLong
+		}
+		p.msg = append(p.msg, byte(j-i))
+		p.msg = append(p.msg, name[i:j]...)
+		i = j + 1
+	}
+	p.msg = append(p.msg, 0)
+	return nil
+}
+
+// str packs a character string: a length byte followed by the bytes.
+func (p *packer) str(s string) error {
+	if len(s) > 255 {
+		return errStringTooLong
+	}
+	p.msg = append(p.msg, byte(len(s)))
+	p.msg = append(p.msg, s...)
+	return nil
+}
+
+// resource packs r.  The type and data length in the header
+// are derived from r.Body.
+func (p *packer) resource(r *Resource) error {
+	if r.Body == nil {
+		return errNilBody
+	}
+	if err := p.name(r.Header.Name, true); err != nil {
+		return err
+	}
+	p.uint16(uint16(r.Body.realType()))
+	p.uint16(uint16(r.Header.Class))
+	p.uint32(r.Header.TTL)
+	lenOff := len(p.msg)
+	p.uint16(0)
+	if err := r.Body.pack(p); err != nil {
+		return err
+	}
+	n := len(p.msg) - lenOff - 2
+	if n > 0xFFFF {
+		return errDataTooLong
+	}
+	p.msg[lenOff] = byte(n >> 8)
+	p.msg[lenOff+1] = byte(n)
+	return nil
+}
+
+// An unpacker reads the wire format of a message.
+// Its end is the end of the data being read, which is
+// the end of a resource record while reading its data.
+type unpacker struct {
+	msg []byte
+	off int
+	end int
+}
+
+func (u *unpacker) limit() int {
+	if u.end > 0 {
+		return u.end
+	}
+	return len(u.msg)
+}
+
+func (u *unpacker) uint16() (uint16, error) {
+	if u.off+2 > u.limit() {
+		return 0, errShortMessage
+	}
+	v := uint16(u.msg[u.off])<<8 | uint16(u.msg[u.off+1])
+	u.off += 2
+	return v, nil
+}
+
+func (u *unpacker) uint32() (uint32, error) {
+	if u.off+4 > u.limit() {
+		return 0, errShortMessage
+	}
+	v := uint32(u.msg[u.off])<<24 | uint32(u.msg[u.off+1])<<16 |
+		uint32(u.msg[u.off+2])<<8 | uint32(u.msg[u.off+3])
+	u.off += 4
+	return v, nil
+}
+
+func (u *unpacker) bytes(n int) ([]byte, error) {
+	if n < 0 || u.off+n > u.limit() {
+		return nil, errShortMessage
+	}
+	b := make([]byte, n)
+	copy(b, u.msg[u.off:])
+	u.off += n
+	return b, nil
+}
+
+func (u *unpacker) str() (string, error) {
+	if u.off >= u.limit() {
+		return "", errShortMessage
+	}
+	n := int(u.msg[u.off])
+	if u.off+1+n > u.limit() {
+		return "", errShortMessage
+	}
+	s := string(u.msg[u.off+1 : u.off+1+n])
+	u.off += 1 + n
+	return s, nil
+}
+
+// name unpacks a domain name.  In addition to the sequences of
+// counted labels that name packs, names may end with a pointer to
+// labels elsewhere in the message: a length byte with the top two
+// bits set, which together with the next byte gives a 14-bit
+// offset from the start of the message.  The unpacker continues
+// after the first pointer.  Pointers may point anywhere in the
+// message, so following stops after a while in case of a loop.
+func (u *unpacker) name() (string, error) {
+	var name []byte
+	off := u.off
+	ptrs := 0
+	for {
+		if off >= len(u.msg) {
+			return "", errShortMessage
+		}
+		c := int(u.msg[off])
+		off++
+		switch c & 0xC0 {
+		case 0x00:
+			if c == 0 {
+				// End of name.
+				if ptrs == 0 {
+					u.off = off
+				}
+				if len(name) == 0 {
+					return ".", nil
+				}
+				return string(name), nil
+			}
+			if off+c > len(u.msg) {
+				return "", errShortMessage
+			}
+			name = append(name, u.msg[off:off+c]...)
+			name = append(name, '.')
+			if len(name) > 254 {
+				return "", errNameTooLong
+			}
+			off += c
+		case 0xC0:
+			if off >= len(u.msg) {
+				return "", errShortMessage
+			}
+			if ptrs == 0 {
+				u.off = off + 1
+			}
+			if ptrs++; ptrs > 10 {
+				return "", errTooManyPtrs
+			}
+			off = (c^0xC0)<<8 | int(u.msg[off])
+		default:
+			// 0x80 and 0x40 are reserved.
+			return "", errInvalidName
+		}
+	}
+}
+
+// resource unpacks a resource record.
+func (u *unpacker) resource() (Resource, error) {
+	var r Resource
+	h := &r.Header
+	var err error
+	if h.Name, err = u.name(); err != nil {
+		return r, err
+	}
+	var t, c, n uint16
+	if t, err = u.uint16(); err != nil {
+		return r, err
+	}
+	if c, err = u.uint16(); err != nil {
+		return r, err
+	}
+	if h.TTL, err = u.uint32(); err != nil {
+		return r, err
+	}
+	if n, err = u.uint16(); err != nil {
+		return r, err
+	}
+	h.Type, h.Class, h.Length = Type(t), Class(c), n
+	end := u.off + int(n)
+	if end > len(u.msg) {
+		// Truncated, perhaps maliciously.  Leave the next
+		// record to fail.
+		u.off = len(u.msg)
+		return r, nil
+	}
+	body := &unpacker{msg: u.msg, off: u.off, end: end}
+	if b, err := unpackBody(body, h.Type); err == nil && body.off == end {
+		r.Body = b
+	}
+	u.off = end
+	return r, nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dnsmessage
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func allTypesMessage() *Message {
+	return &Message{
+		Header: Header{ID: 0x1234, Response: true, Authoritative: true, RecursionDesired: true, RecursionAvailable: true},
+		Questions: []Question{
+			{Name: "example.com.", Type: TypeALL, Class: ClassINET},
+		},
+		Answers: []Resource{
+			{ResourceHeader{Name: "example.com.", Type: TypeA, Class: ClassINET, TTL: 300, Length: 4},
+				&AResource{[4]byte{192, 0, 2, 1}}},
+			{ResourceHeader{Name: "example.com.", Type: TypeAAAA, Class: ClassINET, TTL: 300, Length: 16},
+				&AAAAResource{[16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}}},
+			{ResourceHeader{Name: "www.example.com.", Type: TypeCNAME, Class: ClassINET, TTL: 60, Length: 2},
+				&CNAMEResource{"example.com."}},
+			{ResourceHeader{Name: "example.com.", Type: TypeMX, Class: ClassINET, TTL: 3600, Length: 7},
+				&MXResource{10, "mx.example.com."}},
+			{ResourceHeader{Name: "example.com.", Type: TypeTXT, Class: ClassINET, TTL: 3600, Length: 13},
+				&TXTResource{[]string{"v=spf1", "", "-all"}}},
+			{ResourceHeader{Name: "_http._tcp.example.com.", Type: TypeSRV, Class: ClassINET, TTL: 3600, Length: 23},
+				&SRVResource{1, 2, 80, "www.example.com."}},
+			{ResourceHeader{Name: "1.2.0.192.in-addr.arpa.", Type: TypePTR, Class: ClassINET, TTL: 3600, Length: 2},
+				&PTRResource{"example.com."}},
+			{ResourceHeader{Name: "example.com.", Type: Type(99), Class: ClassINET, TTL: 3600, Length: 3},
+				&UnknownResource{Type(99), []byte("abc")}},
+		},
+		Authorities: []Resource{
+			{ResourceHeader{Name: "example.com.", Type: TypeNS, Class: ClassINET, TTL: 86400, Length: 6},
+				&NSResource{"ns1.example.com."}},
+			{ResourceHeader{Name: "example.com.", Type: TypeSOA, Class: ClassINET, TTL: 86400, Length: 35},
+				&SOAResource{"ns1.example.com.", "hostmaster.example.com.", 2014010100, 7200, 900, 1209600, 300}},
+		},
+		Additionals: []Resource{
+			{ResourceHeader{Name: ".", Type: TypeOPT, Class: 4096, Length: 8},
+				&OPTResource{[]Option{{Code: 10, Data: []byte("abcd")}}}},
+		},
+	}
+}
+
+func TestPackUnpack(t *testing.T) {
+	m := allTypesMessage()
+	b, err := m.Pack()
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	var m2 Message
+	if err := m2.Unpack(b); err != nil {
+		t.Fatalf("Unpack: %v", err)
+	}
+	if !reflect.DeepEqual(m, &m2) {
+		t.Errorf("unpacked message differs from original:\nhave %v\nwant %v", &m2, m)
+	}
+	_ = m.String() // exercise this code path
+
+	// AppendPack must produce pointers relative to the message.
+	b2, err := m.AppendPack([]byte{0xAA, 0xBB})
+	if err != nil {
+		t.Fatalf("AppendPack: %v", err)
+	}
+	if !bytes.Equal(b2[2:], b) {
+		t.Errorf("AppendPack differs from Pack")
+	}
+}
+
+func TestCompression(t *testing.T) {
+	m := &Message{
+		Questions: []Question{{Name: "example.com.", Type: TypeMX, Class: ClassINET}},
+		Answers: []Resource{
+			{ResourceHeader{Name: "example.com.", Class: ClassINET}, &MXResource{10, "mx.example.com."}},
+			{ResourceHeader{Name: "_x._tcp.example.com.", Class: ClassINET}, &SRVResource{Target: "mx.example.com."}},
+		},
+	}
+	b, err := m.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const header = 12
+	question := len("\x07example\x03com\x00") + 4
+	mx := 2 + 10 + 2 + len("\x02mx") + 2
+	srv := len("\x02_x\x04_tcp") + 2 + 10 + 6 + len("\x02mx\x07example\x03com\x00")
+	if want := header + question + mx + srv; len(b) != want {
+		t.Errorf("packed message is %d bytes, want %d", len(b), want)
+	}
+	// The MX record's name is a pointer to the question's.
+	if off := header + question; b[off] != 0xC0 || b[off+1] != header {
+		t.Errorf("MX name not compressed: % x", b[off:off+2])
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{".", nil},
+		{"a", nil},
+		{"a.b.", nil},
+		{strings.Repeat("a", 63) + ".", nil},
+		{strings.Repeat("a", 64) + ".", errLabelTooLong},
+		{"a..b.", errInvalidName},
+		{".a.", errInvalidName},
+		{strings.Repeat("abc.", 64), errNameTooLong},
+	}
+	for _, tt := range tests {
+		m := &Message{Questions: []Question{{Name: tt.name, Type: TypeA, Class: ClassINET}}}
+		b, err := m.Pack()
+		if err != tt.err {
+			t.Errorf("Pack of %q: err = %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		var m2 Message
+		if err := m2.Unpack(b); err != nil {
+			t.Errorf("Unpack of %q: %v", tt.name, err)
+			continue
+		}
+		want := tt.name
+		if want[len(want)-1] != '.' {
+			want += "."
+		}
+		if got := m2.Questions[0].Name; got != want {
+			t.Errorf("Unpack of %q: name = %q", tt.name, got)
+		}
+	}
+}
+
+func TestPointerLoop(t *testing.T) {
+	// A question whose name is a pointer to itself.
+	b := []byte{0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0xC0, 12, 0, 1, 0, 1}
+	var m Message
+	if err := m.Unpack(b); err != errTooManyPtrs {
+		t.Errorf("Unpack: err = %v, want %v", err, errTooManyPtrs)
+	}
+}
+
+func TestShortMessage(t *testing.T) {
+	b, err := allTypesMessage().Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; n < len(b); n++ {
+		var m Message
+		if err := m.Unpack(b[:n]); err == nil {
+			// Only the data of the last record may be cut short.
+			if r := m.Additionals[0]; r.Body != nil {
+				t.Errorf("Unpack of %d/%d bytes succeeded with %v", n, len(b), &r)
+			}
+		}
+	}
+}
+
+func TestNilBody(t *testing.T) {
+	m := &Message{Answers: []Resource{{Header: ResourceHeader{Name: "a."}}}}
+	if _, err := m.Pack(); err != errNilBody {
+		t.Errorf("Pack: err = %v, want %v", err, errNilBody)
+	}
+}
+
+func TestEDNS0(t *testing.T) {
+	var h ResourceHeader
+	h.SetEDNS0(1232, 0x123, true)
+	m := &Message{
+		Header:      Header{RCode: 0x3},
+		Additionals: []Resource{{h, &OPTResource{}}},
+	}
+	b, err := m.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m2 Message
+	if err := m2.Unpack(b); err != nil {
+		t.Fatal(err)
+	}
+	opt := &m2.Additionals[0].Header
+	if opt.Type != TypeOPT || opt.Class != 1232 {
+		t.Errorf("OPT header = %v", opt)
+	}
+	if !opt.DNSSECAllowed() {
+		t.Errorf("DNSSECAllowed = false, want true")
+	}
+	if rc := opt.ExtendedRCode(m2.RCode); rc != 0x123 {
+		t.Errorf("ExtendedRCode = %#x, want %#x", rc, 0x123)
+	}
+	if _, ok := m2.Additionals[0].Body.(*OPTResource); !ok {
+		t.Errorf("OPT body = %T, want *OPTResource", m2.Additionals[0].Body)
+	}
+}
+
+func TestParseSRVReply(t *testing.T) {
+	data, err := hex.DecodeString(srvReply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg Message
+	if err := msg.Unpack(data); err != nil {
+		t.Fatalf("Unpack: %v", err)
+	}
+	_ = msg.String() // exercise this code path
+	if g, e := len(msg.Answers), 5; g != e {
+		t.Errorf("len(msg.Answers) = %d; want %d", g, e)
+	}
+	for idx, rr := range msg.Answers {
+		if g, e := rr.Header.Type, TypeSRV; g != e {
+			t.Errorf("Answers[%d].Header.Type = %v; want %v", idx, g, e)
+		}
+		if _, ok := rr.Body.(*SRVResource); !ok {
+			t.Errorf("Answers[%d].Body = %T; want *SRVResource", idx, rr.Body)
+		}
+	}
+	// repack and unpack.
+	data2, err := msg.Pack()
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	var msg2 Message
+	if err := msg2.Unpack(data2); err != nil {
+		t.Fatalf("Unpack of repacked message: %v", err)
+	}
+	if !reflect.DeepEqual(msg, msg2) {
+		t.Errorf("repacked message differs from original")
+	}
+}
+
+func TestParseCorruptSRVReply(t *testing.T) {
+	data, err := hex.DecodeString(srvCorruptReply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg Message
+	if err := msg.Unpack(data); err != nil {
+		t.Fatalf("Unpack: %v", err)
+	}
+	_ = msg.String() // exercise this code path
+	if g, e := len(msg.Answers), 5; g != e {
+		t.Errorf("len(msg.Answers) = %d; want %d", g, e)
+	}
+	for idx, rr := range msg.Answers {
+		if g, e := rr.Header.Type, TypeSRV; g != e {
+			t.Errorf("Answers[%d].Header.Type = %v; want %v", idx, g, e)
+		}
+		if idx == 4 {
+			if rr.Body != nil {
+				t.Errorf("Answers[%d].Body = %T; want nil", idx, rr.Body)
+			}
+		} else {
+			if _, ok := rr.Body.(*SRVResource); !ok {
+				t.Errorf("Answers[%d].Body = %T; want *SRVResource", idx, rr.Body)
+			}
+		}
+	}
+}
+
+// Valid DNS SRV reply
+const srvReply = "0901818000010005000000000c5f786d70702d736572766572045f74637006676f6f67" +
+	"6c6503636f6d0000210001c00c002100010000012c00210014000014950c786d70702d" +
+	"73657276657234016c06676f6f676c6503636f6d00c00c002100010000012c00210014" +
+	"000014950c786d70702d73657276657232016c06676f6f676c6503636f6d00c00c0021" +
+	"00010000012c00210014000014950c786d70702d73657276657233016c06676f6f676c" +
+	"6503636f6d00c00c002100010000012c00200005000014950b786d70702d7365727665" +
+	"72016c06676f6f676c6503636f6d00c00c002100010000012c00210014000014950c78" +
+	"6d70702d73657276657231016c06676f6f676c6503636f6d00"
+
+// Corrupt DNS SRV reply, with its final RR having a bogus length
+// (perhaps it was truncated, or it's malicious) The mutation is the
+// capital "FF" below, instead of the proper "21".
+const srvCorruptReply = "0901818000010005000000000c5f786d70702d736572766572045f74637006676f6f67" +
+	"6c6503636f6d0000210001c00c002100010000012c00210014000014950c786d70702d" +
+	"73657276657234016c06676f6f676c6503636f6d00c00c002100010000012c00210014" +
+	"000014950c786d70702d73657276657232016c06676f6f676c6503636f6d00c00c0021" +
+	"00010000012c00210014000014950c786d70702d73657276657233016c06676f6f676c" +
+	"6503636f6d00c00c002100010000012c00200005000014950b786d70702d7365727665" +
+	"72016c06676f6f676c6503636f6d00c00c002100010000012c00FF0014000014950c78" +
+	"6d70702d73657276657231016c06676f6f676c6503636f6d00"
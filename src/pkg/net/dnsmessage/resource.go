@@ -0,0 +1,444 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dnsmessage
+
+import "strconv"
+
+// A ResourceHeader is the header of a resource record.
+type ResourceHeader struct {
+	Name  string
+	Type  Type // set by Unpack; Pack uses the type of the Resource's Body
+	Class Class
+	TTL   uint32
+
+	// Length is the length of the record's data.
+	// Unpack sets it; Pack computes it.
+	Length uint16
+}
+
+func (h *ResourceHeader) String() string {
+	return "{Name=" + h.Name +
+		", Type=" + h.Type.String() +
+		", Class=" + h.Class.String() +
+		", TTL=" + strconv.FormatUint(uint64(h.TTL), 10) +
+		", Length=" + strconv.Itoa(int(h.Length)) + "}"
+}
+
+// EDNS0 keeps flags and an extension of the response code
+// in the TTL of the OPT pseudo-record.  See RFC 6891.
+const (
+	edns0DNSSECOK   = 1 << 15
+	edns0RCodeShift = 24
+)
+
+// SetEDNS0 makes h the header of an EDNS0 OPT pseudo-record
+// advertising that UDP messages up to udpPayloadLen bytes long
+// may be sent.  The upper 8 bits of the 12-bit extended response
+// code extRCode are stored in h; the lower 4 bits belong in the
+// message Header.  If dnssecOK is set, the DO bit is set, asking
+// for DNSSEC records.  The caller must set the Body of the
+// Resource to an *OPTResource.
+func (h *ResourceHeader) SetEDNS0(udpPayloadLen int, extRCode RCode, dnssecOK bool) {
+	h.Name = "."
+	h.Type = TypeOPT
+	h.Class = Class(udpPayloadLen)
+	h.TTL = uint32(extRCode>>4) << edns0RCodeShift
+	if dnssecOK {
+		h.TTL |= edns0DNSSECOK
+	}
+}
+
+// DNSSECAllowed reports whether the DO bit of the EDNS0 OPT
+// pseudo-record with header h is set.
+func (h *ResourceHeader) DNSSECAllowed() bool {
+	return h.TTL&edns0DNSSECOK != 0
+}
+
+// ExtendedRCode returns the full response code of a message
+// whose EDNS0 OPT pseudo-record has header h and whose Header
+// has response code rcode.
+func (h *ResourceHeader) ExtendedRCode(rcode RCode) RCode {
+	if h.Type != TypeOPT {
+		return rcode
+	}
+	return RCode(h.TTL>>edns0RCodeShift)<<4 | rcode&0xF
+}
+
+// A Resource is a resource record.
+type Resource struct {
+	Header ResourceHeader
+
+	// Body is the record's data.  It is nil if Unpack found
+	// the data malformed.
+	Body ResourceBody
+}
+
+func (r *Resource) String() string {
+	s := r.Header.String()
+	if r.Body != nil {
+		s += " " + r.Body.String()
+	}
+	return s
+}
+
+// A ResourceBody is the data of a resource record.
+// It is one of the *Resource types in this package.
+type ResourceBody interface {
+	// String returns a description of the data, for debugging.
+	String() string
+
+	realType() Type
+	pack(p *packer) error
+}
+
+// unpackBody unpacks the data of a resource record of type t.
+func unpackBody(u *unpacker, t Type) (ResourceBody, error) {
+	var err error
+	switch t {
+	case TypeA:
+		var r AResource
+		b, err := u.bytes(len(r.A))
+		if err != nil {
+			return nil, err
+		}
+		copy(r.A[:], b)
+		return &r, nil
+	case TypeAAAA:
+		var r AAAAResource
+		b, err := u.bytes(len(r.AAAA))
+		if err != nil {
+			return nil, err
+		}
+		copy(r.AAAA[:], b)
+		return &r, nil
+	case TypeNS:
+		var r NSResource
+		if r.NS, err = u.name(); err != nil {
+			return nil, err
+		}
+		return &r, nil
+	case TypeCNAME:
+		var r CNAMEResource
+		if r.CNAME, err = u.name(); err != nil {
+			return nil, err
+		}
+		return &r, nil
+	case TypePTR:
+		var r PTRResource
+		if r.PTR, err = u.name(); err != nil {
+			return nil, err
+		}
+		return &r, nil
+	case TypeSOA:
+		var r SOAResource
+		if r.NS, err = u.name(); err != nil {
+			return nil, err
+		}
+		if r.MBox, err = u.name(); err != nil {
+			return nil, err
+		}
+		for _, v := range []*uint32{&r.Serial, &r.Refresh, &r.Retry, &r.Expire, &r.MinTTL} {
+			if *v, err = u.uint32(); err != nil {
+				return nil, err
+			}
+		}
+		return &r, nil
+	case TypeMX:
+		var r MXResource
+		if r.Pref, err = u.uint16(); err != nil {
+			return nil, err
+		}
+		if r.MX, err = u.name(); err != nil {
+			return nil, err
+		}
+		return &r, nil
+	case TypeTXT:
+		var r TXTResource
+		for u.off < u.end {
+			s, err := u.str()
+			if err != nil {
+				return nil, err
+			}
+			r.TXT = append(r.TXT, s)
+		}
+		return &r, nil
+	case TypeSRV:
+		var r SRVResource
+		for _, v := range []*uint16{&r.Priority, &r.Weight, &r.Port} {
+			if *v, err = u.uint16(); err != nil {
+				return nil, err
+			}
+		}
+		if r.Target, err = u.name(); err != nil {
+			return nil, err
+		}
+		return &r, nil
+	case TypeOPT:
+		var r OPTResource
+		for u.off < u.end {
+			var o Option
+			if o.Code, err = u.uint16(); err != nil {
+				return nil, err
+			}
+			n, err := u.uint16()
+			if err != nil {
+				return nil, err
+			}
+			if o.Data, err = u.bytes(int(n)); err != nil {
+				return nil, err
+			}
+			r.Options = append(r.Options, o)
+		}
+		return &r, nil
+	}
+	r := &UnknownResource{Type: t}
+	if r.Data, err = u.bytes(u.end - u.off); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// An AResource is the data of an A record: an IPv4 address.
+type AResource struct {
+	A [4]byte
+}
+
+func (r *AResource) realType() Type { return TypeA }
+
+func (r *AResource) pack(p *packer) error {
+	p.msg = append(p.msg, r.A[:]...)
+	return nil
+}
+
+func (r *AResource) String() string {
+	s := ""
+	for i, b := range r.A {
+		if i > 0 {
+			s += "."
+		}
+		s += strconv.Itoa(int(b))
+	}
+	return "{A=" + s + "}"
+}
+
+// An AAAAResource is the data of an AAAA record: an IPv6 address.
+type AAAAResource struct {
+	AAAA [16]byte
+}
+
+func (r *AAAAResource) realType() Type { return TypeAAAA }
+
+func (r *AAAAResource) pack(p *packer) error {
+	p.msg = append(p.msg, r.AAAA[:]...)
+	return nil
+}
+
+func (r *AAAAResource) String() string {
+	s := ""
+	for i := 0; i < len(r.AAAA); i += 2 {
+		if i > 0 {
+			s += ":"
+		}
+		s += strconv.FormatUint(uint64(r.AAAA[i])<<8|uint64(r.AAAA[i+1]), 16)
+	}
+	return "{AAAA=" + s + "}"
+}
+
+// An NSResource is the data of an NS record: the name of
+// an authoritative name server.
+type NSResource struct {
+	NS string
+}
+
+func (r *NSResource) realType() Type       { return TypeNS }
+func (r *NSResource) pack(p *packer) error { return p.name(r.NS, true) }
+func (r *NSResource) String() string       { return "{NS=" + r.NS + "}" }
+
+// A CNAMEResource is the data of a CNAME record: the canonical
+// name of an alias.
+type CNAMEResource struct {
+	CNAME string
+}
+
+func (r *CNAMEResource) realType() Type       { return TypeCNAME }
+func (r *CNAMEResource) pack(p *packer) error { return p.name(r.CNAME, true) }
+func (r *CNAMEResource) String() string       { return "{CNAME=" + r.CNAME + "}" }
+
+// A PTRResource is the data of a PTR record: a domain name,
+// usually that of the host with a reverse-lookup address.
+type PTRResource struct {
+	PTR string
+}
+
+func (r *PTRResource) realType() Type       { return TypePTR }
+func (r *PTRResource) pack(p *packer) error { return p.name(r.PTR, true) }
+func (r *PTRResource) String() string       { return "{PTR=" + r.PTR + "}" }
+
+// An SOAResource is the data of an SOA record, which marks the
+// start of a zone of authority.
+type SOAResource struct {
+	NS      string
+	MBox    string
+	Serial  uint32
+	Refresh uint32
+	Retry   uint32
+	Expire  uint32
+
+	// MinTTL is the TTL of negative responses.  See RFC 2308.
+	MinTTL uint32
+}
+
+func (r *SOAResource) realType() Type { return TypeSOA }
+
+func (r *SOAResource) pack(p *packer) error {
+	if err := p.name(r.NS, true); err != nil {
+		return err
+	}
+	if err := p.name(r.MBox, true); err != nil {
+		return err
+	}
+	for _, v := range []uint32{r.Serial, r.Refresh, r.Retry, r.Expire, r.MinTTL} {
+		p.uint32(v)
+	}
+	return nil
+}
+
+func (r *SOAResource) String() string {
+	return "{NS=" + r.NS +
+		", MBox=" + r.MBox +
+		", Serial=" + strconv.FormatUint(uint64(r.Serial), 10) +
+		", Refresh=" + strconv.FormatUint(uint64(r.Refresh), 10) +
+		", Retry=" + strconv.FormatUint(uint64(r.Retry), 10) +
+		", Expire=" + strconv.FormatUint(uint64(r.Expire), 10) +
+		", MinTTL=" + strconv.FormatUint(uint64(r.MinTTL), 10) + "}"
+}
+
+// An MXResource is the data of an MX record: a mail exchange
+// and its preference.
+type MXResource struct {
+	Pref uint16
+	MX   string
+}
+
+func (r *MXResource) realType() Type { return TypeMX }
+
+func (r *MXResource) pack(p *packer) error {
+	p.uint16(r.Pref)
+	return p.name(r.MX, true)
+}
+
+func (r *MXResource) String() string {
+	return "{Pref=" + strconv.Itoa(int(r.Pref)) + ", MX=" + r.MX + "}"
+}
+
+// A TXTResource is the data of a TXT record: a list of strings
+// of up to 255 bytes each.
+type TXTResource struct {
+	TXT []string
+}
+
+func (r *TXTResource) realType() Type { return TypeTXT }
+
+func (r *TXTResource) pack(p *packer) error {
+	for _, s := range r.TXT {
+		if err := p.str(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TXTResource) String() string {
+	s := "{TXT=["
+	for i, t := range r.TXT {
+		if i > 0 {
+			s += " "
+		}
+		s += strconv.Quote(t)
+	}
+	return s + "]}"
+}
+
+// An SRVResource is the data of an SRV record, which locates
+// a service.  See RFC 2782.
+type SRVResource struct {
+	Priority uint16
+	Weight   uint16
+	Port     uint16
+	Target   string
+}
+
+func (r *SRVResource) realType() Type { return TypeSRV }
+
+func (r *SRVResource) pack(p *packer) error {
+	p.uint16(r.Priority)
+	p.uint16(r.Weight)
+	p.uint16(r.Port)
+	// RFC 2782 forbids compressing the target.
+	return p.name(r.Target, false)
+}
+
+func (r *SRVResource) String() string {
+	return "{Priority=" + strconv.Itoa(int(r.Priority)) +
+		", Weight=" + strconv.Itoa(int(r.Weight)) +
+		", Port=" + strconv.Itoa(int(r.Port)) +
+		", Target=" + r.Target + "}"
+}
+
+// An OPTResource is the data of an EDNS0 OPT pseudo-record,
+// which carries options about the message rather than data
+// about a name.  See ResourceHeader.SetEDNS0 for its header.
+type OPTResource struct {
+	Options []Option
+}
+
+// An Option is an EDNS0 option.
+type Option struct {
+	Code uint16
+	Data []byte
+}
+
+func (r *OPTResource) realType() Type { return TypeOPT }
+
+func (r *OPTResource) pack(p *packer) error {
+	for _, o := range r.Options {
+		if len(o.Data) > 0xFFFF {
+			return errDataTooLong
+		}
+		p.uint16(o.Code)
+		p.uint16(uint16(len(o.Data)))
+		p.msg = append(p.msg, o.Data...)
+	}
+	return nil
+}
+
+func (r *OPTResource) String() string {
+	s := "{Options=["
+	for i, o := range r.Options {
+		if i > 0 {
+			s += " "
+		}
+		s += "{Code=" + strconv.Itoa(int(o.Code)) + ", Data=" + strconv.Quote(string(o.Data)) + "}"
+	}
+	return s + "]}"
+}
+
+// An UnknownResource is the data of a record of a type
+// this package does not interpret.
+type UnknownResource struct {
+	Type Type
+	Data []byte
+}
+
+func (r *UnknownResource) realType() Type { return r.Type }
+
+func (r *UnknownResource) pack(p *packer) error {
+	p.msg = append(p.msg, r.Data...)
+	return nil
+}
+
+func (r *UnknownResource) String() string {
+	return "{Type=" + r.Type.String() + ", Data=" + strconv.Quote(string(r.Data)) + "}"
+}
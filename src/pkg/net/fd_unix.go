@@ -176,6 +176,57 @@ func (fd *netFD) writeUnlock() {
 	}
 }
 
+// rawControl calls f with the file descriptor, which stays
+// open while f runs.
+func (fd *netFD) rawControl(f func(uintptr)) error {
+	if err := fd.incref(); err != nil {
+		return err
+	}
+	defer fd.decref()
+	f(uintptr(fd.sysfd))
+	return nil
+}
+
+// rawRead calls f with the file descriptor, under the read lock,
+// until f reports that it is done, waiting for the descriptor to
+// become readable before each further call.
+func (fd *netFD) rawRead(f func(uintptr) bool) error {
+	if err := fd.readLock(); err != nil {
+		return err
+	}
+	defer fd.readUnlock()
+	if err := fd.pd.PrepareRead(); err != nil {
+		return err
+	}
+	for {
+		if f(uintptr(fd.sysfd)) {
+			return nil
+		}
+		if err := fd.pd.WaitRead(); err != nil {
+			return err
+		}
+	}
+}
+
+// rawWrite is like rawRead but for writing.
+func (fd *netFD) rawWrite(f func(uintptr) bool) error {
+	if err := fd.writeLock(); err != nil {
+		return err
+	}
+	defer fd.writeUnlock()
+	if err := fd.pd.PrepareWrite(); err != nil {
+		return err
+	}
+	for {
+		if f(uintptr(fd.sysfd)) {
+			return nil
+		}
+		if err := fd.pd.WaitWrite(); err != nil {
+			return err
+		}
+	}
+}
+
 func (fd *netFD) Close() error {
 	fd.pd.Lock() // needed for both fd.incref(true) and pollDesc.Evict
 	if !fd.fdmu.IncrefAndClose() {
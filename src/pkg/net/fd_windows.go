@@ -409,6 +409,59 @@ func (fd *netFD) writeUnlock() {
 	}
 }
 
+// rawControl calls f with the socket handle, which stays
+// open while f runs.
+func (fd *netFD) rawControl(f func(uintptr)) error {
+	if err := fd.incref(); err != nil {
+		return err
+	}
+	defer fd.decref()
+	f(uintptr(fd.sysfd))
+	return nil
+}
+
+// rawRead calls f with the socket handle, under the read lock,
+// until f reports that it is done.  For stream sockets, it waits
+// for the socket to become readable before each further call,
+// using a zero-byte read, which does not consume any data.
+func (fd *netFD) rawRead(f func(uintptr) bool) error {
+	if err := fd.readLock(); err != nil {
+		return err
+	}
+	defer fd.readUnlock()
+	for {
+		if f(uintptr(fd.sysfd)) {
+			return nil
+		}
+		if fd.sotype != syscall.SOCK_STREAM {
+			// A zero-byte read would discard a datagram.
+			return syscall.EWINDOWS
+		}
+		o := &fd.rop
+		o.InitBuf(nil)
+		_, err := rsrv.ExecIO(o, "WSARecv", func(o *operation) error {
+			return syscall.WSARecv(o.fd.sysfd, &o.buf, 1, &o.qty, &o.flags, &o.o, nil)
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// rawWrite calls f with the socket handle, under the write lock.
+// There is no way to wait for a socket to become writable, so f
+// must be done after its first call.
+func (fd *netFD) rawWrite(f func(uintptr) bool) error {
+	if err := fd.writeLock(); err != nil {
+		return err
+	}
+	defer fd.writeUnlock()
+	if f(uintptr(fd.sysfd)) {
+		return nil
+	}
+	return syscall.EWINDOWS
+}
+
 func (fd *netFD) Close() error {
 	if !fd.fdmu.IncrefAndClose() {
 		return errClosing
@@ -3,9 +3,8 @@
 // license that can be found in the LICENSE file.
 
 // Package pprof serves via its HTTP server runtime profiling data
-// in the format expected by the pprof visualization tool.
-// For more information about pprof, see
-// http://code.google.com/p/google-perftools/.
+// in the format expected by the pprof visualization tool,
+// go tool pprof.
 //
 // The package is typically only imported for the side effect of
 // registering its HTTP handlers.
@@ -34,6 +33,16 @@
 //
 //	go tool pprof http://localhost:6060/debug/pprof/block
 //
+// Or to look at the holders of contended mutexes, after calling
+// runtime.SetMutexProfileFraction in your program:
+//
+//	go tool pprof http://localhost:6060/debug/pprof/mutex
+//
+// Or to collect a 5-second execution trace:
+//
+//	wget http://localhost:6060/debug/pprof/trace?seconds=5
+//	go tool trace trace
+//
 // To view all available profiles, open http://localhost:6060/debug/pprof/
 // in your browser.
 //
@@ -54,6 +63,7 @@ import (
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"runtime/trace"
 	"strconv"
 	"strings"
 	"time"
@@ -64,6 +74,7 @@ func init() {
 	http.Handle("/debug/pprof/cmdline", http.HandlerFunc(Cmdline))
 	http.Handle("/debug/pprof/profile", http.HandlerFunc(Profile))
 	http.Handle("/debug/pprof/symbol", http.HandlerFunc(Symbol))
+	http.Handle("/debug/pprof/trace", http.HandlerFunc(Trace))
 }
 
 // Cmdline responds with the running program's
@@ -98,6 +109,31 @@ func Profile(w http.ResponseWriter, r *http.Request) {
 	pprof.StopCPUProfile()
 }
 
+// Trace responds with the execution trace in binary form,
+// traced for the number of seconds given by the seconds parameter,
+// or for 1 second if it is not set.
+// The package initialization registers it as /debug/pprof/trace.
+func Trace(w http.ResponseWriter, r *http.Request) {
+	sec, _ := strconv.ParseFloat(r.FormValue("seconds"), 64)
+	if sec <= 0 {
+		sec = 1
+	}
+
+	// Set Content Type assuming trace.Start will work,
+	// because if it does it starts writing.
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if err := trace.Start(w); err != nil {
+		// trace.Start failed, so no writes yet.
+		// Can change header back to text content and send error code.
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Could not enable tracing: %s\n", err)
+		return
+	}
+	time.Sleep(time.Duration(sec * float64(time.Second)))
+	trace.Stop()
+}
+
 // Symbol looks up the program counters listed in the request,
 // responding with a table mapping program counters to function names.
 // The package initialization registers it as /debug/pprof/symbol.
@@ -162,6 +198,10 @@ func (name handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "Unknown profile: %s\n", name)
 		return
 	}
+	if debug == 0 {
+		// The profile is a gzipped protocol buffer.
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
 	p.WriteTo(w, debug)
 	return
 }
@@ -200,6 +240,7 @@ profiles:<br>
 </table>
 <br>
 <a href="/debug/pprof/goroutine?debug=2">full goroutine stack dump</a><br>
+<a href="/debug/pprof/trace?seconds=5">5-second execution trace</a><br>
 </body>
 </html>
 `))
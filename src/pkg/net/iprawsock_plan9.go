@@ -66,10 +66,10 @@ func (c *IPConn) WriteMsgIP(b, oob []byte, addr *IPAddr) (n, oobn int, err error
 // netProto, which must be "ip", "ip4", or "ip6" followed by a colon
 // and a protocol number or name.
 func DialIP(netProto string, laddr, raddr *IPAddr) (*IPConn, error) {
-	return dialIP(netProto, laddr, raddr, noDeadline)
+	return dialIP(netProto, laddr, raddr, noDeadline, nil)
 }
 
-func dialIP(netProto string, laddr, raddr *IPAddr, deadline time.Time) (*IPConn, error) {
+func dialIP(netProto string, laddr, raddr *IPAddr, deadline time.Time, _ func(string, string, syscall.RawConn) error) (*IPConn, error) {
 	return nil, syscall.EPLAN9
 }
 
@@ -80,3 +80,7 @@ func dialIP(netProto string, laddr, raddr *IPAddr, deadline time.Time) (*IPConn,
 func ListenIP(netProto string, laddr *IPAddr) (*IPConn, error) {
 	return nil, syscall.EPLAN9
 }
+
+func listenIP(netProto string, laddr *IPAddr, _ func(string, string, syscall.RawConn) error) (*IPConn, error) {
+	return ListenIP(netProto, laddr)
+}
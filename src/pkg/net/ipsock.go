@@ -64,36 +64,30 @@ var errNoSuitableAddress = errors.New("no suitable address found")
 // implement the netaddr interface. Known filters are nil, ipv4only
 // and ipv6only. It returns any address when filter is nil. The result
 // contains at least one address when error is nil.
+//
+// All suitable addresses are returned, so that a dial may try each
+// in turn.  When filter is nil, IPv4 addresses come first: although
+// the dialing code tries the others too, too much code assumes that
+// localhost, which may resolve to [ipv6-localhost, ipv4-localhost],
+// means ipv4-localhost.
 func firstFavoriteAddr(filter func(IP) IP, ips []IP, inetaddr func(IP) netaddr) (netaddr, error) {
+	var list addrList
 	if filter != nil {
-		return firstSupportedAddr(filter, ips, inetaddr)
-	}
-	var (
-		ipv4, ipv6, swap bool
-		list             addrList
-	)
-	for _, ip := range ips {
-		// We'll take any IP address, but since the dialing
-		// code does not yet try multiple addresses
-		// effectively, prefer to use an IPv4 address if
-		// possible. This is especially relevant if localhost
-		// resolves to [ipv6-localhost, ipv4-localhost]. Too
-		// much code assumes localhost == ipv4-localhost.
-		if ip4 := ipv4only(ip); ip4 != nil && !ipv4 {
-			list = append(list, inetaddr(ip4))
-			ipv4 = true
-			if ipv6 {
-				swap = true
+		for _, ip := range ips {
+			if ip := filter(ip); ip != nil {
+				list = append(list, inetaddr(ip))
+			}
+		}
+	} else {
+		for _, ip := range ips {
+			if ip4 := ipv4only(ip); ip4 != nil {
+				list = append(list, inetaddr(ip4))
 			}
-		} else if ip6 := ipv6only(ip); ip6 != nil && !ipv6 {
-			list = append(list, inetaddr(ip6))
-			ipv6 = true
 		}
-		if ipv4 && ipv6 {
-			if swap {
-				list[0], list[1] = list[1], list[0]
+		for _, ip := range ips {
+			if ip6 := ipv6only(ip); ip6 != nil {
+				list = append(list, inetaddr(ip6))
 			}
-			break
 		}
 	}
 	switch len(list) {
@@ -106,13 +100,32 @@ func firstFavoriteAddr(filter func(IP) IP, ips []IP, inetaddr func(IP) netaddr)
 	}
 }
 
-func firstSupportedAddr(filter func(IP) IP, ips []IP, inetaddr func(IP) netaddr) (netaddr, error) {
-	for _, ip := range ips {
-		if ip := filter(ip); ip != nil {
-			return inetaddr(ip), nil
+// partition divides al into the addresses of the same family as
+// the first one, and the rest.
+func (al addrList) partition() (primaries, fallbacks addrList) {
+	first := isIPv4Addr(al[0].toAddr())
+	for _, a := range al {
+		if isIPv4Addr(a.toAddr()) == first {
+			primaries = append(primaries, a)
+		} else {
+			fallbacks = append(fallbacks, a)
 		}
 	}
-	return nil, errNoSuitableAddress
+	return
+}
+
+// isIPv4Addr reports whether a is an IPv4 TCP, UDP or IP address.
+func isIPv4Addr(a Addr) bool {
+	var ip IP
+	switch a := a.(type) {
+	case *TCPAddr:
+		ip = a.IP
+	case *UDPAddr:
+		ip = a.IP
+	case *IPAddr:
+		ip = a.IP
+	}
+	return ip.To4() != nil
 }
 
 // ipv4only returns IPv4 addresses that we can use with the kernel's
@@ -229,12 +242,12 @@ func JoinHostPort(host, port string) string {
 }
 
 // resolveInternetAddr resolves addr that is either a literal IP
-// address or a DNS name and returns an internet protocol family
+// address or a DNS name, looked up using r, and returns an internet protocol family
 // address. It returns a list that contains a pair of different
 // address family addresses when addr is a DNS name and the name has
 // mutiple address family records. The result contains at least one
 // address when error is nil.
-func resolveInternetAddr(net, addr string, deadline time.Time) (netaddr, error) {
+func (r *Resolver) resolveInternetAddr(net, addr string, deadline time.Time) (netaddr, error) {
 	var (
 		err              error
 		host, port, zone string
@@ -282,7 +295,7 @@ func resolveInternetAddr(net, addr string, deadline time.Time) (netaddr, error)
 	}
 	// Try as a DNS name.
 	host, zone = splitHostZone(host)
-	ips, err := lookupIPDeadline(host, deadline)
+	ips, err := r.LookupIPDeadline(host, deadline)
 	if err != nil {
 		return nil, err
 	}
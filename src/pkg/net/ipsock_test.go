@@ -51,7 +51,10 @@ var firstFavoriteAddrTests = []struct {
 			IPv4(192, 168, 0, 1),
 		},
 		testInetaddr,
-		&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+		addrList{
+			&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+			&TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682},
+		},
 		nil,
 	},
 	{
@@ -61,7 +64,10 @@ var firstFavoriteAddrTests = []struct {
 			ParseIP("fe80::1"),
 		},
 		testInetaddr,
-		&TCPAddr{IP: IPv6loopback, Port: 5682},
+		addrList{
+			&TCPAddr{IP: IPv6loopback, Port: 5682},
+			&TCPAddr{IP: ParseIP("fe80::1"), Port: 5682},
+		},
 		nil,
 	},
 	{
@@ -75,7 +81,9 @@ var firstFavoriteAddrTests = []struct {
 		testInetaddr,
 		addrList{
 			&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+			&TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682},
 			&TCPAddr{IP: IPv6loopback, Port: 5682},
+			&TCPAddr{IP: ParseIP("fe80::1"), Port: 5682},
 		},
 		nil,
 	},
@@ -90,7 +98,9 @@ var firstFavoriteAddrTests = []struct {
 		testInetaddr,
 		addrList{
 			&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+			&TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682},
 			&TCPAddr{IP: IPv6loopback, Port: 5682},
+			&TCPAddr{IP: ParseIP("fe80::1"), Port: 5682},
 		},
 		nil,
 	},
@@ -105,7 +115,9 @@ var firstFavoriteAddrTests = []struct {
 		testInetaddr,
 		addrList{
 			&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+			&TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682},
 			&TCPAddr{IP: IPv6loopback, Port: 5682},
+			&TCPAddr{IP: ParseIP("fe80::1"), Port: 5682},
 		},
 		nil,
 	},
@@ -120,7 +132,9 @@ var firstFavoriteAddrTests = []struct {
 		testInetaddr,
 		addrList{
 			&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+			&TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682},
 			&TCPAddr{IP: IPv6loopback, Port: 5682},
+			&TCPAddr{IP: ParseIP("fe80::1"), Port: 5682},
 		},
 		nil,
 	},
@@ -146,6 +160,21 @@ var firstFavoriteAddrTests = []struct {
 		nil,
 	},
 
+	{
+		ipv4only,
+		[]IP{
+			IPv4(127, 0, 0, 1),
+			IPv6loopback,
+			IPv4(192, 168, 0, 1),
+		},
+		testInetaddr,
+		addrList{
+			&TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682},
+			&TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682},
+		},
+		nil,
+	},
+
 	{
 		ipv6only,
 		[]IP{
@@ -191,3 +220,25 @@ func TestFirstFavoriteAddr(t *testing.T) {
 		}
 	}
 }
+
+func TestAddrListPartition(t *testing.T) {
+	var (
+		a4 = &TCPAddr{IP: IPv4(127, 0, 0, 1), Port: 5682}
+		b4 = &TCPAddr{IP: IPv4(192, 168, 0, 1), Port: 5682}
+		a6 = &TCPAddr{IP: IPv6loopback, Port: 5682}
+		b6 = &TCPAddr{IP: ParseIP("fe80::1"), Port: 5682}
+	)
+	tests := []struct {
+		list, primaries, fallbacks addrList
+	}{
+		{addrList{a4}, addrList{a4}, nil},
+		{addrList{a4, b4, a6, b6}, addrList{a4, b4}, addrList{a6, b6}},
+		{addrList{a6, a4, b6, b4}, addrList{a6, b6}, addrList{a4, b4}},
+	}
+	for i, tt := range tests {
+		primaries, fallbacks := tt.list.partition()
+		if !reflect.DeepEqual(primaries, tt.primaries) || !reflect.DeepEqual(fallbacks, tt.fallbacks) {
+			t.Errorf("#%v: got %v, %v; expected %v, %v", i, primaries, fallbacks, tt.primaries, tt.fallbacks)
+		}
+	}
+}
@@ -19,16 +19,124 @@ var protocols = map[string]int{
 	"ipv6-icmp": 58, "IPV6-ICMP": 58, "IPv6-ICMP": 58,
 }
 
+// A Resolver looks up names and numbers.
+//
+// A nil *Resolver is equivalent to a zero Resolver, which behaves
+// like the package-level lookup functions.
+type Resolver struct {
+	// PreferGo controls whether Go's built-in DNS resolver is
+	// preferred on platforms where it is available, instead of
+	// the C library's resolver.
+	PreferGo bool
+
+	// Dial optionally specifies an alternate dialer for use by
+	// Go's built-in DNS resolver to make TCP and UDP connections
+	// to DNS servers.  The network is "udp" or "tcp" and the
+	// address is that of a server listed in /etc/resolv.conf.
+	// The returned Conn may be a PacketConn, which exchanges one
+	// DNS message per packet, or a stream, on which messages are
+	// prefixed by their length as in DNS over TCP.
+	//
+	// Setting Dial implies PreferGo.  On Windows and Plan 9,
+	// which have no built-in DNS resolver, Dial is not used.
+	Dial func(network, address string) (Conn, error)
+
+	// Timeout is the maximum amount of time a lookup will wait
+	// for a result.  A Dialer using the Resolver, or a call to one
+	// of the Deadline lookup methods, may give up earlier, at its
+	// own deadline.
+	//
+	// The default is no timeout.
+	Timeout time.Duration
+
+	// CacheSize is the maximum number of answers Go's built-in
+	// DNS resolver keeps, for as long as their time to live
+	// allows, to avoid asking the servers again.  Zero, the
+	// default, disables the cache.
+	CacheSize int
+
+	cache dnsCache
+}
+
+// DefaultResolver is the resolver used by the package-level
+// lookup functions and by Dialers without a Resolver.
+var DefaultResolver = &Resolver{}
+
+// preferGo reports whether r uses Go's built-in DNS resolver
+// rather than the C library's.
+func (r *Resolver) preferGo() bool {
+	return r != nil && (r.PreferGo || r.Dial != nil)
+}
+
+// shared reports whether lookups by r may share their results
+// with those of other resolvers.
+func (r *Resolver) shared() bool {
+	return r == nil || r.Dial == nil && !r.PreferGo
+}
+
+// deadline returns the earlier of deadline and now+r.Timeout,
+// or zero if neither is set.
+func (r *Resolver) deadline(deadline time.Time) time.Time {
+	if r == nil || r.Timeout == 0 {
+		return deadline
+	}
+	timeoutDeadline := time.Now().Add(r.Timeout)
+	if deadline.IsZero() || timeoutDeadline.Before(deadline) {
+		return timeoutDeadline
+	}
+	return deadline
+}
+
 // LookupHost looks up the given host using the local resolver.
 // It returns an array of that host's addresses.
 func LookupHost(host string) (addrs []string, err error) {
-	return lookupHost(host)
+	return DefaultResolver.LookupHost(host)
+}
+
+// LookupHost looks up the given host using r.
+// It returns an array of that host's addresses.
+func (r *Resolver) LookupHost(host string) (addrs []string, err error) {
+	return r.LookupHostDeadline(host, noDeadline)
+}
+
+// LookupHostDeadline is like LookupHost but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupHostDeadline(host string, deadline time.Time) (addrs []string, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupHost(host, deadline)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]string), nil
 }
 
 // LookupIP looks up host using the local resolver.
 // It returns an array of that host's IPv4 and IPv6 addresses.
 func LookupIP(host string) (addrs []IP, err error) {
-	return lookupIPMerge(host)
+	return DefaultResolver.LookupIP(host)
+}
+
+// LookupIP looks up host using r.
+// It returns an array of that host's IPv4 and IPv6 addresses.
+func (r *Resolver) LookupIP(host string) (addrs []IP, err error) {
+	return r.LookupIPDeadline(host, noDeadline)
+}
+
+// LookupIPDeadline is like LookupIP but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupIPDeadline(host string, deadline time.Time) (addrs []IP, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupIPMerge(host, deadline)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]IP), nil
 }
 
 var lookupGroup singleflight
@@ -36,9 +144,15 @@ var lookupGroup singleflight
 // lookupIPMerge wraps lookupIP, but makes sure that for any given
 // host, only one lookup is in-flight at a time. The returned memory
 // is always owned by the caller.
-func lookupIPMerge(host string) (addrs []IP, err error) {
+func (r *Resolver) lookupIPMerge(host string, deadline time.Time) (addrs []IP, err error) {
+	if !r.shared() {
+		return r.lookupIP(host, deadline)
+	}
+	// The shared lookup does not stop at the deadline of
+	// whichever caller started it; each caller gives up at
+	// its own deadline instead.
 	addrsi, err, shared := lookupGroup.Do(host, func() (interface{}, error) {
-		return lookupIP(host)
+		return r.lookupIP(host, noDeadline)
 	})
 	if err != nil {
 		return nil, err
@@ -52,46 +166,64 @@ func lookupIPMerge(host string) (addrs []IP, err error) {
 	return addrs, nil
 }
 
-func lookupIPDeadline(host string, deadline time.Time) (addrs []IP, err error) {
+// lookupDeadline returns the results of lookup, or errTimeout if
+// they are not available by deadline.
+//
+// Go's DNS resolver gives up at the deadline it is passed, but the
+// C library, Windows and Plan 9 resolvers know nothing of deadlines.
+// Those lookups run in a goroutine, which finishes in the background
+// when the caller gives up.  Most users affected by
+// http://golang.org/issue/2631 are due to TCP connections to
+// unresponsive hosts, not DNS.
+func lookupDeadline(deadline time.Time, lookup func() (interface{}, error)) (v interface{}, err error) {
 	if deadline.IsZero() {
-		return lookupIPMerge(host)
+		return lookup()
 	}
-
-	// TODO(bradfitz): consider pushing the deadline down into the
-	// name resolution functions. But that involves fixing it for
-	// the native Go resolver, cgo, Windows, etc.
-	//
-	// In the meantime, just use a goroutine. Most users affected
-	// by http://golang.org/issue/2631 are due to TCP connections
-	// to unresponsive hosts, not DNS.
 	timeout := deadline.Sub(time.Now())
 	if timeout <= 0 {
-		err = errTimeout
-		return
+		return nil, errTimeout
 	}
 	t := time.NewTimer(timeout)
 	defer t.Stop()
 	type res struct {
-		addrs []IP
-		err   error
+		v   interface{}
+		err error
 	}
 	resc := make(chan res, 1)
 	go func() {
-		a, err := lookupIPMerge(host)
-		resc <- res{a, err}
+		v, err := lookup()
+		resc <- res{v, err}
 	}()
 	select {
 	case <-t.C:
-		err = errTimeout
+		return nil, errTimeout
 	case r := <-resc:
-		addrs, err = r.addrs, r.err
+		return r.v, r.err
 	}
-	return
 }
 
 // LookupPort looks up the port for the given network and service.
 func LookupPort(network, service string) (port int, err error) {
-	return lookupPort(network, service)
+	return DefaultResolver.LookupPort(network, service)
+}
+
+// LookupPort looks up the port for the given network and service using r.
+func (r *Resolver) LookupPort(network, service string) (port int, err error) {
+	return r.LookupPortDeadline(network, service, noDeadline)
+}
+
+// LookupPortDeadline is like LookupPort but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupPortDeadline(network, service string, deadline time.Time) (port int, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupPort(network, service, deadline)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return v.(int), nil
 }
 
 // LookupCNAME returns the canonical DNS host for the given name.
@@ -99,7 +231,26 @@ func LookupPort(network, service string) (port int, err error) {
 // LookupHost or LookupIP directly; both take care of resolving
 // the canonical name as part of the lookup.
 func LookupCNAME(name string) (cname string, err error) {
-	return lookupCNAME(name)
+	return DefaultResolver.LookupCNAME(name)
+}
+
+// LookupCNAME returns the canonical DNS host for the given name, using r.
+func (r *Resolver) LookupCNAME(name string) (cname string, err error) {
+	return r.LookupCNAMEDeadline(name, noDeadline)
+}
+
+// LookupCNAMEDeadline is like LookupCNAME but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupCNAMEDeadline(name string, deadline time.Time) (cname string, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupCNAME(name, deadline)
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
 }
 
 // LookupSRV tries to resolve an SRV query of the given service,
@@ -112,26 +263,129 @@ func LookupCNAME(name string) (cname string, err error) {
 // publishing SRV records under non-standard names, if both service
 // and proto are empty strings, LookupSRV looks up name directly.
 func LookupSRV(service, proto, name string) (cname string, addrs []*SRV, err error) {
-	return lookupSRV(service, proto, name)
+	return DefaultResolver.LookupSRV(service, proto, name)
+}
+
+// LookupSRV is like the package-level LookupSRV but uses r.
+func (r *Resolver) LookupSRV(service, proto, name string) (cname string, addrs []*SRV, err error) {
+	return r.LookupSRVDeadline(service, proto, name, noDeadline)
+}
+
+// LookupSRVDeadline is like LookupSRV but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupSRVDeadline(service, proto, name string, deadline time.Time) (cname string, addrs []*SRV, err error) {
+	deadline = r.deadline(deadline)
+	type srvResult struct {
+		cname string
+		addrs []*SRV
+	}
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		cname, addrs, err := r.lookupSRV(service, proto, name, deadline)
+		return srvResult{cname, addrs}, err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	res := v.(srvResult)
+	return res.cname, res.addrs, nil
 }
 
 // LookupMX returns the DNS MX records for the given domain name sorted by preference.
 func LookupMX(name string) (mx []*MX, err error) {
-	return lookupMX(name)
+	return DefaultResolver.LookupMX(name)
+}
+
+// LookupMX returns the DNS MX records for the given domain name
+// sorted by preference, using r.
+func (r *Resolver) LookupMX(name string) (mx []*MX, err error) {
+	return r.LookupMXDeadline(name, noDeadline)
+}
+
+// LookupMXDeadline is like LookupMX but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupMXDeadline(name string, deadline time.Time) (mx []*MX, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupMX(name, deadline)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*MX), nil
 }
 
 // LookupNS returns the DNS NS records for the given domain name.
 func LookupNS(name string) (ns []*NS, err error) {
-	return lookupNS(name)
+	return DefaultResolver.LookupNS(name)
+}
+
+// LookupNS returns the DNS NS records for the given domain name, using r.
+func (r *Resolver) LookupNS(name string) (ns []*NS, err error) {
+	return r.LookupNSDeadline(name, noDeadline)
+}
+
+// LookupNSDeadline is like LookupNS but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupNSDeadline(name string, deadline time.Time) (ns []*NS, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupNS(name, deadline)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*NS), nil
 }
 
 // LookupTXT returns the DNS TXT records for the given domain name.
 func LookupTXT(name string) (txt []string, err error) {
-	return lookupTXT(name)
+	return DefaultResolver.LookupTXT(name)
+}
+
+// LookupTXT returns the DNS TXT records for the given domain name, using r.
+func (r *Resolver) LookupTXT(name string) (txt []string, err error) {
+	return r.LookupTXTDeadline(name, noDeadline)
+}
+
+// LookupTXTDeadline is like LookupTXT but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupTXTDeadline(name string, deadline time.Time) (txt []string, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupTXT(name, deadline)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]string), nil
 }
 
 // LookupAddr performs a reverse lookup for the given address, returning a list
 // of names mapping to that address.
 func LookupAddr(addr string) (name []string, err error) {
-	return lookupAddr(addr)
+	return DefaultResolver.LookupAddr(addr)
+}
+
+// LookupAddr performs a reverse lookup for the given address using r,
+// returning a list of names mapping to that address.
+func (r *Resolver) LookupAddr(addr string) (name []string, err error) {
+	return r.LookupAddrDeadline(addr, noDeadline)
+}
+
+// LookupAddrDeadline is like LookupAddr but gives up at the given
+// deadline, or at r's Timeout if that is earlier.  A zero deadline
+// means no deadline.
+func (r *Resolver) LookupAddrDeadline(addr string, deadline time.Time) (name []string, err error) {
+	deadline = r.deadline(deadline)
+	v, err := lookupDeadline(deadline, func() (interface{}, error) {
+		return r.lookupAddr(addr, deadline)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]string), nil
 }
@@ -0,0 +1,49 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build plan9 windows
+
+package net
+
+import "time"
+
+// Plan 9 and Windows look up names using the system's resolver,
+// which knows nothing of deadlines or of the Resolver's Dial and
+// PreferGo settings.  lookupDeadline enforces the deadlines.
+
+func (*Resolver) lookupHost(host string, _ time.Time) ([]string, error) {
+	return lookupHost(host)
+}
+
+func (*Resolver) lookupIP(host string, _ time.Time) ([]IP, error) {
+	return lookupIP(host)
+}
+
+func (*Resolver) lookupPort(network, service string, _ time.Time) (int, error) {
+	return lookupPort(network, service)
+}
+
+func (*Resolver) lookupCNAME(name string, _ time.Time) (string, error) {
+	return lookupCNAME(name)
+}
+
+func (*Resolver) lookupSRV(service, proto, name string, _ time.Time) (string, []*SRV, error) {
+	return lookupSRV(service, proto, name)
+}
+
+func (*Resolver) lookupMX(name string, _ time.Time) ([]*MX, error) {
+	return lookupMX(name)
+}
+
+func (*Resolver) lookupNS(name string, _ time.Time) ([]*NS, error) {
+	return lookupNS(name)
+}
+
+func (*Resolver) lookupTXT(name string, _ time.Time) ([]string, error) {
+	return lookupTXT(name)
+}
+
+func (*Resolver) lookupAddr(addr string, _ time.Time) ([]string, error) {
+	return lookupAddr(addr)
+}
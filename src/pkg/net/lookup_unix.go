@@ -8,7 +8,9 @@ package net
 
 import (
 	"errors"
+	"net/dnsmessage"
 	"sync"
+	"time"
 )
 
 var onceReadProtocols sync.Once
@@ -52,99 +54,106 @@ func lookupProtocol(name string) (proto int, err error) {
 	return
 }
 
-func lookupHost(host string) (addrs []string, err error) {
-	addrs, err, ok := cgoLookupHost(host)
-	if !ok {
-		addrs, err = goLookupHost(host)
+func (r *Resolver) lookupHost(host string, deadline time.Time) (addrs []string, err error) {
+	if !r.preferGo() {
+		if addrs, err, ok := cgoLookupHost(host); ok {
+			return addrs, err
+		}
 	}
-	return
+	return r.goLookupHost(host, deadline)
 }
 
-func lookupIP(host string) (addrs []IP, err error) {
-	addrs, err, ok := cgoLookupIP(host)
-	if !ok {
-		addrs, err = goLookupIP(host)
+func (r *Resolver) lookupIP(host string, deadline time.Time) (addrs []IP, err error) {
+	if !r.preferGo() {
+		if addrs, err, ok := cgoLookupIP(host); ok {
+			return addrs, err
+		}
 	}
-	return
+	return r.goLookupIP(host, deadline)
 }
 
-func lookupPort(network, service string) (port int, err error) {
-	port, err, ok := cgoLookupPort(network, service)
-	if !ok {
-		port, err = goLookupPort(network, service)
+func (r *Resolver) lookupPort(network, service string, deadline time.Time) (port int, err error) {
+	if !r.preferGo() {
+		if port, err, ok := cgoLookupPort(network, service); ok {
+			return port, err
+		}
 	}
-	return
+	return goLookupPort(network, service)
 }
 
-func lookupCNAME(name string) (cname string, err error) {
-	cname, err, ok := cgoLookupCNAME(name)
-	if !ok {
-		cname, err = goLookupCNAME(name)
+func (r *Resolver) lookupCNAME(name string, deadline time.Time) (cname string, err error) {
+	if !r.preferGo() {
+		if cname, err, ok := cgoLookupCNAME(name); ok {
+			return cname, err
+		}
 	}
-	return
+	return r.goLookupCNAME(name, deadline)
 }
 
-func lookupSRV(service, proto, name string) (cname string, addrs []*SRV, err error) {
+func (r *Resolver) lookupSRV(service, proto, name string, deadline time.Time) (cname string, addrs []*SRV, err error) {
 	var target string
 	if service == "" && proto == "" {
 		target = name
 	} else {
 		target = "_" + service + "._" + proto + "." + name
 	}
-	var records []dnsRR
-	cname, records, err = lookup(target, dnsTypeSRV)
+	var records []dnsmessage.Resource
+	cname, records, err = r.lookup(target, dnsmessage.TypeSRV, deadline)
 	if err != nil {
 		return
 	}
 	addrs = make([]*SRV, len(records))
 	for i, rr := range records {
-		r := rr.(*dnsRR_SRV)
-		addrs[i] = &SRV{r.Target, r.Port, r.Priority, r.Weight}
+		srv := rr.Body.(*dnsmessage.SRVResource)
+		addrs[i] = &SRV{srv.Target, srv.Port, srv.Priority, srv.Weight}
 	}
 	byPriorityWeight(addrs).sort()
 	return
 }
 
-func lookupMX(name string) (mx []*MX, err error) {
-	_, records, err := lookup(name, dnsTypeMX)
+func (r *Resolver) lookupMX(name string, deadline time.Time) (mx []*MX, err error) {
+	_, records, err := r.lookup(name, dnsmessage.TypeMX, deadline)
 	if err != nil {
 		return
 	}
 	mx = make([]*MX, len(records))
 	for i, rr := range records {
-		r := rr.(*dnsRR_MX)
-		mx[i] = &MX{r.Mx, r.Pref}
+		m := rr.Body.(*dnsmessage.MXResource)
+		mx[i] = &MX{m.MX, m.Pref}
 	}
 	byPref(mx).sort()
 	return
 }
 
-func lookupNS(name string) (ns []*NS, err error) {
-	_, records, err := lookup(name, dnsTypeNS)
+func (r *Resolver) lookupNS(name string, deadline time.Time) (ns []*NS, err error) {
+	_, records, err := r.lookup(name, dnsmessage.TypeNS, deadline)
 	if err != nil {
 		return
 	}
 	ns = make([]*NS, len(records))
-	for i, r := range records {
-		r := r.(*dnsRR_NS)
-		ns[i] = &NS{r.Ns}
+	for i, rr := range records {
+		ns[i] = &NS{rr.Body.(*dnsmessage.NSResource).NS}
 	}
 	return
 }
 
-func lookupTXT(name string) (txt []string, err error) {
-	_, records, err := lookup(name, dnsTypeTXT)
+func (r *Resolver) lookupTXT(name string, deadline time.Time) (txt []string, err error) {
+	_, records, err := r.lookup(name, dnsmessage.TypeTXT, deadline)
 	if err != nil {
 		return
 	}
 	txt = make([]string, len(records))
-	for i, r := range records {
-		txt[i] = r.(*dnsRR_TXT).Txt
+	for i, rr := range records {
+		// A record holds one or more strings of up to
+		// 255 bytes, which together make up its text.
+		for _, s := range rr.Body.(*dnsmessage.TXTResource).TXT {
+			txt[i] += s
+		}
 	}
 	return
 }
 
-func lookupAddr(addr string) (name []string, err error) {
+func (r *Resolver) lookupAddr(addr string, deadline time.Time) (name []string, err error) {
 	name = lookupStaticAddr(addr)
 	if len(name) > 0 {
 		return
@@ -154,15 +163,14 @@ func lookupAddr(addr string) (name []string, err error) {
 	if err != nil {
 		return
 	}
-	var records []dnsRR
-	_, records, err = lookup(arpa, dnsTypePTR)
+	var records []dnsmessage.Resource
+	_, records, err = r.lookup(arpa, dnsmessage.TypePTR, deadline)
 	if err != nil {
 		return
 	}
 	name = make([]string, len(records))
-	for i := range records {
-		r := records[i].(*dnsRR_PTR)
-		name[i] = r.Ptr
+	for i, rr := range records {
+		name[i] = rr.Body.(*dnsmessage.PTRResource).PTR
 	}
 	return
 }
@@ -18,7 +18,7 @@ func TestGoLookupIP(t *testing.T) {
 	if err != nil {
 		t.Errorf("cgoLookupIP failed: %v", err)
 	}
-	if _, err := goLookupIP(host); err != nil {
+	if _, err := DefaultResolver.goLookupIP(host, noDeadline); err != nil {
 		t.Errorf("goLookupIP failed: %v", err)
 	}
 }
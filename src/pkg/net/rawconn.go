@@ -0,0 +1,51 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build darwin dragonfly freebsd linux nacl netbsd openbsd solaris windows
+
+package net
+
+import "syscall"
+
+// rawConn implements syscall.RawConn for the file descriptor of a
+// socket.
+type rawConn struct {
+	fd *netFD
+}
+
+func newRawConn(fd *netFD) *rawConn {
+	return &rawConn{fd: fd}
+}
+
+func (c *rawConn) ok() bool { return c != nil && c.fd != nil }
+
+func (c *rawConn) Control(f func(uintptr)) error {
+	if !c.ok() {
+		return syscall.EINVAL
+	}
+	if err := c.fd.rawControl(f); err != nil {
+		return &OpError{Op: "raw-control", Net: c.fd.net, Addr: c.fd.laddr, Err: err}
+	}
+	return nil
+}
+
+func (c *rawConn) Read(f func(uintptr) bool) error {
+	if !c.ok() {
+		return syscall.EINVAL
+	}
+	if err := c.fd.rawRead(f); err != nil {
+		return &OpError{Op: "raw-read", Net: c.fd.net, Addr: c.fd.raddr, Err: err}
+	}
+	return nil
+}
+
+func (c *rawConn) Write(f func(uintptr) bool) error {
+	if !c.ok() {
+		return syscall.EINVAL
+	}
+	if err := c.fd.rawWrite(f); err != nil {
+		return &OpError{Op: "raw-write", Net: c.fd.net, Addr: c.fd.raddr, Err: err}
+	}
+	return nil
+}
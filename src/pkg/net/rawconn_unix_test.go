@@ -0,0 +1,184 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package net
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestDialerControl(t *testing.T) {
+	ln := newLocalListener(t)
+	defer ln.Close()
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Close()
+		}
+	}()
+
+	var network, address string
+	var fd uintptr
+	d := Dialer{Control: func(n, a string, c syscall.RawConn) error {
+		network, address = n, a
+		return c.Control(func(s uintptr) { fd = s })
+	}}
+	c, err := d.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	want := "tcp4"
+	if ln.Addr().(*TCPAddr).IP.To4() == nil {
+		want = "tcp6"
+	}
+	if network != want {
+		t.Errorf("Control called with network %q; want %q", network, want)
+	}
+	if address != ln.Addr().String() {
+		t.Errorf("Control called with address %q; want %q", address, ln.Addr())
+	}
+	if fd == 0 {
+		t.Errorf("Control's RawConn did not supply the file descriptor")
+	}
+
+	errControl := errors.New("control failed")
+	d.Control = func(string, string, syscall.RawConn) error { return errControl }
+	if c, err := d.Dial("tcp", ln.Addr().String()); err == nil {
+		c.Close()
+		t.Errorf("Dial succeeded although Control failed")
+	} else if oe, ok := err.(*OpError); !ok || oe.Err != errControl {
+		t.Errorf("Dial returned %v; want OpError with %v", err, errControl)
+	}
+}
+
+func TestListenConfigControl(t *testing.T) {
+	setReuseAddr := func(network, address string, c syscall.RawConn) error {
+		var serr error
+		err := c.Control(func(fd uintptr) {
+			serr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_REUSEADDR, 1)
+		})
+		if err != nil {
+			return err
+		}
+		return serr
+	}
+	lc := ListenConfig{Control: setReuseAddr}
+
+	ln, err := lc.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	checkReuseAddr(t, ln.(*TCPListener).fd)
+
+	c, err := lc.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	checkReuseAddr(t, c.(*UDPConn).fd)
+
+	path := testUnixAddr()
+	defer os.Remove(path)
+	called := false
+	lc.Control = func(network, address string, c syscall.RawConn) error {
+		called = true
+		if network != "unix" || address != path {
+			t.Errorf("Control called with %q, %q; want %q, %q", network, address, "unix", path)
+		}
+		return nil
+	}
+	uln, err := lc.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uln.Close()
+	if !called {
+		t.Errorf("Control not called for unix listener")
+	}
+}
+
+func checkReuseAddr(t *testing.T, fd *netFD) {
+	v, err := syscall.GetsockoptInt(fd.sysfd, syscall.SOL_SOCKET, syscall.SO_REUSEADDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == 0 {
+		t.Errorf("%s: SO_REUSEADDR not set by Control", fd.net)
+	}
+}
+
+func TestRawConn(t *testing.T) {
+	ln := newLocalListener(t)
+	defer ln.Close()
+	done := make(chan error, 1)
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer c.Close()
+		b := make([]byte, 5)
+		if _, err := c.Read(b); err != nil {
+			done <- err
+			return
+		}
+		_, err = c.Write(b)
+		done <- err
+	}()
+
+	c, err := Dial(ln.Addr().Network(), ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	rc, err := c.(*TCPConn).SyscallConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var werr error
+	err = rc.Write(func(fd uintptr) bool {
+		_, werr = syscall.Write(int(fd), []byte("hello"))
+		return werr != syscall.EAGAIN
+	})
+	if err != nil || werr != nil {
+		t.Fatalf("Write: %v, %v", err, werr)
+	}
+
+	// The reply arrives later, so Read must wait for it.
+	b := make([]byte, 5)
+	var n int
+	var rerr error
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	err = rc.Read(func(fd uintptr) bool {
+		n, rerr = syscall.Read(int(fd), b)
+		return rerr != syscall.EAGAIN
+	})
+	if err != nil || rerr != nil {
+		t.Fatalf("Read: %v, %v", err, rerr)
+	}
+	if string(b[:n]) != "hello" {
+		t.Errorf("Read %q; want %q", b[:n], "hello")
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	c.Close()
+	if err := rc.Control(func(uintptr) {}); err == nil {
+		t.Errorf("Control on closed connection succeeded")
+	}
+}
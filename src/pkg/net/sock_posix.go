@@ -36,7 +36,7 @@ type sockaddr interface {
 
 // socket returns a network file descriptor that is ready for
 // asynchronous I/O using the network poller.
-func socket(net string, family, sotype, proto int, ipv6only bool, laddr, raddr sockaddr, deadline time.Time, toAddr func(syscall.Sockaddr) Addr) (fd *netFD, err error) {
+func socket(net string, family, sotype, proto int, ipv6only bool, laddr, raddr sockaddr, deadline time.Time, toAddr func(syscall.Sockaddr) Addr, ctrlFn func(string, string, syscall.RawConn) error) (fd *netFD, err error) {
 	s, err := sysSocket(family, sotype, proto)
 	if err != nil {
 		return nil, err
@@ -49,6 +49,18 @@ func socket(net string, family, sotype, proto int, ipv6only bool, laddr, raddr s
 		closesocket(s)
 		return nil, err
 	}
+	if ctrlFn != nil {
+		var address string
+		if raddr != nil {
+			address = raddr.String()
+		} else if laddr != nil {
+			address = laddr.String()
+		}
+		if err := ctrlFn(fd.ctrlNetwork(), address, newRawConn(fd)); err != nil {
+			fd.Close()
+			return nil, err
+		}
+	}
 
 	// This function makes a network file descriptor for the
 	// following applications:
@@ -95,6 +107,24 @@ func socket(net string, family, sotype, proto int, ipv6only bool, laddr, raddr s
 	return fd, nil
 }
 
+// ctrlNetwork returns the network passed to a Control function:
+// the network of fd, with the address family made explicit for
+// internet sockets.
+func (fd *netFD) ctrlNetwork() string {
+	switch fd.net {
+	case "unix", "unixgram", "unixpacket":
+		return fd.net
+	}
+	switch fd.net[len(fd.net)-1] {
+	case '4', '6':
+		return fd.net
+	}
+	if fd.family == syscall.AF_INET {
+		return fd.net + "4"
+	}
+	return fd.net + "6"
+}
+
 func (fd *netFD) dial(laddr, raddr sockaddr, deadline time.Time, toAddr func(syscall.Sockaddr) Addr) error {
 	var err error
 	var lsa syscall.Sockaddr
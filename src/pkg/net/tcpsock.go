@@ -46,7 +46,7 @@ func ResolveTCPAddr(net, addr string) (*TCPAddr, error) {
 	default:
 		return nil, UnknownNetworkError(net)
 	}
-	a, err := resolveInternetAddr(net, addr, noDeadline)
+	a, err := DefaultResolver.resolveInternetAddr(net, addr, noDeadline)
 	if err != nil {
 		return nil, err
 	}
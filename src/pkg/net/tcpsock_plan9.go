@@ -76,6 +76,13 @@ func (c *TCPConn) SetKeepAlivePeriod(d time.Duration) error {
 	return setKeepAlivePeriod(c.fd, d)
 }
 
+// SyscallConn returns a raw network connection.
+// This implements the syscall.Conn interface.
+// It is not implemented on Plan 9.
+func (c *TCPConn) SyscallConn() (syscall.RawConn, error) {
+	return nil, syscall.EPLAN9
+}
+
 // SetNoDelay controls whether the operating system should delay
 // packet transmission in hopes of sending fewer packets (Nagle's
 // algorithm).  The default is true (no delay), meaning that data is
@@ -88,10 +95,10 @@ func (c *TCPConn) SetNoDelay(noDelay bool) error {
 // which must be "tcp", "tcp4", or "tcp6".  If laddr is not nil, it is
 // used as the local address for the connection.
 func DialTCP(net string, laddr, raddr *TCPAddr) (*TCPConn, error) {
-	return dialTCP(net, laddr, raddr, noDeadline)
+	return dialTCP(net, laddr, raddr, noDeadline, nil)
 }
 
-func dialTCP(net string, laddr, raddr *TCPAddr, deadline time.Time) (*TCPConn, error) {
+func dialTCP(net string, laddr, raddr *TCPAddr, deadline time.Time, _ func(string, string, syscall.RawConn) error) (*TCPConn, error) {
 	if !deadline.IsZero() {
 		panic("net.dialTCP: deadline not implemented on Plan 9")
 	}
@@ -195,3 +202,7 @@ func ListenTCP(net string, laddr *TCPAddr) (*TCPListener, error) {
 	}
 	return &TCPListener{fd}, nil
 }
+
+func listenTCP(net string, laddr *TCPAddr, _ func(string, string, syscall.RawConn) error) (*TCPListener, error) {
+	return ListenTCP(net, laddr)
+}
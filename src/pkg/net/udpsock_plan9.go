@@ -19,6 +19,13 @@ type UDPConn struct {
 
 func newUDPConn(fd *netFD) *UDPConn { return &UDPConn{conn{fd}} }
 
+// SyscallConn returns a raw network connection.
+// This implements the syscall.Conn interface.
+// It is not implemented on Plan 9.
+func (c *UDPConn) SyscallConn() (syscall.RawConn, error) {
+	return nil, syscall.EPLAN9
+}
+
 // ReadFromUDP reads a UDP packet from c, copying the payload into b.
 // It returns the number of bytes copied into b and the return address
 // that was on the packet.
@@ -112,10 +119,10 @@ func (c *UDPConn) WriteMsgUDP(b, oob []byte, addr *UDPAddr) (n, oobn int, err er
 // which must be "udp", "udp4", or "udp6".  If laddr is not nil, it is
 // used as the local address for the connection.
 func DialUDP(net string, laddr, raddr *UDPAddr) (*UDPConn, error) {
-	return dialUDP(net, laddr, raddr, noDeadline)
+	return dialUDP(net, laddr, raddr, noDeadline, nil)
 }
 
-func dialUDP(net string, laddr, raddr *UDPAddr, deadline time.Time) (*UDPConn, error) {
+func dialUDP(net string, laddr, raddr *UDPAddr, deadline time.Time, _ func(string, string, syscall.RawConn) error) (*UDPConn, error) {
 	if !deadline.IsZero() {
 		panic("net.dialUDP: deadline not implemented on Plan 9")
 	}
@@ -194,6 +201,10 @@ func ListenUDP(net string, laddr *UDPAddr) (*UDPConn, error) {
 	return newUDPConn(fd), err
 }
 
+func listenUDP(net string, laddr *UDPAddr, _ func(string, string, syscall.RawConn) error) (*UDPConn, error) {
+	return ListenUDP(net, laddr)
+}
+
 // ListenMulticastUDP listens for incoming multicast UDP packets
 // addressed to the group address gaddr on ifi, which specifies the
 // interface to join.  ListenMulticastUDP uses default multicast
@@ -16,6 +16,13 @@ type UnixConn struct {
 	conn
 }
 
+// SyscallConn returns a raw network connection.
+// This implements the syscall.Conn interface.
+// It is not implemented on Plan 9.
+func (c *UnixConn) SyscallConn() (syscall.RawConn, error) {
+	return nil, syscall.EPLAN9
+}
+
 // ReadFromUnix reads a packet from c, copying the payload into b.  It
 // returns the number of bytes copied into b and the source address of
 // the packet.
@@ -78,10 +85,10 @@ func (c *UnixConn) CloseWrite() error {
 // which must be "unix", "unixgram" or "unixpacket".  If laddr is not
 // nil, it is used as the local address for the connection.
 func DialUnix(net string, laddr, raddr *UnixAddr) (*UnixConn, error) {
-	return dialUnix(net, laddr, raddr, noDeadline)
+	return dialUnix(net, laddr, raddr, noDeadline, nil)
 }
 
-func dialUnix(net string, laddr, raddr *UnixAddr, deadline time.Time) (*UnixConn, error) {
+func dialUnix(net string, laddr, raddr *UnixAddr, deadline time.Time, _ func(string, string, syscall.RawConn) error) (*UnixConn, error) {
 	return nil, syscall.EPLAN9
 }
 
@@ -96,6 +103,10 @@ func ListenUnix(net string, laddr *UnixAddr) (*UnixListener, error) {
 	return nil, syscall.EPLAN9
 }
 
+func listenUnix(net string, laddr *UnixAddr, _ func(string, string, syscall.RawConn) error) (*UnixListener, error) {
+	return ListenUnix(net, laddr)
+}
+
 // AcceptUnix accepts the next incoming call and returns the new
 // connection.
 func (l *UnixListener) AcceptUnix() (*UnixConn, error) {
@@ -141,3 +152,7 @@ func (l *UnixListener) File() (*os.File, error) {
 func ListenUnixgram(net string, laddr *UnixAddr) (*UnixConn, error) {
 	return nil, syscall.EPLAN9
 }
+
+func listenUnixgram(net string, laddr *UnixAddr, _ func(string, string, syscall.RawConn) error) (*UnixConn, error) {
+	return ListenUnixgram(net, laddr)
+}
@@ -13,7 +13,7 @@ import (
 	"time"
 )
 
-func unixSocket(net string, laddr, raddr sockaddr, mode string, deadline time.Time) (*netFD, error) {
+func unixSocket(net string, laddr, raddr sockaddr, mode string, deadline time.Time, ctrlFn func(string, string, syscall.RawConn) error) (*netFD, error) {
 	var sotype int
 	switch net {
 	case "unix":
@@ -49,7 +49,7 @@ func unixSocket(net string, laddr, raddr sockaddr, mode string, deadline time.Ti
 		f = sockaddrToUnixpacket
 	}
 
-	fd, err := socket(net, syscall.AF_UNIX, sotype, 0, false, laddr, raddr, deadline, f)
+	fd, err := socket(net, syscall.AF_UNIX, sotype, 0, false, laddr, raddr, deadline, f, ctrlFn)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +113,15 @@ type UnixConn struct {
 
 func newUnixConn(fd *netFD) *UnixConn { return &UnixConn{conn{fd}} }
 
+// SyscallConn returns a raw network connection.
+// This implements the syscall.Conn interface.
+func (c *UnixConn) SyscallConn() (syscall.RawConn, error) {
+	if !c.ok() {
+		return nil, syscall.EINVAL
+	}
+	return newRawConn(c.fd), nil
+}
+
 // ReadFromUnix reads a packet from c, copying the payload into b.  It
 // returns the number of bytes copied into b and the source address of
 // the packet.
@@ -243,11 +252,11 @@ func DialUnix(net string, laddr, raddr *UnixAddr) (*UnixConn, error) {
 	default:
 		return nil, &OpError{Op: "dial", Net: net, Addr: raddr, Err: UnknownNetworkError(net)}
 	}
-	return dialUnix(net, laddr, raddr, noDeadline)
+	return dialUnix(net, laddr, raddr, noDeadline, nil)
 }
 
-func dialUnix(net string, laddr, raddr *UnixAddr, deadline time.Time) (*UnixConn, error) {
-	fd, err := unixSocket(net, laddr, raddr, "dial", deadline)
+func dialUnix(net string, laddr, raddr *UnixAddr, deadline time.Time, ctrlFn func(string, string, syscall.RawConn) error) (*UnixConn, error) {
+	fd, err := unixSocket(net, laddr, raddr, "dial", deadline, ctrlFn)
 	if err != nil {
 		return nil, &OpError{Op: "dial", Net: net, Addr: raddr, Err: err}
 	}
@@ -265,6 +274,10 @@ type UnixListener struct {
 // ListenUnix announces on the Unix domain socket laddr and returns a
 // Unix listener.  The network net must be "unix" or "unixpacket".
 func ListenUnix(net string, laddr *UnixAddr) (*UnixListener, error) {
+	return listenUnix(net, laddr, nil)
+}
+
+func listenUnix(net string, laddr *UnixAddr, ctrlFn func(string, string, syscall.RawConn) error) (*UnixListener, error) {
 	switch net {
 	case "unix", "unixpacket":
 	default:
@@ -273,7 +286,7 @@ func ListenUnix(net string, laddr *UnixAddr) (*UnixListener, error) {
 	if laddr == nil {
 		return nil, &OpError{Op: "listen", Net: net, Addr: nil, Err: errMissingAddress}
 	}
-	fd, err := unixSocket(net, laddr, nil, "listen", noDeadline)
+	fd, err := unixSocket(net, laddr, nil, "listen", noDeadline, ctrlFn)
 	if err != nil {
 		return nil, &OpError{Op: "listen", Net: net, Addr: laddr, Err: err}
 	}
@@ -357,6 +370,10 @@ func (l *UnixListener) File() (f *os.File, err error) { return l.fd.dup() }
 // The returned connection's ReadFrom and WriteTo methods can be used
 // to receive and send packets with per-packet addressing.
 func ListenUnixgram(net string, laddr *UnixAddr) (*UnixConn, error) {
+	return listenUnixgram(net, laddr, nil)
+}
+
+func listenUnixgram(net string, laddr *UnixAddr, ctrlFn func(string, string, syscall.RawConn) error) (*UnixConn, error) {
 	switch net {
 	case "unixgram":
 	default:
@@ -365,7 +382,7 @@ func ListenUnixgram(net string, laddr *UnixAddr) (*UnixConn, error) {
 	if laddr == nil {
 		return nil, &OpError{Op: "listen", Net: net, Addr: nil, Err: errMissingAddress}
 	}
-	fd, err := unixSocket(net, laddr, nil, "listen", noDeadline)
+	fd, err := unixSocket(net, laddr, nil, "listen", noDeadline, ctrlFn)
 	if err != nil {
 		return nil, &OpError{Op: "listen", Net: net, Addr: laddr, Err: err}
 	}
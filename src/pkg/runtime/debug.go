@@ -140,6 +140,33 @@ func CPUProfile() []byte
 // SetCPUProfileRate directly.
 func SetCPUProfileRate(hz int)
 
+// StartTrace enables tracing for the current process.
+// While tracing, the data will be buffered and available via ReadTrace.
+// StartTrace returns an error if tracing is already enabled.
+//
+// Most clients should use the runtime/trace package or
+// the testing package's -test.trace flag instead of calling
+// StartTrace directly.
+func StartTrace() error {
+	if !starttrace() {
+		return errorString("tracing is already enabled")
+	}
+	return nil
+}
+
+func starttrace() bool
+
+// StopTrace stops tracing, if it was previously enabled.
+// StopTrace only returns after all the reads for the trace have completed.
+func StopTrace()
+
+// ReadTrace returns the next chunk of binary tracing data, blocking until data
+// is available.  If tracing is turned off and all the data accumulated while it
+// was on has been returned, ReadTrace returns nil.  The caller must copy the
+// returned data before calling ReadTrace again.
+// ReadTrace must be called from one goroutine at a time.
+func ReadTrace() []byte
+
 // SetBlockProfileRate controls the fraction of goroutine blocking events
 // that are reported in the blocking profile.  The profiler aims to sample
 // an average of one blocking event per rate nanoseconds spent blocked.
@@ -165,6 +192,27 @@ type BlockProfileRecord struct {
 // of calling BlockProfile directly.
 func BlockProfile(p []BlockProfileRecord) (n int, ok bool)
 
+// SetMutexProfileFraction controls the fraction of mutex contention events
+// that are reported in the mutex profile.  On average 1/rate events are
+// reported.  The previous rate is returned.
+//
+// To turn off profiling entirely, pass rate 0.
+// To just read the current rate, pass rate < 0.
+// (For n>1 the details of sampling may change.)
+func SetMutexProfileFraction(rate int) int
+
+// MutexProfile returns n, the number of records in the current mutex profile.
+// If len(p) >= n, MutexProfile copies the profile into p and returns n, true.
+// Otherwise, MutexProfile does not change p, and returns n, false.
+//
+// Each record describes the time goroutines spent waiting for a contended
+// sync.Mutex or sync.RWMutex, attributed to the stack of the goroutine that
+// unlocked it.
+//
+// Most clients should use the runtime/pprof package
+// instead of calling MutexProfile directly.
+func MutexProfile(p []BlockProfileRecord) (n int, ok bool)
+
 // Stack formats a stack trace of the calling goroutine into buf
 // and returns the number of bytes written to buf.
 // If all is true, Stack formats stack traces of all other goroutines
@@ -464,6 +464,7 @@ struct MCentral
 	MSpan nonempty;	// list of spans with a free object
 	MSpan empty;	// list of spans with no free objects (or cached in an MCache)
 	int32 nfree;	// # of objects available in nonempty spans
+	uint64 nmalloc;	// # of objects handed to MCaches less those returned unused
 };
 
 void	runtime·MCentral_Init(MCentral *c, int32 sizeclass);
@@ -517,6 +518,8 @@ struct MHeap
 	Lock speciallock; // lock for sepcial record allocators.
 
 	// Malloc stats.
+	uint64 largealloc;	// bytes allocated for large objects (>MaxSmallSize)
+	uint64 nlargealloc;	// number of allocations of large objects (>MaxSmallSize)
 	uint64 largefree;	// bytes freed for large objects (>MaxSmallSize)
 	uint64 nlargefree;	// number of frees for large objects (>MaxSmallSize)
 	uint64 nsmallfree[NumSizeClasses];	// number of frees for small objects (<=MaxSmallSize)
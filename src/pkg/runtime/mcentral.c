@@ -95,6 +95,10 @@ havespan:
 	if(s->freelist == nil)
 		runtime·throw("freelist empty");
 	c->nfree -= n;
+	// Count the free objects as allocated now, so that the
+	// allocation counts can be read without flushing the MCaches.
+	// MCentral_UncacheSpan takes back the ones left unused.
+	c->nmalloc += n;
 	runtime·MSpanList_Remove(s);
 	runtime·MSpanList_InsertBack(&c->empty, s);
 	s->incache = true;
@@ -113,6 +117,11 @@ runtime·MCentral_UncacheSpan(MCentral *c, MSpan *s)
 
 	s->incache = false;
 
+	// The objects still on the freelist were never allocated.
+	// Objects on freebuf were allocated and then freed.
+	cap = (s->npages << PageShift) / s->elemsize;
+	c->nmalloc -= cap - s->ref;
+
 	// Move any explicitly freed items from the freebuf to the freelist.
 	while((v = s->freebuf) != nil) {
 		s->freebuf = v->next;
@@ -127,8 +136,7 @@ runtime·MCentral_UncacheSpan(MCentral *c, MSpan *s)
 		MCentral_ReturnToHeap(c, s); // unlocks c
 		return;
 	}
-	
-	cap = (s->npages << PageShift) / s->elemsize;
+
 	n = cap - s->ref;
 	if(n > 0) {
 		c->nfree += n;
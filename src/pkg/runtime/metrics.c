@@ -0,0 +1,97 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Support for package runtime/metrics.
+//
+// Everything here is read without stopping the world.  Allocations
+// of small objects are counted in the MCentrals when a span is handed
+// to an MCache, and frees in the MHeap when the MCaches flush their
+// statistics, which they do during each GC cycle.  The latency
+// histograms are updated atomically.
+
+#include "runtime.h"
+#include "arch_GOARCH.h"
+#include "malloc.h"
+
+TimeHist runtime·schedlatency;
+TimeHist runtime·gcpauses;
+
+// Record ns in h.  Bucket 0 holds durations below 2^10 ns, bucket b
+// those in [2^(9+b), 2^(10+b)) ns, and the last bucket everything
+// longer.  Package runtime/metrics knows this layout.
+void
+runtime·timehistrecord(TimeHist *h, int64 ns)
+{
+	int32 b;
+
+	b = 0;
+	if(ns >= (1<<10)) {
+		b = 1;
+		for(ns >>= 10; ns > 1 && b < TimeHistBuckets-1; ns >>= 1)
+			b++;
+	}
+	runtime·xadd64(&h->counts[b], 1);
+}
+
+static uint64*
+readtimehist(uint64 *p, TimeHist *h)
+{
+	int32 i;
+
+	for(i=0; i<TimeHistBuckets; i++)
+		*p++ = runtime·atomicload64(&h->counts[i]);
+	return p;
+}
+
+// Pass back: the number of size classes n, then n triples of
+// object size, allocations and frees, the large objects taking the
+// place of size class 0; then bytes allocated and freed for large
+// objects, the number of GC cycles and of goroutines; then the number
+// of histogram buckets b followed by the b buckets of the scheduler
+// latency and GC pause histograms.
+void
+runtime∕metrics·readMetrics(Slice *buf)
+{
+	uint64 *p;
+	MCentral *c;
+	int32 i;
+
+	// Calling code in runtime/metrics should make the slice large enough.
+	if(buf->cap < 1+3*NumSizeClasses+5+2*TimeHistBuckets)
+		runtime·throw("runtime: short slice passed to readMetrics");
+
+	p = (uint64*)buf->array;
+	p[0] = NumSizeClasses;
+	runtime·lock(&runtime·mheap);
+	p[1] = 0;
+	p[2] = runtime·mheap.nlargealloc;
+	p[3] = runtime·mheap.nlargefree;
+	for(i=1; i<NumSizeClasses; i++) {
+		p[1+3*i] = runtime·class_to_size[i];
+		p[3+3*i] = runtime·mheap.nsmallfree[i];
+	}
+	p += 1+3*NumSizeClasses;
+	p[0] = runtime·mheap.largealloc;
+	p[1] = runtime·mheap.largefree;
+	p[2] = mstats.numgc;
+	runtime·unlock(&runtime·mheap);
+
+	// Read the allocations after the frees, so that no class
+	// appears to have freed more objects than it allocated.
+	for(i=1; i<NumSizeClasses; i++) {
+		c = &runtime·mheap.central[i];
+		runtime·lock(c);
+		((uint64*)buf->array)[2+3*i] = c->nmalloc;
+		runtime·unlock(c);
+	}
+
+	// gcount takes allglock, which must not be acquired
+	// while holding the heap lock.
+	p[3] = runtime·gcount();
+	p[4] = TimeHistBuckets;
+	p += 5;
+	p = readtimehist(p, &runtime·schedlatency);
+	p = readtimehist(p, &runtime·gcpauses);
+	buf->len = p - (uint64*)buf->array;
+}
@@ -0,0 +1,119 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package metrics provides a stable interface to metrics exported by
+// the Go runtime.
+//
+// Unlike runtime.ReadMemStats, reading metrics does not stop the
+// world, so it is cheap enough to do often, for example from a
+// monitoring endpoint.  The price is that some metrics lag behind:
+// see the descriptions returned by All.
+//
+// Metrics are identified by a name of the form
+//
+//	/category/name:unit
+//
+// where the unit says how to interpret the value.  The set of
+// metrics may grow between releases, so programs should discover
+// the supported metrics with All rather than hard-code them.
+// Reading a metric that is not supported yields a value of kind
+// KindBad.
+package metrics
+
+// Description describes a runtime metric.
+type Description struct {
+	// Name is the full name of the metric, including its unit.
+	Name string
+
+	// Description is an English language sentence describing
+	// the metric.
+	Description string
+
+	// Kind is the kind of value of the metric.
+	Kind ValueKind
+
+	// Cumulative is whether the metric only ever increases,
+	// so that rates may be computed from the difference of
+	// two samples.
+	Cumulative bool
+}
+
+var allDesc = []Description{
+	{
+		Name:        "/gc/cycles/total:gc-cycles",
+		Description: "Count of completed GC cycles.",
+		Kind:        KindUint64,
+		Cumulative:  true,
+	},
+	{
+		Name: "/gc/heap/allocs-by-size:bytes",
+		Description: "Distribution of heap allocations by size class. " +
+			"Small objects are counted when the runtime hands a span of them to a per-P cache, " +
+			"so the counts may run ahead of the program by up to a span per size class and P.",
+		Kind:       KindFloat64Histogram,
+		Cumulative: true,
+	},
+	{
+		Name:        "/gc/heap/allocs:bytes",
+		Description: "Cumulative sum of memory allocated to the heap, counted as for /gc/heap/allocs-by-size:bytes.",
+		Kind:        KindUint64,
+		Cumulative:  true,
+	},
+	{
+		Name:        "/gc/heap/allocs:objects",
+		Description: "Cumulative count of heap allocations, counted as for /gc/heap/allocs-by-size:bytes.",
+		Kind:        KindUint64,
+		Cumulative:  true,
+	},
+	{
+		Name: "/gc/heap/frees-by-size:bytes",
+		Description: "Distribution of freed heap objects by size class. " +
+			"Frees are counted when the per-P caches flush their statistics during each GC cycle, " +
+			"so the frees found by sweeping lag behind by up to a cycle.",
+		Kind:       KindFloat64Histogram,
+		Cumulative: true,
+	},
+	{
+		Name:        "/gc/heap/frees:bytes",
+		Description: "Cumulative sum of heap memory freed, counted as for /gc/heap/frees-by-size:bytes.",
+		Kind:        KindUint64,
+		Cumulative:  true,
+	},
+	{
+		Name:        "/gc/heap/frees:objects",
+		Description: "Cumulative count of freed heap objects, counted as for /gc/heap/frees-by-size:bytes.",
+		Kind:        KindUint64,
+		Cumulative:  true,
+	},
+	{
+		Name:        "/gc/heap/objects:objects",
+		Description: "Number of objects, live or unswept, occupying heap memory.",
+		Kind:        KindUint64,
+	},
+	{
+		Name:        "/gc/pauses:seconds",
+		Description: "Distribution of individual GC-related stop-the-world pause latencies.",
+		Kind:        KindFloat64Histogram,
+		Cumulative:  true,
+	},
+	{
+		Name:        "/sched/goroutines:goroutines",
+		Description: "Count of live goroutines.",
+		Kind:        KindUint64,
+	},
+	{
+		Name:        "/sched/latencies:seconds",
+		Description: "Distribution of the time goroutines have spent in a run queue before starting to run.",
+		Kind:        KindFloat64Histogram,
+		Cumulative:  true,
+	},
+}
+
+// All returns a slice containing the descriptions of all supported
+// metrics, sorted by name.
+func All() []Description {
+	d := make([]Description, len(allDesc))
+	copy(d, allDesc)
+	return d
+}
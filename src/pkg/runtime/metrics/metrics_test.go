@@ -0,0 +1,155 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func readAll() map[string]Value {
+	descs := All()
+	samples := make([]Sample, len(descs))
+	for i, d := range descs {
+		samples[i].Name = d.Name
+	}
+	Read(samples)
+	m := make(map[string]Value)
+	for _, s := range samples {
+		m[s.Name] = s.Value
+	}
+	return m
+}
+
+func TestAll(t *testing.T) {
+	descs := All()
+	if !sort.IsSorted(byName(descs)) {
+		t.Errorf("All is not sorted by name")
+	}
+	for i, d := range descs {
+		if i > 0 && descs[i-1].Name == d.Name {
+			t.Errorf("duplicate metric %s", d.Name)
+		}
+		if d.Name[0] != '/' || !strings.Contains(d.Name, ":") {
+			t.Errorf("malformed metric name %q", d.Name)
+		}
+		if d.Description == "" {
+			t.Errorf("%s: no description", d.Name)
+		}
+	}
+}
+
+type byName []Description
+
+func (x byName) Len() int           { return len(x) }
+func (x byName) Less(i, j int) bool { return x[i].Name < x[j].Name }
+func (x byName) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+func TestReadKinds(t *testing.T) {
+	m := readAll()
+	for _, d := range All() {
+		v := m[d.Name]
+		if v.Kind() != d.Kind {
+			t.Errorf("%s: kind %d, want %d", d.Name, v.Kind(), d.Kind)
+			continue
+		}
+		if v.Kind() != KindFloat64Histogram {
+			continue
+		}
+		h := v.Float64Histogram()
+		if len(h.Buckets) != len(h.Counts)+1 {
+			t.Errorf("%s: %d buckets for %d counts", d.Name, len(h.Buckets), len(h.Counts))
+			continue
+		}
+		for i := 1; i < len(h.Buckets); i++ {
+			if h.Buckets[i] <= h.Buckets[i-1] {
+				t.Errorf("%s: bucket boundaries not increasing: %v", d.Name, h.Buckets)
+				break
+			}
+		}
+		if !math.IsInf(h.Buckets[len(h.Buckets)-1], +1) {
+			t.Errorf("%s: last bucket is bounded", d.Name)
+		}
+	}
+
+	s := []Sample{{Name: "/no/such/metric:bytes"}}
+	Read(s)
+	if s[0].Value.Kind() != KindBad {
+		t.Errorf("unknown metric has kind %d, want KindBad", s[0].Value.Kind())
+	}
+}
+
+var sink []byte
+
+func TestReadValues(t *testing.T) {
+	before := readAll()
+	for i := 0; i < 1000; i++ {
+		sink = make([]byte, 64)
+	}
+	sink = make([]byte, 1<<20)
+	done := make(chan bool)
+	for i := 0; i < 10; i++ {
+		go func() {
+			<-done
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	// The frees found by one collection are flushed by the next.
+	runtime.GC()
+	runtime.GC()
+	after := readAll()
+	close(done)
+
+	// Small objects are counted a span at a time.
+	if d := after["/gc/heap/allocs:objects"].Uint64() - before["/gc/heap/allocs:objects"].Uint64(); d < 500 {
+		t.Errorf("allocated objects increased by %d, want at least 500", d)
+	}
+	if d := after["/gc/heap/allocs:bytes"].Uint64() - before["/gc/heap/allocs:bytes"].Uint64(); d < 1<<20 {
+		t.Errorf("allocated bytes increased by %d, want at least %d", d, 1<<20)
+	}
+	if n := after["/gc/cycles/total:gc-cycles"].Uint64(); n <= before["/gc/cycles/total:gc-cycles"].Uint64() {
+		t.Errorf("GC cycles did not increase after runtime.GC")
+	}
+	if n := after["/sched/goroutines:goroutines"].Uint64(); n < 11 {
+		t.Errorf("goroutines = %d, want at least 11", n)
+	}
+
+	allocs := after["/gc/heap/allocs-by-size:bytes"].Float64Histogram()
+	if n := sum(allocs.Counts); n != after["/gc/heap/allocs:objects"].Uint64() {
+		t.Errorf("allocation histogram holds %d objects, want %d", n, after["/gc/heap/allocs:objects"].Uint64())
+	}
+	if allocs.Counts[len(allocs.Counts)-1] == 0 {
+		t.Errorf("no large allocations counted")
+	}
+	if after["/gc/heap/frees:objects"].Uint64() == 0 {
+		t.Errorf("no frees counted after runtime.GC")
+	}
+
+	pauses := after["/gc/pauses:seconds"].Float64Histogram()
+	// A collection may finish between reading the cycle count
+	// and reading the histogram.
+	if n := sum(pauses.Counts); n < after["/gc/cycles/total:gc-cycles"].Uint64() {
+		t.Errorf("pause histogram holds %d pauses, want one per GC cycle", n)
+	}
+	latencies := after["/sched/latencies:seconds"].Float64Histogram()
+	if sum(latencies.Counts) <= sum(before["/sched/latencies:seconds"].Float64Histogram().Counts) {
+		t.Errorf("scheduler latency histogram did not grow after starting goroutines")
+	}
+}
+
+func TestTimeHist(t *testing.T) {
+	h := timeHist([]uint64{1, 2, 3})
+	want := []float64{0, 1024e-9, 2048e-9, math.Inf(+1)}
+	for i, b := range want {
+		if h.Buckets[i] != b {
+			t.Errorf("timeHist buckets = %v, want %v", h.Buckets, want)
+			break
+		}
+	}
+}
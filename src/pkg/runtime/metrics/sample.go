@@ -0,0 +1,226 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import "math"
+
+// ValueKind is a tag for a metric Value which indicates its type.
+type ValueKind int
+
+const (
+	// KindBad indicates that the Value has no type and should not
+	// be used.  Read returns it for unsupported metrics.
+	KindBad ValueKind = iota
+
+	// KindUint64 indicates that the type of the Value is a uint64.
+	KindUint64
+
+	// KindFloat64Histogram indicates that the type of the Value
+	// is a *Float64Histogram.
+	KindFloat64Histogram
+)
+
+// Value represents a metric value returned by the runtime.
+type Value struct {
+	kind   ValueKind
+	scalar uint64
+	hist   *Float64Histogram
+}
+
+// Kind returns the tag representing the kind of value this is.
+func (v Value) Kind() ValueKind {
+	return v.kind
+}
+
+// Uint64 returns the internal uint64 value for the metric.
+// It panics if v.Kind() is not KindUint64.
+func (v Value) Uint64() uint64 {
+	if v.kind != KindUint64 {
+		panic("called Uint64 on non-uint64 metric value")
+	}
+	return v.scalar
+}
+
+// Float64Histogram returns the internal *Float64Histogram value
+// for the metric.  It panics if v.Kind() is not KindFloat64Histogram.
+func (v Value) Float64Histogram() *Float64Histogram {
+	if v.kind != KindFloat64Histogram {
+		panic("called Float64Histogram on non-Float64Histogram metric value")
+	}
+	return v.hist
+}
+
+// Float64Histogram represents a distribution of float64 values.
+type Float64Histogram struct {
+	// Counts contains the number of values in each bucket.
+	Counts []uint64
+
+	// Buckets contains the boundaries of the buckets, in
+	// increasing order.  Bucket i holds the values v with
+	// Buckets[i] <= v < Buckets[i+1], so len(Buckets) is
+	// len(Counts)+1.  The last boundary may be +Inf.
+	Buckets []float64
+}
+
+// Sample captures a single metric sample.
+type Sample struct {
+	// Name is the name of the metric sampled.
+	// It must correspond to a name in one of the
+	// descriptions returned by All.
+	Name string
+
+	// Value is the value of the metric sample.
+	Value Value
+}
+
+// Implemented in package runtime.
+func readMetrics(*[]uint64)
+
+// Limits on the layout of the buffer filled by readMetrics,
+// which reports the actual number of size classes and of
+// histogram buckets.
+const (
+	maxSizeClasses     = 128
+	maxTimeHistBuckets = 64
+	bufLen             = 1 + 3*maxSizeClasses + 5 + 2*maxTimeHistBuckets
+)
+
+// stats is a snapshot of the runtime's statistics.
+type stats struct {
+	sizes  []uint64 // object size of each size class; 0 for large objects
+	allocs []uint64 // allocations in each size class
+	frees  []uint64 // frees in each size class
+
+	largeAllocBytes uint64
+	largeFreeBytes  uint64
+	numGC           uint64
+	goroutines      uint64
+
+	schedLatency []uint64
+	gcPauses     []uint64
+}
+
+func readStats() *stats {
+	buf := make([]uint64, 0, bufLen)
+	readMetrics(&buf)
+
+	// See readMetrics in runtime/metrics.c for the layout.
+	s := new(stats)
+	n := int(buf[0])
+	classes := buf[1 : 1+3*n]
+	s.sizes = make([]uint64, n)
+	s.allocs = make([]uint64, n)
+	s.frees = make([]uint64, n)
+	for i := 0; i < n; i++ {
+		s.sizes[i] = classes[3*i]
+		s.allocs[i] = classes[3*i+1]
+		s.frees[i] = classes[3*i+2]
+	}
+	buf = buf[1+3*n:]
+	s.largeAllocBytes = buf[0]
+	s.largeFreeBytes = buf[1]
+	s.numGC = buf[2]
+	s.goroutines = buf[3]
+	b := int(buf[4])
+	buf = buf[5:]
+	s.schedLatency = buf[:b]
+	s.gcPauses = buf[b : 2*b]
+	return s
+}
+
+// sizeHist returns the histogram of counts by size class.
+// The runtime reports large objects in place of size class 0;
+// they go in a last bucket with no upper bound.
+func (s *stats) sizeHist(counts []uint64) *Float64Histogram {
+	n := len(s.sizes)
+	h := &Float64Histogram{
+		Counts:  make([]uint64, n),
+		Buckets: make([]float64, n+1),
+	}
+	copy(h.Counts, counts[1:])
+	h.Counts[n-1] = counts[0]
+	for i := 1; i < n; i++ {
+		h.Buckets[i] = float64(s.sizes[i] + 1)
+	}
+	h.Buckets[n] = math.Inf(+1)
+	return h
+}
+
+// bytes returns the number of bytes of counts objects,
+// given the number of bytes of the large ones.
+func (s *stats) bytes(counts []uint64, large uint64) uint64 {
+	for i := 1; i < len(s.sizes); i++ {
+		large += counts[i] * s.sizes[i]
+	}
+	return large
+}
+
+// timeHist returns the histogram of the durations counted in
+// the buckets of a runtime latency histogram.  Bucket 0 holds
+// durations below 2^10 ns, bucket i those below 2^(10+i) ns,
+// and the last bucket all the longer ones.
+func timeHist(counts []uint64) *Float64Histogram {
+	h := &Float64Histogram{
+		Counts:  make([]uint64, len(counts)),
+		Buckets: make([]float64, len(counts)+1),
+	}
+	copy(h.Counts, counts)
+	for i := 1; i < len(counts); i++ {
+		h.Buckets[i] = float64(uint64(1)<<uint(9+i)) / 1e9
+	}
+	h.Buckets[len(counts)] = math.Inf(+1)
+	return h
+}
+
+func sum(counts []uint64) uint64 {
+	var n uint64
+	for _, c := range counts {
+		n += c
+	}
+	return n
+}
+
+// Read populates each Value field in the given slice of metric
+// samples.
+//
+// Desired metrics should be present in the slice with the
+// appropriate name.  Metrics not known to this version of the
+// runtime are given a value of kind KindBad.
+//
+// All the samples are taken from a single snapshot of the runtime's
+// statistics, which Read takes without stopping the world.
+func Read(m []Sample) {
+	s := readStats()
+	for i := range m {
+		v := &m[i].Value
+		*v = Value{kind: KindUint64}
+		switch m[i].Name {
+		case "/gc/cycles/total:gc-cycles":
+			v.scalar = s.numGC
+		case "/gc/heap/allocs-by-size:bytes":
+			*v = Value{kind: KindFloat64Histogram, hist: s.sizeHist(s.allocs)}
+		case "/gc/heap/allocs:bytes":
+			v.scalar = s.bytes(s.allocs, s.largeAllocBytes)
+		case "/gc/heap/allocs:objects":
+			v.scalar = sum(s.allocs)
+		case "/gc/heap/frees-by-size:bytes":
+			*v = Value{kind: KindFloat64Histogram, hist: s.sizeHist(s.frees)}
+		case "/gc/heap/frees:bytes":
+			v.scalar = s.bytes(s.frees, s.largeFreeBytes)
+		case "/gc/heap/frees:objects":
+			v.scalar = sum(s.frees)
+		case "/gc/heap/objects:objects":
+			v.scalar = sum(s.allocs) - sum(s.frees)
+		case "/gc/pauses:seconds":
+			*v = Value{kind: KindFloat64Histogram, hist: timeHist(s.gcPauses)}
+		case "/sched/goroutines:goroutines":
+			v.scalar = s.goroutines
+		case "/sched/latencies:seconds":
+			*v = Value{kind: KindFloat64Histogram, hist: timeHist(s.schedLatency)}
+		default:
+			*v = Value{}
+		}
+	}
+}
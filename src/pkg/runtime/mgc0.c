@@ -1979,10 +1979,14 @@ bgsweep(void)
 {
 	g->issystem = 1;
 	for(;;) {
+		if(runtime·traceenabled)
+			runtime·tracegcsweepstart();
 		while(runtime·sweepone() != -1) {
 			gcstats.nbgsweep++;
 			runtime·gosched();
 		}
+		if(runtime·traceenabled)
+			runtime·tracegcsweepdone();
 		runtime·lock(&gclock);
 		if(!runtime·mheap.sweepdone) {
 			// It's possible if GC has happened between sweepone has
@@ -1991,7 +1995,7 @@ bgsweep(void)
 			continue;
 		}
 		sweep.parked = true;
-		runtime·parkunlock(&gclock, "GC sweep wait");
+		runtime·parkunlock(&gclock, "GC sweep wait", TraceEvGoBlock);
 	}
 }
 
@@ -2304,6 +2308,8 @@ runtime·gc(int32 force)
 	}
 
 	// Ok, we're doing it!  Stop everybody else
+	if(runtime·traceenabled)
+		runtime·tracegcstart();
 	a.start_time = runtime·nanotime();
 	m->gcing = 1;
 	runtime·stoptheworld();
@@ -2331,6 +2337,8 @@ runtime·gc(int32 force)
 	runtime·semrelease(&runtime·worldsema);
 	runtime·starttheworld();
 	m->locks--;
+	if(runtime·traceenabled)
+		runtime·tracegcdone();
 
 	// now that gc is done, kick off finalizer thread if needed
 	if(!ConcurrentSweep) {
@@ -2383,8 +2391,12 @@ gc(struct gc_args *args)
 		t1 = runtime·nanotime();
 
 	// Sweep what is not sweeped by bgsweep.
+	if(runtime·traceenabled)
+		runtime·tracegcsweepstart();
 	while(runtime·sweepone() != -1)
 		gcstats.npausesweep++;
+	if(runtime·traceenabled)
+		runtime·tracegcsweepdone();
 
 	work.nwait = 0;
 	work.ndone = 0;
@@ -2418,11 +2430,16 @@ gc(struct gc_args *args)
 	// conservatively set next_gc to high value assuming that everything is live
 	// concurrent/lazy sweep will reduce this number while discovering new garbage
 	mstats.next_gc = mstats.heap_alloc+mstats.heap_alloc*gcpercent/100;
+	if(runtime·traceenabled) {
+		runtime·traceheapalloc();
+		runtime·tracenextgc();
+	}
 
 	t4 = runtime·nanotime();
 	mstats.last_gc = t4;
 	mstats.pause_ns[mstats.numgc%nelem(mstats.pause_ns)] = t4 - t0;
 	mstats.pause_total_ns += t4 - t0;
+	runtime·timehistrecord(&runtime·gcpauses, t4 - t0);
 	mstats.numgc++;
 	if(mstats.debuggc)
 		runtime·printf("pause %D\n", t4-t0);
@@ -2505,8 +2522,12 @@ gc(struct gc_args *args)
 		runtime·unlock(&gclock);
 	} else {
 		// Sweep all spans eagerly.
+		if(runtime·traceenabled)
+			runtime·tracegcsweepstart();
 		while(runtime·sweepone() != -1)
 			gcstats.npausesweep++;
+		if(runtime·traceenabled)
+			runtime·tracegcsweepdone();
 	}
 
 	// Shrink a stack if not much of it is being used.
@@ -2636,7 +2657,7 @@ runfinq(void)
 		finq = nil;
 		if(fb == nil) {
 			runtime·fingwait = true;
-			runtime·parkunlock(&finlock, "finalizer wait");
+			runtime·parkunlock(&finlock, "finalizer wait", TraceEvGoBlock);
 			continue;
 		}
 		runtime·unlock(&finlock);
@@ -0,0 +1,398 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Export guts for testing.
+
+package pprof
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+var (
+	LabelSetID = labelSetID
+	LabelSetOf = labelSet
+	WriteGzip  = writeGzip
+)
+
+// A ValueType is a valueType with exported fields.
+type ValueType struct {
+	Type, Unit string
+}
+
+func valueTypes(types []ValueType) []valueType {
+	var v []valueType
+	for _, t := range types {
+		v = append(v, valueType{t.Type, t.Unit})
+	}
+	return v
+}
+
+// A ProfileBuilder wraps a profileBuilder.
+type ProfileBuilder struct {
+	b *profileBuilder
+}
+
+func NewProfileBuilder(types []ValueType, periodType ValueType, period int64) *ProfileBuilder {
+	return &ProfileBuilder{newProfileBuilder(valueTypes(types), valueType{periodType.Type, periodType.Unit}, period)}
+}
+
+func (b *ProfileBuilder) AddSample(stk []uintptr, values []int64, labels LabelSet) {
+	b.b.addSample(stk, values, labels)
+}
+
+func (b *ProfileBuilder) Build(w io.Writer) error {
+	return b.b.build(w)
+}
+
+// A Protobuf wraps a protobuf.
+type Protobuf struct {
+	b protobuf
+}
+
+func (b *Protobuf) Uint64(tag int, x uint64)      { b.b.uint64(tag, x) }
+func (b *Protobuf) Uint64s(tag int, x []uint64)   { b.b.uint64s(tag, x) }
+func (b *Protobuf) Int64(tag int, x int64)        { b.b.int64(tag, x) }
+func (b *Protobuf) String(tag int, x string)      { b.b.string(tag, x) }
+func (b *Protobuf) StartMessage() int             { return int(b.b.startMessage()) }
+func (b *Protobuf) EndMessage(tag int, start int) { b.b.endMessage(tag, msgOffset(start)) }
+func (b *Protobuf) Data() []byte                  { return b.b.data }
+func (b *Protobuf) Nest() int                     { return b.b.nest }
+
+// A MemMap is a memMap with exported fields.
+type MemMap struct {
+	Start, End uintptr
+	Offset     uint64
+	File       string
+}
+
+func ParseProcSelfMaps(data []byte) []MemMap {
+	var mm []MemMap
+	for _, m := range parseProcSelfMaps(data) {
+		mm = append(mm, MemMap{m.start, m.end, m.offset, m.file})
+	}
+	return mm
+}
+
+// ParseCPUProfile decodes the CPU profile in data and calls f with
+// the count, stack and labels of each sample.
+func ParseCPUProfile(data []byte, f func(count uintptr, stk []uintptr, labels map[string]string)) error {
+	p, err := DecodeProfile(data)
+	if err != nil {
+		return err
+	}
+	if len(p.SampleTypes) != 2 || p.SampleTypes[0] != (ValueType{"samples", "count"}) || p.SampleTypes[1] != (ValueType{"cpu", "nanoseconds"}) {
+		return fmt.Errorf("unexpected sample types %v", p.SampleTypes)
+	}
+	if p.Period != 1e9/100 || p.Duration <= 0 {
+		return fmt.Errorf("unexpected period %d or duration %d", p.Period, p.Duration)
+	}
+	for _, s := range p.Samples {
+		if len(s.Values) != 2 || s.Values[0] < 1 || s.Values[1] != s.Values[0]*p.Period || len(s.Locs) == 0 {
+			return fmt.Errorf("malformed sample %+v", s)
+		}
+		f(uintptr(s.Values[0]), p.Stack(s), s.Labels)
+	}
+	return nil
+}
+
+// A DecodedProfile is a profile.proto message as read back by the tests.
+type DecodedProfile struct {
+	SampleTypes []ValueType
+	PeriodType  ValueType
+	Period      int64
+	TimeNanos   int64
+	Duration    int64
+	Samples     []DecodedSample
+	Locs        map[uint64]DecodedLoc
+	Funcs       map[uint64]DecodedFunc
+	Mappings    []DecodedMapping
+}
+
+// A DecodedSample is a sample of a DecodedProfile.
+type DecodedSample struct {
+	Locs   []uint64
+	Values []int64
+	Labels map[string]string
+}
+
+// A DecodedLoc is a location of a DecodedProfile.
+type DecodedLoc struct {
+	Mapping uint64
+	Addr    uint64
+	Fn      uint64
+	Line    int64
+}
+
+// A DecodedFunc is a function of a DecodedProfile.
+type DecodedFunc struct {
+	Name string
+	File string
+}
+
+// A DecodedMapping is a mapping of a DecodedProfile.
+type DecodedMapping struct {
+	ID           uint64
+	Start, Limit uint64
+	File         string
+	HasFunctions bool
+}
+
+// Stack returns the addresses of the locations of s.
+func (p *DecodedProfile) Stack(s DecodedSample) []uintptr {
+	var stk []uintptr
+	for _, id := range s.Locs {
+		stk = append(stk, uintptr(p.Locs[id].Addr))
+	}
+	return stk
+}
+
+var errMalformed = errors.New("malformed protocol buffer")
+
+// decodeFields calls f with each field of the message in data.
+// Varint fields are passed in x, length-delimited fields in b.
+func decodeFields(data []byte, f func(tag, wire int, x uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := decodeVarint(data)
+		if n == 0 {
+			return errMalformed
+		}
+		data = data[n:]
+		tag, wire := int(key>>3), int(key&7)
+		var x uint64
+		var b []byte
+		switch wire {
+		case 0:
+			x, n = decodeVarint(data)
+			if n == 0 {
+				return errMalformed
+			}
+			data = data[n:]
+		case 2:
+			x, n = decodeVarint(data)
+			if n == 0 || uint64(len(data)-n) < x {
+				return errMalformed
+			}
+			b, data = data[n:n+int(x)], data[n+int(x):]
+		default:
+			return fmt.Errorf("unexpected wire type %d", wire)
+		}
+		if err := f(tag, wire, x, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeVarint(data []byte) (uint64, int) {
+	var x uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		x |= uint64(data[i]&0x7f) << (7 * uint(i))
+		if data[i] < 0x80 {
+			return x, i + 1
+		}
+	}
+	return 0, 0
+}
+
+// decodeUint64s decodes a repeated field, packed or not.
+func decodeUint64s(list []uint64, wire int, x uint64, b []byte) ([]uint64, error) {
+	if wire == 0 {
+		return append(list, x), nil
+	}
+	for len(b) > 0 {
+		x, n := decodeVarint(b)
+		if n == 0 {
+			return nil, errMalformed
+		}
+		list = append(list, x)
+		b = b[n:]
+	}
+	return list, nil
+}
+
+// DecodeProfile decodes a gzipped profile written by a profileBuilder.
+func DecodeProfile(data []byte) (*DecodedProfile, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	data, err = ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the string table first: other messages refer to it by index.
+	var strs []string
+	err = decodeFields(data, func(tag, wire int, x uint64, b []byte) error {
+		if tag == tagProfile_StringTable {
+			strs = append(strs, string(b))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	str := func(i uint64) string {
+		if i < uint64(len(strs)) {
+			return strs[i]
+		}
+		return fmt.Sprintf("<bad string %d>", i)
+	}
+	valueTypeOf := func(b []byte) (ValueType, error) {
+		var t ValueType
+		err := decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+			switch tag {
+			case tagValueType_Type:
+				t.Type = str(x)
+			case tagValueType_Unit:
+				t.Unit = str(x)
+			}
+			return nil
+		})
+		return t, err
+	}
+
+	p := &DecodedProfile{
+		Locs:  map[uint64]DecodedLoc{},
+		Funcs: map[uint64]DecodedFunc{},
+	}
+	err = decodeFields(data, func(tag, wire int, x uint64, b []byte) error {
+		switch tag {
+		case tagProfile_SampleType:
+			t, err := valueTypeOf(b)
+			p.SampleTypes = append(p.SampleTypes, t)
+			return err
+		case tagProfile_PeriodType:
+			t, err := valueTypeOf(b)
+			p.PeriodType = t
+			return err
+		case tagProfile_Period:
+			p.Period = int64(x)
+		case tagProfile_TimeNanos:
+			p.TimeNanos = int64(x)
+		case tagProfile_DurationNanos:
+			p.Duration = int64(x)
+		case tagProfile_Sample:
+			s := DecodedSample{Labels: map[string]string{}}
+			err := decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+				var err error
+				switch tag {
+				case tagSample_Location:
+					s.Locs, err = decodeUint64s(s.Locs, wire, x, b)
+				case tagSample_Value:
+					var v []uint64
+					v, err = decodeUint64s(nil, wire, x, b)
+					for _, u := range v {
+						s.Values = append(s.Values, int64(u))
+					}
+				case tagSample_Label:
+					var k, v uint64
+					err = decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+						switch tag {
+						case tagLabel_Key:
+							k = x
+						case tagLabel_Str:
+							v = x
+						}
+						return nil
+					})
+					s.Labels[str(k)] = str(v)
+				}
+				return err
+			})
+			p.Samples = append(p.Samples, s)
+			return err
+		case tagProfile_Location:
+			var id uint64
+			var l DecodedLoc
+			err := decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+				switch tag {
+				case tagLocation_ID:
+					id = x
+				case tagLocation_MappingID:
+					l.Mapping = x
+				case tagLocation_Address:
+					l.Addr = x
+				case tagLocation_Line:
+					return decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+						switch tag {
+						case tagLine_FunctionID:
+							l.Fn = x
+						case tagLine_Line:
+							l.Line = int64(x)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+			if _, dup := p.Locs[id]; dup || id == 0 {
+				return fmt.Errorf("bad location id %d", id)
+			}
+			p.Locs[id] = l
+			return err
+		case tagProfile_Function:
+			var id uint64
+			var f DecodedFunc
+			err := decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+				switch tag {
+				case tagFunction_ID:
+					id = x
+				case tagFunction_Name:
+					f.Name = str(x)
+				case tagFunction_Filename:
+					f.File = str(x)
+				}
+				return nil
+			})
+			if _, dup := p.Funcs[id]; dup || id == 0 {
+				return fmt.Errorf("bad function id %d", id)
+			}
+			p.Funcs[id] = f
+			return err
+		case tagProfile_Mapping:
+			var m DecodedMapping
+			err := decodeFields(b, func(tag, wire int, x uint64, b []byte) error {
+				switch tag {
+				case tagMapping_ID:
+					m.ID = x
+				case tagMapping_Start:
+					m.Start = x
+				case tagMapping_Limit:
+					m.Limit = x
+				case tagMapping_Filename:
+					m.File = str(x)
+				case tagMapping_HasFunctions:
+					m.HasFunctions = x != 0
+				}
+				return nil
+			})
+			p.Mappings = append(p.Mappings, m)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range p.Samples {
+		for _, id := range s.Locs {
+			if _, ok := p.Locs[id]; !ok {
+				return nil, fmt.Errorf("sample refers to missing location %d", id)
+			}
+		}
+	}
+	for _, l := range p.Locs {
+		if _, ok := p.Funcs[l.Fn]; l.Fn != 0 && !ok {
+			return nil, fmt.Errorf("location refers to missing function %d", l.Fn)
+		}
+	}
+	return p, nil
+}
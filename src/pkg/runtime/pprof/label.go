@@ -0,0 +1,171 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pprof
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+)
+
+type label struct {
+	key   string
+	value string
+}
+
+// A LabelSet is a set of profiler labels: key/value pairs attached
+// to a goroutine and recorded with the CPU profile samples taken
+// while it runs, so that a profile can be split by, for example,
+// the request handler or the tenant the work was done for.
+// The zero LabelSet has no labels.
+type LabelSet struct {
+	list []label // sorted by key, keys unique
+}
+
+// Labels returns a LabelSet of the given key/value pairs.
+// Labels panics if it is given an odd number of arguments.
+// If a key appears more than once, the last value is used.
+func Labels(args ...string) LabelSet {
+	if len(args)%2 != 0 {
+		panic("pprof: uneven number of arguments to pprof.Labels")
+	}
+	var s LabelSet
+	for i := 0; i < len(args); i += 2 {
+		s = s.with(args[i], args[i+1])
+	}
+	return s
+}
+
+// with returns a copy of s with key set to value.
+func (s LabelSet) with(key, value string) LabelSet {
+	i := sort.Search(len(s.list), func(i int) bool { return s.list[i].key >= key })
+	list := make([]label, 0, len(s.list)+1)
+	list = append(list, s.list[:i]...)
+	list = append(list, label{key, value})
+	if i < len(s.list) && s.list[i].key == key {
+		i++
+	}
+	list = append(list, s.list[i:]...)
+	return LabelSet{list}
+}
+
+// Label returns the value of the label key in s, if any.
+func (s LabelSet) Label(key string) (string, bool) {
+	i := sort.Search(len(s.list), func(i int) bool { return s.list[i].key >= key })
+	if i < len(s.list) && s.list[i].key == key {
+		return s.list[i].value, true
+	}
+	return "", false
+}
+
+// ForLabels calls f with each label in s, in key order,
+// stopping early if f returns false.
+func (s LabelSet) ForLabels(f func(key, value string) bool) {
+	for _, l := range s.list {
+		if !f(l.key, l.value) {
+			break
+		}
+	}
+}
+
+// String returns s in the form {key:"value", ...}.
+func (s LabelSet) String() string {
+	var b []byte
+	b = append(b, '{')
+	for i, l := range s.list {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = append(b, l.key...)
+		b = append(b, ':')
+		b = strconv.AppendQuote(b, l.value)
+	}
+	b = append(b, '}')
+	return string(b)
+}
+
+// The runtime records a goroutine's labels as a small integer id,
+// which it can copy to new goroutines and store with CPU profile
+// samples without allocating or keeping memory alive.  Label sets
+// are interned here: each distinct set gets an id the first time it
+// is attached to a goroutine and keeps it for the life of the program.
+// Labels should therefore take a bounded number of values, such as
+// handler names, rather than unique values such as request IDs.
+var labelSets struct {
+	sync.Mutex
+	ids  map[string]uintptr
+	sets []LabelSet // sets[id-1] has id
+}
+
+// labelSetID returns the id of s, interning it if necessary.
+// The empty set has id 0.
+func labelSetID(s LabelSet) uintptr {
+	if len(s.list) == 0 {
+		return 0
+	}
+	var key []byte
+	for _, l := range s.list {
+		key = append(key, l.key...)
+		key = append(key, 0)
+		key = append(key, l.value...)
+		key = append(key, 0)
+	}
+	labelSets.Lock()
+	defer labelSets.Unlock()
+	id, ok := labelSets.ids[string(key)]
+	if !ok {
+		if labelSets.ids == nil {
+			labelSets.ids = make(map[string]uintptr)
+		}
+		labelSets.sets = append(labelSets.sets, s)
+		id = uintptr(len(labelSets.sets))
+		labelSets.ids[string(key)] = id
+	}
+	return id
+}
+
+// labelSet returns the label set with the given id.
+func labelSet(id uintptr) LabelSet {
+	if id == 0 {
+		return LabelSet{}
+	}
+	labelSets.Lock()
+	defer labelSets.Unlock()
+	if id > uintptr(len(labelSets.sets)) {
+		return LabelSet{}
+	}
+	return labelSets.sets[id-1]
+}
+
+// SetGoroutineLabels sets the labels of the current goroutine to s.
+// Goroutines it starts afterward inherit the labels.
+// Most callers should use Do instead.
+func SetGoroutineLabels(s LabelSet) {
+	runtime_setProfLabel(labelSetID(s))
+}
+
+// GoroutineLabels returns the labels of the current goroutine.
+func GoroutineLabels() LabelSet {
+	return labelSet(runtime_getProfLabel())
+}
+
+// Do calls f with the current goroutine's labels extended by s,
+// where labels in s replace labels with the same key, and restores
+// the goroutine's labels when f returns.  Goroutines started by f
+// inherit the extended labels.
+func Do(s LabelSet, f func()) {
+	old := runtime_getProfLabel()
+	defer runtime_setProfLabel(old)
+	labels := labelSet(old)
+	for _, l := range s.list {
+		labels = labels.with(l.key, l.value)
+	}
+	runtime_setProfLabel(labelSetID(labels))
+	f()
+}
+
+// Implemented in package runtime.
+func runtime_setProfLabel(labels uintptr)
+func runtime_getProfLabel() uintptr
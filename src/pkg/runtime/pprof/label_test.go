@@ -0,0 +1,84 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pprof_test
+
+import (
+	. "runtime/pprof"
+	"testing"
+)
+
+func TestLabels(t *testing.T) {
+	s := Labels("b", "2", "a", "1", "b", "3")
+	if got, want := s.String(), `{a:"1", b:"3"}`; got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+	if v, ok := s.Label("b"); !ok || v != "3" {
+		t.Errorf(`Label("b") = %q, %v, want "3", true`, v, ok)
+	}
+	if v, ok := s.Label("c"); ok {
+		t.Errorf(`Label("c") = %q, true, want "", false`, v)
+	}
+	var keys []string
+	s.ForLabels(func(key, value string) bool {
+		keys = append(keys, key)
+		return false
+	})
+	if len(keys) != 1 || keys[0] != "a" {
+		t.Errorf("ForLabels visited %v, want [a] before stopping", keys)
+	}
+	if got := (LabelSet{}).String(); got != "{}" {
+		t.Errorf("empty String() = %s, want {}", got)
+	}
+}
+
+func TestLabelsOdd(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Labels with odd arguments did not panic")
+		}
+	}()
+	Labels("key")
+}
+
+func TestLabelSetID(t *testing.T) {
+	if id := LabelSetID(LabelSet{}); id != 0 {
+		t.Errorf("id of empty set = %d, want 0", id)
+	}
+	a := LabelSetID(Labels("k", "v", "k2", "v2"))
+	b := LabelSetID(Labels("k2", "v2", "k", "v"))
+	c := LabelSetID(Labels("k", "v2", "k2", "v"))
+	if a == 0 || a != b || a == c {
+		t.Errorf("ids %d, %d, %d: want equal sets to share a nonzero id", a, b, c)
+	}
+	if got := LabelSetOf(c).String(); got != `{k:"v2", k2:"v"}` {
+		t.Errorf("LabelSetOf(%d) = %s", c, got)
+	}
+}
+
+func TestDo(t *testing.T) {
+	defer SetGoroutineLabels(GoroutineLabels())
+	SetGoroutineLabels(Labels("a", "1"))
+
+	done := make(chan LabelSet)
+	Do(Labels("b", "2"), func() {
+		if got, want := GoroutineLabels().String(), `{a:"1", b:"2"}`; got != want {
+			t.Errorf("in Do: labels %s, want %s", got, want)
+		}
+		Do(Labels("a", "3"), func() {
+			go func() {
+				done <- GoroutineLabels()
+			}()
+		})
+		if got, want := GoroutineLabels().String(), `{a:"1", b:"2"}`; got != want {
+			t.Errorf("after nested Do: labels %s, want %s", got, want)
+		}
+	})
+	if got, want := GoroutineLabels().String(), `{a:"1"}`; got != want {
+		t.Errorf("after Do: labels %s, want %s", got, want)
+	}
+	if got, want := (<-done).String(), `{a:"3", b:"2"}`; got != want {
+		t.Errorf("new goroutine: labels %s, want %s", got, want)
+	}
+}
@@ -3,9 +3,19 @@
 // license that can be found in the LICENSE file.
 
 // Package pprof writes runtime profiling data in the format expected
-// by the pprof visualization tool.
-// For more information about pprof, see
-// http://code.google.com/p/google-perftools/.
+// by the pprof visualization tool, go tool pprof.
+//
+// Profiles are written as gzipped protocol buffers in the format
+// described by profile.proto
+// (https://github.com/google/pprof/blob/master/proto/profile.proto).
+// The format is self-describing: it records the type and unit of
+// each sample value, and the function names, files and lines of the
+// program counters in the profile together with the memory mappings
+// of the process, so that a profile can be read without access to
+// the binary that produced it.
+//
+// Samples in a CPU profile also record the profiler labels of the
+// goroutine that was running; see LabelSet and Do.
 package pprof
 
 import (
@@ -18,6 +28,8 @@ import (
 	"strings"
 	"sync"
 	"text/tabwriter"
+	"time"
+	"unsafe"
 )
 
 // BUG(rsc): Profiles are incomplete and inaccuate on NetBSD and OS X.
@@ -37,6 +49,7 @@ import (
 //	heap         - a sampling of all heap allocations
 //	threadcreate - stack traces that led to the creation of new OS threads
 //	block        - stack traces that led to blocking on synchronization primitives
+//	mutex        - stack traces of holders of contended mutexes
 //
 // These predefined profiles maintain themselves and panic on an explicit
 // Add or Remove method call.
@@ -83,6 +96,12 @@ var blockProfile = &Profile{
 	write: writeBlock,
 }
 
+var mutexProfile = &Profile{
+	name:  "mutex",
+	count: countMutex,
+	write: writeMutex,
+}
+
 func lockProfiles() {
 	profiles.mu.Lock()
 	if profiles.m == nil {
@@ -92,6 +111,7 @@ func lockProfiles() {
 			"threadcreate": threadcreateProfile,
 			"heap":         heapProfile,
 			"block":        blockProfile,
+			"mutex":        mutexProfile,
 		}
 	}
 }
@@ -213,9 +233,11 @@ func (p *Profile) Remove(value interface{}) {
 // Otherwise, WriteTo returns nil.
 //
 // The debug parameter enables additional output.
-// Passing debug=0 prints only the hexadecimal addresses that pprof needs.
-// Passing debug=1 adds comments translating addresses to function names
-// and line numbers, so that a programmer can read the profile without tools.
+// Passing debug=0 writes the gzip-compressed protocol buffer
+// described in the package comment.
+// Passing debug=1 writes the legacy text format with comments
+// translating addresses to function names and line numbers, so that
+// a programmer can read the profile without tools.
 //
 // The predefined profiles may assign meaning to other debug values;
 // for example, when printing the "goroutine" profile, debug=2 means to
@@ -268,7 +290,12 @@ type countProfile interface {
 }
 
 // printCountProfile prints a countProfile at the specified debug level.
+// The profile will be in compressed proto format unless debug is nonzero.
 func printCountProfile(w io.Writer, debug int, name string, p countProfile) error {
+	if debug == 0 {
+		return writeCountProfileProto(w, name, p)
+	}
+
 	b := bufio.NewWriter(w)
 	var tw *tabwriter.Writer
 	w = b
@@ -314,6 +341,34 @@ func printCountProfile(w io.Writer, debug int, name string, p countProfile) erro
 	return b.Flush()
 }
 
+// writeCountProfileProto writes p to w as a protocol buffer
+// profile, with one sample for each distinct stack.
+func writeCountProfileProto(w io.Writer, name string, p countProfile) error {
+	b := newProfileBuilder([]valueType{{name, "count"}}, valueType{name, "count"}, 1)
+
+	// Build count of each stack, in order of first appearance.
+	var stks [][]uintptr
+	var counts []int64
+	index := map[string]int{}
+	n := p.Len()
+	for i := 0; i < n; i++ {
+		stk := p.Stack(i)
+		key := fmt.Sprint(stk)
+		j, ok := index[key]
+		if !ok {
+			j = len(stks)
+			index[key] = j
+			stks = append(stks, stk)
+			counts = append(counts, 0)
+		}
+		counts[j]++
+	}
+	for i, stk := range stks {
+		b.addSample(stk, []int64{counts[i]}, LabelSet{})
+	}
+	return b.build(w)
+}
+
 // printStackRecord prints the function + source line information
 // for a single stack trace.
 func printStackRecord(w io.Writer, stk []uintptr, allFrames bool) {
@@ -401,6 +456,10 @@ func writeHeap(w io.Writer, debug int) error {
 
 	sort.Sort(byInUseBytes(p))
 
+	if debug == 0 {
+		return writeHeapProto(w, p, int64(runtime.MemProfileRate))
+	}
+
 	b := bufio.NewWriter(w)
 	var tw *tabwriter.Writer
 	w = b
@@ -478,6 +537,24 @@ func writeHeap(w io.Writer, debug int) error {
 	return b.Flush()
 }
 
+// writeHeapProto writes the heap profile p, sampled at the given
+// rate, to w as a protocol buffer profile.
+func writeHeapProto(w io.Writer, p []runtime.MemProfileRecord, rate int64) error {
+	b := newProfileBuilder([]valueType{
+		{"alloc_objects", "count"},
+		{"alloc_space", "bytes"},
+		{"inuse_objects", "count"},
+		{"inuse_space", "bytes"},
+	}, valueType{"space", "bytes"}, rate)
+	for i := range p {
+		r := &p[i]
+		allocObjects, allocBytes := scaleHeapSample(r.AllocObjects, r.AllocBytes, rate)
+		inUseObjects, inUseBytes := scaleHeapSample(r.InUseObjects(), r.InUseBytes(), rate)
+		b.addSample(r.Stack(), []int64{allocObjects, allocBytes, inUseObjects, inUseBytes}, LabelSet{})
+	}
+	return b.build(w)
+}
+
 // countThreadCreate returns the size of the current ThreadCreateProfile.
 func countThreadCreate() int {
 	n, _ := runtime.ThreadCreateProfile(nil)
@@ -590,22 +667,93 @@ func StartCPUProfile(w io.Writer) error {
 		return fmt.Errorf("cpu profiling already in use")
 	}
 	cpu.profiling = true
-	runtime.SetCPUProfileRate(hz)
-	go profileWriter(w)
+	runtime_setCPUProfileRate(hz, true)
+	go profileWriter(w, hz)
 	return nil
 }
 
-func profileWriter(w io.Writer) {
+// profileWriter collects the CPU profile started at the given rate
+// and writes it to w when the profile stops.
+func profileWriter(w io.Writer, hz int) {
+	b := newProfileBuilder([]valueType{
+		{"samples", "count"},
+		{"cpu", "nanoseconds"},
+	}, valueType{"cpu", "nanoseconds"}, 1e9/int64(hz))
+	var p cpuProfile
 	for {
 		data := runtime.CPUProfile()
 		if data == nil {
 			break
 		}
-		w.Write(data)
+		p.addData(data)
+	}
+	b.end = time.Now()
+	for _, s := range p.samples {
+		b.addSample(s.stk, []int64{s.count, s.count * 1e9 / int64(hz)}, labelSet(s.labels))
 	}
+	b.build(w)
 	cpu.done <- true
 }
 
+// A cpuProfile accumulates the samples of a CPU profile from the
+// log returned by runtime.CPUProfile.  The log starts with a header
+// of five words, followed by records of the form
+//
+//	count, depth, labels, pc[depth]
+//
+// where labels is the label set id of the sampled goroutine.
+// A record with count 0 marks the end of the log.
+type cpuProfile struct {
+	header  bool           // header has been read
+	index   map[string]int // samples index by labels and stack
+	samples []cpuSample
+}
+
+type cpuSample struct {
+	count  int64
+	labels uintptr
+	stk    []uintptr
+}
+
+// addData adds the records in the next chunk of the log to p.
+// Chunks always hold whole records.
+func (p *cpuProfile) addData(data []byte) {
+	const ptrSize = unsafe.Sizeof(uintptr(0))
+	n := len(data) / int(ptrSize)
+	if n == 0 {
+		return
+	}
+	words := (*[1 << 20]uintptr)(unsafe.Pointer(&data[0]))[:n:n]
+	i := 0
+	if !p.header {
+		if n < 5 {
+			return
+		}
+		p.header = true
+		i = 5
+	}
+	if p.index == nil {
+		p.index = make(map[string]int)
+	}
+	for i+3 <= n {
+		count, depth := words[i], int(words[i+1])
+		if count == 0 || i+3+depth > n {
+			break
+		}
+		// The labels and stack words, as raw bytes, identify the sample.
+		key := string(data[uintptr(i+2)*ptrSize : uintptr(i+3+depth)*ptrSize])
+		if j, ok := p.index[key]; ok {
+			p.samples[j].count += int64(count)
+		} else {
+			stk := make([]uintptr, depth)
+			copy(stk, words[i+3:i+3+depth])
+			p.index[key] = len(p.samples)
+			p.samples = append(p.samples, cpuSample{int64(count), words[i+2], stk})
+		}
+		i += 3 + depth
+	}
+}
+
 // StopCPUProfile stops the current CPU profile, if any.
 // StopCPUProfile only returns after all the writes for the
 // profile have completed.
@@ -635,11 +783,32 @@ func countBlock() int {
 
 // writeBlock writes the current blocking profile to w.
 func writeBlock(w io.Writer, debug int) error {
+	return writeProfileCycles(w, debug, "contention", runtime.BlockProfile, 1, "")
+}
+
+// countMutex returns the number of records in the mutex profile.
+func countMutex() int {
+	n, _ := runtime.MutexProfile(nil)
+	return n
+}
+
+// writeMutex writes the current mutex profile to w.
+func writeMutex(w io.Writer, debug int) error {
+	period := runtime.SetMutexProfileFraction(-1)
+	extra := fmt.Sprintf("sampling period=%d\n", period)
+	return writeProfileCycles(w, debug, "mutex", runtime.MutexProfile, int64(period), extra)
+}
+
+// writeProfileCycles writes a profile of delays, as returned by
+// runtime.BlockProfile or runtime.MutexProfile and sampled with the
+// given period, to w.  In the text format, the extra header lines,
+// if any, follow the cycles/second line.
+func writeProfileCycles(w io.Writer, debug int, name string, fetch func([]runtime.BlockProfileRecord) (int, bool), period int64, extra string) error {
 	var p []runtime.BlockProfileRecord
-	n, ok := runtime.BlockProfile(nil)
+	n, ok := fetch(nil)
 	for {
 		p = make([]runtime.BlockProfileRecord, n+50)
-		n, ok = runtime.BlockProfile(p)
+		n, ok = fetch(p)
 		if ok {
 			p = p[:n]
 			break
@@ -648,6 +817,10 @@ func writeBlock(w io.Writer, debug int) error {
 
 	sort.Sort(byCycles(p))
 
+	if debug == 0 {
+		return writeProfileCyclesProto(w, p, period)
+	}
+
 	b := bufio.NewWriter(w)
 	var tw *tabwriter.Writer
 	w = b
@@ -656,8 +829,9 @@ func writeBlock(w io.Writer, debug int) error {
 		w = tw
 	}
 
-	fmt.Fprintf(w, "--- contention:\n")
+	fmt.Fprintf(w, "--- %s:\n", name)
 	fmt.Fprintf(w, "cycles/second=%v\n", runtime_cyclesPerSecond())
+	fmt.Fprint(w, extra)
 	for i := range p {
 		r := &p[i]
 		fmt.Fprintf(w, "%v %v @", r.Cycles, r.Count)
@@ -676,4 +850,21 @@ func writeBlock(w io.Writer, debug int) error {
 	return b.Flush()
 }
 
+// writeProfileCyclesProto writes the delay profile p, sampled with
+// the given period, to w as a protocol buffer profile.
+func writeProfileCyclesProto(w io.Writer, p []runtime.BlockProfileRecord, period int64) error {
+	b := newProfileBuilder([]valueType{
+		{"contentions", "count"},
+		{"delay", "nanoseconds"},
+	}, valueType{"contentions", "count"}, period)
+	cpn := float64(runtime_cyclesPerSecond()) / 1e9 // cycles per nanosecond
+	for i := range p {
+		r := &p[i]
+		b.addSample(r.Stack(), []int64{r.Count, int64(float64(r.Cycles) / cpn)}, LabelSet{})
+	}
+	return b.build(w)
+}
+
+// Implemented in package runtime.
 func runtime_cyclesPerSecond() int64
+func runtime_setCPUProfileRate(hz int, labels bool)
@@ -17,7 +17,6 @@ import (
 	"sync"
 	"testing"
 	"time"
-	"unsafe"
 )
 
 func TestCPUProfile(t *testing.T) {
@@ -53,41 +52,15 @@ func TestCPUProfileMultithreaded(t *testing.T) {
 	})
 }
 
-func parseProfile(t *testing.T, bytes []byte, f func(uintptr, []uintptr)) {
-	// Convert []byte to []uintptr.
-	l := len(bytes) / int(unsafe.Sizeof(uintptr(0)))
-	val := *(*[]uintptr)(unsafe.Pointer(&bytes))
-	val = val[:l]
-
-	// 5 for the header, 2 for the per-sample header on at least one sample, 3 for the trailer.
-	if l < 5+2+3 {
-		t.Logf("profile too short: %#x", val)
-		if badOS[runtime.GOOS] {
-			t.Skipf("ignoring failure on %s; see golang.org/issue/6047", runtime.GOOS)
-			return
-		}
-		t.FailNow()
-	}
-
-	hd, val, tl := val[:5], val[5:l-3], val[l-3:]
-	if hd[0] != 0 || hd[1] != 3 || hd[2] != 0 || hd[3] != 1e6/100 || hd[4] != 0 {
-		t.Fatalf("unexpected header %#x", hd)
-	}
-
-	if tl[0] != 0 || tl[1] != 1 || tl[2] != 0 {
-		t.Fatalf("malformed end-of-data marker %#x", tl)
-	}
-
-	for len(val) > 0 {
-		if len(val) < 2 || val[0] < 1 || val[1] < 1 || uintptr(len(val)) < 2+val[1] {
-			t.Fatalf("malformed profile.  leftover: %#x", val)
-		}
-		f(val[0], val[2:2+val[1]])
-		val = val[2+val[1]:]
+func parseProfile(t *testing.T, bytes []byte, f func(uintptr, []uintptr, map[string]string)) {
+	if err := ParseCPUProfile(bytes, f); err != nil {
+		t.Fatalf("malformed profile: %v", err)
 	}
 }
 
-func testCPUProfile(t *testing.T, need []string, f func()) {
+// testCPUProfile profiles f and checks that the profile has samples
+// in each of the functions in need.  It returns the profile.
+func testCPUProfile(t *testing.T, need []string, f func()) []byte {
 	switch runtime.GOOS {
 	case "darwin":
 		out, err := exec.Command("uname", "-a").CombinedOutput()
@@ -98,7 +71,7 @@ func testCPUProfile(t *testing.T, need []string, f func()) {
 		t.Logf("uname -a: %v", vers)
 	case "plan9":
 		// unimplemented
-		return
+		return nil
 	}
 
 	var prof bytes.Buffer
@@ -110,7 +83,7 @@ func testCPUProfile(t *testing.T, need []string, f func()) {
 
 	// Check that profile is well formed and contains ChecksumIEEE.
 	have := make([]uintptr, len(need))
-	parseProfile(t, prof.Bytes(), func(count uintptr, stk []uintptr) {
+	parseProfile(t, prof.Bytes(), func(count uintptr, stk []uintptr, _ map[string]string) {
 		for _, pc := range stk {
 			f := runtime.FuncForPC(pc)
 			if f == nil {
@@ -125,7 +98,7 @@ func testCPUProfile(t *testing.T, need []string, f func()) {
 	})
 
 	if len(need) == 0 {
-		return
+		return prof.Bytes()
 	}
 
 	var total uintptr
@@ -153,10 +126,37 @@ func testCPUProfile(t *testing.T, need []string, f func()) {
 	if !ok {
 		if badOS[runtime.GOOS] {
 			t.Skipf("ignoring failure on %s; see golang.org/issue/6047", runtime.GOOS)
-			return
+			return nil
 		}
 		t.FailNow()
 	}
+	return prof.Bytes()
+}
+
+func TestCPUProfileLabel(t *testing.T) {
+	buf := make([]byte, 100000)
+	prof := testCPUProfile(t, []string{"crc32.ChecksumIEEE"}, func() {
+		Do(Labels("key", "value"), func() {
+			for i := 0; i < 1000; i++ {
+				crc32.ChecksumIEEE(buf)
+			}
+		})
+	})
+	if prof == nil {
+		return
+	}
+	parseProfile(t, prof, func(count uintptr, stk []uintptr, labels map[string]string) {
+		for _, pc := range stk {
+			f := runtime.FuncForPC(pc)
+			if f == nil || !strings.Contains(f.Name(), "crc32.ChecksumIEEE") {
+				continue
+			}
+			if len(labels) != 1 || labels["key"] != "value" {
+				t.Errorf("sample in %s has labels %v, want key:value", f.Name(), labels)
+			}
+			return
+		}
+	})
 }
 
 func TestCPUProfileWithFork(t *testing.T) {
@@ -213,7 +213,7 @@ func TestGoroutineSwitch(t *testing.T) {
 
 		// Read profile to look for entries for runtime.gogo with an attempt at a traceback.
 		// The special entry
-		parseProfile(t, prof.Bytes(), func(count uintptr, stk []uintptr) {
+		parseProfile(t, prof.Bytes(), func(count uintptr, stk []uintptr, _ map[string]string) {
 			// An entry with two frames with 'System' in its top frame
 			// exists to record a PC without a traceback. Those are okay.
 			if len(stk) == 2 {
@@ -335,6 +335,39 @@ func TestBlockProfile(t *testing.T) {
 	}
 }
 
+func TestMutexProfile(t *testing.T) {
+	old := runtime.SetMutexProfileFraction(1)
+	defer runtime.SetMutexProfileFraction(old)
+	if old != 0 {
+		t.Fatalf("need MutexProfileRate 0, got %d", old)
+	}
+
+	blockMutex()
+
+	var w bytes.Buffer
+	Lookup("mutex").WriteTo(&w, 1)
+	prof := w.String()
+
+	if !strings.HasPrefix(prof, "--- mutex:\ncycles/second=") {
+		t.Fatalf("Bad profile header:\n%v", prof)
+	}
+	lines := strings.Split(strings.Trim(prof, "\n"), "\n")
+	if len(lines) < 4 {
+		t.Fatalf("Bad profile, too few lines:\n%v", prof)
+	}
+	if lines[2] != "sampling period=1" {
+		t.Errorf("Bad sampling period line %q, want %q", lines[2], "sampling period=1")
+	}
+	// The contention is charged to the goroutine that unlocked.
+	re := `
+[0-9]+ 1 @( 0x[0-9,a-f]+)+
+#	0x[0-9,a-f]+	sync\.\(\*Mutex\)\.Unlock\+0x[0-9,a-f]+	.*/src/pkg/sync/mutex\.go:[0-9]+
+`
+	if !regexp.MustCompile(re).MatchString(prof) {
+		t.Fatalf("Bad mutex entry, expect:\n%v\ngot:\n%v", re, prof)
+	}
+}
+
 const blockDelay = 10 * time.Millisecond
 
 func blockChanRecv() {
@@ -0,0 +1,409 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pprof
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Field numbers of the messages in profile.proto, the format read
+// by the pprof tool.  See
+// https://github.com/google/pprof/blob/master/proto/profile.proto.
+const (
+	// message Profile
+	tagProfile_SampleType    = 1  // repeated ValueType
+	tagProfile_Sample        = 2  // repeated Sample
+	tagProfile_Mapping       = 3  // repeated Mapping
+	tagProfile_Location      = 4  // repeated Location
+	tagProfile_Function      = 5  // repeated Function
+	tagProfile_StringTable   = 6  // repeated string
+	tagProfile_TimeNanos     = 9  // int64
+	tagProfile_DurationNanos = 10 // int64
+	tagProfile_PeriodType    = 11 // ValueType
+	tagProfile_Period        = 12 // int64
+
+	// message ValueType
+	tagValueType_Type = 1 // int64 (string table index)
+	tagValueType_Unit = 2 // int64 (string table index)
+
+	// message Sample
+	tagSample_Location = 1 // repeated uint64
+	tagSample_Value    = 2 // repeated int64
+	tagSample_Label    = 3 // repeated Label
+
+	// message Label
+	tagLabel_Key = 1 // int64 (string table index)
+	tagLabel_Str = 2 // int64 (string table index)
+
+	// message Mapping
+	tagMapping_ID             = 1 // uint64
+	tagMapping_Start          = 2 // uint64
+	tagMapping_Limit          = 3 // uint64
+	tagMapping_Offset         = 4 // uint64
+	tagMapping_Filename       = 5 // int64 (string table index)
+	tagMapping_HasFunctions   = 7 // bool
+	tagMapping_HasFilenames   = 8 // bool
+	tagMapping_HasLineNumbers = 9 // bool
+
+	// message Location
+	tagLocation_ID        = 1 // uint64
+	tagLocation_MappingID = 2 // uint64
+	tagLocation_Address   = 3 // uint64
+	tagLocation_Line      = 4 // repeated Line
+
+	// message Line
+	tagLine_FunctionID = 1 // uint64
+	tagLine_Line       = 2 // int64
+
+	// message Function
+	tagFunction_ID         = 1 // uint64
+	tagFunction_Name       = 2 // int64 (string table index)
+	tagFunction_SystemName = 3 // int64 (string table index)
+	tagFunction_Filename   = 4 // int64 (string table index)
+)
+
+// A valueType is the type and unit of the values in a profile,
+// such as alloc_space and bytes.
+type valueType struct {
+	typ  string
+	unit string
+}
+
+// A profileBuilder writes a profile incrementally from a
+// stream of samples, in the gzipped protocol buffer format
+// of profile.proto.
+//
+// The profile is self-describing: each sample refers to locations
+// that are symbolized when first seen, so that the profile can be
+// read without the binary that produced it.
+type profileBuilder struct {
+	start time.Time
+	end   time.Time // if set, the profile covers [start, end)
+	pb    protobuf
+
+	strings   []string
+	stringMap map[string]int
+	locs      map[uintptr]uint64 // location id by pc
+	funcs     map[string]uint64  // function id by name
+	mem       []memMap
+}
+
+// A memMap is an executable mapping of the process's address space.
+type memMap struct {
+	start  uintptr
+	end    uintptr
+	offset uint64
+	file   string
+	used   bool // has symbolized locations
+}
+
+// newProfileBuilder returns a profileBuilder for a profile
+// with the given sample types and sampling period.
+func newProfileBuilder(types []valueType, periodType valueType, period int64) *profileBuilder {
+	b := &profileBuilder{
+		start:     time.Now(),
+		strings:   []string{""},
+		stringMap: map[string]int{"": 0},
+		locs:      map[uintptr]uint64{},
+		funcs:     map[string]uint64{},
+	}
+	b.readMapping()
+	for _, t := range types {
+		b.pbValueType(tagProfile_SampleType, t)
+	}
+	b.pbValueType(tagProfile_PeriodType, periodType)
+	b.pb.int64Opt(tagProfile_Period, period)
+	return b
+}
+
+// stringIndex returns the index of s in the string table,
+// adding it if necessary.
+func (b *profileBuilder) stringIndex(s string) int64 {
+	id, ok := b.stringMap[s]
+	if !ok {
+		id = len(b.strings)
+		b.strings = append(b.strings, s)
+		b.stringMap[s] = id
+	}
+	return int64(id)
+}
+
+func (b *profileBuilder) pbValueType(tag int, t valueType) {
+	start := b.pb.startMessage()
+	b.pb.int64(tagValueType_Type, b.stringIndex(t.typ))
+	b.pb.int64(tagValueType_Unit, b.stringIndex(t.unit))
+	b.pb.endMessage(tag, start)
+}
+
+// addSample adds a sample with the given stack and values,
+// one for each sample type, labeled with labels.
+func (b *profileBuilder) addSample(stk []uintptr, values []int64, labels LabelSet) {
+	locs := make([]uint64, 0, len(stk))
+	for i, pc := range stk {
+		locs = append(locs, b.locForPC(pc, i > 0))
+	}
+	start := b.pb.startMessage()
+	b.pb.uint64s(tagSample_Location, locs)
+	b.pb.int64s(tagSample_Value, values)
+	for _, l := range labels.list {
+		lstart := b.pb.startMessage()
+		b.pb.int64(tagLabel_Key, b.stringIndex(l.key))
+		b.pb.int64(tagLabel_Str, b.stringIndex(l.value))
+		b.pb.endMessage(tagSample_Label, lstart)
+	}
+	b.pb.endMessage(tagProfile_Sample, start)
+}
+
+// locForPC returns the id of the location for pc, writing the
+// location and its function to the profile when first seen.
+// If ret is set, pc is a return address, and the location is
+// the call instruction preceding it.
+func (b *profileBuilder) locForPC(pc uintptr, ret bool) uint64 {
+	if id, ok := b.locs[pc]; ok {
+		return id
+	}
+	id := uint64(len(b.locs) + 1)
+	b.locs[pc] = id
+
+	// Write a new function before starting the location,
+	// so that its message is not nested inside the location's.
+	var funcID uint64
+	var line int
+	f := runtime.FuncForPC(pc)
+	if f != nil {
+		tracepc := pc
+		// Back up to call instruction.
+		if ret && pc > f.Entry() {
+			if runtime.GOARCH == "386" || runtime.GOARCH == "amd64" {
+				tracepc--
+			} else {
+				tracepc -= 4 // arm, etc
+			}
+		}
+		var file string
+		file, line = f.FileLine(tracepc)
+		funcID = b.funcID(f.Name(), file)
+	}
+	var mapID uint64
+	for i := range b.mem {
+		m := &b.mem[i]
+		if m.start <= pc && pc < m.end {
+			mapID = uint64(i + 1)
+			if f != nil {
+				m.used = true
+			}
+			break
+		}
+	}
+
+	start := b.pb.startMessage()
+	b.pb.uint64(tagLocation_ID, id)
+	b.pb.uint64Opt(tagLocation_MappingID, mapID)
+	b.pb.uint64(tagLocation_Address, uint64(pc))
+	if f != nil {
+		lstart := b.pb.startMessage()
+		b.pb.uint64(tagLine_FunctionID, funcID)
+		b.pb.int64(tagLine_Line, int64(line))
+		b.pb.endMessage(tagLocation_Line, lstart)
+	}
+	b.pb.endMessage(tagProfile_Location, start)
+	return id
+}
+
+// funcID returns the id of the named function,
+// writing it to the profile when first seen.
+func (b *profileBuilder) funcID(name, file string) uint64 {
+	if id, ok := b.funcs[name]; ok {
+		return id
+	}
+	id := uint64(len(b.funcs) + 1)
+	b.funcs[name] = id
+
+	start := b.pb.startMessage()
+	b.pb.uint64(tagFunction_ID, id)
+	b.pb.int64(tagFunction_Name, b.stringIndex(name))
+	b.pb.int64(tagFunction_SystemName, b.stringIndex(name))
+	b.pb.int64(tagFunction_Filename, b.stringIndex(file))
+	b.pb.endMessage(tagProfile_Function, start)
+	return id
+}
+
+// readMapping records the executable mappings of the process,
+// so that locations can refer to the binary or shared library
+// containing them.  Where the mappings cannot be read, a single
+// mapping covering the address space stands for the executable.
+func (b *profileBuilder) readMapping() {
+	var data bytes.Buffer
+	if f, err := os.Open("/proc/self/maps"); err == nil {
+		data.ReadFrom(f)
+		f.Close()
+	}
+	b.mem = parseProcSelfMaps(data.Bytes())
+	if len(b.mem) == 0 {
+		b.mem = []memMap{{start: 0, end: ^uintptr(0), file: os.Args[0]}}
+	}
+}
+
+// parseProcSelfMaps parses the executable mappings in the
+// contents of a Linux /proc/self/maps file.  Each line has the form
+//
+//	00400000-0052c000 r-xp 00000000 fd:01 1049 /usr/bin/prog
+//
+// with the address range, permissions, file offset, device,
+// inode and, for mappings of files, the file name.
+func parseProcSelfMaps(data []byte) []memMap {
+	var mem []memMap
+	for len(data) > 0 {
+		var line []byte
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			line, data = data, nil
+		}
+		f := bytes.Fields(line)
+		if len(f) < 5 || len(f[1]) < 3 || f[1][2] != 'x' {
+			continue
+		}
+		r := bytes.SplitN(f[0], []byte("-"), 2)
+		if len(r) != 2 {
+			continue
+		}
+		lo, err1 := strconv.ParseUint(string(r[0]), 16, 64)
+		hi, err2 := strconv.ParseUint(string(r[1]), 16, 64)
+		off, err3 := strconv.ParseUint(string(f[2]), 16, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		var file string
+		if len(f) >= 6 {
+			file = string(bytes.Join(f[5:], []byte(" ")))
+		}
+		mem = append(mem, memMap{
+			start:  uintptr(lo),
+			end:    uintptr(hi),
+			offset: off,
+			file:   file,
+		})
+	}
+	return mem
+}
+
+// build completes the profile and writes it, gzipped, to w.
+func (b *profileBuilder) build(w io.Writer) error {
+	b.pb.int64Opt(tagProfile_TimeNanos, b.start.UnixNano())
+	if !b.end.IsZero() {
+		b.pb.int64Opt(tagProfile_DurationNanos, b.end.Sub(b.start).Nanoseconds())
+	}
+	for i, m := range b.mem {
+		start := b.pb.startMessage()
+		b.pb.uint64(tagMapping_ID, uint64(i+1))
+		b.pb.uint64(tagMapping_Start, uint64(m.start))
+		b.pb.uint64(tagMapping_Limit, uint64(m.end))
+		b.pb.uint64(tagMapping_Offset, m.offset)
+		b.pb.int64(tagMapping_Filename, b.stringIndex(m.file))
+		b.pb.boolOpt(tagMapping_HasFunctions, m.used)
+		b.pb.boolOpt(tagMapping_HasFilenames, m.used)
+		b.pb.boolOpt(tagMapping_HasLineNumbers, m.used)
+		b.pb.endMessage(tagProfile_Mapping, start)
+	}
+	b.pb.strings(tagProfile_StringTable, b.strings)
+	return writeGzip(w, b.pb.data)
+}
+
+// writeGzip writes data to w in the gzip format, in stored (that is,
+// uncompressed) deflate blocks.  Package runtime/pprof cannot use
+// compress/gzip: package testing imports runtime/pprof, so the tests
+// of compress/gzip and of the packages it imports, which are in those
+// packages, would import them again through testing.
+func writeGzip(w io.Writer, data []byte) error {
+	// Magic number, deflate, no flags, no modification time,
+	// no extra flags, unknown operating system.
+	hdr := []byte{0x1f, 0x8b, 8, 0, 0, 0, 0, 0, 0, 255}
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	crc, size := crc32IEEE(data), uint32(len(data))
+	for {
+		n := len(data)
+		if n > 0xffff {
+			n = 0xffff
+		}
+		// A stored block: BFINAL, BTYPE 00 and padding to a byte
+		// boundary, then LEN and NLEN, little-endian, and the data.
+		var final byte
+		if n == len(data) {
+			final = 1
+		}
+		blk := []byte{final, byte(n), byte(n >> 8), ^byte(n), ^byte(n >> 8)}
+		if _, err := w.Write(blk); err != nil {
+			return err
+		}
+		if _, err := w.Write(data[:n]); err != nil {
+			return err
+		}
+		if data = data[n:]; final == 1 {
+			break
+		}
+	}
+	trailer := []byte{
+		byte(crc), byte(crc >> 8), byte(crc >> 16), byte(crc >> 24),
+		byte(size), byte(size >> 8), byte(size >> 16), byte(size >> 24),
+	}
+	_, err := w.Write(trailer)
+	return err
+}
+
+var (
+	crcOnce  sync.Once
+	crcTable [256]uint32
+)
+
+// crc32IEEE returns the CRC-32 checksum of data using the IEEE
+// polynomial, as in the gzip trailer.
+func crc32IEEE(data []byte) uint32 {
+	crcOnce.Do(func() {
+		const poly = 0xedb88320 // IEEE, reversed
+		for i := range crcTable {
+			crc := uint32(i)
+			for j := 0; j < 8; j++ {
+				if crc&1 == 1 {
+					crc = crc>>1 ^ poly
+				} else {
+					crc >>= 1
+				}
+			}
+			crcTable[i] = crc
+		}
+	})
+	crc := ^uint32(0)
+	for _, b := range data {
+		crc = crcTable[byte(crc)^b] ^ crc>>8
+	}
+	return ^crc
+}
+
+// scaleHeapSample adjusts the counts of a heap profile record for
+// the sampling of allocations: an allocation of size bytes is
+// recorded with probability 1-exp(-size/rate).
+func scaleHeapSample(count, size, rate int64) (int64, int64) {
+	if count == 0 || size == 0 {
+		return 0, 0
+	}
+	if rate <= 1 {
+		// if rate==1 all samples were collected so no adjustment is needed.
+		// if rate<1 treat as unknown and skip scaling.
+		return count, size
+	}
+	avgSize := float64(size) / float64(count)
+	scale := 1 / (1 - math.Exp(-avgSize/float64(rate)))
+	return int64(float64(count) * scale), int64(float64(size) * scale)
+}
@@ -0,0 +1,175 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pprof_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"runtime"
+	. "runtime/pprof"
+	"strings"
+	"testing"
+)
+
+func TestProtobufEncoding(t *testing.T) {
+	var b Protobuf
+	b.Uint64(1, 300)
+	b.Uint64s(2, []uint64{1, 2, 3})
+	b.Uint64s(3, []uint64{4})
+	b.String(4, "hi")
+	start := b.StartMessage()
+	b.Int64(1, -1)
+	b.EndMessage(5, start)
+	want := []byte{
+		1<<3 | 0, 0xac, 0x02,
+		2<<3 | 2, 3, 1, 2, 3,
+		3<<3 | 0, 4,
+		4<<3 | 2, 2, 'h', 'i',
+		5<<3 | 2, 11, 1<<3 | 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
+	}
+	if !bytes.Equal(b.Data(), want) {
+		t.Errorf("encoding:\nhave % x\nwant % x", b.Data(), want)
+	}
+	if n := b.Nest(); n != 0 {
+		t.Errorf("nest = %d after balanced messages", n)
+	}
+}
+
+func TestWriteGzip(t *testing.T) {
+	// Empty, one stored block, and several.
+	for _, n := range []int{0, 1000, 200000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i * 7)
+		}
+		var buf bytes.Buffer
+		if err := WriteGzip(&buf, data); err != nil {
+			t.Fatal(err)
+		}
+		zr, err := gzip.NewReader(&buf)
+		if err != nil {
+			t.Errorf("%d bytes: %v", n, err)
+			continue
+		}
+		have, err := ioutil.ReadAll(zr)
+		if err != nil {
+			t.Errorf("%d bytes: %v", n, err)
+			continue
+		}
+		if !bytes.Equal(have, data) {
+			t.Errorf("%d bytes: read back %d different bytes", n, len(have))
+		}
+	}
+}
+
+func TestProfileBuilder(t *testing.T) {
+	pcs := make([]uintptr, 10)
+	pcs = pcs[:runtime.Callers(1, pcs)]
+	if len(pcs) < 2 {
+		t.Fatalf("runtime.Callers returned %d pcs", len(pcs))
+	}
+
+	b := NewProfileBuilder([]ValueType{{"samples", "count"}, {"cpu", "nanoseconds"}}, ValueType{"cpu", "nanoseconds"}, 10000000)
+	b.AddSample(pcs, []int64{1, 10000000}, Labels("k", "v"))
+	b.AddSample(pcs[1:], []int64{2, 20000000}, LabelSet{})
+	var buf bytes.Buffer
+	if err := b.Build(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := DecodeProfile(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []ValueType{{"samples", "count"}, {"cpu", "nanoseconds"}}; !reflect.DeepEqual(p.SampleTypes, want) {
+		t.Errorf("sample types = %v, want %v", p.SampleTypes, want)
+	}
+	if p.PeriodType != (ValueType{"cpu", "nanoseconds"}) || p.Period != 10000000 {
+		t.Errorf("period = %d %v, want 10000000 cpu/nanoseconds", p.Period, p.PeriodType)
+	}
+	if p.TimeNanos == 0 {
+		t.Errorf("missing time")
+	}
+	if len(p.Samples) != 2 {
+		t.Fatalf("got %d samples, want 2", len(p.Samples))
+	}
+	if stk := p.Stack(p.Samples[0]); !reflect.DeepEqual(stk, pcs) {
+		t.Errorf("stack = %#x, want %#x", stk, pcs)
+	}
+	if want := []int64{2, 20000000}; !reflect.DeepEqual(p.Samples[1].Values, want) {
+		t.Errorf("values = %v, want %v", p.Samples[1].Values, want)
+	}
+	if want := map[string]string{"k": "v"}; !reflect.DeepEqual(p.Samples[0].Labels, want) {
+		t.Errorf("labels = %v, want %v", p.Samples[0].Labels, want)
+	}
+	if len(p.Samples[1].Labels) != 0 {
+		t.Errorf("unlabeled sample has labels %v", p.Samples[1].Labels)
+	}
+	// Both samples share the locations of pcs[1:].
+	if len(p.Locs) != len(pcs) {
+		t.Errorf("got %d locations, want %d", len(p.Locs), len(pcs))
+	}
+
+	// The first frame is this function.
+	loc := p.Locs[p.Samples[0].Locs[0]]
+	fn := p.Funcs[loc.Fn]
+	if !strings.HasSuffix(fn.Name, ".TestProfileBuilder") || !strings.HasSuffix(fn.File, "proto_test.go") || loc.Line == 0 {
+		t.Errorf("first location is %s at %s:%d, want TestProfileBuilder in proto_test.go", fn.Name, fn.File, loc.Line)
+	}
+	if loc.Mapping == 0 {
+		t.Fatalf("first location has no mapping")
+	}
+	m := p.Mappings[loc.Mapping-1]
+	if m.ID != loc.Mapping || loc.Addr < m.Start || loc.Addr >= m.Limit || !m.HasFunctions {
+		t.Errorf("location %#x in mapping %+v", loc.Addr, m)
+	}
+}
+
+func TestParseProcSelfMaps(t *testing.T) {
+	const maps = `00400000-0040b000 r-xp 00000000 fd:01 1049 /usr/bin/prog
+0060a000-0060b000 rw-p 0000a000 fd:01 1049 /usr/bin/prog
+b74e7000-b76a2000 r-xp 00001000 fd:01 2031 /lib/i386-linux-gnu/libc-2.19.so
+bfff2000-bfff4000 r-xp 00000000 00:00 0          [vdso]
+bfff4000-bfff6000 r-xp 00000000 00:00 0
+bad line
+`
+	want := []MemMap{
+		{Start: 0x400000, End: 0x40b000, File: "/usr/bin/prog"},
+		{Start: 0xb74e7000, End: 0xb76a2000, Offset: 0x1000, File: "/lib/i386-linux-gnu/libc-2.19.so"},
+		{Start: 0xbfff2000, End: 0xbfff4000, File: "[vdso]"},
+		{Start: 0xbfff4000, End: 0xbfff6000},
+	}
+	have := ParseProcSelfMaps([]byte(maps))
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("ParseProcSelfMaps:\nhave %+v\nwant %+v", have, want)
+	}
+}
+
+func TestHeapProto(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Lookup("heap").WriteTo(&buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	p, err := DecodeProfile(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ValueType{
+		{"alloc_objects", "count"},
+		{"alloc_space", "bytes"},
+		{"inuse_objects", "count"},
+		{"inuse_space", "bytes"},
+	}
+	if !reflect.DeepEqual(p.SampleTypes, want) {
+		t.Errorf("sample types = %v, want %v", p.SampleTypes, want)
+	}
+	for _, s := range p.Samples {
+		if len(s.Values) != len(want) {
+			t.Fatalf("sample has %d values, want %d", len(s.Values), len(want))
+		}
+	}
+}
@@ -253,7 +253,7 @@ runtime·main(void)
 	// let the other goroutine finish printing the panic trace.
 	// Once it does, it will exit. See issue 3934.
 	if(runtime·panicking)
-		runtime·park(nil, nil, "panicwait");
+		runtime·park(nil, nil, "panicwait", TraceEvGoStop);
 
 	runtime·exit(0);
 	for(;;)
@@ -380,6 +380,8 @@ runtime·ready(G *gp)
 		runtime·throw("bad g->status in ready");
 	}
 	gp->status = Grunnable;
+	if(runtime·traceenabled)
+		runtime·tracegounpark(gp, 1);
 	runqput(m->p, gp);
 	if(runtime·atomicload(&runtime·sched.npidle) != 0 && runtime·atomicload(&runtime·sched.nmspinning) == 0)  // TODO: fast atomic
 		wakep();
@@ -492,8 +494,14 @@ runtime·stoptheworld(void)
 	for(i = 0; i < runtime·gomaxprocs; i++) {
 		p = runtime·allp[i];
 		s = p->status;
-		if(s == Psyscall && runtime·cas(&p->status, s, Pgcstop))
+		if(s == Psyscall && runtime·cas(&p->status, s, Pgcstop)) {
+			if(runtime·traceenabled) {
+				runtime·tracegosysblock(p);
+				runtime·traceprocstop(p);
+			}
+			p->syscalltick++;
 			runtime·sched.stopwait--;
+		}
 	}
 	// stop idle P's
 	while(p = pidleget()) {
@@ -1120,11 +1128,17 @@ execute(G *gp)
 	}
 	gp->status = Grunning;
 	gp->waitsince = 0;
+	if(gp->runnabletime != 0) {
+		runtime·timehistrecord(&runtime·schedlatency, runtime·nanotime() - gp->runnabletime);
+		gp->runnabletime = 0;
+	}
 	gp->preempt = false;
 	gp->stackguard0 = gp->stackguard;
 	m->p->schedtick++;
 	m->curg = gp;
 	gp->m = m;
+	if(runtime·traceenabled)
+		runtime·tracegostart();
 
 	// Check whether the profiler needs to be turned on or off.
 	hz = runtime·sched.profilehz;
@@ -1282,6 +1296,8 @@ injectglist(G *glist)
 		gp = glist;
 		glist = gp->schedlink;
 		gp->status = Grunnable;
+		if(runtime·traceenabled)
+			runtime·tracegounpark(gp, 0);
 		globrunqput(gp);
 	}
 	runtime·unlock(&runtime·sched);
@@ -1343,14 +1359,17 @@ top:
 
 // Puts the current goroutine into a waiting state and calls unlockf.
 // If unlockf returns false, the goroutine is resumed.
+// If tracing is enabled, traceev is recorded as the reason for blocking.
 void
-runtime·park(bool(*unlockf)(G*, void*), void *lock, int8 *reason)
+runtime·park(bool(*unlockf)(G*, void*), void *lock, int8 *reason, byte traceev)
 {
 	if(g->status != Grunning)
 		runtime·throw("bad g status");
 	m->waitlock = lock;
 	m->waitunlockf = unlockf;
 	g->waitreason = reason;
+	if(runtime·traceenabled)
+		runtime·tracegopark(traceev, 1);
 	runtime·mcall(park0);
 }
 
@@ -1365,9 +1384,9 @@ parkunlock(G *gp, void *lock)
 // Puts the current goroutine into a waiting state and unlocks the lock.
 // The goroutine can be made runnable again by calling runtime·ready(gp).
 void
-runtime·parkunlock(Lock *lock, int8 *reason)
+runtime·parkunlock(Lock *lock, int8 *reason, byte traceev)
 {
-	runtime·park(parkunlock, lock, reason);
+	runtime·park(parkunlock, lock, reason, traceev);
 }
 
 // runtime·park continuation on g0.
@@ -1401,6 +1420,8 @@ runtime·gosched(void)
 {
 	if(g->status != Grunning)
 		runtime·throw("bad g status");
+	if(runtime·traceenabled)
+		runtime·tracegosched();
 	runtime·mcall(runtime·gosched0);
 }
 
@@ -1440,6 +1461,8 @@ runtime·goexit(void)
 static void
 goexit0(G *gp)
 {
+	if(runtime·traceenabled)
+		runtime·tracegoend();
 	gp->status = Gdead;
 	gp->m = nil;
 	gp->lockedm = nil;
@@ -1484,6 +1507,11 @@ void
 	// but can have inconsistent g->sched, do not let GC observe it.
 	m->locks++;
 
+	// Record the event while the goroutine is still running:
+	// tracing can split the stack, which clobbers g->sched.
+	if(runtime·traceenabled)
+		runtime·tracegosyscall();
+
 	// Leave SP around for GC and traceback.
 	save(runtime·getcallerpc(&dummy), runtime·getcallersp(&dummy));
 	g->syscallsp = g->sched.sp;
@@ -1513,6 +1541,11 @@ void
 	if(runtime·sched.gcwaiting) {
 		runtime·lock(&runtime·sched);
 		if (runtime·sched.stopwait > 0 && runtime·cas(&m->p->status, Psyscall, Pgcstop)) {
+			if(runtime·traceenabled) {
+				runtime·tracegosysblock(m->p);
+				runtime·traceprocstop(m->p);
+			}
+			m->p->syscalltick++;
 			if(--runtime·sched.stopwait == 0)
 				runtime·notewakeup(&runtime·sched.stopnote);
 		}
@@ -1536,6 +1569,11 @@ void
 
 	m->locks++;  // see comment in entersyscall
 
+	if(runtime·traceenabled) {
+		runtime·tracegosyscall();
+		runtime·tracegosysblock(m->p);
+	}
+
 	// Leave SP around for GC and traceback.
 	save(runtime·getcallerpc(&dummy), runtime·getcallersp(&dummy));
 	g->syscallsp = g->sched.sp;
@@ -1569,14 +1607,25 @@ void
 void
 runtime·exitsyscall(void)
 {
+	P *oldp;
+	uint32 tick;
+
 	m->locks++;  // see comment in entersyscall
 
 	if(g->isbackground)  // do not consider blocked scavenger for deadlock detection
 		incidlelocked(-1);
 
 	g->waitsince = 0;
+	oldp = m->p;
+	tick = oldp != nil ? oldp->syscalltick : 0;
 	if(exitsyscallfast()) {
 		// There's a cpu for us, so we can run.
+		if(runtime·traceenabled && (m->p != oldp || m->p->syscalltick != tick)) {
+			// The P was taken from us during the call
+			// (retake and stoptheworld bump syscalltick).
+			runtime·tracegosysexit(g);
+			runtime·tracegostart();
+		}
 		m->p->syscalltick++;
 		g->status = Grunning;
 		// Garbage collector isn't running (since we are),
@@ -1654,6 +1703,8 @@ exitsyscall0(G *gp)
 {
 	P *p;
 
+	if(runtime·traceenabled)
+		runtime·tracegosysexit(gp);
 	gp->status = Grunnable;
 	gp->m = nil;
 	m->curg = nil;
@@ -1836,6 +1887,8 @@ runtime·newproc1(FuncVal *fn, byte *argp, int32 narg, int32 nret, void *callerp
 	newg->sched.g = newg;
 	runtime·gostartcallfn(&newg->sched, fn);
 	newg->gopc = (uintptr)callerpc;
+	newg->startpc = (uintptr)fn->fn;
+	newg->labels = g->labels;  // inherit the creator's profiler labels
 	newg->status = Grunnable;
 	if(p->goidcache == p->goidcacheend) {
 		p->goidcache = runtime·xadd64(&runtime·sched.goidgen, GoidCacheBatch);
@@ -1845,6 +1898,8 @@ runtime·newproc1(FuncVal *fn, byte *argp, int32 narg, int32 nret, void *callerp
 	newg->panicwrap = 0;
 	if(raceenabled)
 		newg->racectx = runtime·racegostart((void*)callerpc);
+	if(runtime·traceenabled)
+		runtime·tracegocreate(newg, newg->startpc);
 	runqput(p, newg);
 
 	if(runtime·atomicload(&runtime·sched.npidle) != 0 && runtime·atomicload(&runtime·sched.nmspinning) == 0 && fn->fn != runtime·main)  // TODO: fast atomic
@@ -2017,6 +2072,8 @@ runtime·gomaxprocsfunc(int32 n)
 	m->gcing = 1;
 	runtime·stoptheworld();
 	newprocs = n;
+	if(runtime·traceenabled)
+		runtime·tracegomaxprocs(n);
 	m->gcing = 0;
 	runtime·semrelease(&runtime·worldsema);
 	runtime·starttheworld();
@@ -2136,7 +2193,7 @@ runtime·badreflectcall(void) // called from assembly
 
 static struct {
 	Lock;
-	void (*fn)(uintptr*, int32);
+	void (*fn)(uintptr*, int32, uintptr);
 	int32 hz;
 	uintptr pcbuf[100];
 } prof;
@@ -2152,6 +2209,7 @@ runtime·sigprof(uint8 *pc, uint8 *sp, uint8 *lr, G *gp, M *mp)
 {
 	int32 n;
 	bool traceback;
+	uintptr labels;
 	// Do not use global m in this function, use mp instead.
 	// On windows one m is sending reports about all the g's, so m means a wrong thing.
 	byte m;
@@ -2283,14 +2341,19 @@ runtime·sigprof(uint8 *pc, uint8 *sp, uint8 *lr, G *gp, M *mp)
 				prof.pcbuf[1] = (uintptr)System + PCQuantum;
 		}
 	}
-	prof.fn(prof.pcbuf, n);
+	// Charge the sample to the labels of the user goroutine
+	// even if it was interrupted in a system goroutine.
+	labels = 0;
+	if(mp->curg != nil)
+		labels = mp->curg->labels;
+	prof.fn(prof.pcbuf, n, labels);
 	runtime·unlock(&prof);
 	mp->mallocing--;
 }
 
 // Arrange to call fn with a traceback hz times a second.
 void
-runtime·setcpuprofilerate(void (*fn)(uintptr*, int32), int32 hz)
+runtime·setcpuprofilerate(void (*fn)(uintptr*, int32, uintptr), int32 hz)
 {
 	// Force sane arguments.
 	if(hz < 0)
@@ -2397,14 +2460,19 @@ procresize(int32 new)
 		// can't free P itself because it can be referenced by an M in syscall
 	}
 
-	if(m->p)
+	if(m->p) {
+		if(runtime·traceenabled)
+			runtime·traceprocstop(m->p);
 		m->p->m = nil;
+	}
 	m->p = nil;
 	m->mcache = nil;
 	p = runtime·allp[0];
 	p->m = nil;
 	p->status = Pidle;
 	acquirep(p);
+	if(runtime·traceenabled)
+		runtime·tracegostart();
 	for(i = new-1; i > 0; i--) {
 		p = runtime·allp[i];
 		p->status = Pidle;
@@ -2427,6 +2495,8 @@ acquirep(P *p)
 	m->p = p;
 	p->m = m;
 	p->status = Prunning;
+	if(runtime·traceenabled)
+		runtime·traceprocstart();
 }
 
 // Disassociate p and the current m.
@@ -2443,6 +2513,8 @@ releasep(void)
 			m, m->p, p->m, m->mcache, p->mcache, p->status);
 		runtime·throw("releasep: invalid p state");
 	}
+	if(runtime·traceenabled)
+		runtime·traceprocstop(p);
 	m->p = nil;
 	m->mcache = nil;
 	p->m = nil;
@@ -2616,6 +2688,11 @@ retake(int64 now)
 			// increment nmidle and report deadlock.
 			incidlelocked(-1);
 			if(runtime·cas(&p->status, s, Pidle)) {
+				if(runtime·traceenabled) {
+					runtime·tracegosysblock(p);
+					runtime·traceprocstop(p);
+				}
+				p->syscalltick++;
 				n++;
 				handoffp(p);
 			}
@@ -2800,6 +2877,7 @@ mget(void)
 static void
 globrunqput(G *gp)
 {
+	gp->runnabletime = runtime·nanotime();
 	gp->schedlink = nil;
 	if(runtime·sched.runqtail)
 		runtime·sched.runqtail->schedlink = gp;
@@ -2887,6 +2965,7 @@ runqput(P *p, G *gp)
 {
 	uint32 h, t;
 
+	gp->runnabletime = runtime·nanotime();
 retry:
 	h = runtime·atomicload(&p->runqhead);  // load-acquire, synchronize with consumers
 	t = p->runqtail;
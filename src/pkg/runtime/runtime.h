@@ -93,6 +93,8 @@ typedef	struct	ParForThread	ParForThread;
 typedef	struct	CgoMal		CgoMal;
 typedef	struct	PollDesc	PollDesc;
 typedef	struct	DebugVars	DebugVars;
+typedef	struct	TraceBuf	TraceBuf;
+typedef	struct	TimeHist	TimeHist;
 
 /*
  * Per-CPU declaration.
@@ -286,6 +288,9 @@ struct	G
 	uintptr	sigpc;
 	uintptr	gopc;		// pc of go statement that created this goroutine
 	uintptr	racectx;
+	uintptr	startpc;	// pc of goroutine function
+	uintptr	labels;		// profiler label set id, see runtime/pprof
+	int64	runnabletime;	// when put on a run queue, for the scheduler latency metric
 	uintptr	end[];
 };
 
@@ -402,6 +407,8 @@ struct P
 	G*	gfree;
 	int32	gfreecnt;
 
+	TraceBuf*	tracebuf;	// execution trace events written by the owner of this P
+
 	byte	pad[64];
 };
 
@@ -908,8 +915,8 @@ void	runtime·breakpoint(void);
 void	runtime·gosched(void);
 void	runtime·gosched0(G*);
 void	runtime·schedtrace(bool);
-void	runtime·park(bool(*)(G*, void*), void*, int8*);
-void	runtime·parkunlock(Lock*, int8*);
+void	runtime·park(bool(*)(G*, void*), void*, int8*, byte);
+void	runtime·parkunlock(Lock*, int8*, byte);
 void	runtime·tsleep(int64, int8*);
 M*	runtime·newm(void);
 void	runtime·goexit(void);
@@ -927,12 +934,104 @@ void	runtime·freezetheworld(void);
 void	runtime·unwindstack(G*, byte*);
 void	runtime·sigprof(uint8 *pc, uint8 *sp, uint8 *lr, G *gp, M *mp);
 void	runtime·resetcpuprofiler(int32);
-void	runtime·setcpuprofilerate(void(*)(uintptr*, int32), int32);
+void	runtime·setcpuprofilerate(void(*)(uintptr*, int32, uintptr), int32);
 void	runtime·usleep(uint32);
 int64	runtime·cputicks(void);
 int64	runtime·tickspersecond(void);
 void	runtime·blockevent(int64, int32);
 extern int64 runtime·blockprofilerate;
+void	runtime·mutexevent(int64, int32);
+extern uint64 runtime·mutexprofilerate;
+
+/*
+ * latency histograms for package runtime/metrics; see metrics.c.
+ */
+enum
+{
+	TimeHistBuckets = 32,
+};
+struct TimeHist
+{
+	uint64	counts[TimeHistBuckets];
+};
+extern TimeHist runtime·schedlatency;
+extern TimeHist runtime·gcpauses;
+void	runtime·timehistrecord(TimeHist*, int64);
+
+/*
+ * execution tracer; see trace.goc for the event format.
+ */
+enum
+{
+	TraceEvNone,		// unused
+	TraceEvBatch,		// start of per-P batch of events [pid, ticks]
+	TraceEvFrequency,	// ticks per second [frequency]
+	TraceEvStack,		// stack [stack id, number of frames, {pc, func string id, file string id, line}...]
+	TraceEvString,		// string [string id, length, bytes]
+	TraceEvGomaxprocs,	// current value of GOMAXPROCS [ticks, GOMAXPROCS, stack id]
+	TraceEvProcStart,	// P starts running on an M [ticks, thread id]
+	TraceEvProcStop,	// P stops [ticks]
+	TraceEvGCStart,		// GC starts [ticks, stack id]
+	TraceEvGCDone,		// GC is done [ticks]
+	TraceEvGCSweepStart,	// GC sweep starts [ticks]
+	TraceEvGCSweepDone,	// GC sweep is done [ticks]
+	TraceEvGoCreate,	// goroutine creation [ticks, new goroutine id, start stack id, stack id]
+	TraceEvGoStart,		// goroutine starts running [ticks, goroutine id]
+	TraceEvGoEnd,		// goroutine ends [ticks]
+	TraceEvGoStop,		// goroutine stops forever, as in select{} [ticks, stack id]
+	TraceEvGoSched,		// goroutine calls Gosched [ticks, stack id]
+	TraceEvGoPreempt,	// goroutine is preempted [ticks, stack id]
+	TraceEvGoSleep,		// goroutine calls Sleep [ticks, stack id]
+	TraceEvGoBlock,		// goroutine blocks [ticks, stack id]
+	TraceEvGoUnblock,	// goroutine is unblocked [ticks, goroutine id, stack id]
+	TraceEvGoBlockSend,	// goroutine blocks on chan send [ticks, stack id]
+	TraceEvGoBlockRecv,	// goroutine blocks on chan recv [ticks, stack id]
+	TraceEvGoBlockSelect,	// goroutine blocks on select [ticks, stack id]
+	TraceEvGoBlockSync,	// goroutine blocks on Mutex/RWMutex/WaitGroup [ticks, stack id]
+	TraceEvGoBlockCond,	// goroutine blocks on Cond [ticks, stack id]
+	TraceEvGoBlockNet,	// goroutine blocks on network [ticks, stack id]
+	TraceEvGoSysCall,	// goroutine enters a system call [ticks, stack id]
+	TraceEvGoSysExit,	// goroutine that lost its P returns from a system call [ticks, goroutine id]
+	TraceEvGoSysBlock,	// goroutine blocks in a system call and loses its P [ticks]
+	TraceEvGoWaiting,	// goroutine was blocked when tracing started [ticks, goroutine id]
+	TraceEvGoInSyscall,	// goroutine was in a system call when tracing started [ticks, goroutine id]
+	TraceEvHeapAlloc,	// mstats.heap_alloc changed [ticks, heap_alloc]
+	TraceEvNextGC,		// mstats.next_gc changed [ticks, next_gc]
+	TraceEvCount,
+};
+enum
+{
+	TraceBufSize = 64<<10,
+	TraceStackSize = 128,	// maximum depth of recorded stacks
+};
+struct TraceBuf
+{
+	TraceBuf*	link;
+	uint64	lastticks;	// ticks of the last event written
+	uintptr	pos;		// next write offset in arr
+	uintptr	stk[TraceStackSize];	// scratch space for tracebacks
+	byte	arr[TraceBufSize];
+};
+extern bool runtime·traceenabled;
+void	runtime·tracegomaxprocs(int32);
+void	runtime·traceprocstart(void);
+void	runtime·traceprocstop(P*);
+void	runtime·tracegcstart(void);
+void	runtime·tracegcdone(void);
+void	runtime·tracegcsweepstart(void);
+void	runtime·tracegcsweepdone(void);
+void	runtime·tracegocreate(G*, uintptr);
+void	runtime·tracegostart(void);
+void	runtime·tracegoend(void);
+void	runtime·tracegosched(void);
+void	runtime·tracegopreempt(void);
+void	runtime·tracegopark(byte, int32);
+void	runtime·tracegounpark(G*, int32);
+void	runtime·tracegosyscall(void);
+void	runtime·tracegosysexit(G*);
+void	runtime·tracegosysblock(P*);
+void	runtime·traceheapalloc(void);
+void	runtime·tracenextgc(void);
 void	runtime·addtimer(Timer*);
 bool	runtime·deltimer(Timer*);
 G*	runtime·netpoll(bool);
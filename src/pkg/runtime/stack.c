@@ -735,6 +735,8 @@ runtime·newstack(void)
 		}
 		// Act like goroutine called runtime.Gosched.
 		gp->status = oldstatus;
+		if(runtime·traceenabled)
+			runtime·tracegopreempt();
 		runtime·gosched0(gp);	// never return
 	}
 
@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+// GDesc summarizes the execution of one goroutine in a trace.
+// Times are in nanoseconds.
+type GDesc struct {
+	ID           uint64
+	Name         string // function the goroutine started in
+	PC           uint64 // entry PC of that function
+	CreationTime int64
+	StartTime    int64 // first time it ran, or 0
+	EndTime      int64 // time it exited, or 0
+
+	ExecTime      int64 // running
+	SchedWaitTime int64 // runnable, waiting for a P
+	IOTime        int64 // blocked on the network
+	BlockTime     int64 // blocked on channels, select and sync primitives
+	SyscallTime   int64 // blocked in system calls that lost their P
+	GCTime        int64 // alive while the world was stopped for GC
+	TotalTime     int64 // from creation, or the start of the trace, to exit or the end of the trace
+}
+
+// States of a goroutine, for GoroutineStats.
+const (
+	gDead = iota
+	gRunnable
+	gRunning
+	gWaiting // blocked for an uncounted reason: sleep, GoBlock or unknown
+	gBlocked
+	gIO
+	gSyscall
+)
+
+type gState struct {
+	state int
+	since int64 // when state was entered
+}
+
+// GoroutineStats returns a summary of each goroutine in events,
+// as returned by Parse, keyed by goroutine ID.
+func GoroutineStats(events []*Event) map[uint64]*GDesc {
+	gs := make(map[uint64]*GDesc)
+	states := make(map[uint64]*gState)
+	get := func(id uint64, ts int64) (*GDesc, *gState) {
+		g := gs[id]
+		if g == nil {
+			g = &GDesc{ID: id, CreationTime: ts}
+			gs[id] = g
+			states[id] = &gState{state: gWaiting, since: ts}
+		}
+		return g, states[id]
+	}
+	// leave ends the current state of g at ts and enters state.
+	leave := func(g *GDesc, s *gState, ts int64, state int) {
+		d := ts - s.since
+		switch s.state {
+		case gRunnable:
+			g.SchedWaitTime += d
+		case gRunning:
+			g.ExecTime += d
+		case gBlocked:
+			g.BlockTime += d
+		case gIO:
+			g.IOTime += d
+		case gSyscall:
+			g.SyscallTime += d
+		}
+		s.state = state
+		s.since = ts
+	}
+
+	var gcStart int64 = -1
+	var lastTs int64
+	for _, ev := range events {
+		lastTs = ev.Ts
+		switch ev.Type {
+		case EvGoCreate:
+			g, s := get(ev.Args[0], ev.Ts)
+			g.Name = ev.Fn
+			g.PC = ev.Args[1]
+			s.state = gRunnable
+		case EvGoWaiting:
+			_, s := get(ev.G, ev.Ts)
+			s.state = gWaiting
+		case EvGoInSyscall:
+			_, s := get(ev.G, ev.Ts)
+			s.state = gSyscall
+		case EvGoStart:
+			g, s := get(ev.G, ev.Ts)
+			if g.StartTime == 0 {
+				g.StartTime = ev.Ts
+			}
+			leave(g, s, ev.Ts, gRunning)
+		case EvGoEnd:
+			g, s := get(ev.G, ev.Ts)
+			leave(g, s, ev.Ts, gDead)
+			g.EndTime = ev.Ts
+		case EvGoStop, EvGoSleep, EvGoBlock:
+			g, s := get(ev.G, ev.Ts)
+			leave(g, s, ev.Ts, gWaiting)
+		case EvGoSched, EvGoPreempt:
+			g, s := get(ev.G, ev.Ts)
+			leave(g, s, ev.Ts, gRunnable)
+		case EvGoBlockSend, EvGoBlockRecv, EvGoBlockSelect, EvGoBlockSync, EvGoBlockCond:
+			g, s := get(ev.G, ev.Ts)
+			leave(g, s, ev.Ts, gBlocked)
+		case EvGoBlockNet:
+			g, s := get(ev.G, ev.Ts)
+			leave(g, s, ev.Ts, gIO)
+		case EvGoSysBlock:
+			g, s := get(ev.G, ev.Ts)
+			leave(g, s, ev.Ts, gSyscall)
+		case EvGoUnblock, EvGoSysExit:
+			g, s := get(ev.Args[0], ev.Ts)
+			leave(g, s, ev.Ts, gRunnable)
+		case EvGCStart:
+			gcStart = ev.Ts
+		case EvGCDone:
+			if gcStart >= 0 {
+				for id, s := range states {
+					if s.state != gDead {
+						gs[id].GCTime += ev.Ts - gcStart
+					}
+				}
+				gcStart = -1
+			}
+		}
+	}
+
+	for id, g := range gs {
+		leave(g, states[id], lastTs, gDead)
+		end := g.EndTime
+		if end == 0 {
+			end = lastTs
+		}
+		g.TotalTime = end - g.CreationTime
+	}
+	return gs
+}
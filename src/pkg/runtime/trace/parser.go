@@ -0,0 +1,417 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Event types in the trace.  The numbering matches the runtime's
+// TraceEv constants; arguments are described by EventDescriptions.
+const (
+	EvNone          = 0  // unused
+	EvBatch         = 1  // start of per-P batch of events [pid, ticks]
+	EvFrequency     = 2  // ticks per second [frequency]
+	EvStack         = 3  // stack [stack id, number of frames, {pc, func string id, file string id, line}...]
+	EvString        = 4  // string [string id, length, bytes]
+	EvGomaxprocs    = 5  // current value of GOMAXPROCS [ticks, GOMAXPROCS, stack id]
+	EvProcStart     = 6  // P starts running on an M [ticks, thread id]
+	EvProcStop      = 7  // P stops [ticks]
+	EvGCStart       = 8  // GC starts [ticks, stack id]
+	EvGCDone        = 9  // GC is done [ticks]
+	EvGCSweepStart  = 10 // GC sweep starts [ticks]
+	EvGCSweepDone   = 11 // GC sweep is done [ticks]
+	EvGoCreate      = 12 // goroutine creation [ticks, new goroutine id, start stack id, stack id]
+	EvGoStart       = 13 // goroutine starts running [ticks, goroutine id]
+	EvGoEnd         = 14 // goroutine ends [ticks]
+	EvGoStop        = 15 // goroutine stops forever, as in select{} [ticks, stack id]
+	EvGoSched       = 16 // goroutine calls Gosched [ticks, stack id]
+	EvGoPreempt     = 17 // goroutine is preempted [ticks, stack id]
+	EvGoSleep       = 18 // goroutine calls Sleep [ticks, stack id]
+	EvGoBlock       = 19 // goroutine blocks [ticks, stack id]
+	EvGoUnblock     = 20 // goroutine is unblocked [ticks, goroutine id, stack id]
+	EvGoBlockSend   = 21 // goroutine blocks on chan send [ticks, stack id]
+	EvGoBlockRecv   = 22 // goroutine blocks on chan recv [ticks, stack id]
+	EvGoBlockSelect = 23 // goroutine blocks on select [ticks, stack id]
+	EvGoBlockSync   = 24 // goroutine blocks on Mutex/RWMutex/WaitGroup [ticks, stack id]
+	EvGoBlockCond   = 25 // goroutine blocks on Cond [ticks, stack id]
+	EvGoBlockNet    = 26 // goroutine blocks on network [ticks, stack id]
+	EvGoSysCall     = 27 // goroutine enters a system call [ticks, stack id]
+	EvGoSysExit     = 28 // goroutine that lost its P returns from a system call [ticks, goroutine id]
+	EvGoSysBlock    = 29 // goroutine blocks in a system call and loses its P [ticks]
+	EvGoWaiting     = 30 // goroutine was blocked when tracing started [ticks, goroutine id]
+	EvGoInSyscall   = 31 // goroutine was in a system call when tracing started [ticks, goroutine id]
+	EvHeapAlloc     = 32 // heap size changed [ticks, heap_alloc]
+	EvNextGC        = 33 // heap size that triggers the next GC changed [ticks, next_gc]
+	EvCount         = 34
+)
+
+// EventDescriptions describes each event type: its name, whether it
+// records a stack, and the names of its arguments in Event.Args.
+var EventDescriptions = [EvCount]struct {
+	Name  string
+	Stack bool
+	Args  []string
+}{
+	EvNone:          {"None", false, nil},
+	EvBatch:         {"Batch", false, []string{"p", "ticks"}},
+	EvFrequency:     {"Frequency", false, []string{"freq"}},
+	EvStack:         {"Stack", false, []string{"id", "size"}},
+	EvString:        {"String", false, nil},
+	EvGomaxprocs:    {"Gomaxprocs", true, []string{"procs"}},
+	EvProcStart:     {"ProcStart", false, []string{"thread"}},
+	EvProcStop:      {"ProcStop", false, nil},
+	EvGCStart:       {"GCStart", true, nil},
+	EvGCDone:        {"GCDone", false, nil},
+	EvGCSweepStart:  {"GCSweepStart", false, nil},
+	EvGCSweepDone:   {"GCSweepDone", false, nil},
+	EvGoCreate:      {"GoCreate", true, []string{"g", "pc"}},
+	EvGoStart:       {"GoStart", false, []string{"g"}},
+	EvGoEnd:         {"GoEnd", false, nil},
+	EvGoStop:        {"GoStop", true, nil},
+	EvGoSched:       {"GoSched", true, nil},
+	EvGoPreempt:     {"GoPreempt", true, nil},
+	EvGoSleep:       {"GoSleep", true, nil},
+	EvGoBlock:       {"GoBlock", true, nil},
+	EvGoUnblock:     {"GoUnblock", true, []string{"g"}},
+	EvGoBlockSend:   {"GoBlockSend", true, nil},
+	EvGoBlockRecv:   {"GoBlockRecv", true, nil},
+	EvGoBlockSelect: {"GoBlockSelect", true, nil},
+	EvGoBlockSync:   {"GoBlockSync", true, nil},
+	EvGoBlockCond:   {"GoBlockCond", true, nil},
+	EvGoBlockNet:    {"GoBlockNet", true, nil},
+	EvGoSysCall:     {"GoSysCall", true, nil},
+	EvGoSysExit:     {"GoSysExit", false, []string{"g"}},
+	EvGoSysBlock:    {"GoSysBlock", false, nil},
+	EvGoWaiting:     {"GoWaiting", false, []string{"g"}},
+	EvGoInSyscall:   {"GoInSyscall", false, []string{"g"}},
+	EvHeapAlloc:     {"HeapAlloc", false, []string{"mem"}},
+	EvNextGC:        {"NextGC", false, []string{"mem"}},
+}
+
+// NoP is the P of events that happened on a thread without a P,
+// such as a goroutine's return from a system call that lost its P.
+const NoP = -1
+
+// An Event is one event in a trace.
+type Event struct {
+	Off   int       // offset in the trace, for error messages
+	Type  byte      // one of the Ev constants
+	Ts    int64     // nanoseconds since the first event in the trace
+	P     int       // P on which the event happened, or NoP
+	G     uint64    // goroutine running when the event happened, or 0
+	StkID uint64    // id of the stack recorded with the event, or 0
+	Stk   []*Frame  // stack recorded with the event, innermost frame first
+	Args  [2]uint64 // arguments, as named in EventDescriptions
+	Fn    string    // for GoCreate, the function of the new goroutine
+
+	// Link is the event that ends the state this event starts, if any:
+	// the GCDone for a GCStart, the GCSweepDone for a GCSweepStart,
+	// the event that stops a goroutine for its GoStart, the GoUnblock
+	// for a blocking event, the GoSysExit for a GoSysBlock, and the
+	// next GoStart of the goroutine for a GoCreate, GoSched, GoPreempt,
+	// GoUnblock or GoSysExit.
+	Link *Event
+}
+
+// A Frame is a frame of a stack recorded in a trace.
+type Frame struct {
+	PC   uint64
+	Fn   string
+	File string
+	Line int
+}
+
+func (e *Event) String() string {
+	desc := EventDescriptions[e.Type]
+	s := fmt.Sprintf("%d %s p=%d g=%d off=%d", e.Ts, desc.Name, e.P, e.G, e.Off)
+	for i, name := range desc.Args {
+		s += fmt.Sprintf(" %s=%d", name, e.Args[i])
+	}
+	return s
+}
+
+// Parse reads the trace from r and returns its events in time order,
+// with their stacks and goroutines filled in and related events linked.
+func Parse(r io.Reader) ([]*Event, error) {
+	raw, strs, err := readTrace(r)
+	if err != nil {
+		return nil, err
+	}
+	events, err := parseEvents(raw, strs)
+	if err != nil {
+		return nil, err
+	}
+	link(events)
+	return events, nil
+}
+
+const header = "go 1.3 trace\x00\x00\x00\x00"
+
+// A rawEvent is an event as read from the trace, before its
+// timestamp is resolved.
+type rawEvent struct {
+	off  int
+	typ  byte
+	args []uint64
+}
+
+// readTrace splits the trace into raw events and the string table.
+func readTrace(r io.Reader) ([]rawEvent, map[uint64]string, error) {
+	br := bufio.NewReader(r)
+	var hdr [len(header)]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return nil, nil, fmt.Errorf("trace: failed to read header: %v", err)
+	}
+	if string(hdr[:]) != header {
+		return nil, nil, fmt.Errorf("trace: not a trace file")
+	}
+	off := len(header)
+	strs := make(map[uint64]string)
+	var events []rawEvent
+	for {
+		off0 := off
+		b, err := br.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		off++
+		typ := b & 0x3f
+		if typ == EvNone || typ >= EvCount {
+			return nil, nil, fmt.Errorf("trace: unknown event type %d at offset 0x%x", typ, off0)
+		}
+		if typ == EvString {
+			var id, n uint64
+			if id, off, err = readVal(br, off); err != nil {
+				return nil, nil, err
+			}
+			if n, off, err = readVal(br, off); err != nil {
+				return nil, nil, err
+			}
+			if n > 1<<20 {
+				return nil, nil, fmt.Errorf("trace: string at offset 0x%x is too long", off0)
+			}
+			buf := make([]byte, n)
+			if _, err := io.ReadFull(br, buf); err != nil {
+				return nil, nil, fmt.Errorf("trace: failed to read string at offset 0x%x: %v", off0, err)
+			}
+			off += int(n)
+			strs[id] = string(buf)
+			continue
+		}
+		ev := rawEvent{off: off0, typ: typ}
+		if narg := int(b >> 6); narg < 3 {
+			for i := 0; i < narg; i++ {
+				var v uint64
+				if v, off, err = readVal(br, off); err != nil {
+					return nil, nil, err
+				}
+				ev.args = append(ev.args, v)
+			}
+		} else {
+			var n uint64
+			if n, off, err = readVal(br, off); err != nil {
+				return nil, nil, err
+			}
+			end := off + int(n)
+			for off < end {
+				var v uint64
+				if v, off, err = readVal(br, off); err != nil {
+					return nil, nil, err
+				}
+				ev.args = append(ev.args, v)
+			}
+			if off != end {
+				return nil, nil, fmt.Errorf("trace: event at offset 0x%x has wrong length", off0)
+			}
+		}
+		events = append(events, ev)
+	}
+	return events, strs, nil
+}
+
+// readVal reads an unsigned varint from r, which is at offset off.
+func readVal(r io.ByteReader, off int) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < 10; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, off, fmt.Errorf("trace: failed to read value at offset 0x%x: %v", off, err)
+		}
+		off++
+		v |= uint64(b&0x7f) << (uint(i) * 7)
+		if b&0x80 == 0 {
+			return v, off, nil
+		}
+	}
+	return 0, off, fmt.Errorf("trace: bad value at offset 0x%x", off)
+}
+
+// parseEvents resolves the timestamps, Ps and stacks of the raw
+// events and sorts them by time.
+func parseEvents(raw []rawEvent, strs map[uint64]string) ([]*Event, error) {
+	var (
+		events  []*Event
+		stacks  = make(map[uint64][]*Frame)
+		freq    int64
+		inBatch bool
+		batchP  int
+		ticks   int64
+	)
+	for _, r := range raw {
+		desc := EventDescriptions[r.typ]
+		switch r.typ {
+		case EvBatch:
+			if len(r.args) != 2 {
+				return nil, fmt.Errorf("trace: malformed Batch event at offset 0x%x", r.off)
+			}
+			inBatch = true
+			batchP = int(int64(r.args[0]))
+			ticks = int64(r.args[1])
+		case EvFrequency:
+			if len(r.args) != 1 || int64(r.args[0]) <= 0 {
+				return nil, fmt.Errorf("trace: malformed Frequency event at offset 0x%x", r.off)
+			}
+			freq = int64(r.args[0])
+		case EvStack:
+			if len(r.args) < 2 || uint64(len(r.args)) != 2+4*r.args[1] {
+				return nil, fmt.Errorf("trace: malformed Stack event at offset 0x%x", r.off)
+			}
+			n := int(r.args[1])
+			stk := make([]*Frame, n)
+			for i := range stk {
+				a := r.args[2+4*i:]
+				stk[i] = &Frame{PC: a[0], Fn: strs[a[1]], File: strs[a[2]], Line: int(a[3])}
+			}
+			stacks[r.args[0]] = stk
+		default:
+			if !inBatch {
+				return nil, fmt.Errorf("trace: %s event at offset 0x%x is not in a batch", desc.Name, r.off)
+			}
+			narg := 1 + len(desc.Args)
+			if desc.Stack {
+				narg++
+			}
+			if len(r.args) != narg {
+				return nil, fmt.Errorf("trace: %s event at offset 0x%x has %d arguments, want %d",
+					desc.Name, r.off, len(r.args), narg)
+			}
+			ticks += int64(r.args[0])
+			ev := &Event{Off: r.off, Type: r.typ, P: batchP, Ts: ticks}
+			copy(ev.Args[:], r.args[1:1+len(desc.Args)])
+			if desc.Stack {
+				ev.StkID = r.args[narg-1]
+			}
+			events = append(events, ev)
+		}
+	}
+	if freq == 0 {
+		return nil, fmt.Errorf("trace: no Frequency event; the trace is incomplete")
+	}
+
+	sort.Stable(eventList(events))
+	if len(events) == 0 {
+		return events, nil
+	}
+	start := events[0].Ts
+	for _, ev := range events {
+		ev.Ts = int64(float64(ev.Ts-start) * 1e9 / float64(freq))
+		if ev.StkID != 0 {
+			ev.Stk = stacks[ev.StkID]
+		}
+		if ev.Type == EvGoCreate {
+			// The start stack is the goroutine's function.
+			if stk := stacks[ev.Args[1]]; len(stk) > 0 {
+				ev.Fn = stk[0].Fn
+				ev.Args[1] = stk[0].PC
+			} else {
+				ev.Args[1] = 0
+			}
+		}
+	}
+	return events, nil
+}
+
+// link sets the goroutine of each event and links related events.
+// Timestamps on different Ps need not be exactly consistent, so the
+// sequence of events for a goroutine is not checked.
+func link(events []*Event) {
+	// Per-goroutine state: the event waiting for a Link.
+	gs := make(map[uint64]*Event)
+	// The goroutine running on each P.
+	ps := make(map[int]uint64)
+	var gc, sweep *Event
+
+	setLink := func(g uint64, ev *Event) {
+		if prev := gs[g]; prev != nil && prev.Link == nil {
+			prev.Link = ev
+		}
+	}
+	for _, ev := range events {
+		ev.G = ps[ev.P]
+		switch ev.Type {
+		case EvProcStart, EvProcStop:
+			ev.G = 0
+			delete(ps, ev.P)
+		case EvGCStart:
+			gc = ev
+		case EvGCDone:
+			if gc != nil {
+				gc.Link = ev
+				gc = nil
+			}
+		case EvGCSweepStart:
+			if sweep == nil {
+				sweep = ev
+			}
+		case EvGCSweepDone:
+			if sweep != nil {
+				sweep.Link = ev
+				sweep = nil
+			}
+		case EvGoCreate:
+			gs[ev.Args[0]] = ev
+		case EvGoWaiting, EvGoInSyscall:
+			ev.G = ev.Args[0]
+			gs[ev.G] = nil
+		case EvGoStart:
+			ev.G = ev.Args[0]
+			setLink(ev.G, ev)
+			gs[ev.G] = ev
+			ps[ev.P] = ev.G
+		case EvGoEnd, EvGoStop:
+			setLink(ev.G, ev)
+			delete(gs, ev.G)
+			delete(ps, ev.P)
+		case EvGoSched, EvGoPreempt, EvGoSleep, EvGoBlock, EvGoBlockSend, EvGoBlockRecv,
+			EvGoBlockSelect, EvGoBlockSync, EvGoBlockCond, EvGoBlockNet, EvGoSysBlock:
+			setLink(ev.G, ev)
+			gs[ev.G] = ev
+			delete(ps, ev.P)
+		case EvGoUnblock, EvGoSysExit:
+			g := ev.Args[0]
+			if ev.Type == EvGoSysExit {
+				ev.G = g
+			}
+			setLink(g, ev)
+			gs[g] = ev
+		}
+	}
+}
+
+type eventList []*Event
+
+func (l eventList) Len() int           { return len(l) }
+func (l eventList) Less(i, j int) bool { return l[i].Ts < l[j].Ts }
+func (l eventList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
@@ -0,0 +1,197 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace_test
+
+import (
+	"bytes"
+	. "runtime/trace"
+	"strings"
+	"testing"
+)
+
+// A traceWriter encodes events the way the runtime does.
+type traceWriter struct {
+	bytes.Buffer
+}
+
+func newTraceWriter() *traceWriter {
+	w := new(traceWriter)
+	w.WriteString(Header)
+	return w
+}
+
+func (w *traceWriter) varint(v uint64) {
+	for ; v >= 0x80; v >>= 7 {
+		w.WriteByte(0x80 | byte(v))
+	}
+	w.WriteByte(byte(v))
+}
+
+func (w *traceWriter) ev(typ byte, args ...uint64) {
+	if len(args) < 3 {
+		w.WriteByte(typ | byte(len(args))<<6)
+		for _, a := range args {
+			w.varint(a)
+		}
+		return
+	}
+	var body traceWriter
+	for _, a := range args {
+		body.varint(a)
+	}
+	w.WriteByte(typ | 3<<6)
+	w.varint(uint64(body.Len()))
+	w.Write(body.Bytes())
+}
+
+func (w *traceWriter) str(id uint64, s string) {
+	w.WriteByte(EvString)
+	w.varint(id)
+	w.varint(uint64(len(s)))
+	w.WriteString(s)
+}
+
+// testTrace is a trace of goroutine 1 creating goroutine 2, which
+// blocks on a channel until goroutine 1 unblocks it, on two Ps with
+// a tick frequency of 1GHz.  Timestamps are in ticks.
+func testTrace() []byte {
+	w := newTraceWriter()
+	w.ev(EvBatch, 0, 1000)
+	w.ev(EvGoCreate, 0, 1, 2, 0)   // 1000: goroutine 1 existed at the start
+	w.ev(EvProcStart, 0, 7)        // 1000
+	w.ev(EvGoStart, 0, 1)          // 1000
+	w.ev(EvGoCreate, 100, 2, 2, 1) // 1100
+	w.ev(EvGCStart, 100, 1)        // 1200
+	w.ev(EvGCDone, 300)            // 1500
+	w.ev(EvGoUnblock, 500, 2, 1)   // 2000
+	w.ev(EvGoEnd, 100)             // 2100
+	w.ev(EvProcStop, 0)            // 2100
+	w.ev(EvBatch, 1, 1150)
+	w.ev(EvProcStart, 0, 8)     // 1150
+	w.ev(EvGoStart, 50, 2)      // 1200
+	w.ev(EvGoBlockRecv, 100, 3) // 1300
+	w.ev(EvProcStop, 0)         // 1300
+	w.ev(EvBatch, uint64(1<<64-1), 2500)
+	w.ev(EvGoSysExit, 0, 2) // 2500
+	w.str(1, "main.main")
+	w.str(2, "main.f")
+	w.str(3, "main.go")
+	w.str(4, "runtime.chanrecv1")
+	w.ev(EvStack, 1, 1, 0x1010, 1, 3, 10)
+	w.ev(EvStack, 2, 1, 0x2000, 2, 3, 20)
+	w.ev(EvStack, 3, 2, 0x3010, 4, 0, 0, 0x2020, 2, 3, 22)
+	w.ev(EvFrequency, 1e9)
+	return w.Bytes()
+}
+
+func TestParse(t *testing.T) {
+	events, err := Parse(bytes.NewReader(testTrace()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		typ byte
+		ts  int64
+		p   int
+		g   uint64
+	}{
+		{EvGoCreate, 0, 0, 0},
+		{EvProcStart, 0, 0, 0},
+		{EvGoStart, 0, 0, 1},
+		{EvGoCreate, 100, 0, 1},
+		{EvProcStart, 150, 1, 0},
+		{EvGCStart, 200, 0, 1},
+		{EvGoStart, 200, 1, 2},
+		{EvGoBlockRecv, 300, 1, 2},
+		{EvProcStop, 300, 1, 0},
+		{EvGCDone, 500, 0, 1},
+		{EvGoUnblock, 1000, 0, 1},
+		{EvGoEnd, 1100, 0, 1},
+		{EvProcStop, 1100, 0, 0},
+		{EvGoSysExit, 1500, NoP, 2},
+	}
+	if len(events) != len(want) {
+		for _, ev := range events {
+			t.Log(ev)
+		}
+		t.Fatalf("got %d events, want %d", len(events), len(want))
+	}
+	for i, w := range want {
+		ev := events[i]
+		if ev.Type != w.typ || ev.Ts != w.ts || ev.P != w.p || ev.G != w.g {
+			t.Errorf("event %d: got %v, want %s at %d on p %d g %d",
+				i, ev, EventDescriptions[w.typ].Name, w.ts, w.p, w.g)
+		}
+	}
+
+	create := events[3]
+	if create.Fn != "main.f" || create.Args[0] != 2 || create.Args[1] != 0x2000 {
+		t.Errorf("GoCreate: Fn=%q Args=%v, want main.f, [2 0x2000]", create.Fn, create.Args)
+	}
+	if len(create.Stk) != 1 || *create.Stk[0] != (Frame{0x1010, "main.main", "main.go", 10}) {
+		t.Errorf("GoCreate stack = %v", create.Stk)
+	}
+	block := events[7]
+	if len(block.Stk) != 2 || block.Stk[0].Fn != "runtime.chanrecv1" || block.Stk[1].Line != 22 {
+		t.Errorf("GoBlockRecv stack = %v", block.Stk)
+	}
+
+	links := map[int]int{0: 2, 3: 6, 6: 7, 7: 10, 5: 9, 2: 11}
+	for from, to := range links {
+		if events[from].Link != events[to] {
+			t.Errorf("%v links to %v, want %v", events[from], events[from].Link, events[to])
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	data := testTrace()
+	tests := []struct {
+		data []byte
+		err  string
+	}{
+		{[]byte("go 1.2 trace\x00\x00\x00\x00"), "not a trace file"},
+		{data[:10], "failed to read header"},
+		{data[:len(data)-3], "failed to read value"},
+		{append(append([]byte{}, data[:len(Header)]...), EvGoStart|1<<6, 0), "not in a batch"},
+		{append(append([]byte{}, data[:len(Header)]...), EvBatch|2<<6, 0, 0), "no Frequency event"},
+		{append(append([]byte{}, data[:len(Header)]...), EvBatch|2<<6, 0, 0, EvGoStart|1<<6, 0), "has 1 arguments, want 2"},
+		{append(append([]byte{}, data[:len(Header)]...), 63), "unknown event type"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(bytes.NewReader(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Parse(%q) = %v, want error containing %q", tt.data, err, tt.err)
+		}
+	}
+}
+
+func TestGoroutineStats(t *testing.T) {
+	events, err := Parse(bytes.NewReader(testTrace()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gs := GoroutineStats(events)
+	g1 := gs[1]
+	if g1 == nil || g1.ExecTime != 1100 || g1.EndTime != 1100 || g1.GCTime != 300 {
+		t.Errorf("goroutine 1: %+v", g1)
+	}
+	g2 := gs[2]
+	want := GDesc{
+		ID:            2,
+		Name:          "main.f",
+		PC:            0x2000,
+		CreationTime:  100,
+		StartTime:     200,
+		ExecTime:      100,
+		SchedWaitTime: 100 + 500,
+		BlockTime:     700,
+		GCTime:        300,
+		TotalTime:     1400,
+	}
+	if g2 == nil || *g2 != want {
+		t.Errorf("goroutine 2: got %+v, want %+v", g2, want)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package trace writes and reads execution traces of Go programs.
+//
+// An execution trace records, with timestamps, the events that shape
+// a program's latency but that profiles average away: goroutine
+// creation, blocking and unblocking, system calls, garbage collection
+// and sweeping, heap size changes, and processors starting and
+// stopping.  Most events also record the stack of the goroutine that
+// caused them.
+//
+// Start and Stop trace the current program; the testing package's
+// -test.trace flag and the /debug/pprof/trace handler in
+// net/http/pprof use them.  Parse decodes a trace, and GoroutineStats
+// summarizes the time each goroutine spent in each state.  The go
+// tool trace command serves a timeline and per-goroutine analysis of
+// a trace file.
+package trace
+
+import (
+	"io"
+	"runtime"
+	"sync"
+)
+
+var tracing struct {
+	sync.Mutex
+	enabled bool
+	done    chan bool
+}
+
+// Start enables tracing for the current program.
+// While tracing, the trace will be buffered and written to w.
+// Start returns an error if tracing is already enabled.
+func Start(w io.Writer) error {
+	tracing.Lock()
+	defer tracing.Unlock()
+
+	if err := runtime.StartTrace(); err != nil {
+		return err
+	}
+	tracing.done = make(chan bool)
+	go func() {
+		for {
+			data := runtime.ReadTrace()
+			if data == nil {
+				break
+			}
+			w.Write(data)
+		}
+		tracing.done <- true
+	}()
+	tracing.enabled = true
+	return nil
+}
+
+// Stop stops the current tracing, if any.
+// Stop only returns after all the writes for the trace have completed.
+func Stop() {
+	tracing.Lock()
+	defer tracing.Unlock()
+
+	if !tracing.enabled {
+		return
+	}
+	runtime.StopTrace()
+	<-tracing.done
+	tracing.enabled = false
+}
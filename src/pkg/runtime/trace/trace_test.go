@@ -0,0 +1,158 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace_test
+
+import (
+	"bytes"
+	"runtime"
+	. "runtime/trace"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTraceStartStop(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := Start(buf); err != nil {
+		t.Fatalf("failed to start tracing: %v", err)
+	}
+	Stop()
+	size := buf.Len()
+	if size == 0 {
+		t.Fatalf("trace is empty")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if size != buf.Len() {
+		t.Fatalf("trace writes after stop: %v -> %v", size, buf.Len())
+	}
+	if _, err := Parse(buf); err != nil {
+		t.Fatalf("failed to parse trace: %v", err)
+	}
+}
+
+func TestTraceDoubleStart(t *testing.T) {
+	Stop()
+	buf := new(bytes.Buffer)
+	if err := Start(buf); err != nil {
+		t.Fatalf("failed to start tracing: %v", err)
+	}
+	if err := Start(buf); err == nil {
+		t.Fatalf("succeed to start tracing second time")
+	}
+	Stop()
+	Stop()
+}
+
+func traceWork() {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	c := make(chan int)
+	done := make(chan bool)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			time.Sleep(time.Millisecond)
+			mu.Unlock()
+			c <- 1
+		}()
+	}
+	go func() {
+		for i := 0; i < 4; i++ {
+			<-c
+		}
+		done <- true
+	}()
+	wg.Wait()
+	<-done
+	runtime.GC()
+	runtime.Gosched()
+}
+
+func TestTraceEvents(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := Start(buf); err != nil {
+		t.Fatalf("failed to start tracing: %v", err)
+	}
+	traceWork()
+	Stop()
+
+	events, err := Parse(buf)
+	if err != nil {
+		t.Fatalf("failed to parse trace: %v", err)
+	}
+	seen := make(map[byte]bool)
+	created := make(map[uint64]bool) // goroutines started by traceWork
+	for _, ev := range events {
+		seen[ev.Type] = true
+		if ev.Type != EvGoCreate {
+			continue
+		}
+		for _, f := range ev.Stk {
+			if strings.HasSuffix(f.Fn, ".traceWork") {
+				if !strings.HasSuffix(f.File, "trace_test.go") || f.Line == 0 {
+					t.Errorf("bad frame in GoCreate stack: %+v", f)
+				}
+				created[ev.Args[0]] = true
+			}
+		}
+	}
+	if len(created) != 5 {
+		t.Errorf("found %d goroutines started by traceWork, want 5", len(created))
+	}
+	for _, typ := range []byte{EvProcStart, EvGoCreate, EvGoStart, EvGoEnd, EvGoBlockRecv,
+		EvGoBlockSync, EvGoSleep, EvGoUnblock, EvGoSched, EvGCStart, EvGCDone, EvHeapAlloc, EvGomaxprocs} {
+		if !seen[typ] {
+			t.Errorf("no %s event in trace", EventDescriptions[typ].Name)
+		}
+	}
+
+	stats := GoroutineStats(events)
+	for id := range created {
+		g := stats[id]
+		if g == nil {
+			t.Errorf("no stats for goroutine %d", id)
+			continue
+		}
+		if g.Name == "" || g.EndTime == 0 || g.ExecTime <= 0 || g.TotalTime < g.ExecTime {
+			t.Errorf("bad stats for goroutine %d: %+v", id, g)
+		}
+	}
+}
+
+func TestTraceStress(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := Start(buf); err != nil {
+		t.Fatalf("failed to start tracing: %v", err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				_ = make([]byte, 1<<10)
+				runtime.Gosched()
+			}
+		}()
+	}
+	// Stop and restart tracing while the goroutines run.
+	time.Sleep(10 * time.Millisecond)
+	Stop()
+	if _, err := Parse(buf); err != nil {
+		t.Fatalf("failed to parse trace: %v", err)
+	}
+	buf.Reset()
+	if err := Start(buf); err != nil {
+		t.Fatalf("failed to restart tracing: %v", err)
+	}
+	wg.Wait()
+	Stop()
+	if _, err := Parse(buf); err != nil {
+		t.Fatalf("failed to parse restarted trace: %v", err)
+	}
+}
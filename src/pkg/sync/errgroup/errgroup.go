@@ -0,0 +1,131 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package errgroup provides synchronization, error propagation and
+// cancellation for groups of goroutines working on subtasks of a
+// common task.
+package errgroup
+
+import "sync"
+
+// A Group is a collection of goroutines working on subtasks that are
+// part of the same overall task.
+//
+// The first subtask to return a non-nil error cancels the group:
+// the channel returned by Done is closed, and subtasks that watch it
+// should give up early.
+//
+// A zero Group is valid and has no limit on the number of active
+// goroutines.
+type Group struct {
+	wg sync.WaitGroup
+
+	mu   sync.Mutex
+	err  error
+	done chan struct{} // created lazily, closed by cancel
+	sem  chan struct{} // nil means no limit
+
+	errOnce sync.Once
+}
+
+// Done returns a channel that is closed when the group is canceled:
+// when a subtask first returns a non-nil error, or when Wait returns,
+// whichever happens first.
+func (g *Group) Done() <-chan struct{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.done == nil {
+		g.done = make(chan struct{})
+	}
+	return g.done
+}
+
+// cancel closes the Done channel if it is not already closed.
+func (g *Group) cancel() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.done == nil {
+		g.done = make(chan struct{})
+	}
+	select {
+	case <-g.done:
+	default:
+		close(g.done)
+	}
+}
+
+// Wait blocks until all function calls from the Go method have
+// returned, then returns the first non-nil error (if any) from them.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	return g.err
+}
+
+// Go calls the given function in a new goroutine.
+// It blocks until the new goroutine can be added without the number
+// of active goroutines in the group exceeding the configured limit.
+//
+// The first call to return a non-nil error cancels the group; its
+// error will be returned by Wait.
+func (g *Group) Go(f func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.wg.Add(1)
+	go g.run(f)
+}
+
+// TryGo calls the given function in a new goroutine only if the
+// number of active goroutines in the group is currently below the
+// configured limit.  The return value reports whether the goroutine
+// was started.
+func (g *Group) TryGo(f func() error) bool {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+	g.wg.Add(1)
+	go g.run(f)
+	return true
+}
+
+func (g *Group) run(f func() error) {
+	defer g.release()
+	if err := f(); err != nil {
+		g.errOnce.Do(func() {
+			g.err = err
+			g.cancel()
+		})
+	}
+}
+
+func (g *Group) release() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+// SetLimit limits the number of active goroutines in this group to
+// at most n.  A negative value indicates no limit.
+//
+// Any subsequent call to the Go method will block until it can add
+// an active goroutine without exceeding the configured limit.
+//
+// The limit must not be modified while any goroutines in the group
+// are active.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	if len(g.sem) != 0 {
+		panic("errgroup: modify limit while goroutines in the group are still active")
+	}
+	g.sem = make(chan struct{}, n)
+}
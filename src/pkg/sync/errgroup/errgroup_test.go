@@ -0,0 +1,135 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errgroup_test
+
+import (
+	"errors"
+	"sync/atomic"
+	. "sync/errgroup"
+	"testing"
+	"time"
+)
+
+func TestZeroGroup(t *testing.T) {
+	err1 := errors.New("errgroup_test: 1")
+	err2 := errors.New("errgroup_test: 2")
+
+	cases := []struct {
+		errs []error
+	}{
+		{errs: []error{}},
+		{errs: []error{nil}},
+		{errs: []error{err1}},
+		{errs: []error{err1, nil}},
+		{errs: []error{err1, nil, err2}},
+	}
+
+	for _, tc := range cases {
+		g := new(Group)
+
+		var firstErr error
+		for i, err := range tc.errs {
+			err := err
+			g.Go(func() error { return err })
+
+			if firstErr == nil && err != nil {
+				firstErr = err
+			}
+
+			if gErr := g.Wait(); gErr != firstErr {
+				t.Errorf("after %T.Go(func() error { return err }) for err in %v\n"+
+					"g.Wait() = %v; want %v",
+					g, tc.errs[:i+1], gErr, firstErr)
+			}
+		}
+	}
+}
+
+func TestGroupCancel(t *testing.T) {
+	errDoom := errors.New("group_test: doomed")
+
+	var g Group
+	var canceled int32
+	for i := 0; i < 4; i++ {
+		g.Go(func() error {
+			select {
+			case <-g.Done():
+				atomic.AddInt32(&canceled, 1)
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("not canceled")
+			}
+		})
+	}
+	g.Go(func() error { return errDoom })
+
+	if err := g.Wait(); err != errDoom {
+		t.Fatalf("Wait() = %v; want %v", err, errDoom)
+	}
+	if canceled != 4 {
+		t.Fatalf("%d goroutines saw the cancellation; want 4", canceled)
+	}
+}
+
+func TestGroupDoneAfterWait(t *testing.T) {
+	var g Group
+	g.Go(func() error { return nil })
+	select {
+	case <-g.Done():
+		// The only goroutine may already be done, but Done must
+		// not close until Wait is called or a goroutine fails.
+		t.Fatal("Done closed before Wait")
+	default:
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-g.Done():
+	default:
+		t.Fatal("Done not closed after Wait")
+	}
+}
+
+func TestGroupLimit(t *testing.T) {
+	for _, limit := range []int{1, 2, 4, 8} {
+		var g Group
+		g.SetLimit(limit)
+		var active int32
+		for i := 0; i <= 1<<10; i++ {
+			g.Go(func() error {
+				n := atomic.AddInt32(&active, 1)
+				defer atomic.AddInt32(&active, -1)
+				if n > int32(limit) {
+					return errors.New("too many active goroutines")
+				}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			t.Errorf("limit %d: %v", limit, err)
+		}
+	}
+}
+
+func TestGroupTryGo(t *testing.T) {
+	var g Group
+	g.SetLimit(1)
+	release := make(chan struct{})
+	if !g.TryGo(func() error { <-release; return nil }) {
+		t.Fatal("TryGo failed on an idle group")
+	}
+	if g.TryGo(func() error { return nil }) {
+		t.Fatal("TryGo succeeded beyond the limit")
+	}
+	close(release)
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if !g.TryGo(func() error { return nil }) {
+		t.Fatal("TryGo failed after the group drained")
+	}
+	g.Wait()
+}
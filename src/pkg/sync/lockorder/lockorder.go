@@ -0,0 +1,309 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lockorder provides mutexes that check, when asked to,
+// that locks are always acquired in a consistent order.
+//
+// A program that acquires lock A while holding lock B in one place,
+// and B while holding A in another, can deadlock even if no test
+// happens to interleave the two.  With checking enabled, every
+// acquisition of a Mutex or RWMutex while other locks are held records
+// an ordering between the locks' classes, and acquiring them in an
+// order that contradicts one seen before, directly or through other
+// locks, is reported as a Violation before the lock is taken.
+//
+// Locks with the same non-empty Name belong to the same class; a lock
+// with no Name is a class of its own, identified by its address, so
+// locks that are allocated and freed repeatedly should be named.
+//
+// Checking is off by default: Mutex and RWMutex then behave like their
+// counterparts in package sync, at the cost of an atomic load.  It is
+// meant for tests, which turn it on with Enable, typically in an init
+// function in a _test.go file.
+package lockorder
+
+import (
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// A Mutex is a sync.Mutex whose acquisition order is checked
+// when checking is enabled.
+type Mutex struct {
+	Name string // lock class; if empty, the Mutex is its own class
+	mu   sync.Mutex
+}
+
+// Lock locks m.
+func (m *Mutex) Lock() {
+	if atomic.LoadInt32(&enabled) != 0 {
+		acquire(m.Name, unsafe.Pointer(m))
+	}
+	m.mu.Lock()
+}
+
+// Unlock unlocks m.
+func (m *Mutex) Unlock() {
+	m.mu.Unlock()
+	if atomic.LoadInt32(&enabled) != 0 {
+		release(unsafe.Pointer(m))
+	}
+}
+
+// An RWMutex is a sync.RWMutex whose acquisition order is checked
+// when checking is enabled.  Read and write locks are checked alike.
+type RWMutex struct {
+	Name string // lock class; if empty, the RWMutex is its own class
+	rw   sync.RWMutex
+}
+
+// Lock locks rw for writing.
+func (rw *RWMutex) Lock() {
+	if atomic.LoadInt32(&enabled) != 0 {
+		acquire(rw.Name, unsafe.Pointer(rw))
+	}
+	rw.rw.Lock()
+}
+
+// Unlock unlocks rw for writing.
+func (rw *RWMutex) Unlock() {
+	rw.rw.Unlock()
+	if atomic.LoadInt32(&enabled) != 0 {
+		release(unsafe.Pointer(rw))
+	}
+}
+
+// RLock locks rw for reading.
+func (rw *RWMutex) RLock() {
+	if atomic.LoadInt32(&enabled) != 0 {
+		acquire(rw.Name, unsafe.Pointer(rw))
+	}
+	rw.rw.RLock()
+}
+
+// RUnlock undoes a single RLock call.
+func (rw *RWMutex) RUnlock() {
+	rw.rw.RUnlock()
+	if atomic.LoadInt32(&enabled) != 0 {
+		release(unsafe.Pointer(rw))
+	}
+}
+
+// A Violation reports that a lock was acquired in an order that
+// contradicts an order seen before.
+type Violation struct {
+	Held     string // class of a lock held by the goroutine
+	Acquired string // class of the lock being acquired
+	Stack    []byte // stack of the goroutine acquiring the lock
+
+	// PrevStack is the stack of the goroutine that, earlier, acquired
+	// a lock while holding one of class Acquired, establishing the
+	// first step of the order now contradicted.
+	PrevStack []byte
+}
+
+func (v *Violation) Error() string {
+	return "lockorder: acquiring " + v.Acquired + " while holding " + v.Held +
+		", but " + v.Held + " was earlier acquired after " + v.Acquired +
+		"\n\ncurrent goroutine:\n" + string(v.Stack) +
+		"\nearlier ordering established by:\n" + string(v.PrevStack)
+}
+
+var (
+	enabled int32 // accessed atomically
+
+	mu     sync.Mutex
+	report func(*Violation)
+	held   map[int64][]heldLock     // locks held, by goroutine ID
+	after  map[class]map[class]edge // after[a][b]: b acquired while a held
+)
+
+// A class identifies a lock class: a Name, or an unnamed lock.
+type class struct {
+	name string
+	lock unsafe.Pointer // nil if name is set
+}
+
+type heldLock struct {
+	c    class
+	lock unsafe.Pointer
+}
+
+type edge struct {
+	stack []byte
+}
+
+// Enable turns on lock order checking and calls f for each
+// violation found.  If f is nil, a violation causes a panic
+// with the *Violation as its value.
+func Enable(f func(*Violation)) {
+	mu.Lock()
+	defer mu.Unlock()
+	report = f
+	held = make(map[int64][]heldLock)
+	after = make(map[class]map[class]edge)
+	atomic.StoreInt32(&enabled, 1)
+}
+
+// Disable turns off lock order checking and forgets the orders seen.
+func Disable() {
+	mu.Lock()
+	defer mu.Unlock()
+	atomic.StoreInt32(&enabled, 0)
+	held, after = nil, nil
+}
+
+func classOf(name string, lock unsafe.Pointer) class {
+	if name != "" {
+		return class{name: name}
+	}
+	return class{lock: lock}
+}
+
+func (c class) String() string {
+	if c.name != "" {
+		return c.name
+	}
+	return "lock@0x" + strconv.FormatUint(uint64(uintptr(c.lock)), 16)
+}
+
+// acquire records that the current goroutine is about to acquire lock,
+// of class name, and reports a violation if that contradicts the
+// order of an earlier acquisition.
+func acquire(name string, lock unsafe.Pointer) {
+	c := classOf(name, lock)
+	id := goid()
+	var v *Violation
+	var f func(*Violation)
+
+	mu.Lock()
+	if held == nil {
+		// Disabled between the check and here.
+		mu.Unlock()
+		return
+	}
+	for _, h := range held[id] {
+		if h.c == c {
+			continue
+		}
+		if e, ok := path(c, h.c); ok {
+			if v == nil {
+				v = &Violation{Held: h.c.String(), Acquired: c.String(), Stack: stack(), PrevStack: e.stack}
+			}
+			continue
+		}
+		m := after[h.c]
+		if m == nil {
+			m = make(map[class]edge)
+			after[h.c] = m
+		}
+		if _, ok := m[c]; !ok {
+			m[c] = edge{stack()}
+		}
+	}
+	held[id] = append(held[id], heldLock{c, lock})
+	f = report
+	mu.Unlock()
+
+	if v != nil {
+		if f == nil {
+			panic(v)
+		}
+		f(v)
+	}
+}
+
+// release records that lock has been released.  The releasing
+// goroutine need not be the one that acquired it.
+func release(lock unsafe.Pointer) {
+	id := goid()
+	mu.Lock()
+	defer mu.Unlock()
+	if held == nil {
+		return
+	}
+	if removeHeld(id, lock) {
+		return
+	}
+	for other := range held {
+		if removeHeld(other, lock) {
+			return
+		}
+	}
+}
+
+// removeHeld removes the most recent acquisition of lock from the
+// locks held by goroutine id.  mu must be held.
+func removeHeld(id int64, lock unsafe.Pointer) bool {
+	hs := held[id]
+	for i := len(hs) - 1; i >= 0; i-- {
+		if hs[i].lock == lock {
+			hs = append(hs[:i], hs[i+1:]...)
+			if len(hs) == 0 {
+				delete(held, id)
+			} else {
+				held[id] = hs
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// path reports whether some goroutine has acquired a lock of class to
+// while holding one of class from, directly or through a chain of
+// other classes.  It returns the first edge of that chain.
+// mu must be held.
+func path(from, to class) (edge, bool) {
+	seen := map[class]bool{from: true}
+	type item struct {
+		c     class
+		first edge
+	}
+	var queue []item
+	for c, e := range after[from] {
+		queue = append(queue, item{c, e})
+	}
+	for len(queue) > 0 {
+		it := queue[0]
+		queue = queue[1:]
+		if it.c == to {
+			return it.first, true
+		}
+		if seen[it.c] {
+			continue
+		}
+		seen[it.c] = true
+		for c := range after[it.c] {
+			queue = append(queue, item{c, it.first})
+		}
+	}
+	return edge{}, false
+}
+
+func stack() []byte {
+	buf := make([]byte, 4096)
+	return buf[:runtime.Stack(buf, false)]
+}
+
+// goid returns the ID of the current goroutine, taken from the
+// header of its stack trace, "goroutine 18 [running]:".
+func goid() int64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	const prefix = "goroutine "
+	if len(b) < len(prefix) || string(b[:len(prefix)]) != prefix {
+		panic("lockorder: cannot parse goroutine ID")
+	}
+	b = b[len(prefix):]
+	var id int64
+	for len(b) > 0 && '0' <= b[0] && b[0] <= '9' {
+		id = id*10 + int64(b[0]-'0')
+		b = b[1:]
+	}
+	return id
+}
@@ -0,0 +1,166 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lockorder_test
+
+import (
+	"strings"
+	. "sync/lockorder"
+	"testing"
+)
+
+// record enables checking and returns a pointer to the violations
+// reported.  The caller must call Disable when done.
+func record() *[]*Violation {
+	var vs []*Violation
+	Enable(func(v *Violation) { vs = append(vs, v) })
+	return &vs
+}
+
+func TestConsistentOrder(t *testing.T) {
+	vs := record()
+	defer Disable()
+
+	a := &Mutex{Name: "a"}
+	b := &Mutex{Name: "b"}
+	c := &RWMutex{Name: "c"}
+	for i := 0; i < 3; i++ {
+		a.Lock()
+		b.Lock()
+		c.RLock()
+		c.RUnlock()
+		b.Unlock()
+		a.Unlock()
+
+		a.Lock()
+		c.Lock()
+		c.Unlock()
+		a.Unlock()
+	}
+	if len(*vs) != 0 {
+		t.Fatalf("consistent order reported as violation:\n%v", (*vs)[0])
+	}
+}
+
+func TestInversion(t *testing.T) {
+	vs := record()
+	defer Disable()
+
+	a := &Mutex{Name: "a"}
+	b := &Mutex{Name: "b"}
+	a.Lock()
+	b.Lock()
+	b.Unlock()
+	a.Unlock()
+
+	b.Lock()
+	a.Lock()
+	a.Unlock()
+	b.Unlock()
+
+	if len(*vs) != 1 {
+		t.Fatalf("got %d violations, want 1", len(*vs))
+	}
+	v := (*vs)[0]
+	if v.Held != "b" || v.Acquired != "a" {
+		t.Errorf("violation Held=%q Acquired=%q, want b, a", v.Held, v.Acquired)
+	}
+	if !strings.Contains(string(v.Stack), "TestInversion") || !strings.Contains(string(v.PrevStack), "TestInversion") {
+		t.Errorf("violation stacks do not mention the test:\n%v", v)
+	}
+}
+
+func TestTransitiveInversion(t *testing.T) {
+	vs := record()
+	defer Disable()
+
+	a := &Mutex{Name: "a"}
+	b := &RWMutex{Name: "b"}
+	c := &Mutex{Name: "c"}
+	a.Lock()
+	b.RLock()
+	b.RUnlock()
+	a.Unlock()
+
+	b.Lock()
+	c.Lock()
+	c.Unlock()
+	b.Unlock()
+
+	// a < b < c, so taking a while holding c inverts the order.
+	c.Lock()
+	a.Lock()
+	a.Unlock()
+	c.Unlock()
+
+	if len(*vs) != 1 {
+		t.Fatalf("got %d violations, want 1", len(*vs))
+	}
+	if v := (*vs)[0]; v.Held != "c" || v.Acquired != "a" {
+		t.Errorf("violation Held=%q Acquired=%q, want c, a", v.Held, v.Acquired)
+	}
+}
+
+func TestUnlockElsewhere(t *testing.T) {
+	vs := record()
+	defer Disable()
+
+	a := &Mutex{Name: "a"}
+	b := &Mutex{Name: "b"}
+	a.Lock()
+	done := make(chan bool)
+	go func() {
+		a.Unlock()
+		done <- true
+	}()
+	<-done
+
+	// a is no longer held, so this establishes no order.
+	b.Lock()
+	b.Unlock()
+	b.Lock()
+	a.Lock()
+	a.Unlock()
+	b.Unlock()
+	a.Lock()
+	a.Unlock()
+
+	if len(*vs) != 0 {
+		t.Fatalf("unexpected violation:\n%v", (*vs)[0])
+	}
+}
+
+func TestPanicByDefault(t *testing.T) {
+	Enable(nil)
+	defer Disable()
+
+	a := &Mutex{Name: "a"}
+	b := &Mutex{Name: "b"}
+	a.Lock()
+	b.Lock()
+	b.Unlock()
+	a.Unlock()
+
+	b.Lock()
+	defer b.Unlock()
+	defer func() {
+		if _, ok := recover().(*Violation); !ok {
+			t.Fatal("inversion did not panic with a *Violation")
+		}
+	}()
+	a.Lock()
+	a.Unlock()
+}
+
+func TestDisabled(t *testing.T) {
+	var a, b Mutex
+	a.Lock()
+	b.Lock()
+	b.Unlock()
+	a.Unlock()
+	b.Lock()
+	a.Lock()
+	a.Unlock()
+	b.Unlock()
+}
@@ -0,0 +1,207 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sync_test
+
+import (
+	"math/rand"
+	"runtime"
+	. "sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestMapMatchesMap applies the same random sequence of operations
+// to a Map and to a plain Go map and checks that they agree.
+func TestMapMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var m Map
+	ref := make(map[interface{}]interface{})
+	for i := 0; i < 10000; i++ {
+		k := r.Intn(64)
+		v := r.Int()
+		switch op := r.Intn(5); op {
+		case 0:
+			got, ok := m.Load(k)
+			want, wantok := ref[k]
+			if got != want || ok != wantok {
+				t.Fatalf("step %d: Load(%v) = %v, %v; want %v, %v", i, k, got, ok, want, wantok)
+			}
+		case 1:
+			m.Store(k, v)
+			ref[k] = v
+		case 2:
+			got, loaded := m.LoadOrStore(k, v)
+			want, wantloaded := ref[k]
+			if !wantloaded {
+				want = v
+				ref[k] = v
+			}
+			if got != want || loaded != wantloaded {
+				t.Fatalf("step %d: LoadOrStore(%v, %v) = %v, %v; want %v, %v", i, k, v, got, loaded, want, wantloaded)
+			}
+		case 3:
+			m.Delete(k)
+			delete(ref, k)
+		case 4:
+			seen := make(map[interface{}]interface{})
+			m.Range(func(k, v interface{}) bool {
+				if _, dup := seen[k]; dup {
+					t.Fatalf("step %d: Range visited %v twice", i, k)
+				}
+				seen[k] = v
+				return true
+			})
+			if len(seen) != len(ref) {
+				t.Fatalf("step %d: Range visited %d keys; want %d", i, len(seen), len(ref))
+			}
+			for k, v := range ref {
+				if seen[k] != v {
+					t.Fatalf("step %d: Range gave %v=%v; want %v", i, k, seen[k], v)
+				}
+			}
+		}
+	}
+}
+
+func TestMapRangeStop(t *testing.T) {
+	var m Map
+	for i := 0; i < 10; i++ {
+		m.Store(i, i)
+	}
+	n := 0
+	m.Range(func(k, v interface{}) bool {
+		n++
+		return n < 3
+	})
+	if n != 3 {
+		t.Fatalf("Range called f %d times after stop; want 3", n)
+	}
+}
+
+func TestMapConcurrentRange(t *testing.T) {
+	const mapSize = 1 << 10
+
+	m := new(Map)
+	for n := int64(1); n <= mapSize; n++ {
+		m.Store(n, int64(n))
+	}
+
+	done := make(chan struct{})
+	var wg WaitGroup
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+	for g := int64(runtime.GOMAXPROCS(0)); g > 0; g-- {
+		r := rand.New(rand.NewSource(g))
+		wg.Add(1)
+		go func(g int64) {
+			defer wg.Done()
+			for i := int64(0); ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				for n := int64(1); n < mapSize; n++ {
+					if r.Int63n(mapSize) == 0 {
+						m.Store(n, n*i*g)
+					} else {
+						m.Load(n)
+					}
+				}
+			}
+		}(g)
+	}
+
+	iters := 1 << 10
+	if testing.Short() {
+		iters = 16
+	}
+	for n := iters; n > 0; n-- {
+		seen := make(map[int64]bool, mapSize)
+
+		m.Range(func(ki, vi interface{}) bool {
+			k, v := ki.(int64), vi.(int64)
+			if v%k != 0 {
+				t.Fatalf("while Storing multiples of %v, Range saw value %v", k, v)
+			}
+			if seen[k] {
+				t.Fatalf("Range visited key %v twice", k)
+			}
+			seen[k] = true
+			return true
+		})
+
+		if len(seen) != mapSize {
+			t.Fatalf("Range visited %v elements of %v-element Map", len(seen), mapSize)
+		}
+	}
+}
+
+func TestMapLoadOrStoreOnce(t *testing.T) {
+	var m Map
+	var stored int32
+	var wg WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, loaded := m.LoadOrStore("key", i); !loaded {
+				atomic.AddInt32(&stored, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if stored != 1 {
+		t.Fatalf("LoadOrStore stored %d times; want 1", stored)
+	}
+}
+
+func BenchmarkMapLoadMostlyHits(b *testing.B) {
+	var m Map
+	for i := 0; i < 1024; i++ {
+		m.Store(i, i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Load(i % 1024)
+			i++
+		}
+	})
+}
+
+func BenchmarkRWMutexMapLoadMostlyHits(b *testing.B) {
+	var mu RWMutex
+	m := make(map[int]int)
+	for i := 0; i < 1024; i++ {
+		m[i] = i
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			mu.RLock()
+			_ = m[i%1024]
+			mu.RUnlock()
+			i++
+		}
+	})
+}
+
+func BenchmarkMapStoreDisjoint(b *testing.B) {
+	var m Map
+	var id int64
+	b.RunParallel(func(pb *testing.PB) {
+		base := atomic.AddInt64(&id, 1) << 32
+		i := int64(0)
+		for pb.Next() {
+			m.Store(base+i%1024, i)
+			i++
+		}
+	})
+}
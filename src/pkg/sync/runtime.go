@@ -13,6 +13,11 @@ import "unsafe"
 // library and should not be used directly.
 func runtime_Semacquire(s *uint32)
 
+// SemacquireMutex is like Semacquire, but for profiling contended Mutexes:
+// if the mutex profile is on, the goroutine that wakes the waiter with
+// Semrelease records how long it waited.
+func runtime_SemacquireMutex(s *uint32)
+
 // Semrelease atomically increments *s and notifies a waiting goroutine
 // if one is blocked in Semacquire.
 // It is intended as a simple wakeup primitive for use by the synchronization
@@ -0,0 +1,150 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package semaphore provides a weighted semaphore, which bounds
+// access to a resource shared by goroutines that each need some
+// number of units of it.
+package semaphore
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrCanceled is returned by Acquire when its cancel channel is
+// closed before the semaphore could be acquired.
+var ErrCanceled = errors.New("semaphore: acquire canceled")
+
+type waiter struct {
+	n     int64
+	ready chan struct{} // closed when the semaphore is acquired
+}
+
+// Weighted provides a way to bound concurrent access to a resource.
+// The callers can request access with a given weight.
+//
+// Waiters are served in the order in which they called Acquire, so
+// that a large request is not starved by a stream of small ones.
+type Weighted struct {
+	size    int64
+	cur     int64
+	mu      sync.Mutex
+	waiters []*waiter
+}
+
+// NewWeighted creates a new weighted semaphore with the given
+// maximum combined weight for concurrent access.
+func NewWeighted(n int64) *Weighted {
+	return &Weighted{size: n}
+}
+
+// Acquire acquires the semaphore with a weight of n, blocking until
+// resources are available or cancel is closed.  On success it
+// returns nil.  If cancel is closed first, Acquire returns
+// ErrCanceled and leaves the semaphore unchanged.  A nil cancel
+// channel is never closed: a request for more than the semaphore's
+// size then blocks forever.
+func (s *Weighted) Acquire(n int64, cancel <-chan struct{}) error {
+	s.mu.Lock()
+	if s.size-s.cur >= n && len(s.waiters) == 0 {
+		s.cur += n
+		s.mu.Unlock()
+		return nil
+	}
+
+	if n > s.size {
+		// Don't make other Acquire calls block on one that's
+		// doomed to fail.
+		s.mu.Unlock()
+		<-cancel
+		return ErrCanceled
+	}
+
+	w := &waiter{n: n, ready: make(chan struct{})}
+	s.waiters = append(s.waiters, w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-cancel:
+	}
+
+	s.mu.Lock()
+	select {
+	case <-w.ready:
+		// Acquired the semaphore after we were canceled.
+		// Pretend we didn't and put the tokens back.
+		s.cur -= n
+		s.notifyWaiters()
+	default:
+		isFront := s.waiters[0] == w
+		s.removeWaiter(w)
+		// If we're at the front and there are extra tokens
+		// left, notify the other waiters.
+		if isFront && s.size > s.cur {
+			s.notifyWaiters()
+		}
+	}
+	s.mu.Unlock()
+	return ErrCanceled
+}
+
+// TryAcquire acquires the semaphore with a weight of n without
+// blocking.  On success, it returns true.  On failure, it returns
+// false and leaves the semaphore unchanged.
+func (s *Weighted) TryAcquire(n int64) bool {
+	s.mu.Lock()
+	success := s.size-s.cur >= n && len(s.waiters) == 0
+	if success {
+		s.cur += n
+	}
+	s.mu.Unlock()
+	return success
+}
+
+// Release releases the semaphore with a weight of n.
+// It panics if that would release more than is held.
+func (s *Weighted) Release(n int64) {
+	s.mu.Lock()
+	s.cur -= n
+	if s.cur < 0 {
+		s.mu.Unlock()
+		panic("semaphore: released more than held")
+	}
+	s.notifyWaiters()
+	s.mu.Unlock()
+}
+
+// notifyWaiters wakes waiters in order for as long as the next one
+// fits.  s.mu must be held.
+func (s *Weighted) notifyWaiters() {
+	for len(s.waiters) > 0 {
+		w := s.waiters[0]
+		if s.size-s.cur < w.n {
+			// Not enough tokens for the next waiter.  We could
+			// keep going (to try to find a waiter with a smaller
+			// request), but under load that could cause
+			// starvation for large requests; instead, we leave
+			// all remaining waiters blocked.
+			break
+		}
+		s.cur += w.n
+		s.waiters[0] = nil
+		s.waiters = s.waiters[1:]
+		close(w.ready)
+	}
+}
+
+// removeWaiter removes w from the queue.  s.mu must be held.
+func (s *Weighted) removeWaiter(w *waiter) {
+	for i, x := range s.waiters {
+		if x == w {
+			copy(s.waiters[i:], s.waiters[i+1:])
+			s.waiters[len(s.waiters)-1] = nil
+			s.waiters = s.waiters[:len(s.waiters)-1]
+			return
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semaphore_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	. "sync/semaphore"
+	"testing"
+	"time"
+)
+
+func TestWeighted(t *testing.T) {
+	const (
+		n     = 16
+		loops = 1000
+	)
+	sem := NewWeighted(n)
+	var cur int64
+	var wg sync.WaitGroup
+	for i := 0; i < 2*n; i++ {
+		w := int64(i%n + 1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < loops; j++ {
+				if err := sem.Acquire(w, nil); err != nil {
+					t.Error(err)
+					return
+				}
+				c := atomic.AddInt64(&cur, w)
+				if c > n {
+					t.Errorf("holding %d units of a semaphore of size %d", c, n)
+				}
+				runtime.Gosched()
+				atomic.AddInt64(&cur, -w)
+				sem.Release(w)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWeightedTryAcquire(t *testing.T) {
+	sem := NewWeighted(2)
+	tries := []bool{}
+	sem.Acquire(1, nil)
+	tries = append(tries, sem.TryAcquire(1))
+	tries = append(tries, sem.TryAcquire(1))
+
+	sem.Release(2)
+
+	tries = append(tries, sem.TryAcquire(1))
+	sem.Acquire(1, nil)
+	tries = append(tries, sem.TryAcquire(1))
+
+	want := []bool{true, false, true, false}
+	for i := range tries {
+		if tries[i] != want[i] {
+			t.Errorf("tries[%d]: got %t, want %t", i, tries[i], want[i])
+		}
+	}
+}
+
+func TestWeightedReleasePanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("release of an unacquired weighted semaphore did not panic")
+		}
+	}()
+	w := NewWeighted(1)
+	w.Release(1)
+}
+
+func TestWeightedAcquireCanceled(t *testing.T) {
+	sem := NewWeighted(2)
+	if err := sem.Acquire(2, nil); err != nil {
+		t.Fatal(err)
+	}
+	cancel := make(chan struct{})
+	errc := make(chan error, 1)
+	go func() {
+		errc <- sem.Acquire(1, cancel)
+	}()
+	select {
+	case err := <-errc:
+		t.Fatalf("Acquire on a full semaphore returned %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(cancel)
+	if err := <-errc; err != ErrCanceled {
+		t.Fatalf("canceled Acquire returned %v; want %v", err, ErrCanceled)
+	}
+
+	// The canceled waiter must not hold anything.
+	sem.Release(2)
+	if !sem.TryAcquire(2) {
+		t.Fatal("TryAcquire failed after canceled waiter and full release")
+	}
+
+	// A request larger than the semaphore waits only for cancel.
+	close2 := make(chan struct{})
+	close(close2)
+	if err := NewWeighted(1).Acquire(2, close2); err != ErrCanceled {
+		t.Fatalf("Acquire(2) of size-1 semaphore returned %v; want %v", err, ErrCanceled)
+	}
+}
+
+// TestWeightedFIFO checks that a large request is not starved by a
+// stream of small ones, and that canceling the waiter at the front
+// of the queue wakes those behind it.
+func TestWeightedFIFO(t *testing.T) {
+	sem := NewWeighted(2)
+	if err := sem.Acquire(1, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel := make(chan struct{})
+	bigErr := make(chan error, 1)
+	go func() {
+		bigErr <- sem.Acquire(2, cancel)
+	}()
+	for sem.TryAcquire(1) {
+		// Until the big waiter queues up, a small request can
+		// still succeed; give the unit back and try again.
+		sem.Release(1)
+		runtime.Gosched()
+	}
+
+	smallDone := make(chan struct{})
+	go func() {
+		sem.Acquire(1, nil)
+		close(smallDone)
+	}()
+	select {
+	case <-smallDone:
+		t.Fatal("small Acquire overtook a queued large one")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(cancel)
+	if err := <-bigErr; err != ErrCanceled {
+		t.Fatalf("large Acquire returned %v; want %v", err, ErrCanceled)
+	}
+	select {
+	case <-smallDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("small Acquire not woken after the waiter ahead of it was canceled")
+	}
+}
+
+func BenchmarkWeightedAcquireRelease(b *testing.B) {
+	sem := NewWeighted(1)
+	for i := 0; i < b.N; i++ {
+		sem.Acquire(1, nil)
+		sem.Release(1)
+	}
+}